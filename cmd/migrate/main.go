@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"unicode/utf8"
 
 	"github.com/joho/godotenv"
 	_ "github.com/lib/pq"
@@ -12,8 +13,55 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql/schema"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	_ "github.com/kiminodare/HOVARLAY-BE/ent/generated/runtime"
+	entschema "github.com/kiminodare/HOVARLAY-BE/ent/schema"
+	"github.com/kiminodare/HOVARLAY-BE/internal/langid"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/quota"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/voice"
 )
 
+// backfillHistories mengisi kolom turunan (content_hash, plain_text,
+// char_count dan bahasa) untuk history lama, termasuk yang ada di trash.
+func backfillHistories(ctx context.Context, client *generated.Client) (int, error) {
+	ctx = entschema.SkipSoftDelete(ctx)
+	total := 0
+	for {
+		rows, err := client.History.Query().
+			Where(history.Or(
+				history.ContentHashIsNil(),
+				history.ContentHash(""),
+				history.PlainTextIsNil(),
+				history.LanguageConfidenceIsNil(),
+			)).
+			Limit(500).
+			All(ctx)
+		if err != nil || len(rows) == 0 {
+			return total, err
+		}
+		for _, h := range rows {
+			plain, err := entschema.HistoryPlainText(h.Text, h.Format)
+			if err != nil {
+				// SSML yang rusak tetap bisa dicari lewat teks mentahnya.
+				plain = h.Text
+			}
+			detected := langid.Detect(plain)
+			err = client.History.UpdateOneID(h.ID).
+				SetContentHash(entschema.HistoryContentHash(h.Text, h.Voice)).
+				SetPlainText(plain).
+				SetCharCount(utf8.RuneCountInString(plain)).
+				SetLanguage(detected.Language).
+				SetLanguageConfidence(detected.Confidence).
+				SetUpdatedAt(h.UpdatedAt).
+				Exec(ctx)
+			if err != nil {
+				return total, err
+			}
+		}
+		total += len(rows)
+	}
+}
+
 func buildPostgresDSN(host, port, user, pass, name, ssl string) string {
 	return fmt.Sprintf(
 		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
@@ -67,5 +115,27 @@ func main() {
 		log.Fatalf("❌ migration failed: %v", err)
 	}
 
+	seeded, err := voice.NewService(voice.NewVoiceRepository(client)).Seed(ctx, os.Getenv("VOICE_CATALOG_FILE"))
+	if err != nil {
+		log.Fatalf("❌ voice catalog seed failed: %v", err)
+	}
+	log.Printf("🎙️ Voice catalog: %d created, %d updated, %d disabled", seeded.Created, seeded.Updated, seeded.Disabled)
+
+	plans, err := quota.NewService(quota.NewQuotaRepository(client)).SeedPlans(ctx)
+	if err != nil {
+		log.Fatalf("❌ plan seed failed: %v", err)
+	}
+	if plans > 0 {
+		log.Printf("💳 Created %d plans", plans)
+	}
+
+	n, err := backfillHistories(ctx, client)
+	if err != nil {
+		log.Fatalf("❌ history backfill failed: %v", err)
+	}
+	if n > 0 {
+		log.Printf("🔁 Backfilled derived fields for %d histories", n)
+	}
+
 	log.Printf("✅ Migration successful for %s environment", appEnv)
 }
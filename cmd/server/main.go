@@ -5,6 +5,8 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
 	"github.com/kiminodare/HOVARLAY-BE/internal/middleware"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/quota"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/render"
 	"github.com/kiminodare/HOVARLAY-BE/internal/routes"
 	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
 	"log"
@@ -49,7 +51,8 @@ func main() {
 				return false
 			},
 			AllowMethods:     "GET,POST,PUT,PATCH,DELETE,OPTIONS",
-			AllowHeaders:     "Origin,Content-Type,Authorization,Accept",
+			AllowHeaders:     "Origin,Content-Type,Authorization,Accept,Idempotency-Key",
+			ExposeHeaders:    strings.Join(append([]string{"Idempotent-Replayed", render.HeaderDuration}, quota.ExposedHeaders...), ","),
 			AllowCredentials: true,
 		},
 	))
@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/audiorender"
+	"github.com/kiminodare/HOVARLAY-BE/internal/synth"
+)
+
+// AudioRender is the model entity for the AudioRender schema.
+type AudioRender struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CacheKey holds the value of the "cache_key" field.
+	CacheKey string `json:"cacheKey"`
+	// BlobKey holds the value of the "blob_key" field.
+	BlobKey string `json:"blobKey"`
+	// ContentType holds the value of the "content_type" field.
+	ContentType string `json:"contentType"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// DurationMs holds the value of the "duration_ms" field.
+	DurationMs int64 `json:"durationMs"`
+	// Words holds the value of the "words" field.
+	Words []synth.WordTiming `json:"words,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updatedAt"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AudioRender) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case audiorender.FieldWords:
+			values[i] = new([]byte)
+		case audiorender.FieldSize, audiorender.FieldDurationMs:
+			values[i] = new(sql.NullInt64)
+		case audiorender.FieldCacheKey, audiorender.FieldBlobKey, audiorender.FieldContentType:
+			values[i] = new(sql.NullString)
+		case audiorender.FieldCreatedAt, audiorender.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case audiorender.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AudioRender fields.
+func (_m *AudioRender) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case audiorender.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case audiorender.FieldCacheKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cache_key", values[i])
+			} else if value.Valid {
+				_m.CacheKey = value.String
+			}
+		case audiorender.FieldBlobKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field blob_key", values[i])
+			} else if value.Valid {
+				_m.BlobKey = value.String
+			}
+		case audiorender.FieldContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_type", values[i])
+			} else if value.Valid {
+				_m.ContentType = value.String
+			}
+		case audiorender.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				_m.Size = value.Int64
+			}
+		case audiorender.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				_m.DurationMs = value.Int64
+			}
+		case audiorender.FieldWords:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field words", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Words); err != nil {
+					return fmt.Errorf("unmarshal field words: %w", err)
+				}
+			}
+		case audiorender.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case audiorender.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AudioRender.
+// This includes values selected through modifiers, order, etc.
+func (_m *AudioRender) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AudioRender.
+// Note that you need to call AudioRender.Unwrap() before calling this method if this AudioRender
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AudioRender) Update() *AudioRenderUpdateOne {
+	return NewAudioRenderClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AudioRender entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AudioRender) Unwrap() *AudioRender {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: AudioRender is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AudioRender) String() string {
+	var builder strings.Builder
+	builder.WriteString("AudioRender(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("cache_key=")
+	builder.WriteString(_m.CacheKey)
+	builder.WriteString(", ")
+	builder.WriteString("blob_key=")
+	builder.WriteString(_m.BlobKey)
+	builder.WriteString(", ")
+	builder.WriteString("content_type=")
+	builder.WriteString(_m.ContentType)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Size))
+	builder.WriteString(", ")
+	builder.WriteString("duration_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DurationMs))
+	builder.WriteString(", ")
+	builder.WriteString("words=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Words))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AudioRenders is a parsable slice of AudioRender.
+type AudioRenders []*AudioRender
@@ -0,0 +1,122 @@
+// Code generated by ent, DO NOT EDIT.
+
+package audiorender
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the audiorender type in the database.
+	Label = "audio_render"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCacheKey holds the string denoting the cache_key field in the database.
+	FieldCacheKey = "cache_key"
+	// FieldBlobKey holds the string denoting the blob_key field in the database.
+	FieldBlobKey = "blob_key"
+	// FieldContentType holds the string denoting the content_type field in the database.
+	FieldContentType = "content_type"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldDurationMs holds the string denoting the duration_ms field in the database.
+	FieldDurationMs = "duration_ms"
+	// FieldWords holds the string denoting the words field in the database.
+	FieldWords = "words"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the audiorender in the database.
+	Table = "audio_renders"
+)
+
+// Columns holds all SQL columns for audiorender fields.
+var Columns = []string{
+	FieldID,
+	FieldCacheKey,
+	FieldBlobKey,
+	FieldContentType,
+	FieldSize,
+	FieldDurationMs,
+	FieldWords,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CacheKeyValidator is a validator for the "cache_key" field. It is called by the builders before save.
+	CacheKeyValidator func(string) error
+	// BlobKeyValidator is a validator for the "blob_key" field. It is called by the builders before save.
+	BlobKeyValidator func(string) error
+	// ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	ContentTypeValidator func(string) error
+	// SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	SizeValidator func(int64) error
+	// DurationMsValidator is a validator for the "duration_ms" field. It is called by the builders before save.
+	DurationMsValidator func(int64) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the AudioRender queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCacheKey orders the results by the cache_key field.
+func ByCacheKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCacheKey, opts...).ToFunc()
+}
+
+// ByBlobKey orders the results by the blob_key field.
+func ByBlobKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlobKey, opts...).ToFunc()
+}
+
+// ByContentType orders the results by the content_type field.
+func ByContentType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentType, opts...).ToFunc()
+}
+
+// BySize orders the results by the size field.
+func BySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSize, opts...).ToFunc()
+}
+
+// ByDurationMs orders the results by the duration_ms field.
+func ByDurationMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationMs, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
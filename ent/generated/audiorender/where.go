@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package audiorender
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldLTE(FieldID, id))
+}
+
+// CacheKey applies equality check predicate on the "cache_key" field. It's identical to CacheKeyEQ.
+func CacheKey(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldEQ(FieldCacheKey, v))
+}
+
+// BlobKey applies equality check predicate on the "blob_key" field. It's identical to BlobKeyEQ.
+func BlobKey(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldEQ(FieldBlobKey, v))
+}
+
+// ContentType applies equality check predicate on the "content_type" field. It's identical to ContentTypeEQ.
+func ContentType(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldEQ(FieldContentType, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldEQ(FieldSize, v))
+}
+
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v int64) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CacheKeyEQ applies the EQ predicate on the "cache_key" field.
+func CacheKeyEQ(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldEQ(FieldCacheKey, v))
+}
+
+// CacheKeyNEQ applies the NEQ predicate on the "cache_key" field.
+func CacheKeyNEQ(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldNEQ(FieldCacheKey, v))
+}
+
+// CacheKeyIn applies the In predicate on the "cache_key" field.
+func CacheKeyIn(vs ...string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldIn(FieldCacheKey, vs...))
+}
+
+// CacheKeyNotIn applies the NotIn predicate on the "cache_key" field.
+func CacheKeyNotIn(vs ...string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldNotIn(FieldCacheKey, vs...))
+}
+
+// CacheKeyGT applies the GT predicate on the "cache_key" field.
+func CacheKeyGT(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldGT(FieldCacheKey, v))
+}
+
+// CacheKeyGTE applies the GTE predicate on the "cache_key" field.
+func CacheKeyGTE(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldGTE(FieldCacheKey, v))
+}
+
+// CacheKeyLT applies the LT predicate on the "cache_key" field.
+func CacheKeyLT(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldLT(FieldCacheKey, v))
+}
+
+// CacheKeyLTE applies the LTE predicate on the "cache_key" field.
+func CacheKeyLTE(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldLTE(FieldCacheKey, v))
+}
+
+// CacheKeyContains applies the Contains predicate on the "cache_key" field.
+func CacheKeyContains(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldContains(FieldCacheKey, v))
+}
+
+// CacheKeyHasPrefix applies the HasPrefix predicate on the "cache_key" field.
+func CacheKeyHasPrefix(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldHasPrefix(FieldCacheKey, v))
+}
+
+// CacheKeyHasSuffix applies the HasSuffix predicate on the "cache_key" field.
+func CacheKeyHasSuffix(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldHasSuffix(FieldCacheKey, v))
+}
+
+// CacheKeyEqualFold applies the EqualFold predicate on the "cache_key" field.
+func CacheKeyEqualFold(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldEqualFold(FieldCacheKey, v))
+}
+
+// CacheKeyContainsFold applies the ContainsFold predicate on the "cache_key" field.
+func CacheKeyContainsFold(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldContainsFold(FieldCacheKey, v))
+}
+
+// BlobKeyEQ applies the EQ predicate on the "blob_key" field.
+func BlobKeyEQ(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldEQ(FieldBlobKey, v))
+}
+
+// BlobKeyNEQ applies the NEQ predicate on the "blob_key" field.
+func BlobKeyNEQ(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldNEQ(FieldBlobKey, v))
+}
+
+// BlobKeyIn applies the In predicate on the "blob_key" field.
+func BlobKeyIn(vs ...string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldIn(FieldBlobKey, vs...))
+}
+
+// BlobKeyNotIn applies the NotIn predicate on the "blob_key" field.
+func BlobKeyNotIn(vs ...string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldNotIn(FieldBlobKey, vs...))
+}
+
+// BlobKeyGT applies the GT predicate on the "blob_key" field.
+func BlobKeyGT(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldGT(FieldBlobKey, v))
+}
+
+// BlobKeyGTE applies the GTE predicate on the "blob_key" field.
+func BlobKeyGTE(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldGTE(FieldBlobKey, v))
+}
+
+// BlobKeyLT applies the LT predicate on the "blob_key" field.
+func BlobKeyLT(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldLT(FieldBlobKey, v))
+}
+
+// BlobKeyLTE applies the LTE predicate on the "blob_key" field.
+func BlobKeyLTE(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldLTE(FieldBlobKey, v))
+}
+
+// BlobKeyContains applies the Contains predicate on the "blob_key" field.
+func BlobKeyContains(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldContains(FieldBlobKey, v))
+}
+
+// BlobKeyHasPrefix applies the HasPrefix predicate on the "blob_key" field.
+func BlobKeyHasPrefix(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldHasPrefix(FieldBlobKey, v))
+}
+
+// BlobKeyHasSuffix applies the HasSuffix predicate on the "blob_key" field.
+func BlobKeyHasSuffix(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldHasSuffix(FieldBlobKey, v))
+}
+
+// BlobKeyEqualFold applies the EqualFold predicate on the "blob_key" field.
+func BlobKeyEqualFold(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldEqualFold(FieldBlobKey, v))
+}
+
+// BlobKeyContainsFold applies the ContainsFold predicate on the "blob_key" field.
+func BlobKeyContainsFold(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldContainsFold(FieldBlobKey, v))
+}
+
+// ContentTypeEQ applies the EQ predicate on the "content_type" field.
+func ContentTypeEQ(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldEQ(FieldContentType, v))
+}
+
+// ContentTypeNEQ applies the NEQ predicate on the "content_type" field.
+func ContentTypeNEQ(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldNEQ(FieldContentType, v))
+}
+
+// ContentTypeIn applies the In predicate on the "content_type" field.
+func ContentTypeIn(vs ...string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldIn(FieldContentType, vs...))
+}
+
+// ContentTypeNotIn applies the NotIn predicate on the "content_type" field.
+func ContentTypeNotIn(vs ...string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldNotIn(FieldContentType, vs...))
+}
+
+// ContentTypeGT applies the GT predicate on the "content_type" field.
+func ContentTypeGT(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldGT(FieldContentType, v))
+}
+
+// ContentTypeGTE applies the GTE predicate on the "content_type" field.
+func ContentTypeGTE(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldGTE(FieldContentType, v))
+}
+
+// ContentTypeLT applies the LT predicate on the "content_type" field.
+func ContentTypeLT(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldLT(FieldContentType, v))
+}
+
+// ContentTypeLTE applies the LTE predicate on the "content_type" field.
+func ContentTypeLTE(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldLTE(FieldContentType, v))
+}
+
+// ContentTypeContains applies the Contains predicate on the "content_type" field.
+func ContentTypeContains(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldContains(FieldContentType, v))
+}
+
+// ContentTypeHasPrefix applies the HasPrefix predicate on the "content_type" field.
+func ContentTypeHasPrefix(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldHasPrefix(FieldContentType, v))
+}
+
+// ContentTypeHasSuffix applies the HasSuffix predicate on the "content_type" field.
+func ContentTypeHasSuffix(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldHasSuffix(FieldContentType, v))
+}
+
+// ContentTypeEqualFold applies the EqualFold predicate on the "content_type" field.
+func ContentTypeEqualFold(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldEqualFold(FieldContentType, v))
+}
+
+// ContentTypeContainsFold applies the ContainsFold predicate on the "content_type" field.
+func ContentTypeContainsFold(v string) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldContainsFold(FieldContentType, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldLTE(FieldSize, v))
+}
+
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v int64) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v int64) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...int64) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...int64) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v int64) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v int64) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v int64) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v int64) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// WordsIsNil applies the IsNil predicate on the "words" field.
+func WordsIsNil() predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldIsNull(FieldWords))
+}
+
+// WordsNotNil applies the NotNil predicate on the "words" field.
+func WordsNotNil() predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldNotNull(FieldWords))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AudioRender {
+	return predicate.AudioRender(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AudioRender) predicate.AudioRender {
+	return predicate.AudioRender(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AudioRender) predicate.AudioRender {
+	return predicate.AudioRender(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AudioRender) predicate.AudioRender {
+	return predicate.AudioRender(sql.NotPredicates(p))
+}
@@ -0,0 +1,352 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/audiorender"
+	"github.com/kiminodare/HOVARLAY-BE/internal/synth"
+)
+
+// AudioRenderCreate is the builder for creating a AudioRender entity.
+type AudioRenderCreate struct {
+	config
+	mutation *AudioRenderMutation
+	hooks    []Hook
+}
+
+// SetCacheKey sets the "cache_key" field.
+func (_c *AudioRenderCreate) SetCacheKey(v string) *AudioRenderCreate {
+	_c.mutation.SetCacheKey(v)
+	return _c
+}
+
+// SetBlobKey sets the "blob_key" field.
+func (_c *AudioRenderCreate) SetBlobKey(v string) *AudioRenderCreate {
+	_c.mutation.SetBlobKey(v)
+	return _c
+}
+
+// SetContentType sets the "content_type" field.
+func (_c *AudioRenderCreate) SetContentType(v string) *AudioRenderCreate {
+	_c.mutation.SetContentType(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *AudioRenderCreate) SetSize(v int64) *AudioRenderCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_c *AudioRenderCreate) SetDurationMs(v int64) *AudioRenderCreate {
+	_c.mutation.SetDurationMs(v)
+	return _c
+}
+
+// SetWords sets the "words" field.
+func (_c *AudioRenderCreate) SetWords(v []synth.WordTiming) *AudioRenderCreate {
+	_c.mutation.SetWords(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AudioRenderCreate) SetCreatedAt(v time.Time) *AudioRenderCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AudioRenderCreate) SetNillableCreatedAt(v *time.Time) *AudioRenderCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AudioRenderCreate) SetUpdatedAt(v time.Time) *AudioRenderCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AudioRenderCreate) SetNillableUpdatedAt(v *time.Time) *AudioRenderCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AudioRenderCreate) SetID(v uuid.UUID) *AudioRenderCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AudioRenderCreate) SetNillableID(v *uuid.UUID) *AudioRenderCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AudioRenderMutation object of the builder.
+func (_c *AudioRenderCreate) Mutation() *AudioRenderMutation {
+	return _c.mutation
+}
+
+// Save creates the AudioRender in the database.
+func (_c *AudioRenderCreate) Save(ctx context.Context) (*AudioRender, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AudioRenderCreate) SaveX(ctx context.Context) *AudioRender {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AudioRenderCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AudioRenderCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AudioRenderCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := audiorender.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := audiorender.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := audiorender.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AudioRenderCreate) check() error {
+	if _, ok := _c.mutation.CacheKey(); !ok {
+		return &ValidationError{Name: "cache_key", err: errors.New(`generated: missing required field "AudioRender.cache_key"`)}
+	}
+	if v, ok := _c.mutation.CacheKey(); ok {
+		if err := audiorender.CacheKeyValidator(v); err != nil {
+			return &ValidationError{Name: "cache_key", err: fmt.Errorf(`generated: validator failed for field "AudioRender.cache_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BlobKey(); !ok {
+		return &ValidationError{Name: "blob_key", err: errors.New(`generated: missing required field "AudioRender.blob_key"`)}
+	}
+	if v, ok := _c.mutation.BlobKey(); ok {
+		if err := audiorender.BlobKeyValidator(v); err != nil {
+			return &ValidationError{Name: "blob_key", err: fmt.Errorf(`generated: validator failed for field "AudioRender.blob_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ContentType(); !ok {
+		return &ValidationError{Name: "content_type", err: errors.New(`generated: missing required field "AudioRender.content_type"`)}
+	}
+	if v, ok := _c.mutation.ContentType(); ok {
+		if err := audiorender.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`generated: validator failed for field "AudioRender.content_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`generated: missing required field "AudioRender.size"`)}
+	}
+	if v, ok := _c.mutation.Size(); ok {
+		if err := audiorender.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`generated: validator failed for field "AudioRender.size": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DurationMs(); !ok {
+		return &ValidationError{Name: "duration_ms", err: errors.New(`generated: missing required field "AudioRender.duration_ms"`)}
+	}
+	if v, ok := _c.mutation.DurationMs(); ok {
+		if err := audiorender.DurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_ms", err: fmt.Errorf(`generated: validator failed for field "AudioRender.duration_ms": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "AudioRender.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "AudioRender.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *AudioRenderCreate) sqlSave(ctx context.Context) (*AudioRender, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AudioRenderCreate) createSpec() (*AudioRender, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AudioRender{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(audiorender.Table, sqlgraph.NewFieldSpec(audiorender.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CacheKey(); ok {
+		_spec.SetField(audiorender.FieldCacheKey, field.TypeString, value)
+		_node.CacheKey = value
+	}
+	if value, ok := _c.mutation.BlobKey(); ok {
+		_spec.SetField(audiorender.FieldBlobKey, field.TypeString, value)
+		_node.BlobKey = value
+	}
+	if value, ok := _c.mutation.ContentType(); ok {
+		_spec.SetField(audiorender.FieldContentType, field.TypeString, value)
+		_node.ContentType = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(audiorender.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.DurationMs(); ok {
+		_spec.SetField(audiorender.FieldDurationMs, field.TypeInt64, value)
+		_node.DurationMs = value
+	}
+	if value, ok := _c.mutation.Words(); ok {
+		_spec.SetField(audiorender.FieldWords, field.TypeJSON, value)
+		_node.Words = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(audiorender.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(audiorender.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// AudioRenderCreateBulk is the builder for creating many AudioRender entities in bulk.
+type AudioRenderCreateBulk struct {
+	config
+	err      error
+	builders []*AudioRenderCreate
+}
+
+// Save creates the AudioRender entities in the database.
+func (_c *AudioRenderCreateBulk) Save(ctx context.Context) ([]*AudioRender, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AudioRender, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AudioRenderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AudioRenderCreateBulk) SaveX(ctx context.Context) []*AudioRender {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AudioRenderCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AudioRenderCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
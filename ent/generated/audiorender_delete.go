@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/audiorender"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+)
+
+// AudioRenderDelete is the builder for deleting a AudioRender entity.
+type AudioRenderDelete struct {
+	config
+	hooks    []Hook
+	mutation *AudioRenderMutation
+}
+
+// Where appends a list predicates to the AudioRenderDelete builder.
+func (_d *AudioRenderDelete) Where(ps ...predicate.AudioRender) *AudioRenderDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AudioRenderDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AudioRenderDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AudioRenderDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(audiorender.Table, sqlgraph.NewFieldSpec(audiorender.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AudioRenderDeleteOne is the builder for deleting a single AudioRender entity.
+type AudioRenderDeleteOne struct {
+	_d *AudioRenderDelete
+}
+
+// Where appends a list predicates to the AudioRenderDelete builder.
+func (_d *AudioRenderDeleteOne) Where(ps ...predicate.AudioRender) *AudioRenderDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AudioRenderDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{audiorender.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AudioRenderDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,538 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/audiorender"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+	"github.com/kiminodare/HOVARLAY-BE/internal/synth"
+)
+
+// AudioRenderUpdate is the builder for updating AudioRender entities.
+type AudioRenderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AudioRenderMutation
+}
+
+// Where appends a list predicates to the AudioRenderUpdate builder.
+func (_u *AudioRenderUpdate) Where(ps ...predicate.AudioRender) *AudioRenderUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetBlobKey sets the "blob_key" field.
+func (_u *AudioRenderUpdate) SetBlobKey(v string) *AudioRenderUpdate {
+	_u.mutation.SetBlobKey(v)
+	return _u
+}
+
+// SetNillableBlobKey sets the "blob_key" field if the given value is not nil.
+func (_u *AudioRenderUpdate) SetNillableBlobKey(v *string) *AudioRenderUpdate {
+	if v != nil {
+		_u.SetBlobKey(*v)
+	}
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *AudioRenderUpdate) SetContentType(v string) *AudioRenderUpdate {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *AudioRenderUpdate) SetNillableContentType(v *string) *AudioRenderUpdate {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *AudioRenderUpdate) SetSize(v int64) *AudioRenderUpdate {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *AudioRenderUpdate) SetNillableSize(v *int64) *AudioRenderUpdate {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *AudioRenderUpdate) AddSize(v int64) *AudioRenderUpdate {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *AudioRenderUpdate) SetDurationMs(v int64) *AudioRenderUpdate {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *AudioRenderUpdate) SetNillableDurationMs(v *int64) *AudioRenderUpdate {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *AudioRenderUpdate) AddDurationMs(v int64) *AudioRenderUpdate {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// SetWords sets the "words" field.
+func (_u *AudioRenderUpdate) SetWords(v []synth.WordTiming) *AudioRenderUpdate {
+	_u.mutation.SetWords(v)
+	return _u
+}
+
+// AppendWords appends value to the "words" field.
+func (_u *AudioRenderUpdate) AppendWords(v []synth.WordTiming) *AudioRenderUpdate {
+	_u.mutation.AppendWords(v)
+	return _u
+}
+
+// ClearWords clears the value of the "words" field.
+func (_u *AudioRenderUpdate) ClearWords() *AudioRenderUpdate {
+	_u.mutation.ClearWords()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AudioRenderUpdate) SetCreatedAt(v time.Time) *AudioRenderUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AudioRenderUpdate) SetNillableCreatedAt(v *time.Time) *AudioRenderUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AudioRenderUpdate) SetUpdatedAt(v time.Time) *AudioRenderUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the AudioRenderMutation object of the builder.
+func (_u *AudioRenderUpdate) Mutation() *AudioRenderMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AudioRenderUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AudioRenderUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AudioRenderUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AudioRenderUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AudioRenderUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := audiorender.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AudioRenderUpdate) check() error {
+	if v, ok := _u.mutation.BlobKey(); ok {
+		if err := audiorender.BlobKeyValidator(v); err != nil {
+			return &ValidationError{Name: "blob_key", err: fmt.Errorf(`generated: validator failed for field "AudioRender.blob_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentType(); ok {
+		if err := audiorender.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`generated: validator failed for field "AudioRender.content_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Size(); ok {
+		if err := audiorender.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`generated: validator failed for field "AudioRender.size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DurationMs(); ok {
+		if err := audiorender.DurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_ms", err: fmt.Errorf(`generated: validator failed for field "AudioRender.duration_ms": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AudioRenderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(audiorender.Table, audiorender.Columns, sqlgraph.NewFieldSpec(audiorender.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.BlobKey(); ok {
+		_spec.SetField(audiorender.FieldBlobKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(audiorender.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(audiorender.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(audiorender.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(audiorender.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(audiorender.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Words(); ok {
+		_spec.SetField(audiorender.FieldWords, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, audiorender.FieldWords, value)
+		})
+	}
+	if _u.mutation.WordsCleared() {
+		_spec.ClearField(audiorender.FieldWords, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(audiorender.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(audiorender.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{audiorender.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AudioRenderUpdateOne is the builder for updating a single AudioRender entity.
+type AudioRenderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AudioRenderMutation
+}
+
+// SetBlobKey sets the "blob_key" field.
+func (_u *AudioRenderUpdateOne) SetBlobKey(v string) *AudioRenderUpdateOne {
+	_u.mutation.SetBlobKey(v)
+	return _u
+}
+
+// SetNillableBlobKey sets the "blob_key" field if the given value is not nil.
+func (_u *AudioRenderUpdateOne) SetNillableBlobKey(v *string) *AudioRenderUpdateOne {
+	if v != nil {
+		_u.SetBlobKey(*v)
+	}
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *AudioRenderUpdateOne) SetContentType(v string) *AudioRenderUpdateOne {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *AudioRenderUpdateOne) SetNillableContentType(v *string) *AudioRenderUpdateOne {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *AudioRenderUpdateOne) SetSize(v int64) *AudioRenderUpdateOne {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *AudioRenderUpdateOne) SetNillableSize(v *int64) *AudioRenderUpdateOne {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *AudioRenderUpdateOne) AddSize(v int64) *AudioRenderUpdateOne {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *AudioRenderUpdateOne) SetDurationMs(v int64) *AudioRenderUpdateOne {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *AudioRenderUpdateOne) SetNillableDurationMs(v *int64) *AudioRenderUpdateOne {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *AudioRenderUpdateOne) AddDurationMs(v int64) *AudioRenderUpdateOne {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// SetWords sets the "words" field.
+func (_u *AudioRenderUpdateOne) SetWords(v []synth.WordTiming) *AudioRenderUpdateOne {
+	_u.mutation.SetWords(v)
+	return _u
+}
+
+// AppendWords appends value to the "words" field.
+func (_u *AudioRenderUpdateOne) AppendWords(v []synth.WordTiming) *AudioRenderUpdateOne {
+	_u.mutation.AppendWords(v)
+	return _u
+}
+
+// ClearWords clears the value of the "words" field.
+func (_u *AudioRenderUpdateOne) ClearWords() *AudioRenderUpdateOne {
+	_u.mutation.ClearWords()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AudioRenderUpdateOne) SetCreatedAt(v time.Time) *AudioRenderUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AudioRenderUpdateOne) SetNillableCreatedAt(v *time.Time) *AudioRenderUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AudioRenderUpdateOne) SetUpdatedAt(v time.Time) *AudioRenderUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the AudioRenderMutation object of the builder.
+func (_u *AudioRenderUpdateOne) Mutation() *AudioRenderMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AudioRenderUpdate builder.
+func (_u *AudioRenderUpdateOne) Where(ps ...predicate.AudioRender) *AudioRenderUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AudioRenderUpdateOne) Select(field string, fields ...string) *AudioRenderUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AudioRender entity.
+func (_u *AudioRenderUpdateOne) Save(ctx context.Context) (*AudioRender, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AudioRenderUpdateOne) SaveX(ctx context.Context) *AudioRender {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AudioRenderUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AudioRenderUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AudioRenderUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := audiorender.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AudioRenderUpdateOne) check() error {
+	if v, ok := _u.mutation.BlobKey(); ok {
+		if err := audiorender.BlobKeyValidator(v); err != nil {
+			return &ValidationError{Name: "blob_key", err: fmt.Errorf(`generated: validator failed for field "AudioRender.blob_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentType(); ok {
+		if err := audiorender.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`generated: validator failed for field "AudioRender.content_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Size(); ok {
+		if err := audiorender.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`generated: validator failed for field "AudioRender.size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DurationMs(); ok {
+		if err := audiorender.DurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_ms", err: fmt.Errorf(`generated: validator failed for field "AudioRender.duration_ms": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AudioRenderUpdateOne) sqlSave(ctx context.Context) (_node *AudioRender, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(audiorender.Table, audiorender.Columns, sqlgraph.NewFieldSpec(audiorender.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "AudioRender.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, audiorender.FieldID)
+		for _, f := range fields {
+			if !audiorender.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != audiorender.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.BlobKey(); ok {
+		_spec.SetField(audiorender.FieldBlobKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(audiorender.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(audiorender.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(audiorender.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(audiorender.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(audiorender.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Words(); ok {
+		_spec.SetField(audiorender.FieldWords, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, audiorender.FieldWords, value)
+		})
+	}
+	if _u.mutation.WordsCleared() {
+		_spec.ClearField(audiorender.FieldWords, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(audiorender.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(audiorender.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &AudioRender{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{audiorender.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
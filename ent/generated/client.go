@@ -16,8 +16,24 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/audiorender"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/folder"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/historyrevision"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/idempotencykey"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/lexiconentry"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationrule"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationsetting"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/plan"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/playevent"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/tag"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/template"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/usageentry"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userpreference"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userusage"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/voice"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/voicepreset"
 )
 
 // Client is the client that holds all ent builders.
@@ -25,10 +41,42 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AudioRender is the client for interacting with the AudioRender builders.
+	AudioRender *AudioRenderClient
+	// Folder is the client for interacting with the Folder builders.
+	Folder *FolderClient
 	// History is the client for interacting with the History builders.
 	History *HistoryClient
+	// HistoryRevision is the client for interacting with the HistoryRevision builders.
+	HistoryRevision *HistoryRevisionClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// LexiconEntry is the client for interacting with the LexiconEntry builders.
+	LexiconEntry *LexiconEntryClient
+	// ModerationRule is the client for interacting with the ModerationRule builders.
+	ModerationRule *ModerationRuleClient
+	// ModerationSetting is the client for interacting with the ModerationSetting builders.
+	ModerationSetting *ModerationSettingClient
+	// Plan is the client for interacting with the Plan builders.
+	Plan *PlanClient
+	// PlayEvent is the client for interacting with the PlayEvent builders.
+	PlayEvent *PlayEventClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
+	// Template is the client for interacting with the Template builders.
+	Template *TemplateClient
+	// UsageEntry is the client for interacting with the UsageEntry builders.
+	UsageEntry *UsageEntryClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserPreference is the client for interacting with the UserPreference builders.
+	UserPreference *UserPreferenceClient
+	// UserUsage is the client for interacting with the UserUsage builders.
+	UserUsage *UserUsageClient
+	// Voice is the client for interacting with the Voice builders.
+	Voice *VoiceClient
+	// VoicePreset is the client for interacting with the VoicePreset builders.
+	VoicePreset *VoicePresetClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -40,8 +88,24 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AudioRender = NewAudioRenderClient(c.config)
+	c.Folder = NewFolderClient(c.config)
 	c.History = NewHistoryClient(c.config)
+	c.HistoryRevision = NewHistoryRevisionClient(c.config)
+	c.IdempotencyKey = NewIdempotencyKeyClient(c.config)
+	c.LexiconEntry = NewLexiconEntryClient(c.config)
+	c.ModerationRule = NewModerationRuleClient(c.config)
+	c.ModerationSetting = NewModerationSettingClient(c.config)
+	c.Plan = NewPlanClient(c.config)
+	c.PlayEvent = NewPlayEventClient(c.config)
+	c.Tag = NewTagClient(c.config)
+	c.Template = NewTemplateClient(c.config)
+	c.UsageEntry = NewUsageEntryClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.UserPreference = NewUserPreferenceClient(c.config)
+	c.UserUsage = NewUserUsageClient(c.config)
+	c.Voice = NewVoiceClient(c.config)
+	c.VoicePreset = NewVoicePresetClient(c.config)
 }
 
 type (
@@ -132,10 +196,26 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		History: NewHistoryClient(cfg),
-		User:    NewUserClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		AudioRender:       NewAudioRenderClient(cfg),
+		Folder:            NewFolderClient(cfg),
+		History:           NewHistoryClient(cfg),
+		HistoryRevision:   NewHistoryRevisionClient(cfg),
+		IdempotencyKey:    NewIdempotencyKeyClient(cfg),
+		LexiconEntry:      NewLexiconEntryClient(cfg),
+		ModerationRule:    NewModerationRuleClient(cfg),
+		ModerationSetting: NewModerationSettingClient(cfg),
+		Plan:              NewPlanClient(cfg),
+		PlayEvent:         NewPlayEventClient(cfg),
+		Tag:               NewTagClient(cfg),
+		Template:          NewTemplateClient(cfg),
+		UsageEntry:        NewUsageEntryClient(cfg),
+		User:              NewUserClient(cfg),
+		UserPreference:    NewUserPreferenceClient(cfg),
+		UserUsage:         NewUserUsageClient(cfg),
+		Voice:             NewVoiceClient(cfg),
+		VoicePreset:       NewVoicePresetClient(cfg),
 	}, nil
 }
 
@@ -153,17 +233,33 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		History: NewHistoryClient(cfg),
-		User:    NewUserClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		AudioRender:       NewAudioRenderClient(cfg),
+		Folder:            NewFolderClient(cfg),
+		History:           NewHistoryClient(cfg),
+		HistoryRevision:   NewHistoryRevisionClient(cfg),
+		IdempotencyKey:    NewIdempotencyKeyClient(cfg),
+		LexiconEntry:      NewLexiconEntryClient(cfg),
+		ModerationRule:    NewModerationRuleClient(cfg),
+		ModerationSetting: NewModerationSettingClient(cfg),
+		Plan:              NewPlanClient(cfg),
+		PlayEvent:         NewPlayEventClient(cfg),
+		Tag:               NewTagClient(cfg),
+		Template:          NewTemplateClient(cfg),
+		UsageEntry:        NewUsageEntryClient(cfg),
+		User:              NewUserClient(cfg),
+		UserPreference:    NewUserPreferenceClient(cfg),
+		UserUsage:         NewUserUsageClient(cfg),
+		Voice:             NewVoiceClient(cfg),
+		VoicePreset:       NewVoicePresetClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		History.
+//		AudioRender.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -185,29 +281,403 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.History.Use(hooks...)
-	c.User.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AudioRender, c.Folder, c.History, c.HistoryRevision, c.IdempotencyKey,
+		c.LexiconEntry, c.ModerationRule, c.ModerationSetting, c.Plan, c.PlayEvent,
+		c.Tag, c.Template, c.UsageEntry, c.User, c.UserPreference, c.UserUsage,
+		c.Voice, c.VoicePreset,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.History.Intercept(interceptors...)
-	c.User.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AudioRender, c.Folder, c.History, c.HistoryRevision, c.IdempotencyKey,
+		c.LexiconEntry, c.ModerationRule, c.ModerationSetting, c.Plan, c.PlayEvent,
+		c.Tag, c.Template, c.UsageEntry, c.User, c.UserPreference, c.UserUsage,
+		c.Voice, c.VoicePreset,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AudioRenderMutation:
+		return c.AudioRender.mutate(ctx, m)
+	case *FolderMutation:
+		return c.Folder.mutate(ctx, m)
 	case *HistoryMutation:
 		return c.History.mutate(ctx, m)
+	case *HistoryRevisionMutation:
+		return c.HistoryRevision.mutate(ctx, m)
+	case *IdempotencyKeyMutation:
+		return c.IdempotencyKey.mutate(ctx, m)
+	case *LexiconEntryMutation:
+		return c.LexiconEntry.mutate(ctx, m)
+	case *ModerationRuleMutation:
+		return c.ModerationRule.mutate(ctx, m)
+	case *ModerationSettingMutation:
+		return c.ModerationSetting.mutate(ctx, m)
+	case *PlanMutation:
+		return c.Plan.mutate(ctx, m)
+	case *PlayEventMutation:
+		return c.PlayEvent.mutate(ctx, m)
+	case *TagMutation:
+		return c.Tag.mutate(ctx, m)
+	case *TemplateMutation:
+		return c.Template.mutate(ctx, m)
+	case *UsageEntryMutation:
+		return c.UsageEntry.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *UserPreferenceMutation:
+		return c.UserPreference.mutate(ctx, m)
+	case *UserUsageMutation:
+		return c.UserUsage.mutate(ctx, m)
+	case *VoiceMutation:
+		return c.Voice.mutate(ctx, m)
+	case *VoicePresetMutation:
+		return c.VoicePreset.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("generated: unknown mutation type %T", m)
 	}
 }
 
+// AudioRenderClient is a client for the AudioRender schema.
+type AudioRenderClient struct {
+	config
+}
+
+// NewAudioRenderClient returns a client for the AudioRender from the given config.
+func NewAudioRenderClient(c config) *AudioRenderClient {
+	return &AudioRenderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `audiorender.Hooks(f(g(h())))`.
+func (c *AudioRenderClient) Use(hooks ...Hook) {
+	c.hooks.AudioRender = append(c.hooks.AudioRender, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `audiorender.Intercept(f(g(h())))`.
+func (c *AudioRenderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AudioRender = append(c.inters.AudioRender, interceptors...)
+}
+
+// Create returns a builder for creating a AudioRender entity.
+func (c *AudioRenderClient) Create() *AudioRenderCreate {
+	mutation := newAudioRenderMutation(c.config, OpCreate)
+	return &AudioRenderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AudioRender entities.
+func (c *AudioRenderClient) CreateBulk(builders ...*AudioRenderCreate) *AudioRenderCreateBulk {
+	return &AudioRenderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AudioRenderClient) MapCreateBulk(slice any, setFunc func(*AudioRenderCreate, int)) *AudioRenderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AudioRenderCreateBulk{err: fmt.Errorf("calling to AudioRenderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AudioRenderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AudioRenderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AudioRender.
+func (c *AudioRenderClient) Update() *AudioRenderUpdate {
+	mutation := newAudioRenderMutation(c.config, OpUpdate)
+	return &AudioRenderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AudioRenderClient) UpdateOne(_m *AudioRender) *AudioRenderUpdateOne {
+	mutation := newAudioRenderMutation(c.config, OpUpdateOne, withAudioRender(_m))
+	return &AudioRenderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AudioRenderClient) UpdateOneID(id uuid.UUID) *AudioRenderUpdateOne {
+	mutation := newAudioRenderMutation(c.config, OpUpdateOne, withAudioRenderID(id))
+	return &AudioRenderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AudioRender.
+func (c *AudioRenderClient) Delete() *AudioRenderDelete {
+	mutation := newAudioRenderMutation(c.config, OpDelete)
+	return &AudioRenderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AudioRenderClient) DeleteOne(_m *AudioRender) *AudioRenderDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AudioRenderClient) DeleteOneID(id uuid.UUID) *AudioRenderDeleteOne {
+	builder := c.Delete().Where(audiorender.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AudioRenderDeleteOne{builder}
+}
+
+// Query returns a query builder for AudioRender.
+func (c *AudioRenderClient) Query() *AudioRenderQuery {
+	return &AudioRenderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAudioRender},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AudioRender entity by its id.
+func (c *AudioRenderClient) Get(ctx context.Context, id uuid.UUID) (*AudioRender, error) {
+	return c.Query().Where(audiorender.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AudioRenderClient) GetX(ctx context.Context, id uuid.UUID) *AudioRender {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AudioRenderClient) Hooks() []Hook {
+	return c.hooks.AudioRender
+}
+
+// Interceptors returns the client interceptors.
+func (c *AudioRenderClient) Interceptors() []Interceptor {
+	return c.inters.AudioRender
+}
+
+func (c *AudioRenderClient) mutate(ctx context.Context, m *AudioRenderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AudioRenderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AudioRenderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AudioRenderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AudioRenderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown AudioRender mutation op: %q", m.Op())
+	}
+}
+
+// FolderClient is a client for the Folder schema.
+type FolderClient struct {
+	config
+}
+
+// NewFolderClient returns a client for the Folder from the given config.
+func NewFolderClient(c config) *FolderClient {
+	return &FolderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `folder.Hooks(f(g(h())))`.
+func (c *FolderClient) Use(hooks ...Hook) {
+	c.hooks.Folder = append(c.hooks.Folder, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `folder.Intercept(f(g(h())))`.
+func (c *FolderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Folder = append(c.inters.Folder, interceptors...)
+}
+
+// Create returns a builder for creating a Folder entity.
+func (c *FolderClient) Create() *FolderCreate {
+	mutation := newFolderMutation(c.config, OpCreate)
+	return &FolderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Folder entities.
+func (c *FolderClient) CreateBulk(builders ...*FolderCreate) *FolderCreateBulk {
+	return &FolderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FolderClient) MapCreateBulk(slice any, setFunc func(*FolderCreate, int)) *FolderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FolderCreateBulk{err: fmt.Errorf("calling to FolderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FolderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FolderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Folder.
+func (c *FolderClient) Update() *FolderUpdate {
+	mutation := newFolderMutation(c.config, OpUpdate)
+	return &FolderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FolderClient) UpdateOne(_m *Folder) *FolderUpdateOne {
+	mutation := newFolderMutation(c.config, OpUpdateOne, withFolder(_m))
+	return &FolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FolderClient) UpdateOneID(id uuid.UUID) *FolderUpdateOne {
+	mutation := newFolderMutation(c.config, OpUpdateOne, withFolderID(id))
+	return &FolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Folder.
+func (c *FolderClient) Delete() *FolderDelete {
+	mutation := newFolderMutation(c.config, OpDelete)
+	return &FolderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FolderClient) DeleteOne(_m *Folder) *FolderDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FolderClient) DeleteOneID(id uuid.UUID) *FolderDeleteOne {
+	builder := c.Delete().Where(folder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FolderDeleteOne{builder}
+}
+
+// Query returns a query builder for Folder.
+func (c *FolderClient) Query() *FolderQuery {
+	return &FolderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFolder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Folder entity by its id.
+func (c *FolderClient) Get(ctx context.Context, id uuid.UUID) (*Folder, error) {
+	return c.Query().Where(folder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FolderClient) GetX(ctx context.Context, id uuid.UUID) *Folder {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Folder.
+func (c *FolderClient) QueryUser(_m *Folder) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, folder.UserTable, folder.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Folder.
+func (c *FolderClient) QueryParent(_m *Folder) *FolderQuery {
+	query := (&FolderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, id),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, folder.ParentTable, folder.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Folder.
+func (c *FolderClient) QueryChildren(_m *Folder) *FolderQuery {
+	query := (&FolderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, id),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, folder.ChildrenTable, folder.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHistories queries the histories edge of a Folder.
+func (c *FolderClient) QueryHistories(_m *Folder) *HistoryQuery {
+	query := (&HistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, id),
+			sqlgraph.To(history.Table, history.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, folder.HistoriesTable, folder.HistoriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FolderClient) Hooks() []Hook {
+	return c.hooks.Folder
+}
+
+// Interceptors returns the client interceptors.
+func (c *FolderClient) Interceptors() []Interceptor {
+	return c.inters.Folder
+}
+
+func (c *FolderClient) mutate(ctx context.Context, m *FolderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FolderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FolderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FolderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Folder mutation op: %q", m.Op())
+	}
+}
+
 // HistoryClient is a client for the History schema.
 type HistoryClient struct {
 	config
@@ -332,14 +802,64 @@ func (c *HistoryClient) QueryUser(_m *History) *UserQuery {
 	return query
 }
 
+// QueryRevisions queries the revisions edge of a History.
+func (c *HistoryClient) QueryRevisions(_m *History) *HistoryRevisionQuery {
+	query := (&HistoryRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(history.Table, history.FieldID, id),
+			sqlgraph.To(historyrevision.Table, historyrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, history.RevisionsTable, history.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a History.
+func (c *HistoryClient) QueryTags(_m *History) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(history.Table, history.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, history.TagsTable, history.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFolder queries the folder edge of a History.
+func (c *HistoryClient) QueryFolder(_m *History) *FolderQuery {
+	query := (&FolderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(history.Table, history.FieldID, id),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, history.FolderTable, history.FolderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *HistoryClient) Hooks() []Hook {
-	return c.hooks.History
+	hooks := c.hooks.History
+	return append(hooks[:len(hooks):len(hooks)], history.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *HistoryClient) Interceptors() []Interceptor {
-	return c.inters.History
+	inters := c.inters.History
+	return append(inters[:len(inters):len(inters)], history.Interceptors[:]...)
 }
 
 func (c *HistoryClient) mutate(ctx context.Context, m *HistoryMutation) (Value, error) {
@@ -357,107 +877,107 @@ func (c *HistoryClient) mutate(ctx context.Context, m *HistoryMutation) (Value,
 	}
 }
 
-// UserClient is a client for the User schema.
-type UserClient struct {
+// HistoryRevisionClient is a client for the HistoryRevision schema.
+type HistoryRevisionClient struct {
 	config
 }
 
-// NewUserClient returns a client for the User from the given config.
-func NewUserClient(c config) *UserClient {
-	return &UserClient{config: c}
+// NewHistoryRevisionClient returns a client for the HistoryRevision from the given config.
+func NewHistoryRevisionClient(c config) *HistoryRevisionClient {
+	return &HistoryRevisionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
-func (c *UserClient) Use(hooks ...Hook) {
-	c.hooks.User = append(c.hooks.User, hooks...)
+// A call to `Use(f, g, h)` equals to `historyrevision.Hooks(f(g(h())))`.
+func (c *HistoryRevisionClient) Use(hooks ...Hook) {
+	c.hooks.HistoryRevision = append(c.hooks.HistoryRevision, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
-func (c *UserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.User = append(c.inters.User, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `historyrevision.Intercept(f(g(h())))`.
+func (c *HistoryRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HistoryRevision = append(c.inters.HistoryRevision, interceptors...)
 }
 
-// Create returns a builder for creating a User entity.
-func (c *UserClient) Create() *UserCreate {
-	mutation := newUserMutation(c.config, OpCreate)
-	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a HistoryRevision entity.
+func (c *HistoryRevisionClient) Create() *HistoryRevisionCreate {
+	mutation := newHistoryRevisionMutation(c.config, OpCreate)
+	return &HistoryRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of User entities.
-func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
-	return &UserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of HistoryRevision entities.
+func (c *HistoryRevisionClient) CreateBulk(builders ...*HistoryRevisionCreate) *HistoryRevisionCreateBulk {
+	return &HistoryRevisionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+func (c *HistoryRevisionClient) MapCreateBulk(slice any, setFunc func(*HistoryRevisionCreate, int)) *HistoryRevisionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &HistoryRevisionCreateBulk{err: fmt.Errorf("calling to HistoryRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserCreate, rv.Len())
+	builders := make([]*HistoryRevisionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserCreateBulk{config: c.config, builders: builders}
+	return &HistoryRevisionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for User.
-func (c *UserClient) Update() *UserUpdate {
-	mutation := newUserMutation(c.config, OpUpdate)
-	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for HistoryRevision.
+func (c *HistoryRevisionClient) Update() *HistoryRevisionUpdate {
+	mutation := newHistoryRevisionMutation(c.config, OpUpdate)
+	return &HistoryRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *HistoryRevisionClient) UpdateOne(_m *HistoryRevision) *HistoryRevisionUpdateOne {
+	mutation := newHistoryRevisionMutation(c.config, OpUpdateOne, withHistoryRevision(_m))
+	return &HistoryRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserClient) UpdateOneID(id uuid.UUID) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *HistoryRevisionClient) UpdateOneID(id uuid.UUID) *HistoryRevisionUpdateOne {
+	mutation := newHistoryRevisionMutation(c.config, OpUpdateOne, withHistoryRevisionID(id))
+	return &HistoryRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for User.
-func (c *UserClient) Delete() *UserDelete {
-	mutation := newUserMutation(c.config, OpDelete)
-	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for HistoryRevision.
+func (c *HistoryRevisionClient) Delete() *HistoryRevisionDelete {
+	mutation := newHistoryRevisionMutation(c.config, OpDelete)
+	return &HistoryRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+func (c *HistoryRevisionClient) DeleteOne(_m *HistoryRevision) *HistoryRevisionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserClient) DeleteOneID(id uuid.UUID) *UserDeleteOne {
-	builder := c.Delete().Where(user.ID(id))
+func (c *HistoryRevisionClient) DeleteOneID(id uuid.UUID) *HistoryRevisionDeleteOne {
+	builder := c.Delete().Where(historyrevision.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserDeleteOne{builder}
+	return &HistoryRevisionDeleteOne{builder}
 }
 
-// Query returns a query builder for User.
-func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{
+// Query returns a query builder for HistoryRevision.
+func (c *HistoryRevisionClient) Query() *HistoryRevisionQuery {
+	return &HistoryRevisionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUser},
+		ctx:    &QueryContext{Type: TypeHistoryRevision},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a User entity by its id.
-func (c *UserClient) Get(ctx context.Context, id uuid.UUID) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+// Get returns a HistoryRevision entity by its id.
+func (c *HistoryRevisionClient) Get(ctx context.Context, id uuid.UUID) (*HistoryRevision, error) {
+	return c.Query().Where(historyrevision.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserClient) GetX(ctx context.Context, id uuid.UUID) *User {
+func (c *HistoryRevisionClient) GetX(ctx context.Context, id uuid.UUID) *HistoryRevision {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -465,15 +985,15 @@ func (c *UserClient) GetX(ctx context.Context, id uuid.UUID) *User {
 	return obj
 }
 
-// QueryHistories queries the histories edge of a User.
-func (c *UserClient) QueryHistories(_m *User) *HistoryQuery {
+// QueryHistory queries the history edge of a HistoryRevision.
+func (c *HistoryRevisionClient) QueryHistory(_m *HistoryRevision) *HistoryQuery {
 	query := (&HistoryClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.From(historyrevision.Table, historyrevision.FieldID, id),
 			sqlgraph.To(history.Table, history.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.HistoriesTable, user.HistoriesColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, historyrevision.HistoryTable, historyrevision.HistoryColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -482,36 +1002,2334 @@ func (c *UserClient) QueryHistories(_m *User) *HistoryQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+func (c *HistoryRevisionClient) Hooks() []Hook {
+	return c.hooks.HistoryRevision
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserClient) Interceptors() []Interceptor {
-	return c.inters.User
+func (c *HistoryRevisionClient) Interceptors() []Interceptor {
+	return c.inters.HistoryRevision
 }
 
-func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+func (c *HistoryRevisionClient) mutate(ctx context.Context, m *HistoryRevisionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&HistoryRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&HistoryRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&HistoryRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&HistoryRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown User mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown HistoryRevision mutation op: %q", m.Op())
+	}
+}
+
+// IdempotencyKeyClient is a client for the IdempotencyKey schema.
+type IdempotencyKeyClient struct {
+	config
+}
+
+// NewIdempotencyKeyClient returns a client for the IdempotencyKey from the given config.
+func NewIdempotencyKeyClient(c config) *IdempotencyKeyClient {
+	return &IdempotencyKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `idempotencykey.Hooks(f(g(h())))`.
+func (c *IdempotencyKeyClient) Use(hooks ...Hook) {
+	c.hooks.IdempotencyKey = append(c.hooks.IdempotencyKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `idempotencykey.Intercept(f(g(h())))`.
+func (c *IdempotencyKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdempotencyKey = append(c.inters.IdempotencyKey, interceptors...)
+}
+
+// Create returns a builder for creating a IdempotencyKey entity.
+func (c *IdempotencyKeyClient) Create() *IdempotencyKeyCreate {
+	mutation := newIdempotencyKeyMutation(c.config, OpCreate)
+	return &IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IdempotencyKey entities.
+func (c *IdempotencyKeyClient) CreateBulk(builders ...*IdempotencyKeyCreate) *IdempotencyKeyCreateBulk {
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdempotencyKeyClient) MapCreateBulk(slice any, setFunc func(*IdempotencyKeyCreate, int)) *IdempotencyKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdempotencyKeyCreateBulk{err: fmt.Errorf("calling to IdempotencyKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdempotencyKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Update() *IdempotencyKeyUpdate {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdate)
+	return &IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdempotencyKeyClient) UpdateOne(_m *IdempotencyKey) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKey(_m))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdempotencyKeyClient) UpdateOneID(id uuid.UUID) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKeyID(id))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Delete() *IdempotencyKeyDelete {
+	mutation := newIdempotencyKeyMutation(c.config, OpDelete)
+	return &IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdempotencyKeyClient) DeleteOne(_m *IdempotencyKey) *IdempotencyKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdempotencyKeyClient) DeleteOneID(id uuid.UUID) *IdempotencyKeyDeleteOne {
+	builder := c.Delete().Where(idempotencykey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdempotencyKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Query() *IdempotencyKeyQuery {
+	return &IdempotencyKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdempotencyKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IdempotencyKey entity by its id.
+func (c *IdempotencyKeyClient) Get(ctx context.Context, id uuid.UUID) (*IdempotencyKey, error) {
+	return c.Query().Where(idempotencykey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdempotencyKeyClient) GetX(ctx context.Context, id uuid.UUID) *IdempotencyKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a IdempotencyKey.
+func (c *IdempotencyKeyClient) QueryUser(_m *IdempotencyKey) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(idempotencykey.Table, idempotencykey.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, idempotencykey.UserTable, idempotencykey.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IdempotencyKeyClient) Hooks() []Hook {
+	return c.hooks.IdempotencyKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdempotencyKeyClient) Interceptors() []Interceptor {
+	return c.inters.IdempotencyKey
+}
+
+func (c *IdempotencyKeyClient) mutate(ctx context.Context, m *IdempotencyKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown IdempotencyKey mutation op: %q", m.Op())
+	}
+}
+
+// LexiconEntryClient is a client for the LexiconEntry schema.
+type LexiconEntryClient struct {
+	config
+}
+
+// NewLexiconEntryClient returns a client for the LexiconEntry from the given config.
+func NewLexiconEntryClient(c config) *LexiconEntryClient {
+	return &LexiconEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `lexiconentry.Hooks(f(g(h())))`.
+func (c *LexiconEntryClient) Use(hooks ...Hook) {
+	c.hooks.LexiconEntry = append(c.hooks.LexiconEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `lexiconentry.Intercept(f(g(h())))`.
+func (c *LexiconEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LexiconEntry = append(c.inters.LexiconEntry, interceptors...)
+}
+
+// Create returns a builder for creating a LexiconEntry entity.
+func (c *LexiconEntryClient) Create() *LexiconEntryCreate {
+	mutation := newLexiconEntryMutation(c.config, OpCreate)
+	return &LexiconEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LexiconEntry entities.
+func (c *LexiconEntryClient) CreateBulk(builders ...*LexiconEntryCreate) *LexiconEntryCreateBulk {
+	return &LexiconEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LexiconEntryClient) MapCreateBulk(slice any, setFunc func(*LexiconEntryCreate, int)) *LexiconEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LexiconEntryCreateBulk{err: fmt.Errorf("calling to LexiconEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LexiconEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LexiconEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LexiconEntry.
+func (c *LexiconEntryClient) Update() *LexiconEntryUpdate {
+	mutation := newLexiconEntryMutation(c.config, OpUpdate)
+	return &LexiconEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LexiconEntryClient) UpdateOne(_m *LexiconEntry) *LexiconEntryUpdateOne {
+	mutation := newLexiconEntryMutation(c.config, OpUpdateOne, withLexiconEntry(_m))
+	return &LexiconEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LexiconEntryClient) UpdateOneID(id uuid.UUID) *LexiconEntryUpdateOne {
+	mutation := newLexiconEntryMutation(c.config, OpUpdateOne, withLexiconEntryID(id))
+	return &LexiconEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LexiconEntry.
+func (c *LexiconEntryClient) Delete() *LexiconEntryDelete {
+	mutation := newLexiconEntryMutation(c.config, OpDelete)
+	return &LexiconEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LexiconEntryClient) DeleteOne(_m *LexiconEntry) *LexiconEntryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LexiconEntryClient) DeleteOneID(id uuid.UUID) *LexiconEntryDeleteOne {
+	builder := c.Delete().Where(lexiconentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LexiconEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for LexiconEntry.
+func (c *LexiconEntryClient) Query() *LexiconEntryQuery {
+	return &LexiconEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLexiconEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LexiconEntry entity by its id.
+func (c *LexiconEntryClient) Get(ctx context.Context, id uuid.UUID) (*LexiconEntry, error) {
+	return c.Query().Where(lexiconentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LexiconEntryClient) GetX(ctx context.Context, id uuid.UUID) *LexiconEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a LexiconEntry.
+func (c *LexiconEntryClient) QueryUser(_m *LexiconEntry) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lexiconentry.Table, lexiconentry.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lexiconentry.UserTable, lexiconentry.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LexiconEntryClient) Hooks() []Hook {
+	return c.hooks.LexiconEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *LexiconEntryClient) Interceptors() []Interceptor {
+	return c.inters.LexiconEntry
+}
+
+func (c *LexiconEntryClient) mutate(ctx context.Context, m *LexiconEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LexiconEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LexiconEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LexiconEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LexiconEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown LexiconEntry mutation op: %q", m.Op())
+	}
+}
+
+// ModerationRuleClient is a client for the ModerationRule schema.
+type ModerationRuleClient struct {
+	config
+}
+
+// NewModerationRuleClient returns a client for the ModerationRule from the given config.
+func NewModerationRuleClient(c config) *ModerationRuleClient {
+	return &ModerationRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `moderationrule.Hooks(f(g(h())))`.
+func (c *ModerationRuleClient) Use(hooks ...Hook) {
+	c.hooks.ModerationRule = append(c.hooks.ModerationRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `moderationrule.Intercept(f(g(h())))`.
+func (c *ModerationRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ModerationRule = append(c.inters.ModerationRule, interceptors...)
+}
+
+// Create returns a builder for creating a ModerationRule entity.
+func (c *ModerationRuleClient) Create() *ModerationRuleCreate {
+	mutation := newModerationRuleMutation(c.config, OpCreate)
+	return &ModerationRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ModerationRule entities.
+func (c *ModerationRuleClient) CreateBulk(builders ...*ModerationRuleCreate) *ModerationRuleCreateBulk {
+	return &ModerationRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ModerationRuleClient) MapCreateBulk(slice any, setFunc func(*ModerationRuleCreate, int)) *ModerationRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ModerationRuleCreateBulk{err: fmt.Errorf("calling to ModerationRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ModerationRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ModerationRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ModerationRule.
+func (c *ModerationRuleClient) Update() *ModerationRuleUpdate {
+	mutation := newModerationRuleMutation(c.config, OpUpdate)
+	return &ModerationRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ModerationRuleClient) UpdateOne(_m *ModerationRule) *ModerationRuleUpdateOne {
+	mutation := newModerationRuleMutation(c.config, OpUpdateOne, withModerationRule(_m))
+	return &ModerationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ModerationRuleClient) UpdateOneID(id uuid.UUID) *ModerationRuleUpdateOne {
+	mutation := newModerationRuleMutation(c.config, OpUpdateOne, withModerationRuleID(id))
+	return &ModerationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ModerationRule.
+func (c *ModerationRuleClient) Delete() *ModerationRuleDelete {
+	mutation := newModerationRuleMutation(c.config, OpDelete)
+	return &ModerationRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ModerationRuleClient) DeleteOne(_m *ModerationRule) *ModerationRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ModerationRuleClient) DeleteOneID(id uuid.UUID) *ModerationRuleDeleteOne {
+	builder := c.Delete().Where(moderationrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ModerationRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for ModerationRule.
+func (c *ModerationRuleClient) Query() *ModerationRuleQuery {
+	return &ModerationRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeModerationRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ModerationRule entity by its id.
+func (c *ModerationRuleClient) Get(ctx context.Context, id uuid.UUID) (*ModerationRule, error) {
+	return c.Query().Where(moderationrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ModerationRuleClient) GetX(ctx context.Context, id uuid.UUID) *ModerationRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a ModerationRule.
+func (c *ModerationRuleClient) QueryUser(_m *ModerationRule) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(moderationrule.Table, moderationrule.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, moderationrule.UserTable, moderationrule.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ModerationRuleClient) Hooks() []Hook {
+	return c.hooks.ModerationRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *ModerationRuleClient) Interceptors() []Interceptor {
+	return c.inters.ModerationRule
+}
+
+func (c *ModerationRuleClient) mutate(ctx context.Context, m *ModerationRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ModerationRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ModerationRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ModerationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ModerationRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown ModerationRule mutation op: %q", m.Op())
+	}
+}
+
+// ModerationSettingClient is a client for the ModerationSetting schema.
+type ModerationSettingClient struct {
+	config
+}
+
+// NewModerationSettingClient returns a client for the ModerationSetting from the given config.
+func NewModerationSettingClient(c config) *ModerationSettingClient {
+	return &ModerationSettingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `moderationsetting.Hooks(f(g(h())))`.
+func (c *ModerationSettingClient) Use(hooks ...Hook) {
+	c.hooks.ModerationSetting = append(c.hooks.ModerationSetting, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `moderationsetting.Intercept(f(g(h())))`.
+func (c *ModerationSettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ModerationSetting = append(c.inters.ModerationSetting, interceptors...)
+}
+
+// Create returns a builder for creating a ModerationSetting entity.
+func (c *ModerationSettingClient) Create() *ModerationSettingCreate {
+	mutation := newModerationSettingMutation(c.config, OpCreate)
+	return &ModerationSettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ModerationSetting entities.
+func (c *ModerationSettingClient) CreateBulk(builders ...*ModerationSettingCreate) *ModerationSettingCreateBulk {
+	return &ModerationSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ModerationSettingClient) MapCreateBulk(slice any, setFunc func(*ModerationSettingCreate, int)) *ModerationSettingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ModerationSettingCreateBulk{err: fmt.Errorf("calling to ModerationSettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ModerationSettingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ModerationSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ModerationSetting.
+func (c *ModerationSettingClient) Update() *ModerationSettingUpdate {
+	mutation := newModerationSettingMutation(c.config, OpUpdate)
+	return &ModerationSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ModerationSettingClient) UpdateOne(_m *ModerationSetting) *ModerationSettingUpdateOne {
+	mutation := newModerationSettingMutation(c.config, OpUpdateOne, withModerationSetting(_m))
+	return &ModerationSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ModerationSettingClient) UpdateOneID(id uuid.UUID) *ModerationSettingUpdateOne {
+	mutation := newModerationSettingMutation(c.config, OpUpdateOne, withModerationSettingID(id))
+	return &ModerationSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ModerationSetting.
+func (c *ModerationSettingClient) Delete() *ModerationSettingDelete {
+	mutation := newModerationSettingMutation(c.config, OpDelete)
+	return &ModerationSettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ModerationSettingClient) DeleteOne(_m *ModerationSetting) *ModerationSettingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ModerationSettingClient) DeleteOneID(id uuid.UUID) *ModerationSettingDeleteOne {
+	builder := c.Delete().Where(moderationsetting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ModerationSettingDeleteOne{builder}
+}
+
+// Query returns a query builder for ModerationSetting.
+func (c *ModerationSettingClient) Query() *ModerationSettingQuery {
+	return &ModerationSettingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeModerationSetting},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ModerationSetting entity by its id.
+func (c *ModerationSettingClient) Get(ctx context.Context, id uuid.UUID) (*ModerationSetting, error) {
+	return c.Query().Where(moderationsetting.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ModerationSettingClient) GetX(ctx context.Context, id uuid.UUID) *ModerationSetting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a ModerationSetting.
+func (c *ModerationSettingClient) QueryUser(_m *ModerationSetting) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(moderationsetting.Table, moderationsetting.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, moderationsetting.UserTable, moderationsetting.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ModerationSettingClient) Hooks() []Hook {
+	return c.hooks.ModerationSetting
+}
+
+// Interceptors returns the client interceptors.
+func (c *ModerationSettingClient) Interceptors() []Interceptor {
+	return c.inters.ModerationSetting
+}
+
+func (c *ModerationSettingClient) mutate(ctx context.Context, m *ModerationSettingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ModerationSettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ModerationSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ModerationSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ModerationSettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown ModerationSetting mutation op: %q", m.Op())
+	}
+}
+
+// PlanClient is a client for the Plan schema.
+type PlanClient struct {
+	config
+}
+
+// NewPlanClient returns a client for the Plan from the given config.
+func NewPlanClient(c config) *PlanClient {
+	return &PlanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `plan.Hooks(f(g(h())))`.
+func (c *PlanClient) Use(hooks ...Hook) {
+	c.hooks.Plan = append(c.hooks.Plan, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `plan.Intercept(f(g(h())))`.
+func (c *PlanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Plan = append(c.inters.Plan, interceptors...)
+}
+
+// Create returns a builder for creating a Plan entity.
+func (c *PlanClient) Create() *PlanCreate {
+	mutation := newPlanMutation(c.config, OpCreate)
+	return &PlanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Plan entities.
+func (c *PlanClient) CreateBulk(builders ...*PlanCreate) *PlanCreateBulk {
+	return &PlanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlanClient) MapCreateBulk(slice any, setFunc func(*PlanCreate, int)) *PlanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlanCreateBulk{err: fmt.Errorf("calling to PlanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Plan.
+func (c *PlanClient) Update() *PlanUpdate {
+	mutation := newPlanMutation(c.config, OpUpdate)
+	return &PlanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlanClient) UpdateOne(_m *Plan) *PlanUpdateOne {
+	mutation := newPlanMutation(c.config, OpUpdateOne, withPlan(_m))
+	return &PlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlanClient) UpdateOneID(id string) *PlanUpdateOne {
+	mutation := newPlanMutation(c.config, OpUpdateOne, withPlanID(id))
+	return &PlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Plan.
+func (c *PlanClient) Delete() *PlanDelete {
+	mutation := newPlanMutation(c.config, OpDelete)
+	return &PlanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlanClient) DeleteOne(_m *Plan) *PlanDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlanClient) DeleteOneID(id string) *PlanDeleteOne {
+	builder := c.Delete().Where(plan.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlanDeleteOne{builder}
+}
+
+// Query returns a query builder for Plan.
+func (c *PlanClient) Query() *PlanQuery {
+	return &PlanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlan},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Plan entity by its id.
+func (c *PlanClient) Get(ctx context.Context, id string) (*Plan, error) {
+	return c.Query().Where(plan.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlanClient) GetX(ctx context.Context, id string) *Plan {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUsers queries the users edge of a Plan.
+func (c *PlanClient) QueryUsers(_m *Plan) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(plan.Table, plan.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, plan.UsersTable, plan.UsersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PlanClient) Hooks() []Hook {
+	return c.hooks.Plan
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlanClient) Interceptors() []Interceptor {
+	return c.inters.Plan
+}
+
+func (c *PlanClient) mutate(ctx context.Context, m *PlanMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Plan mutation op: %q", m.Op())
+	}
+}
+
+// PlayEventClient is a client for the PlayEvent schema.
+type PlayEventClient struct {
+	config
+}
+
+// NewPlayEventClient returns a client for the PlayEvent from the given config.
+func NewPlayEventClient(c config) *PlayEventClient {
+	return &PlayEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `playevent.Hooks(f(g(h())))`.
+func (c *PlayEventClient) Use(hooks ...Hook) {
+	c.hooks.PlayEvent = append(c.hooks.PlayEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `playevent.Intercept(f(g(h())))`.
+func (c *PlayEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PlayEvent = append(c.inters.PlayEvent, interceptors...)
+}
+
+// Create returns a builder for creating a PlayEvent entity.
+func (c *PlayEventClient) Create() *PlayEventCreate {
+	mutation := newPlayEventMutation(c.config, OpCreate)
+	return &PlayEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PlayEvent entities.
+func (c *PlayEventClient) CreateBulk(builders ...*PlayEventCreate) *PlayEventCreateBulk {
+	return &PlayEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlayEventClient) MapCreateBulk(slice any, setFunc func(*PlayEventCreate, int)) *PlayEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlayEventCreateBulk{err: fmt.Errorf("calling to PlayEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlayEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlayEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PlayEvent.
+func (c *PlayEventClient) Update() *PlayEventUpdate {
+	mutation := newPlayEventMutation(c.config, OpUpdate)
+	return &PlayEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlayEventClient) UpdateOne(_m *PlayEvent) *PlayEventUpdateOne {
+	mutation := newPlayEventMutation(c.config, OpUpdateOne, withPlayEvent(_m))
+	return &PlayEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlayEventClient) UpdateOneID(id uuid.UUID) *PlayEventUpdateOne {
+	mutation := newPlayEventMutation(c.config, OpUpdateOne, withPlayEventID(id))
+	return &PlayEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PlayEvent.
+func (c *PlayEventClient) Delete() *PlayEventDelete {
+	mutation := newPlayEventMutation(c.config, OpDelete)
+	return &PlayEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlayEventClient) DeleteOne(_m *PlayEvent) *PlayEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlayEventClient) DeleteOneID(id uuid.UUID) *PlayEventDeleteOne {
+	builder := c.Delete().Where(playevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlayEventDeleteOne{builder}
+}
+
+// Query returns a query builder for PlayEvent.
+func (c *PlayEventClient) Query() *PlayEventQuery {
+	return &PlayEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlayEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PlayEvent entity by its id.
+func (c *PlayEventClient) Get(ctx context.Context, id uuid.UUID) (*PlayEvent, error) {
+	return c.Query().Where(playevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlayEventClient) GetX(ctx context.Context, id uuid.UUID) *PlayEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a PlayEvent.
+func (c *PlayEventClient) QueryUser(_m *PlayEvent) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playevent.Table, playevent.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, playevent.UserTable, playevent.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PlayEventClient) Hooks() []Hook {
+	return c.hooks.PlayEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlayEventClient) Interceptors() []Interceptor {
+	return c.inters.PlayEvent
+}
+
+func (c *PlayEventClient) mutate(ctx context.Context, m *PlayEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlayEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlayEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlayEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlayEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown PlayEvent mutation op: %q", m.Op())
+	}
+}
+
+// TagClient is a client for the Tag schema.
+type TagClient struct {
+	config
+}
+
+// NewTagClient returns a client for the Tag from the given config.
+func NewTagClient(c config) *TagClient {
+	return &TagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tag.Hooks(f(g(h())))`.
+func (c *TagClient) Use(hooks ...Hook) {
+	c.hooks.Tag = append(c.hooks.Tag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tag.Intercept(f(g(h())))`.
+func (c *TagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tag = append(c.inters.Tag, interceptors...)
+}
+
+// Create returns a builder for creating a Tag entity.
+func (c *TagClient) Create() *TagCreate {
+	mutation := newTagMutation(c.config, OpCreate)
+	return &TagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Tag entities.
+func (c *TagClient) CreateBulk(builders ...*TagCreate) *TagCreateBulk {
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TagClient) MapCreateBulk(slice any, setFunc func(*TagCreate, int)) *TagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TagCreateBulk{err: fmt.Errorf("calling to TagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Tag.
+func (c *TagClient) Update() *TagUpdate {
+	mutation := newTagMutation(c.config, OpUpdate)
+	return &TagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TagClient) UpdateOne(_m *Tag) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTag(_m))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TagClient) UpdateOneID(id uuid.UUID) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTagID(id))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Tag.
+func (c *TagClient) Delete() *TagDelete {
+	mutation := newTagMutation(c.config, OpDelete)
+	return &TagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TagClient) DeleteOne(_m *Tag) *TagDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TagClient) DeleteOneID(id uuid.UUID) *TagDeleteOne {
+	builder := c.Delete().Where(tag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TagDeleteOne{builder}
+}
+
+// Query returns a query builder for Tag.
+func (c *TagClient) Query() *TagQuery {
+	return &TagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTag},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Tag entity by its id.
+func (c *TagClient) Get(ctx context.Context, id uuid.UUID) (*Tag, error) {
+	return c.Query().Where(tag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TagClient) GetX(ctx context.Context, id uuid.UUID) *Tag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Tag.
+func (c *TagClient) QueryUser(_m *Tag) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tag.UserTable, tag.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHistories queries the histories edge of a Tag.
+func (c *TagClient) QueryHistories(_m *Tag) *HistoryQuery {
+	query := (&HistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(history.Table, history.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, tag.HistoriesTable, tag.HistoriesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TagClient) Hooks() []Hook {
+	return c.hooks.Tag
+}
+
+// Interceptors returns the client interceptors.
+func (c *TagClient) Interceptors() []Interceptor {
+	return c.inters.Tag
+}
+
+func (c *TagClient) mutate(ctx context.Context, m *TagMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Tag mutation op: %q", m.Op())
+	}
+}
+
+// TemplateClient is a client for the Template schema.
+type TemplateClient struct {
+	config
+}
+
+// NewTemplateClient returns a client for the Template from the given config.
+func NewTemplateClient(c config) *TemplateClient {
+	return &TemplateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `template.Hooks(f(g(h())))`.
+func (c *TemplateClient) Use(hooks ...Hook) {
+	c.hooks.Template = append(c.hooks.Template, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `template.Intercept(f(g(h())))`.
+func (c *TemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Template = append(c.inters.Template, interceptors...)
+}
+
+// Create returns a builder for creating a Template entity.
+func (c *TemplateClient) Create() *TemplateCreate {
+	mutation := newTemplateMutation(c.config, OpCreate)
+	return &TemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Template entities.
+func (c *TemplateClient) CreateBulk(builders ...*TemplateCreate) *TemplateCreateBulk {
+	return &TemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TemplateClient) MapCreateBulk(slice any, setFunc func(*TemplateCreate, int)) *TemplateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TemplateCreateBulk{err: fmt.Errorf("calling to TemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TemplateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Template.
+func (c *TemplateClient) Update() *TemplateUpdate {
+	mutation := newTemplateMutation(c.config, OpUpdate)
+	return &TemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TemplateClient) UpdateOne(_m *Template) *TemplateUpdateOne {
+	mutation := newTemplateMutation(c.config, OpUpdateOne, withTemplate(_m))
+	return &TemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TemplateClient) UpdateOneID(id uuid.UUID) *TemplateUpdateOne {
+	mutation := newTemplateMutation(c.config, OpUpdateOne, withTemplateID(id))
+	return &TemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Template.
+func (c *TemplateClient) Delete() *TemplateDelete {
+	mutation := newTemplateMutation(c.config, OpDelete)
+	return &TemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TemplateClient) DeleteOne(_m *Template) *TemplateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TemplateClient) DeleteOneID(id uuid.UUID) *TemplateDeleteOne {
+	builder := c.Delete().Where(template.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TemplateDeleteOne{builder}
+}
+
+// Query returns a query builder for Template.
+func (c *TemplateClient) Query() *TemplateQuery {
+	return &TemplateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTemplate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Template entity by its id.
+func (c *TemplateClient) Get(ctx context.Context, id uuid.UUID) (*Template, error) {
+	return c.Query().Where(template.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TemplateClient) GetX(ctx context.Context, id uuid.UUID) *Template {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Template.
+func (c *TemplateClient) QueryUser(_m *Template) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(template.Table, template.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, template.UserTable, template.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TemplateClient) Hooks() []Hook {
+	return c.hooks.Template
+}
+
+// Interceptors returns the client interceptors.
+func (c *TemplateClient) Interceptors() []Interceptor {
+	return c.inters.Template
+}
+
+func (c *TemplateClient) mutate(ctx context.Context, m *TemplateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Template mutation op: %q", m.Op())
+	}
+}
+
+// UsageEntryClient is a client for the UsageEntry schema.
+type UsageEntryClient struct {
+	config
+}
+
+// NewUsageEntryClient returns a client for the UsageEntry from the given config.
+func NewUsageEntryClient(c config) *UsageEntryClient {
+	return &UsageEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usageentry.Hooks(f(g(h())))`.
+func (c *UsageEntryClient) Use(hooks ...Hook) {
+	c.hooks.UsageEntry = append(c.hooks.UsageEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usageentry.Intercept(f(g(h())))`.
+func (c *UsageEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UsageEntry = append(c.inters.UsageEntry, interceptors...)
+}
+
+// Create returns a builder for creating a UsageEntry entity.
+func (c *UsageEntryClient) Create() *UsageEntryCreate {
+	mutation := newUsageEntryMutation(c.config, OpCreate)
+	return &UsageEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UsageEntry entities.
+func (c *UsageEntryClient) CreateBulk(builders ...*UsageEntryCreate) *UsageEntryCreateBulk {
+	return &UsageEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UsageEntryClient) MapCreateBulk(slice any, setFunc func(*UsageEntryCreate, int)) *UsageEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UsageEntryCreateBulk{err: fmt.Errorf("calling to UsageEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UsageEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UsageEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UsageEntry.
+func (c *UsageEntryClient) Update() *UsageEntryUpdate {
+	mutation := newUsageEntryMutation(c.config, OpUpdate)
+	return &UsageEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UsageEntryClient) UpdateOne(_m *UsageEntry) *UsageEntryUpdateOne {
+	mutation := newUsageEntryMutation(c.config, OpUpdateOne, withUsageEntry(_m))
+	return &UsageEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UsageEntryClient) UpdateOneID(id uuid.UUID) *UsageEntryUpdateOne {
+	mutation := newUsageEntryMutation(c.config, OpUpdateOne, withUsageEntryID(id))
+	return &UsageEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UsageEntry.
+func (c *UsageEntryClient) Delete() *UsageEntryDelete {
+	mutation := newUsageEntryMutation(c.config, OpDelete)
+	return &UsageEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UsageEntryClient) DeleteOne(_m *UsageEntry) *UsageEntryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UsageEntryClient) DeleteOneID(id uuid.UUID) *UsageEntryDeleteOne {
+	builder := c.Delete().Where(usageentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UsageEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for UsageEntry.
+func (c *UsageEntryClient) Query() *UsageEntryQuery {
+	return &UsageEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUsageEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UsageEntry entity by its id.
+func (c *UsageEntryClient) Get(ctx context.Context, id uuid.UUID) (*UsageEntry, error) {
+	return c.Query().Where(usageentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UsageEntryClient) GetX(ctx context.Context, id uuid.UUID) *UsageEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a UsageEntry.
+func (c *UsageEntryClient) QueryUser(_m *UsageEntry) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(usageentry.Table, usageentry.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, usageentry.UserTable, usageentry.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UsageEntryClient) Hooks() []Hook {
+	return c.hooks.UsageEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *UsageEntryClient) Interceptors() []Interceptor {
+	return c.inters.UsageEntry
+}
+
+func (c *UsageEntryClient) mutate(ctx context.Context, m *UsageEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UsageEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UsageEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UsageEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UsageEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown UsageEntry mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id uuid.UUID) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id uuid.UUID) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id uuid.UUID) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id uuid.UUID) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryHistories queries the histories edge of a User.
+func (c *UserClient) QueryHistories(_m *User) *HistoryQuery {
+	query := (&HistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(history.Table, history.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.HistoriesTable, user.HistoriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIdempotencyKeys queries the idempotency_keys edge of a User.
+func (c *UserClient) QueryIdempotencyKeys(_m *User) *IdempotencyKeyQuery {
+	query := (&IdempotencyKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(idempotencykey.Table, idempotencykey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IdempotencyKeysTable, user.IdempotencyKeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a User.
+func (c *UserClient) QueryTags(_m *User) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TagsTable, user.TagsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFolders queries the folders edge of a User.
+func (c *UserClient) QueryFolders(_m *User) *FolderQuery {
+	query := (&FolderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.FoldersTable, user.FoldersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVoicePresets queries the voice_presets edge of a User.
+func (c *UserClient) QueryVoicePresets(_m *User) *VoicePresetQuery {
+	query := (&VoicePresetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(voicepreset.Table, voicepreset.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.VoicePresetsTable, user.VoicePresetsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPreference queries the preference edge of a User.
+func (c *UserClient) QueryPreference(_m *User) *UserPreferenceQuery {
+	query := (&UserPreferenceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(userpreference.Table, userpreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.PreferenceTable, user.PreferenceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUsage queries the usage edge of a User.
+func (c *UserClient) QueryUsage(_m *User) *UserUsageQuery {
+	query := (&UserUsageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(userusage.Table, userusage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.UsageTable, user.UsageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUsageEntries queries the usage_entries edge of a User.
+func (c *UserClient) QueryUsageEntries(_m *User) *UsageEntryQuery {
+	query := (&UsageEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(usageentry.Table, usageentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UsageEntriesTable, user.UsageEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPlayEvents queries the play_events edge of a User.
+func (c *UserClient) QueryPlayEvents(_m *User) *PlayEventQuery {
+	query := (&PlayEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(playevent.Table, playevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PlayEventsTable, user.PlayEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLexiconEntries queries the lexicon_entries edge of a User.
+func (c *UserClient) QueryLexiconEntries(_m *User) *LexiconEntryQuery {
+	query := (&LexiconEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(lexiconentry.Table, lexiconentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LexiconEntriesTable, user.LexiconEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTemplates queries the templates edge of a User.
+func (c *UserClient) QueryTemplates(_m *User) *TemplateQuery {
+	query := (&TemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(template.Table, template.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TemplatesTable, user.TemplatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryModerationRules queries the moderation_rules edge of a User.
+func (c *UserClient) QueryModerationRules(_m *User) *ModerationRuleQuery {
+	query := (&ModerationRuleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(moderationrule.Table, moderationrule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ModerationRulesTable, user.ModerationRulesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryModerationSetting queries the moderation_setting edge of a User.
+func (c *UserClient) QueryModerationSetting(_m *User) *ModerationSettingQuery {
+	query := (&ModerationSettingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(moderationsetting.Table, moderationsetting.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.ModerationSettingTable, user.ModerationSettingColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPlan queries the plan edge of a User.
+func (c *UserClient) QueryPlan(_m *User) *PlanQuery {
+	query := (&PlanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(plan.Table, plan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, user.PlanTable, user.PlanColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserClient) Hooks() []Hook {
+	return c.hooks.User
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown User mutation op: %q", m.Op())
+	}
+}
+
+// UserPreferenceClient is a client for the UserPreference schema.
+type UserPreferenceClient struct {
+	config
+}
+
+// NewUserPreferenceClient returns a client for the UserPreference from the given config.
+func NewUserPreferenceClient(c config) *UserPreferenceClient {
+	return &UserPreferenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userpreference.Hooks(f(g(h())))`.
+func (c *UserPreferenceClient) Use(hooks ...Hook) {
+	c.hooks.UserPreference = append(c.hooks.UserPreference, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userpreference.Intercept(f(g(h())))`.
+func (c *UserPreferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserPreference = append(c.inters.UserPreference, interceptors...)
+}
+
+// Create returns a builder for creating a UserPreference entity.
+func (c *UserPreferenceClient) Create() *UserPreferenceCreate {
+	mutation := newUserPreferenceMutation(c.config, OpCreate)
+	return &UserPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserPreference entities.
+func (c *UserPreferenceClient) CreateBulk(builders ...*UserPreferenceCreate) *UserPreferenceCreateBulk {
+	return &UserPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserPreferenceClient) MapCreateBulk(slice any, setFunc func(*UserPreferenceCreate, int)) *UserPreferenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserPreferenceCreateBulk{err: fmt.Errorf("calling to UserPreferenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserPreferenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserPreference.
+func (c *UserPreferenceClient) Update() *UserPreferenceUpdate {
+	mutation := newUserPreferenceMutation(c.config, OpUpdate)
+	return &UserPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserPreferenceClient) UpdateOne(_m *UserPreference) *UserPreferenceUpdateOne {
+	mutation := newUserPreferenceMutation(c.config, OpUpdateOne, withUserPreference(_m))
+	return &UserPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserPreferenceClient) UpdateOneID(id uuid.UUID) *UserPreferenceUpdateOne {
+	mutation := newUserPreferenceMutation(c.config, OpUpdateOne, withUserPreferenceID(id))
+	return &UserPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserPreference.
+func (c *UserPreferenceClient) Delete() *UserPreferenceDelete {
+	mutation := newUserPreferenceMutation(c.config, OpDelete)
+	return &UserPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserPreferenceClient) DeleteOne(_m *UserPreference) *UserPreferenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserPreferenceClient) DeleteOneID(id uuid.UUID) *UserPreferenceDeleteOne {
+	builder := c.Delete().Where(userpreference.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserPreferenceDeleteOne{builder}
+}
+
+// Query returns a query builder for UserPreference.
+func (c *UserPreferenceClient) Query() *UserPreferenceQuery {
+	return &UserPreferenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserPreference},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserPreference entity by its id.
+func (c *UserPreferenceClient) Get(ctx context.Context, id uuid.UUID) (*UserPreference, error) {
+	return c.Query().Where(userpreference.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserPreferenceClient) GetX(ctx context.Context, id uuid.UUID) *UserPreference {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a UserPreference.
+func (c *UserPreferenceClient) QueryUser(_m *UserPreference) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userpreference.Table, userpreference.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, userpreference.UserTable, userpreference.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserPreferenceClient) Hooks() []Hook {
+	return c.hooks.UserPreference
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserPreferenceClient) Interceptors() []Interceptor {
+	return c.inters.UserPreference
+}
+
+func (c *UserPreferenceClient) mutate(ctx context.Context, m *UserPreferenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown UserPreference mutation op: %q", m.Op())
+	}
+}
+
+// UserUsageClient is a client for the UserUsage schema.
+type UserUsageClient struct {
+	config
+}
+
+// NewUserUsageClient returns a client for the UserUsage from the given config.
+func NewUserUsageClient(c config) *UserUsageClient {
+	return &UserUsageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userusage.Hooks(f(g(h())))`.
+func (c *UserUsageClient) Use(hooks ...Hook) {
+	c.hooks.UserUsage = append(c.hooks.UserUsage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userusage.Intercept(f(g(h())))`.
+func (c *UserUsageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserUsage = append(c.inters.UserUsage, interceptors...)
+}
+
+// Create returns a builder for creating a UserUsage entity.
+func (c *UserUsageClient) Create() *UserUsageCreate {
+	mutation := newUserUsageMutation(c.config, OpCreate)
+	return &UserUsageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserUsage entities.
+func (c *UserUsageClient) CreateBulk(builders ...*UserUsageCreate) *UserUsageCreateBulk {
+	return &UserUsageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserUsageClient) MapCreateBulk(slice any, setFunc func(*UserUsageCreate, int)) *UserUsageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserUsageCreateBulk{err: fmt.Errorf("calling to UserUsageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserUsageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserUsageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserUsage.
+func (c *UserUsageClient) Update() *UserUsageUpdate {
+	mutation := newUserUsageMutation(c.config, OpUpdate)
+	return &UserUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserUsageClient) UpdateOne(_m *UserUsage) *UserUsageUpdateOne {
+	mutation := newUserUsageMutation(c.config, OpUpdateOne, withUserUsage(_m))
+	return &UserUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserUsageClient) UpdateOneID(id uuid.UUID) *UserUsageUpdateOne {
+	mutation := newUserUsageMutation(c.config, OpUpdateOne, withUserUsageID(id))
+	return &UserUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserUsage.
+func (c *UserUsageClient) Delete() *UserUsageDelete {
+	mutation := newUserUsageMutation(c.config, OpDelete)
+	return &UserUsageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserUsageClient) DeleteOne(_m *UserUsage) *UserUsageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserUsageClient) DeleteOneID(id uuid.UUID) *UserUsageDeleteOne {
+	builder := c.Delete().Where(userusage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserUsageDeleteOne{builder}
+}
+
+// Query returns a query builder for UserUsage.
+func (c *UserUsageClient) Query() *UserUsageQuery {
+	return &UserUsageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserUsage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserUsage entity by its id.
+func (c *UserUsageClient) Get(ctx context.Context, id uuid.UUID) (*UserUsage, error) {
+	return c.Query().Where(userusage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserUsageClient) GetX(ctx context.Context, id uuid.UUID) *UserUsage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a UserUsage.
+func (c *UserUsageClient) QueryUser(_m *UserUsage) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userusage.Table, userusage.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, userusage.UserTable, userusage.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserUsageClient) Hooks() []Hook {
+	return c.hooks.UserUsage
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserUsageClient) Interceptors() []Interceptor {
+	return c.inters.UserUsage
+}
+
+func (c *UserUsageClient) mutate(ctx context.Context, m *UserUsageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserUsageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserUsageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown UserUsage mutation op: %q", m.Op())
+	}
+}
+
+// VoiceClient is a client for the Voice schema.
+type VoiceClient struct {
+	config
+}
+
+// NewVoiceClient returns a client for the Voice from the given config.
+func NewVoiceClient(c config) *VoiceClient {
+	return &VoiceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `voice.Hooks(f(g(h())))`.
+func (c *VoiceClient) Use(hooks ...Hook) {
+	c.hooks.Voice = append(c.hooks.Voice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `voice.Intercept(f(g(h())))`.
+func (c *VoiceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Voice = append(c.inters.Voice, interceptors...)
+}
+
+// Create returns a builder for creating a Voice entity.
+func (c *VoiceClient) Create() *VoiceCreate {
+	mutation := newVoiceMutation(c.config, OpCreate)
+	return &VoiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Voice entities.
+func (c *VoiceClient) CreateBulk(builders ...*VoiceCreate) *VoiceCreateBulk {
+	return &VoiceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VoiceClient) MapCreateBulk(slice any, setFunc func(*VoiceCreate, int)) *VoiceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VoiceCreateBulk{err: fmt.Errorf("calling to VoiceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VoiceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VoiceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Voice.
+func (c *VoiceClient) Update() *VoiceUpdate {
+	mutation := newVoiceMutation(c.config, OpUpdate)
+	return &VoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VoiceClient) UpdateOne(_m *Voice) *VoiceUpdateOne {
+	mutation := newVoiceMutation(c.config, OpUpdateOne, withVoice(_m))
+	return &VoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VoiceClient) UpdateOneID(id string) *VoiceUpdateOne {
+	mutation := newVoiceMutation(c.config, OpUpdateOne, withVoiceID(id))
+	return &VoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Voice.
+func (c *VoiceClient) Delete() *VoiceDelete {
+	mutation := newVoiceMutation(c.config, OpDelete)
+	return &VoiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VoiceClient) DeleteOne(_m *Voice) *VoiceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VoiceClient) DeleteOneID(id string) *VoiceDeleteOne {
+	builder := c.Delete().Where(voice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VoiceDeleteOne{builder}
+}
+
+// Query returns a query builder for Voice.
+func (c *VoiceClient) Query() *VoiceQuery {
+	return &VoiceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVoice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Voice entity by its id.
+func (c *VoiceClient) Get(ctx context.Context, id string) (*Voice, error) {
+	return c.Query().Where(voice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VoiceClient) GetX(ctx context.Context, id string) *Voice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *VoiceClient) Hooks() []Hook {
+	return c.hooks.Voice
+}
+
+// Interceptors returns the client interceptors.
+func (c *VoiceClient) Interceptors() []Interceptor {
+	return c.inters.Voice
+}
+
+func (c *VoiceClient) mutate(ctx context.Context, m *VoiceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VoiceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VoiceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Voice mutation op: %q", m.Op())
+	}
+}
+
+// VoicePresetClient is a client for the VoicePreset schema.
+type VoicePresetClient struct {
+	config
+}
+
+// NewVoicePresetClient returns a client for the VoicePreset from the given config.
+func NewVoicePresetClient(c config) *VoicePresetClient {
+	return &VoicePresetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `voicepreset.Hooks(f(g(h())))`.
+func (c *VoicePresetClient) Use(hooks ...Hook) {
+	c.hooks.VoicePreset = append(c.hooks.VoicePreset, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `voicepreset.Intercept(f(g(h())))`.
+func (c *VoicePresetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VoicePreset = append(c.inters.VoicePreset, interceptors...)
+}
+
+// Create returns a builder for creating a VoicePreset entity.
+func (c *VoicePresetClient) Create() *VoicePresetCreate {
+	mutation := newVoicePresetMutation(c.config, OpCreate)
+	return &VoicePresetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VoicePreset entities.
+func (c *VoicePresetClient) CreateBulk(builders ...*VoicePresetCreate) *VoicePresetCreateBulk {
+	return &VoicePresetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VoicePresetClient) MapCreateBulk(slice any, setFunc func(*VoicePresetCreate, int)) *VoicePresetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VoicePresetCreateBulk{err: fmt.Errorf("calling to VoicePresetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VoicePresetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VoicePresetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VoicePreset.
+func (c *VoicePresetClient) Update() *VoicePresetUpdate {
+	mutation := newVoicePresetMutation(c.config, OpUpdate)
+	return &VoicePresetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VoicePresetClient) UpdateOne(_m *VoicePreset) *VoicePresetUpdateOne {
+	mutation := newVoicePresetMutation(c.config, OpUpdateOne, withVoicePreset(_m))
+	return &VoicePresetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VoicePresetClient) UpdateOneID(id uuid.UUID) *VoicePresetUpdateOne {
+	mutation := newVoicePresetMutation(c.config, OpUpdateOne, withVoicePresetID(id))
+	return &VoicePresetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VoicePreset.
+func (c *VoicePresetClient) Delete() *VoicePresetDelete {
+	mutation := newVoicePresetMutation(c.config, OpDelete)
+	return &VoicePresetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VoicePresetClient) DeleteOne(_m *VoicePreset) *VoicePresetDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VoicePresetClient) DeleteOneID(id uuid.UUID) *VoicePresetDeleteOne {
+	builder := c.Delete().Where(voicepreset.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VoicePresetDeleteOne{builder}
+}
+
+// Query returns a query builder for VoicePreset.
+func (c *VoicePresetClient) Query() *VoicePresetQuery {
+	return &VoicePresetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVoicePreset},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VoicePreset entity by its id.
+func (c *VoicePresetClient) Get(ctx context.Context, id uuid.UUID) (*VoicePreset, error) {
+	return c.Query().Where(voicepreset.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VoicePresetClient) GetX(ctx context.Context, id uuid.UUID) *VoicePreset {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a VoicePreset.
+func (c *VoicePresetClient) QueryUser(_m *VoicePreset) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(voicepreset.Table, voicepreset.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, voicepreset.UserTable, voicepreset.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *VoicePresetClient) Hooks() []Hook {
+	return c.hooks.VoicePreset
+}
+
+// Interceptors returns the client interceptors.
+func (c *VoicePresetClient) Interceptors() []Interceptor {
+	return c.inters.VoicePreset
+}
+
+func (c *VoicePresetClient) mutate(ctx context.Context, m *VoicePresetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VoicePresetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VoicePresetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VoicePresetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VoicePresetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown VoicePreset mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		History, User []ent.Hook
+		AudioRender, Folder, History, HistoryRevision, IdempotencyKey, LexiconEntry,
+		ModerationRule, ModerationSetting, Plan, PlayEvent, Tag, Template, UsageEntry,
+		User, UserPreference, UserUsage, Voice, VoicePreset []ent.Hook
 	}
 	inters struct {
-		History, User []ent.Interceptor
+		AudioRender, Folder, History, HistoryRevision, IdempotencyKey, LexiconEntry,
+		ModerationRule, ModerationSetting, Plan, PlayEvent, Tag, Template, UsageEntry,
+		User, UserPreference, UserUsage, Voice, VoicePreset []ent.Interceptor
 	}
 )
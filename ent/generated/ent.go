@@ -12,8 +12,24 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/audiorender"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/folder"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/historyrevision"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/idempotencykey"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/lexiconentry"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationrule"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationsetting"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/plan"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/playevent"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/tag"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/template"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/usageentry"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userpreference"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userusage"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/voice"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/voicepreset"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -74,8 +90,24 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			history.Table: history.ValidColumn,
-			user.Table:    user.ValidColumn,
+			audiorender.Table:       audiorender.ValidColumn,
+			folder.Table:            folder.ValidColumn,
+			history.Table:           history.ValidColumn,
+			historyrevision.Table:   historyrevision.ValidColumn,
+			idempotencykey.Table:    idempotencykey.ValidColumn,
+			lexiconentry.Table:      lexiconentry.ValidColumn,
+			moderationrule.Table:    moderationrule.ValidColumn,
+			moderationsetting.Table: moderationsetting.ValidColumn,
+			plan.Table:              plan.ValidColumn,
+			playevent.Table:         playevent.ValidColumn,
+			tag.Table:               tag.ValidColumn,
+			template.Table:          template.ValidColumn,
+			usageentry.Table:        usageentry.ValidColumn,
+			user.Table:              user.ValidColumn,
+			userpreference.Table:    userpreference.ValidColumn,
+			userusage.Table:         userusage.ValidColumn,
+			voice.Table:             voice.ValidColumn,
+			voicepreset.Table:       voicepreset.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
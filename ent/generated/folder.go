@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/folder"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+)
+
+// Folder is the model entity for the Folder schema.
+type Folder struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *uuid.UUID `json:"parentId,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FolderQuery when eager-loading is set.
+	Edges        FolderEdges `json:"edges"`
+	user_folders *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// FolderEdges holds the relations/edges for other nodes in the graph.
+type FolderEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Folder `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Folder `json:"children,omitempty"`
+	// Histories holds the value of the histories edge.
+	Histories []*History `json:"histories,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FolderEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FolderEdges) ParentOrErr() (*Folder, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: folder.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e FolderEdges) ChildrenOrErr() ([]*Folder, error) {
+	if e.loadedTypes[2] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// HistoriesOrErr returns the Histories value or an error if the edge
+// was not loaded in eager-loading.
+func (e FolderEdges) HistoriesOrErr() ([]*History, error) {
+	if e.loadedTypes[3] {
+		return e.Histories, nil
+	}
+	return nil, &NotLoadedError{edge: "histories"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Folder) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case folder.FieldParentID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case folder.FieldName:
+			values[i] = new(sql.NullString)
+		case folder.FieldCreatedAt, folder.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case folder.FieldID:
+			values[i] = new(uuid.UUID)
+		case folder.ForeignKeys[0]: // user_folders
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Folder fields.
+func (_m *Folder) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case folder.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case folder.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case folder.FieldParentID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				_m.ParentID = new(uuid.UUID)
+				*_m.ParentID = *value.S.(*uuid.UUID)
+			}
+		case folder.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case folder.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case folder.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_folders", values[i])
+			} else if value.Valid {
+				_m.user_folders = new(uuid.UUID)
+				*_m.user_folders = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Folder.
+// This includes values selected through modifiers, order, etc.
+func (_m *Folder) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Folder entity.
+func (_m *Folder) QueryUser() *UserQuery {
+	return NewFolderClient(_m.config).QueryUser(_m)
+}
+
+// QueryParent queries the "parent" edge of the Folder entity.
+func (_m *Folder) QueryParent() *FolderQuery {
+	return NewFolderClient(_m.config).QueryParent(_m)
+}
+
+// QueryChildren queries the "children" edge of the Folder entity.
+func (_m *Folder) QueryChildren() *FolderQuery {
+	return NewFolderClient(_m.config).QueryChildren(_m)
+}
+
+// QueryHistories queries the "histories" edge of the Folder entity.
+func (_m *Folder) QueryHistories() *HistoryQuery {
+	return NewFolderClient(_m.config).QueryHistories(_m)
+}
+
+// Update returns a builder for updating this Folder.
+// Note that you need to call Folder.Unwrap() before calling this method if this Folder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Folder) Update() *FolderUpdateOne {
+	return NewFolderClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Folder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Folder) Unwrap() *Folder {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: Folder is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Folder) String() string {
+	var builder strings.Builder
+	builder.WriteString("Folder(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	if v := _m.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Folders is a parsable slice of Folder.
+type Folders []*Folder
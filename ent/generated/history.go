@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/folder"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
 )
@@ -19,8 +20,20 @@ type History struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
 	// Text holds the value of the "text" field.
 	Text string `json:"text,omitempty"`
+	// Format holds the value of the "format" field.
+	Format history.Format `json:"format,omitempty"`
+	// PlainText holds the value of the "plain_text" field.
+	PlainText string `json:"-"`
+	// CharCount holds the value of the "char_count" field.
+	CharCount int `json:"charCount"`
+	// Language holds the value of the "language" field.
+	Language string `json:"language,omitempty"`
+	// LanguageConfidence holds the value of the "language_confidence" field.
+	LanguageConfidence *float64 `json:"languageConfidence,omitempty"`
 	// Voice holds the value of the "voice" field.
 	Voice string `json:"voice,omitempty"`
 	// Rate holds the value of the "rate" field.
@@ -29,24 +42,41 @@ type History struct {
 	Pitch float64 `json:"pitch,omitempty"`
 	// Volume holds the value of the "volume" field.
 	Volume float64 `json:"volume,omitempty"`
+	// IsFavorite holds the value of the "is_favorite" field.
+	IsFavorite bool `json:"isFavorite"`
+	// PinnedOrder holds the value of the "pinned_order" field.
+	PinnedOrder *int `json:"pinnedOrder,omitempty"`
+	// PlayCount holds the value of the "play_count" field.
+	PlayCount int `json:"playCount"`
+	// LastPlayedAt holds the value of the "last_played_at" field.
+	LastPlayedAt *time.Time `json:"lastPlayedAt,omitempty"`
+	// ContentHash holds the value of the "content_hash" field.
+	ContentHash string `json:"-"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"createdAt"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updatedAt"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the HistoryQuery when eager-loading is set.
-	Edges          HistoryEdges `json:"edges"`
-	user_histories *uuid.UUID
-	selectValues   sql.SelectValues
+	Edges            HistoryEdges `json:"edges"`
+	folder_histories *uuid.UUID
+	user_histories   *uuid.UUID
+	selectValues     sql.SelectValues
 }
 
 // HistoryEdges holds the relations/edges for other nodes in the graph.
 type HistoryEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*HistoryRevision `json:"revisions,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Folder holds the value of the folder edge.
+	Folder *Folder `json:"folder,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -60,20 +90,55 @@ func (e HistoryEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e HistoryEdges) RevisionsOrErr() ([]*HistoryRevision, error) {
+	if e.loadedTypes[1] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e HistoryEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[2] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// FolderOrErr returns the Folder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HistoryEdges) FolderOrErr() (*Folder, error) {
+	if e.Folder != nil {
+		return e.Folder, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: folder.Label}
+	}
+	return nil, &NotLoadedError{edge: "folder"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*History) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case history.FieldRate, history.FieldPitch, history.FieldVolume:
+		case history.FieldIsFavorite:
+			values[i] = new(sql.NullBool)
+		case history.FieldLanguageConfidence, history.FieldRate, history.FieldPitch, history.FieldVolume:
 			values[i] = new(sql.NullFloat64)
-		case history.FieldText, history.FieldVoice:
+		case history.FieldCharCount, history.FieldPinnedOrder, history.FieldPlayCount:
+			values[i] = new(sql.NullInt64)
+		case history.FieldText, history.FieldFormat, history.FieldPlainText, history.FieldLanguage, history.FieldVoice, history.FieldContentHash:
 			values[i] = new(sql.NullString)
-		case history.FieldCreatedAt, history.FieldUpdatedAt:
+		case history.FieldDeletedAt, history.FieldLastPlayedAt, history.FieldCreatedAt, history.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case history.FieldID:
 			values[i] = new(uuid.UUID)
-		case history.ForeignKeys[0]: // user_histories
+		case history.ForeignKeys[0]: // folder_histories
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case history.ForeignKeys[1]: // user_histories
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
@@ -96,12 +161,50 @@ func (_m *History) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case history.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case history.FieldText:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field text", values[i])
 			} else if value.Valid {
 				_m.Text = value.String
 			}
+		case history.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = history.Format(value.String)
+			}
+		case history.FieldPlainText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plain_text", values[i])
+			} else if value.Valid {
+				_m.PlainText = value.String
+			}
+		case history.FieldCharCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field char_count", values[i])
+			} else if value.Valid {
+				_m.CharCount = int(value.Int64)
+			}
+		case history.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				_m.Language = value.String
+			}
+		case history.FieldLanguageConfidence:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field language_confidence", values[i])
+			} else if value.Valid {
+				_m.LanguageConfidence = new(float64)
+				*_m.LanguageConfidence = value.Float64
+			}
 		case history.FieldVoice:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field voice", values[i])
@@ -126,6 +229,38 @@ func (_m *History) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Volume = value.Float64
 			}
+		case history.FieldIsFavorite:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_favorite", values[i])
+			} else if value.Valid {
+				_m.IsFavorite = value.Bool
+			}
+		case history.FieldPinnedOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pinned_order", values[i])
+			} else if value.Valid {
+				_m.PinnedOrder = new(int)
+				*_m.PinnedOrder = int(value.Int64)
+			}
+		case history.FieldPlayCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field play_count", values[i])
+			} else if value.Valid {
+				_m.PlayCount = int(value.Int64)
+			}
+		case history.FieldLastPlayedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_played_at", values[i])
+			} else if value.Valid {
+				_m.LastPlayedAt = new(time.Time)
+				*_m.LastPlayedAt = value.Time
+			}
+		case history.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				_m.ContentHash = value.String
+			}
 		case history.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -139,6 +274,13 @@ func (_m *History) assignValues(columns []string, values []any) error {
 				_m.UpdatedAt = value.Time
 			}
 		case history.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field folder_histories", values[i])
+			} else if value.Valid {
+				_m.folder_histories = new(uuid.UUID)
+				*_m.folder_histories = *value.S.(*uuid.UUID)
+			}
+		case history.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_histories", values[i])
 			} else if value.Valid {
@@ -163,6 +305,21 @@ func (_m *History) QueryUser() *UserQuery {
 	return NewHistoryClient(_m.config).QueryUser(_m)
 }
 
+// QueryRevisions queries the "revisions" edge of the History entity.
+func (_m *History) QueryRevisions() *HistoryRevisionQuery {
+	return NewHistoryClient(_m.config).QueryRevisions(_m)
+}
+
+// QueryTags queries the "tags" edge of the History entity.
+func (_m *History) QueryTags() *TagQuery {
+	return NewHistoryClient(_m.config).QueryTags(_m)
+}
+
+// QueryFolder queries the "folder" edge of the History entity.
+func (_m *History) QueryFolder() *FolderQuery {
+	return NewHistoryClient(_m.config).QueryFolder(_m)
+}
+
 // Update returns a builder for updating this History.
 // Note that you need to call History.Unwrap() before calling this method if this History
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -186,9 +343,31 @@ func (_m *History) String() string {
 	var builder strings.Builder
 	builder.WriteString("History(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("text=")
 	builder.WriteString(_m.Text)
 	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Format))
+	builder.WriteString(", ")
+	builder.WriteString("plain_text=")
+	builder.WriteString(_m.PlainText)
+	builder.WriteString(", ")
+	builder.WriteString("char_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CharCount))
+	builder.WriteString(", ")
+	builder.WriteString("language=")
+	builder.WriteString(_m.Language)
+	builder.WriteString(", ")
+	if v := _m.LanguageConfidence; v != nil {
+		builder.WriteString("language_confidence=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("voice=")
 	builder.WriteString(_m.Voice)
 	builder.WriteString(", ")
@@ -201,6 +380,25 @@ func (_m *History) String() string {
 	builder.WriteString("volume=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Volume))
 	builder.WriteString(", ")
+	builder.WriteString("is_favorite=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsFavorite))
+	builder.WriteString(", ")
+	if v := _m.PinnedOrder; v != nil {
+		builder.WriteString("pinned_order=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("play_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PlayCount))
+	builder.WriteString(", ")
+	if v := _m.LastPlayedAt; v != nil {
+		builder.WriteString("last_played_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("content_hash=")
+	builder.WriteString(_m.ContentHash)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -3,8 +3,10 @@
 package history
 
 import (
+	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -15,8 +17,20 @@ const (
 	Label = "history"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldText holds the string denoting the text field in the database.
 	FieldText = "text"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
+	// FieldPlainText holds the string denoting the plain_text field in the database.
+	FieldPlainText = "plain_text"
+	// FieldCharCount holds the string denoting the char_count field in the database.
+	FieldCharCount = "char_count"
+	// FieldLanguage holds the string denoting the language field in the database.
+	FieldLanguage = "language"
+	// FieldLanguageConfidence holds the string denoting the language_confidence field in the database.
+	FieldLanguageConfidence = "language_confidence"
 	// FieldVoice holds the string denoting the voice field in the database.
 	FieldVoice = "voice"
 	// FieldRate holds the string denoting the rate field in the database.
@@ -25,12 +39,28 @@ const (
 	FieldPitch = "pitch"
 	// FieldVolume holds the string denoting the volume field in the database.
 	FieldVolume = "volume"
+	// FieldIsFavorite holds the string denoting the is_favorite field in the database.
+	FieldIsFavorite = "is_favorite"
+	// FieldPinnedOrder holds the string denoting the pinned_order field in the database.
+	FieldPinnedOrder = "pinned_order"
+	// FieldPlayCount holds the string denoting the play_count field in the database.
+	FieldPlayCount = "play_count"
+	// FieldLastPlayedAt holds the string denoting the last_played_at field in the database.
+	FieldLastPlayedAt = "last_played_at"
+	// FieldContentHash holds the string denoting the content_hash field in the database.
+	FieldContentHash = "content_hash"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgeFolder holds the string denoting the folder edge name in mutations.
+	EdgeFolder = "folder"
 	// Table holds the table name of the history in the database.
 	Table = "histories"
 	// UserTable is the table that holds the user relation/edge.
@@ -40,16 +70,46 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_histories"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "history_revisions"
+	// RevisionsInverseTable is the table name for the HistoryRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "historyrevision" package.
+	RevisionsInverseTable = "history_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "history_revisions"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "tag_histories"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
+	// FolderTable is the table that holds the folder relation/edge.
+	FolderTable = "histories"
+	// FolderInverseTable is the table name for the Folder entity.
+	// It exists in this package in order to avoid circular dependency with the "folder" package.
+	FolderInverseTable = "folders"
+	// FolderColumn is the table column denoting the folder relation/edge.
+	FolderColumn = "folder_histories"
 )
 
 // Columns holds all SQL columns for history fields.
 var Columns = []string{
 	FieldID,
+	FieldDeletedAt,
 	FieldText,
+	FieldFormat,
+	FieldPlainText,
+	FieldCharCount,
+	FieldLanguage,
+	FieldLanguageConfidence,
 	FieldVoice,
 	FieldRate,
 	FieldPitch,
 	FieldVolume,
+	FieldIsFavorite,
+	FieldPinnedOrder,
+	FieldPlayCount,
+	FieldLastPlayedAt,
+	FieldContentHash,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -57,9 +117,16 @@ var Columns = []string{
 // ForeignKeys holds the SQL foreign-keys that are owned by the "histories"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"folder_histories",
 	"user_histories",
 }
 
+var (
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"tag_id", "history_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -75,9 +142,22 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/kiminodare/HOVARLAY-BE/ent/generated/runtime"
 var (
+	Hooks        [4]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// TextValidator is a validator for the "text" field. It is called by the builders before save.
 	TextValidator func(string) error
+	// DefaultCharCount holds the default value on creation for the "char_count" field.
+	DefaultCharCount int
+	// CharCountValidator is a validator for the "char_count" field. It is called by the builders before save.
+	CharCountValidator func(int) error
+	// LanguageConfidenceValidator is a validator for the "language_confidence" field. It is called by the builders before save.
+	LanguageConfidenceValidator func(float64) error
 	// VoiceValidator is a validator for the "voice" field. It is called by the builders before save.
 	VoiceValidator func(string) error
 	// DefaultRate holds the default value on creation for the "rate" field.
@@ -92,6 +172,14 @@ var (
 	DefaultVolume float64
 	// VolumeValidator is a validator for the "volume" field. It is called by the builders before save.
 	VolumeValidator func(float64) error
+	// DefaultIsFavorite holds the default value on creation for the "is_favorite" field.
+	DefaultIsFavorite bool
+	// PinnedOrderValidator is a validator for the "pinned_order" field. It is called by the builders before save.
+	PinnedOrderValidator func(int) error
+	// DefaultPlayCount holds the default value on creation for the "play_count" field.
+	DefaultPlayCount int
+	// PlayCountValidator is a validator for the "play_count" field. It is called by the builders before save.
+	PlayCountValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -102,6 +190,32 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+// Format defines the type for the "format" enum field.
+type Format string
+
+// FormatPlain is the default value of the Format enum.
+const DefaultFormat = FormatPlain
+
+// Format values.
+const (
+	FormatPlain Format = "plain"
+	FormatSsml  Format = "ssml"
+)
+
+func (f Format) String() string {
+	return string(f)
+}
+
+// FormatValidator is a validator for the "format" field enum values. It is called by the builders before save.
+func FormatValidator(f Format) error {
+	switch f {
+	case FormatPlain, FormatSsml:
+		return nil
+	default:
+		return fmt.Errorf("history: invalid enum value for format field: %q", f)
+	}
+}
+
 // OrderOption defines the ordering options for the History queries.
 type OrderOption func(*sql.Selector)
 
@@ -110,11 +224,41 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByText orders the results by the text field.
 func ByText(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldText, opts...).ToFunc()
 }
 
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
+// ByPlainText orders the results by the plain_text field.
+func ByPlainText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlainText, opts...).ToFunc()
+}
+
+// ByCharCount orders the results by the char_count field.
+func ByCharCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCharCount, opts...).ToFunc()
+}
+
+// ByLanguage orders the results by the language field.
+func ByLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLanguage, opts...).ToFunc()
+}
+
+// ByLanguageConfidence orders the results by the language_confidence field.
+func ByLanguageConfidence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLanguageConfidence, opts...).ToFunc()
+}
+
 // ByVoice orders the results by the voice field.
 func ByVoice(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVoice, opts...).ToFunc()
@@ -135,6 +279,31 @@ func ByVolume(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVolume, opts...).ToFunc()
 }
 
+// ByIsFavorite orders the results by the is_favorite field.
+func ByIsFavorite(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsFavorite, opts...).ToFunc()
+}
+
+// ByPinnedOrder orders the results by the pinned_order field.
+func ByPinnedOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPinnedOrder, opts...).ToFunc()
+}
+
+// ByPlayCount orders the results by the play_count field.
+func ByPlayCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlayCount, opts...).ToFunc()
+}
+
+// ByLastPlayedAt orders the results by the last_played_at field.
+func ByLastPlayedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastPlayedAt, opts...).ToFunc()
+}
+
+// ByContentHash orders the results by the content_hash field.
+func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -151,6 +320,41 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFolderField orders the results by folder field.
+func ByFolderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFolderStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -158,3 +362,24 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+	)
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+	)
+}
+func newFolderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FolderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FolderTable, FolderColumn),
+	)
+}
@@ -56,11 +56,36 @@ func IDLTE(id uuid.UUID) predicate.History {
 	return predicate.History(sql.FieldLTE(FieldID, id))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // Text applies equality check predicate on the "text" field. It's identical to TextEQ.
 func Text(v string) predicate.History {
 	return predicate.History(sql.FieldEQ(FieldText, v))
 }
 
+// PlainText applies equality check predicate on the "plain_text" field. It's identical to PlainTextEQ.
+func PlainText(v string) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldPlainText, v))
+}
+
+// CharCount applies equality check predicate on the "char_count" field. It's identical to CharCountEQ.
+func CharCount(v int) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldCharCount, v))
+}
+
+// Language applies equality check predicate on the "language" field. It's identical to LanguageEQ.
+func Language(v string) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldLanguage, v))
+}
+
+// LanguageConfidence applies equality check predicate on the "language_confidence" field. It's identical to LanguageConfidenceEQ.
+func LanguageConfidence(v float64) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldLanguageConfidence, v))
+}
+
 // Voice applies equality check predicate on the "voice" field. It's identical to VoiceEQ.
 func Voice(v string) predicate.History {
 	return predicate.History(sql.FieldEQ(FieldVoice, v))
@@ -81,6 +106,31 @@ func Volume(v float64) predicate.History {
 	return predicate.History(sql.FieldEQ(FieldVolume, v))
 }
 
+// IsFavorite applies equality check predicate on the "is_favorite" field. It's identical to IsFavoriteEQ.
+func IsFavorite(v bool) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldIsFavorite, v))
+}
+
+// PinnedOrder applies equality check predicate on the "pinned_order" field. It's identical to PinnedOrderEQ.
+func PinnedOrder(v int) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldPinnedOrder, v))
+}
+
+// PlayCount applies equality check predicate on the "play_count" field. It's identical to PlayCountEQ.
+func PlayCount(v int) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldPlayCount, v))
+}
+
+// LastPlayedAt applies equality check predicate on the "last_played_at" field. It's identical to LastPlayedAtEQ.
+func LastPlayedAt(v time.Time) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldLastPlayedAt, v))
+}
+
+// ContentHash applies equality check predicate on the "content_hash" field. It's identical to ContentHashEQ.
+func ContentHash(v string) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldContentHash, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.History {
 	return predicate.History(sql.FieldEQ(FieldCreatedAt, v))
@@ -91,6 +141,56 @@ func UpdatedAt(v time.Time) predicate.History {
 	return predicate.History(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.History {
+	return predicate.History(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.History {
+	return predicate.History(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.History {
+	return predicate.History(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.History {
+	return predicate.History(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.History {
+	return predicate.History(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.History {
+	return predicate.History(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.History {
+	return predicate.History(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.History {
+	return predicate.History(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.History {
+	return predicate.History(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // TextEQ applies the EQ predicate on the "text" field.
 func TextEQ(v string) predicate.History {
 	return predicate.History(sql.FieldEQ(FieldText, v))
@@ -156,6 +256,266 @@ func TextContainsFold(v string) predicate.History {
 	return predicate.History(sql.FieldContainsFold(FieldText, v))
 }
 
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v Format) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v Format) predicate.History {
+	return predicate.History(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...Format) predicate.History {
+	return predicate.History(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...Format) predicate.History {
+	return predicate.History(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// PlainTextEQ applies the EQ predicate on the "plain_text" field.
+func PlainTextEQ(v string) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldPlainText, v))
+}
+
+// PlainTextNEQ applies the NEQ predicate on the "plain_text" field.
+func PlainTextNEQ(v string) predicate.History {
+	return predicate.History(sql.FieldNEQ(FieldPlainText, v))
+}
+
+// PlainTextIn applies the In predicate on the "plain_text" field.
+func PlainTextIn(vs ...string) predicate.History {
+	return predicate.History(sql.FieldIn(FieldPlainText, vs...))
+}
+
+// PlainTextNotIn applies the NotIn predicate on the "plain_text" field.
+func PlainTextNotIn(vs ...string) predicate.History {
+	return predicate.History(sql.FieldNotIn(FieldPlainText, vs...))
+}
+
+// PlainTextGT applies the GT predicate on the "plain_text" field.
+func PlainTextGT(v string) predicate.History {
+	return predicate.History(sql.FieldGT(FieldPlainText, v))
+}
+
+// PlainTextGTE applies the GTE predicate on the "plain_text" field.
+func PlainTextGTE(v string) predicate.History {
+	return predicate.History(sql.FieldGTE(FieldPlainText, v))
+}
+
+// PlainTextLT applies the LT predicate on the "plain_text" field.
+func PlainTextLT(v string) predicate.History {
+	return predicate.History(sql.FieldLT(FieldPlainText, v))
+}
+
+// PlainTextLTE applies the LTE predicate on the "plain_text" field.
+func PlainTextLTE(v string) predicate.History {
+	return predicate.History(sql.FieldLTE(FieldPlainText, v))
+}
+
+// PlainTextContains applies the Contains predicate on the "plain_text" field.
+func PlainTextContains(v string) predicate.History {
+	return predicate.History(sql.FieldContains(FieldPlainText, v))
+}
+
+// PlainTextHasPrefix applies the HasPrefix predicate on the "plain_text" field.
+func PlainTextHasPrefix(v string) predicate.History {
+	return predicate.History(sql.FieldHasPrefix(FieldPlainText, v))
+}
+
+// PlainTextHasSuffix applies the HasSuffix predicate on the "plain_text" field.
+func PlainTextHasSuffix(v string) predicate.History {
+	return predicate.History(sql.FieldHasSuffix(FieldPlainText, v))
+}
+
+// PlainTextIsNil applies the IsNil predicate on the "plain_text" field.
+func PlainTextIsNil() predicate.History {
+	return predicate.History(sql.FieldIsNull(FieldPlainText))
+}
+
+// PlainTextNotNil applies the NotNil predicate on the "plain_text" field.
+func PlainTextNotNil() predicate.History {
+	return predicate.History(sql.FieldNotNull(FieldPlainText))
+}
+
+// PlainTextEqualFold applies the EqualFold predicate on the "plain_text" field.
+func PlainTextEqualFold(v string) predicate.History {
+	return predicate.History(sql.FieldEqualFold(FieldPlainText, v))
+}
+
+// PlainTextContainsFold applies the ContainsFold predicate on the "plain_text" field.
+func PlainTextContainsFold(v string) predicate.History {
+	return predicate.History(sql.FieldContainsFold(FieldPlainText, v))
+}
+
+// CharCountEQ applies the EQ predicate on the "char_count" field.
+func CharCountEQ(v int) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldCharCount, v))
+}
+
+// CharCountNEQ applies the NEQ predicate on the "char_count" field.
+func CharCountNEQ(v int) predicate.History {
+	return predicate.History(sql.FieldNEQ(FieldCharCount, v))
+}
+
+// CharCountIn applies the In predicate on the "char_count" field.
+func CharCountIn(vs ...int) predicate.History {
+	return predicate.History(sql.FieldIn(FieldCharCount, vs...))
+}
+
+// CharCountNotIn applies the NotIn predicate on the "char_count" field.
+func CharCountNotIn(vs ...int) predicate.History {
+	return predicate.History(sql.FieldNotIn(FieldCharCount, vs...))
+}
+
+// CharCountGT applies the GT predicate on the "char_count" field.
+func CharCountGT(v int) predicate.History {
+	return predicate.History(sql.FieldGT(FieldCharCount, v))
+}
+
+// CharCountGTE applies the GTE predicate on the "char_count" field.
+func CharCountGTE(v int) predicate.History {
+	return predicate.History(sql.FieldGTE(FieldCharCount, v))
+}
+
+// CharCountLT applies the LT predicate on the "char_count" field.
+func CharCountLT(v int) predicate.History {
+	return predicate.History(sql.FieldLT(FieldCharCount, v))
+}
+
+// CharCountLTE applies the LTE predicate on the "char_count" field.
+func CharCountLTE(v int) predicate.History {
+	return predicate.History(sql.FieldLTE(FieldCharCount, v))
+}
+
+// LanguageEQ applies the EQ predicate on the "language" field.
+func LanguageEQ(v string) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldLanguage, v))
+}
+
+// LanguageNEQ applies the NEQ predicate on the "language" field.
+func LanguageNEQ(v string) predicate.History {
+	return predicate.History(sql.FieldNEQ(FieldLanguage, v))
+}
+
+// LanguageIn applies the In predicate on the "language" field.
+func LanguageIn(vs ...string) predicate.History {
+	return predicate.History(sql.FieldIn(FieldLanguage, vs...))
+}
+
+// LanguageNotIn applies the NotIn predicate on the "language" field.
+func LanguageNotIn(vs ...string) predicate.History {
+	return predicate.History(sql.FieldNotIn(FieldLanguage, vs...))
+}
+
+// LanguageGT applies the GT predicate on the "language" field.
+func LanguageGT(v string) predicate.History {
+	return predicate.History(sql.FieldGT(FieldLanguage, v))
+}
+
+// LanguageGTE applies the GTE predicate on the "language" field.
+func LanguageGTE(v string) predicate.History {
+	return predicate.History(sql.FieldGTE(FieldLanguage, v))
+}
+
+// LanguageLT applies the LT predicate on the "language" field.
+func LanguageLT(v string) predicate.History {
+	return predicate.History(sql.FieldLT(FieldLanguage, v))
+}
+
+// LanguageLTE applies the LTE predicate on the "language" field.
+func LanguageLTE(v string) predicate.History {
+	return predicate.History(sql.FieldLTE(FieldLanguage, v))
+}
+
+// LanguageContains applies the Contains predicate on the "language" field.
+func LanguageContains(v string) predicate.History {
+	return predicate.History(sql.FieldContains(FieldLanguage, v))
+}
+
+// LanguageHasPrefix applies the HasPrefix predicate on the "language" field.
+func LanguageHasPrefix(v string) predicate.History {
+	return predicate.History(sql.FieldHasPrefix(FieldLanguage, v))
+}
+
+// LanguageHasSuffix applies the HasSuffix predicate on the "language" field.
+func LanguageHasSuffix(v string) predicate.History {
+	return predicate.History(sql.FieldHasSuffix(FieldLanguage, v))
+}
+
+// LanguageIsNil applies the IsNil predicate on the "language" field.
+func LanguageIsNil() predicate.History {
+	return predicate.History(sql.FieldIsNull(FieldLanguage))
+}
+
+// LanguageNotNil applies the NotNil predicate on the "language" field.
+func LanguageNotNil() predicate.History {
+	return predicate.History(sql.FieldNotNull(FieldLanguage))
+}
+
+// LanguageEqualFold applies the EqualFold predicate on the "language" field.
+func LanguageEqualFold(v string) predicate.History {
+	return predicate.History(sql.FieldEqualFold(FieldLanguage, v))
+}
+
+// LanguageContainsFold applies the ContainsFold predicate on the "language" field.
+func LanguageContainsFold(v string) predicate.History {
+	return predicate.History(sql.FieldContainsFold(FieldLanguage, v))
+}
+
+// LanguageConfidenceEQ applies the EQ predicate on the "language_confidence" field.
+func LanguageConfidenceEQ(v float64) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldLanguageConfidence, v))
+}
+
+// LanguageConfidenceNEQ applies the NEQ predicate on the "language_confidence" field.
+func LanguageConfidenceNEQ(v float64) predicate.History {
+	return predicate.History(sql.FieldNEQ(FieldLanguageConfidence, v))
+}
+
+// LanguageConfidenceIn applies the In predicate on the "language_confidence" field.
+func LanguageConfidenceIn(vs ...float64) predicate.History {
+	return predicate.History(sql.FieldIn(FieldLanguageConfidence, vs...))
+}
+
+// LanguageConfidenceNotIn applies the NotIn predicate on the "language_confidence" field.
+func LanguageConfidenceNotIn(vs ...float64) predicate.History {
+	return predicate.History(sql.FieldNotIn(FieldLanguageConfidence, vs...))
+}
+
+// LanguageConfidenceGT applies the GT predicate on the "language_confidence" field.
+func LanguageConfidenceGT(v float64) predicate.History {
+	return predicate.History(sql.FieldGT(FieldLanguageConfidence, v))
+}
+
+// LanguageConfidenceGTE applies the GTE predicate on the "language_confidence" field.
+func LanguageConfidenceGTE(v float64) predicate.History {
+	return predicate.History(sql.FieldGTE(FieldLanguageConfidence, v))
+}
+
+// LanguageConfidenceLT applies the LT predicate on the "language_confidence" field.
+func LanguageConfidenceLT(v float64) predicate.History {
+	return predicate.History(sql.FieldLT(FieldLanguageConfidence, v))
+}
+
+// LanguageConfidenceLTE applies the LTE predicate on the "language_confidence" field.
+func LanguageConfidenceLTE(v float64) predicate.History {
+	return predicate.History(sql.FieldLTE(FieldLanguageConfidence, v))
+}
+
+// LanguageConfidenceIsNil applies the IsNil predicate on the "language_confidence" field.
+func LanguageConfidenceIsNil() predicate.History {
+	return predicate.History(sql.FieldIsNull(FieldLanguageConfidence))
+}
+
+// LanguageConfidenceNotNil applies the NotNil predicate on the "language_confidence" field.
+func LanguageConfidenceNotNil() predicate.History {
+	return predicate.History(sql.FieldNotNull(FieldLanguageConfidence))
+}
+
 // VoiceEQ applies the EQ predicate on the "voice" field.
 func VoiceEQ(v string) predicate.History {
 	return predicate.History(sql.FieldEQ(FieldVoice, v))
@@ -341,6 +701,231 @@ func VolumeLTE(v float64) predicate.History {
 	return predicate.History(sql.FieldLTE(FieldVolume, v))
 }
 
+// IsFavoriteEQ applies the EQ predicate on the "is_favorite" field.
+func IsFavoriteEQ(v bool) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldIsFavorite, v))
+}
+
+// IsFavoriteNEQ applies the NEQ predicate on the "is_favorite" field.
+func IsFavoriteNEQ(v bool) predicate.History {
+	return predicate.History(sql.FieldNEQ(FieldIsFavorite, v))
+}
+
+// PinnedOrderEQ applies the EQ predicate on the "pinned_order" field.
+func PinnedOrderEQ(v int) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldPinnedOrder, v))
+}
+
+// PinnedOrderNEQ applies the NEQ predicate on the "pinned_order" field.
+func PinnedOrderNEQ(v int) predicate.History {
+	return predicate.History(sql.FieldNEQ(FieldPinnedOrder, v))
+}
+
+// PinnedOrderIn applies the In predicate on the "pinned_order" field.
+func PinnedOrderIn(vs ...int) predicate.History {
+	return predicate.History(sql.FieldIn(FieldPinnedOrder, vs...))
+}
+
+// PinnedOrderNotIn applies the NotIn predicate on the "pinned_order" field.
+func PinnedOrderNotIn(vs ...int) predicate.History {
+	return predicate.History(sql.FieldNotIn(FieldPinnedOrder, vs...))
+}
+
+// PinnedOrderGT applies the GT predicate on the "pinned_order" field.
+func PinnedOrderGT(v int) predicate.History {
+	return predicate.History(sql.FieldGT(FieldPinnedOrder, v))
+}
+
+// PinnedOrderGTE applies the GTE predicate on the "pinned_order" field.
+func PinnedOrderGTE(v int) predicate.History {
+	return predicate.History(sql.FieldGTE(FieldPinnedOrder, v))
+}
+
+// PinnedOrderLT applies the LT predicate on the "pinned_order" field.
+func PinnedOrderLT(v int) predicate.History {
+	return predicate.History(sql.FieldLT(FieldPinnedOrder, v))
+}
+
+// PinnedOrderLTE applies the LTE predicate on the "pinned_order" field.
+func PinnedOrderLTE(v int) predicate.History {
+	return predicate.History(sql.FieldLTE(FieldPinnedOrder, v))
+}
+
+// PinnedOrderIsNil applies the IsNil predicate on the "pinned_order" field.
+func PinnedOrderIsNil() predicate.History {
+	return predicate.History(sql.FieldIsNull(FieldPinnedOrder))
+}
+
+// PinnedOrderNotNil applies the NotNil predicate on the "pinned_order" field.
+func PinnedOrderNotNil() predicate.History {
+	return predicate.History(sql.FieldNotNull(FieldPinnedOrder))
+}
+
+// PlayCountEQ applies the EQ predicate on the "play_count" field.
+func PlayCountEQ(v int) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldPlayCount, v))
+}
+
+// PlayCountNEQ applies the NEQ predicate on the "play_count" field.
+func PlayCountNEQ(v int) predicate.History {
+	return predicate.History(sql.FieldNEQ(FieldPlayCount, v))
+}
+
+// PlayCountIn applies the In predicate on the "play_count" field.
+func PlayCountIn(vs ...int) predicate.History {
+	return predicate.History(sql.FieldIn(FieldPlayCount, vs...))
+}
+
+// PlayCountNotIn applies the NotIn predicate on the "play_count" field.
+func PlayCountNotIn(vs ...int) predicate.History {
+	return predicate.History(sql.FieldNotIn(FieldPlayCount, vs...))
+}
+
+// PlayCountGT applies the GT predicate on the "play_count" field.
+func PlayCountGT(v int) predicate.History {
+	return predicate.History(sql.FieldGT(FieldPlayCount, v))
+}
+
+// PlayCountGTE applies the GTE predicate on the "play_count" field.
+func PlayCountGTE(v int) predicate.History {
+	return predicate.History(sql.FieldGTE(FieldPlayCount, v))
+}
+
+// PlayCountLT applies the LT predicate on the "play_count" field.
+func PlayCountLT(v int) predicate.History {
+	return predicate.History(sql.FieldLT(FieldPlayCount, v))
+}
+
+// PlayCountLTE applies the LTE predicate on the "play_count" field.
+func PlayCountLTE(v int) predicate.History {
+	return predicate.History(sql.FieldLTE(FieldPlayCount, v))
+}
+
+// LastPlayedAtEQ applies the EQ predicate on the "last_played_at" field.
+func LastPlayedAtEQ(v time.Time) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldLastPlayedAt, v))
+}
+
+// LastPlayedAtNEQ applies the NEQ predicate on the "last_played_at" field.
+func LastPlayedAtNEQ(v time.Time) predicate.History {
+	return predicate.History(sql.FieldNEQ(FieldLastPlayedAt, v))
+}
+
+// LastPlayedAtIn applies the In predicate on the "last_played_at" field.
+func LastPlayedAtIn(vs ...time.Time) predicate.History {
+	return predicate.History(sql.FieldIn(FieldLastPlayedAt, vs...))
+}
+
+// LastPlayedAtNotIn applies the NotIn predicate on the "last_played_at" field.
+func LastPlayedAtNotIn(vs ...time.Time) predicate.History {
+	return predicate.History(sql.FieldNotIn(FieldLastPlayedAt, vs...))
+}
+
+// LastPlayedAtGT applies the GT predicate on the "last_played_at" field.
+func LastPlayedAtGT(v time.Time) predicate.History {
+	return predicate.History(sql.FieldGT(FieldLastPlayedAt, v))
+}
+
+// LastPlayedAtGTE applies the GTE predicate on the "last_played_at" field.
+func LastPlayedAtGTE(v time.Time) predicate.History {
+	return predicate.History(sql.FieldGTE(FieldLastPlayedAt, v))
+}
+
+// LastPlayedAtLT applies the LT predicate on the "last_played_at" field.
+func LastPlayedAtLT(v time.Time) predicate.History {
+	return predicate.History(sql.FieldLT(FieldLastPlayedAt, v))
+}
+
+// LastPlayedAtLTE applies the LTE predicate on the "last_played_at" field.
+func LastPlayedAtLTE(v time.Time) predicate.History {
+	return predicate.History(sql.FieldLTE(FieldLastPlayedAt, v))
+}
+
+// LastPlayedAtIsNil applies the IsNil predicate on the "last_played_at" field.
+func LastPlayedAtIsNil() predicate.History {
+	return predicate.History(sql.FieldIsNull(FieldLastPlayedAt))
+}
+
+// LastPlayedAtNotNil applies the NotNil predicate on the "last_played_at" field.
+func LastPlayedAtNotNil() predicate.History {
+	return predicate.History(sql.FieldNotNull(FieldLastPlayedAt))
+}
+
+// ContentHashEQ applies the EQ predicate on the "content_hash" field.
+func ContentHashEQ(v string) predicate.History {
+	return predicate.History(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentHashNEQ applies the NEQ predicate on the "content_hash" field.
+func ContentHashNEQ(v string) predicate.History {
+	return predicate.History(sql.FieldNEQ(FieldContentHash, v))
+}
+
+// ContentHashIn applies the In predicate on the "content_hash" field.
+func ContentHashIn(vs ...string) predicate.History {
+	return predicate.History(sql.FieldIn(FieldContentHash, vs...))
+}
+
+// ContentHashNotIn applies the NotIn predicate on the "content_hash" field.
+func ContentHashNotIn(vs ...string) predicate.History {
+	return predicate.History(sql.FieldNotIn(FieldContentHash, vs...))
+}
+
+// ContentHashGT applies the GT predicate on the "content_hash" field.
+func ContentHashGT(v string) predicate.History {
+	return predicate.History(sql.FieldGT(FieldContentHash, v))
+}
+
+// ContentHashGTE applies the GTE predicate on the "content_hash" field.
+func ContentHashGTE(v string) predicate.History {
+	return predicate.History(sql.FieldGTE(FieldContentHash, v))
+}
+
+// ContentHashLT applies the LT predicate on the "content_hash" field.
+func ContentHashLT(v string) predicate.History {
+	return predicate.History(sql.FieldLT(FieldContentHash, v))
+}
+
+// ContentHashLTE applies the LTE predicate on the "content_hash" field.
+func ContentHashLTE(v string) predicate.History {
+	return predicate.History(sql.FieldLTE(FieldContentHash, v))
+}
+
+// ContentHashContains applies the Contains predicate on the "content_hash" field.
+func ContentHashContains(v string) predicate.History {
+	return predicate.History(sql.FieldContains(FieldContentHash, v))
+}
+
+// ContentHashHasPrefix applies the HasPrefix predicate on the "content_hash" field.
+func ContentHashHasPrefix(v string) predicate.History {
+	return predicate.History(sql.FieldHasPrefix(FieldContentHash, v))
+}
+
+// ContentHashHasSuffix applies the HasSuffix predicate on the "content_hash" field.
+func ContentHashHasSuffix(v string) predicate.History {
+	return predicate.History(sql.FieldHasSuffix(FieldContentHash, v))
+}
+
+// ContentHashIsNil applies the IsNil predicate on the "content_hash" field.
+func ContentHashIsNil() predicate.History {
+	return predicate.History(sql.FieldIsNull(FieldContentHash))
+}
+
+// ContentHashNotNil applies the NotNil predicate on the "content_hash" field.
+func ContentHashNotNil() predicate.History {
+	return predicate.History(sql.FieldNotNull(FieldContentHash))
+}
+
+// ContentHashEqualFold applies the EqualFold predicate on the "content_hash" field.
+func ContentHashEqualFold(v string) predicate.History {
+	return predicate.History(sql.FieldEqualFold(FieldContentHash, v))
+}
+
+// ContentHashContainsFold applies the ContainsFold predicate on the "content_hash" field.
+func ContentHashContainsFold(v string) predicate.History {
+	return predicate.History(sql.FieldContainsFold(FieldContentHash, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.History {
 	return predicate.History(sql.FieldEQ(FieldCreatedAt, v))
@@ -444,6 +1029,75 @@ func HasUserWith(preds ...predicate.User) predicate.History {
 	})
 }
 
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.History {
+	return predicate.History(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.HistoryRevision) predicate.History {
+	return predicate.History(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.History {
+	return predicate.History(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.History {
+	return predicate.History(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFolder applies the HasEdge predicate on the "folder" edge.
+func HasFolder() predicate.History {
+	return predicate.History(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FolderTable, FolderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFolderWith applies the HasEdge predicate on the "folder" edge with a given conditions (other predicates).
+func HasFolderWith(preds ...predicate.Folder) predicate.History {
+	return predicate.History(func(s *sql.Selector) {
+		step := newFolderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.History) predicate.History {
 	return predicate.History(sql.AndPredicates(predicates...))
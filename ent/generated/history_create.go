@@ -11,7 +11,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/folder"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/historyrevision"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/tag"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
 )
 
@@ -22,12 +25,96 @@ type HistoryCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *HistoryCreate) SetDeletedAt(v time.Time) *HistoryCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *HistoryCreate) SetNillableDeletedAt(v *time.Time) *HistoryCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetText sets the "text" field.
 func (_c *HistoryCreate) SetText(v string) *HistoryCreate {
 	_c.mutation.SetText(v)
 	return _c
 }
 
+// SetFormat sets the "format" field.
+func (_c *HistoryCreate) SetFormat(v history.Format) *HistoryCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_c *HistoryCreate) SetNillableFormat(v *history.Format) *HistoryCreate {
+	if v != nil {
+		_c.SetFormat(*v)
+	}
+	return _c
+}
+
+// SetPlainText sets the "plain_text" field.
+func (_c *HistoryCreate) SetPlainText(v string) *HistoryCreate {
+	_c.mutation.SetPlainText(v)
+	return _c
+}
+
+// SetNillablePlainText sets the "plain_text" field if the given value is not nil.
+func (_c *HistoryCreate) SetNillablePlainText(v *string) *HistoryCreate {
+	if v != nil {
+		_c.SetPlainText(*v)
+	}
+	return _c
+}
+
+// SetCharCount sets the "char_count" field.
+func (_c *HistoryCreate) SetCharCount(v int) *HistoryCreate {
+	_c.mutation.SetCharCount(v)
+	return _c
+}
+
+// SetNillableCharCount sets the "char_count" field if the given value is not nil.
+func (_c *HistoryCreate) SetNillableCharCount(v *int) *HistoryCreate {
+	if v != nil {
+		_c.SetCharCount(*v)
+	}
+	return _c
+}
+
+// SetLanguage sets the "language" field.
+func (_c *HistoryCreate) SetLanguage(v string) *HistoryCreate {
+	_c.mutation.SetLanguage(v)
+	return _c
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_c *HistoryCreate) SetNillableLanguage(v *string) *HistoryCreate {
+	if v != nil {
+		_c.SetLanguage(*v)
+	}
+	return _c
+}
+
+// SetLanguageConfidence sets the "language_confidence" field.
+func (_c *HistoryCreate) SetLanguageConfidence(v float64) *HistoryCreate {
+	_c.mutation.SetLanguageConfidence(v)
+	return _c
+}
+
+// SetNillableLanguageConfidence sets the "language_confidence" field if the given value is not nil.
+func (_c *HistoryCreate) SetNillableLanguageConfidence(v *float64) *HistoryCreate {
+	if v != nil {
+		_c.SetLanguageConfidence(*v)
+	}
+	return _c
+}
+
 // SetVoice sets the "voice" field.
 func (_c *HistoryCreate) SetVoice(v string) *HistoryCreate {
 	_c.mutation.SetVoice(v)
@@ -76,6 +163,76 @@ func (_c *HistoryCreate) SetNillableVolume(v *float64) *HistoryCreate {
 	return _c
 }
 
+// SetIsFavorite sets the "is_favorite" field.
+func (_c *HistoryCreate) SetIsFavorite(v bool) *HistoryCreate {
+	_c.mutation.SetIsFavorite(v)
+	return _c
+}
+
+// SetNillableIsFavorite sets the "is_favorite" field if the given value is not nil.
+func (_c *HistoryCreate) SetNillableIsFavorite(v *bool) *HistoryCreate {
+	if v != nil {
+		_c.SetIsFavorite(*v)
+	}
+	return _c
+}
+
+// SetPinnedOrder sets the "pinned_order" field.
+func (_c *HistoryCreate) SetPinnedOrder(v int) *HistoryCreate {
+	_c.mutation.SetPinnedOrder(v)
+	return _c
+}
+
+// SetNillablePinnedOrder sets the "pinned_order" field if the given value is not nil.
+func (_c *HistoryCreate) SetNillablePinnedOrder(v *int) *HistoryCreate {
+	if v != nil {
+		_c.SetPinnedOrder(*v)
+	}
+	return _c
+}
+
+// SetPlayCount sets the "play_count" field.
+func (_c *HistoryCreate) SetPlayCount(v int) *HistoryCreate {
+	_c.mutation.SetPlayCount(v)
+	return _c
+}
+
+// SetNillablePlayCount sets the "play_count" field if the given value is not nil.
+func (_c *HistoryCreate) SetNillablePlayCount(v *int) *HistoryCreate {
+	if v != nil {
+		_c.SetPlayCount(*v)
+	}
+	return _c
+}
+
+// SetLastPlayedAt sets the "last_played_at" field.
+func (_c *HistoryCreate) SetLastPlayedAt(v time.Time) *HistoryCreate {
+	_c.mutation.SetLastPlayedAt(v)
+	return _c
+}
+
+// SetNillableLastPlayedAt sets the "last_played_at" field if the given value is not nil.
+func (_c *HistoryCreate) SetNillableLastPlayedAt(v *time.Time) *HistoryCreate {
+	if v != nil {
+		_c.SetLastPlayedAt(*v)
+	}
+	return _c
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_c *HistoryCreate) SetContentHash(v string) *HistoryCreate {
+	_c.mutation.SetContentHash(v)
+	return _c
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_c *HistoryCreate) SetNillableContentHash(v *string) *HistoryCreate {
+	if v != nil {
+		_c.SetContentHash(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *HistoryCreate) SetCreatedAt(v time.Time) *HistoryCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -137,6 +294,55 @@ func (_c *HistoryCreate) SetUser(v *User) *HistoryCreate {
 	return _c.SetUserID(v.ID)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the HistoryRevision entity by IDs.
+func (_c *HistoryCreate) AddRevisionIDs(ids ...uuid.UUID) *HistoryCreate {
+	_c.mutation.AddRevisionIDs(ids...)
+	return _c
+}
+
+// AddRevisions adds the "revisions" edges to the HistoryRevision entity.
+func (_c *HistoryCreate) AddRevisions(v ...*HistoryRevision) *HistoryCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevisionIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_c *HistoryCreate) AddTagIDs(ids ...uuid.UUID) *HistoryCreate {
+	_c.mutation.AddTagIDs(ids...)
+	return _c
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_c *HistoryCreate) AddTags(v ...*Tag) *HistoryCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTagIDs(ids...)
+}
+
+// SetFolderID sets the "folder" edge to the Folder entity by ID.
+func (_c *HistoryCreate) SetFolderID(id uuid.UUID) *HistoryCreate {
+	_c.mutation.SetFolderID(id)
+	return _c
+}
+
+// SetNillableFolderID sets the "folder" edge to the Folder entity by ID if the given value is not nil.
+func (_c *HistoryCreate) SetNillableFolderID(id *uuid.UUID) *HistoryCreate {
+	if id != nil {
+		_c = _c.SetFolderID(*id)
+	}
+	return _c
+}
+
+// SetFolder sets the "folder" edge to the Folder entity.
+func (_c *HistoryCreate) SetFolder(v *Folder) *HistoryCreate {
+	return _c.SetFolderID(v.ID)
+}
+
 // Mutation returns the HistoryMutation object of the builder.
 func (_c *HistoryCreate) Mutation() *HistoryMutation {
 	return _c.mutation
@@ -144,7 +350,9 @@ func (_c *HistoryCreate) Mutation() *HistoryMutation {
 
 // Save creates the History in the database.
 func (_c *HistoryCreate) Save(ctx context.Context) (*History, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -171,7 +379,15 @@ func (_c *HistoryCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *HistoryCreate) defaults() {
+func (_c *HistoryCreate) defaults() error {
+	if _, ok := _c.mutation.Format(); !ok {
+		v := history.DefaultFormat
+		_c.mutation.SetFormat(v)
+	}
+	if _, ok := _c.mutation.CharCount(); !ok {
+		v := history.DefaultCharCount
+		_c.mutation.SetCharCount(v)
+	}
 	if _, ok := _c.mutation.Rate(); !ok {
 		v := history.DefaultRate
 		_c.mutation.SetRate(v)
@@ -184,18 +400,36 @@ func (_c *HistoryCreate) defaults() {
 		v := history.DefaultVolume
 		_c.mutation.SetVolume(v)
 	}
+	if _, ok := _c.mutation.IsFavorite(); !ok {
+		v := history.DefaultIsFavorite
+		_c.mutation.SetIsFavorite(v)
+	}
+	if _, ok := _c.mutation.PlayCount(); !ok {
+		v := history.DefaultPlayCount
+		_c.mutation.SetPlayCount(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if history.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized history.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
 		v := history.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if history.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized history.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
 		v := history.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if history.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized history.DefaultID (forgotten import generated/runtime?)")
+		}
 		v := history.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -208,6 +442,27 @@ func (_c *HistoryCreate) check() error {
 			return &ValidationError{Name: "text", err: fmt.Errorf(`generated: validator failed for field "History.text": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`generated: missing required field "History.format"`)}
+	}
+	if v, ok := _c.mutation.Format(); ok {
+		if err := history.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`generated: validator failed for field "History.format": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CharCount(); !ok {
+		return &ValidationError{Name: "char_count", err: errors.New(`generated: missing required field "History.char_count"`)}
+	}
+	if v, ok := _c.mutation.CharCount(); ok {
+		if err := history.CharCountValidator(v); err != nil {
+			return &ValidationError{Name: "char_count", err: fmt.Errorf(`generated: validator failed for field "History.char_count": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LanguageConfidence(); ok {
+		if err := history.LanguageConfidenceValidator(v); err != nil {
+			return &ValidationError{Name: "language_confidence", err: fmt.Errorf(`generated: validator failed for field "History.language_confidence": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Voice(); !ok {
 		return &ValidationError{Name: "voice", err: errors.New(`generated: missing required field "History.voice"`)}
 	}
@@ -240,6 +495,22 @@ func (_c *HistoryCreate) check() error {
 			return &ValidationError{Name: "volume", err: fmt.Errorf(`generated: validator failed for field "History.volume": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.IsFavorite(); !ok {
+		return &ValidationError{Name: "is_favorite", err: errors.New(`generated: missing required field "History.is_favorite"`)}
+	}
+	if v, ok := _c.mutation.PinnedOrder(); ok {
+		if err := history.PinnedOrderValidator(v); err != nil {
+			return &ValidationError{Name: "pinned_order", err: fmt.Errorf(`generated: validator failed for field "History.pinned_order": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PlayCount(); !ok {
+		return &ValidationError{Name: "play_count", err: errors.New(`generated: missing required field "History.play_count"`)}
+	}
+	if v, ok := _c.mutation.PlayCount(); ok {
+		if err := history.PlayCountValidator(v); err != nil {
+			return &ValidationError{Name: "play_count", err: fmt.Errorf(`generated: validator failed for field "History.play_count": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "History.created_at"`)}
 	}
@@ -281,10 +552,34 @@ func (_c *HistoryCreate) createSpec() (*History, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(history.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.Text(); ok {
 		_spec.SetField(history.FieldText, field.TypeString, value)
 		_node.Text = value
 	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(history.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := _c.mutation.PlainText(); ok {
+		_spec.SetField(history.FieldPlainText, field.TypeString, value)
+		_node.PlainText = value
+	}
+	if value, ok := _c.mutation.CharCount(); ok {
+		_spec.SetField(history.FieldCharCount, field.TypeInt, value)
+		_node.CharCount = value
+	}
+	if value, ok := _c.mutation.Language(); ok {
+		_spec.SetField(history.FieldLanguage, field.TypeString, value)
+		_node.Language = value
+	}
+	if value, ok := _c.mutation.LanguageConfidence(); ok {
+		_spec.SetField(history.FieldLanguageConfidence, field.TypeFloat64, value)
+		_node.LanguageConfidence = &value
+	}
 	if value, ok := _c.mutation.Voice(); ok {
 		_spec.SetField(history.FieldVoice, field.TypeString, value)
 		_node.Voice = value
@@ -301,6 +596,26 @@ func (_c *HistoryCreate) createSpec() (*History, *sqlgraph.CreateSpec) {
 		_spec.SetField(history.FieldVolume, field.TypeFloat64, value)
 		_node.Volume = value
 	}
+	if value, ok := _c.mutation.IsFavorite(); ok {
+		_spec.SetField(history.FieldIsFavorite, field.TypeBool, value)
+		_node.IsFavorite = value
+	}
+	if value, ok := _c.mutation.PinnedOrder(); ok {
+		_spec.SetField(history.FieldPinnedOrder, field.TypeInt, value)
+		_node.PinnedOrder = &value
+	}
+	if value, ok := _c.mutation.PlayCount(); ok {
+		_spec.SetField(history.FieldPlayCount, field.TypeInt, value)
+		_node.PlayCount = value
+	}
+	if value, ok := _c.mutation.LastPlayedAt(); ok {
+		_spec.SetField(history.FieldLastPlayedAt, field.TypeTime, value)
+		_node.LastPlayedAt = &value
+	}
+	if value, ok := _c.mutation.ContentHash(); ok {
+		_spec.SetField(history.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(history.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -326,6 +641,55 @@ func (_c *HistoryCreate) createSpec() (*History, *sqlgraph.CreateSpec) {
 		_node.user_histories = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   history.RevisionsTable,
+			Columns: []string{history.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(historyrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   history.TagsTable,
+			Columns: history.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FolderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   history.FolderTable,
+			Columns: []string{history.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.folder_histories = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
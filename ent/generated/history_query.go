@@ -4,6 +4,7 @@ package generated
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -12,20 +13,26 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/folder"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/historyrevision"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/tag"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
 )
 
 // HistoryQuery is the builder for querying History entities.
 type HistoryQuery struct {
 	config
-	ctx        *QueryContext
-	order      []history.OrderOption
-	inters     []Interceptor
-	predicates []predicate.History
-	withUser   *UserQuery
-	withFKs    bool
+	ctx           *QueryContext
+	order         []history.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.History
+	withUser      *UserQuery
+	withRevisions *HistoryRevisionQuery
+	withTags      *TagQuery
+	withFolder    *FolderQuery
+	withFKs       bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +91,72 @@ func (_q *HistoryQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryRevisions chains the current query on the "revisions" edge.
+func (_q *HistoryQuery) QueryRevisions() *HistoryRevisionQuery {
+	query := (&HistoryRevisionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(history.Table, history.FieldID, selector),
+			sqlgraph.To(historyrevision.Table, historyrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, history.RevisionsTable, history.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (_q *HistoryQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(history.Table, history.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, history.TagsTable, history.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFolder chains the current query on the "folder" edge.
+func (_q *HistoryQuery) QueryFolder() *FolderQuery {
+	query := (&FolderClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(history.Table, history.FieldID, selector),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, history.FolderTable, history.FolderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first History entity from the query.
 // Returns a *NotFoundError when no History was found.
 func (_q *HistoryQuery) First(ctx context.Context) (*History, error) {
@@ -271,12 +344,15 @@ func (_q *HistoryQuery) Clone() *HistoryQuery {
 		return nil
 	}
 	return &HistoryQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]history.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.History{}, _q.predicates...),
-		withUser:   _q.withUser.Clone(),
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]history.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.History{}, _q.predicates...),
+		withUser:      _q.withUser.Clone(),
+		withRevisions: _q.withRevisions.Clone(),
+		withTags:      _q.withTags.Clone(),
+		withFolder:    _q.withFolder.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -294,18 +370,51 @@ func (_q *HistoryQuery) WithUser(opts ...func(*UserQuery)) *HistoryQuery {
 	return _q
 }
 
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *HistoryQuery) WithRevisions(opts ...func(*HistoryRevisionQuery)) *HistoryQuery {
+	query := (&HistoryRevisionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRevisions = query
+	return _q
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *HistoryQuery) WithTags(opts ...func(*TagQuery)) *HistoryQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTags = query
+	return _q
+}
+
+// WithFolder tells the query-builder to eager-load the nodes that are connected to
+// the "folder" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *HistoryQuery) WithFolder(opts ...func(*FolderQuery)) *HistoryQuery {
+	query := (&FolderClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFolder = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Text string `json:"text,omitempty"`
+//		DeletedAt time.Time `json:"deletedAt,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.History.Query().
-//		GroupBy(history.FieldText).
+//		GroupBy(history.FieldDeletedAt).
 //		Aggregate(generated.Count()).
 //		Scan(ctx, &v)
 func (_q *HistoryQuery) GroupBy(field string, fields ...string) *HistoryGroupBy {
@@ -323,11 +432,11 @@ func (_q *HistoryQuery) GroupBy(field string, fields ...string) *HistoryGroupBy
 // Example:
 //
 //	var v []struct {
-//		Text string `json:"text,omitempty"`
+//		DeletedAt time.Time `json:"deletedAt,omitempty"`
 //	}
 //
 //	client.History.Query().
-//		Select(history.FieldText).
+//		Select(history.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (_q *HistoryQuery) Select(fields ...string) *HistorySelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -373,11 +482,14 @@ func (_q *HistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Hist
 		nodes       = []*History{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [4]bool{
 			_q.withUser != nil,
+			_q.withRevisions != nil,
+			_q.withTags != nil,
+			_q.withFolder != nil,
 		}
 	)
-	if _q.withUser != nil {
+	if _q.withUser != nil || _q.withFolder != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -407,6 +519,26 @@ func (_q *HistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Hist
 			return nil, err
 		}
 	}
+	if query := _q.withRevisions; query != nil {
+		if err := _q.loadRevisions(ctx, query, nodes,
+			func(n *History) { n.Edges.Revisions = []*HistoryRevision{} },
+			func(n *History, e *HistoryRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTags; query != nil {
+		if err := _q.loadTags(ctx, query, nodes,
+			func(n *History) { n.Edges.Tags = []*Tag{} },
+			func(n *History, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withFolder; query != nil {
+		if err := _q.loadFolder(ctx, query, nodes, nil,
+			func(n *History, e *Folder) { n.Edges.Folder = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -442,6 +574,130 @@ func (_q *HistoryQuery) loadUser(ctx context.Context, query *UserQuery, nodes []
 	}
 	return nil
 }
+func (_q *HistoryQuery) loadRevisions(ctx context.Context, query *HistoryRevisionQuery, nodes []*History, init func(*History), assign func(*History, *HistoryRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*History)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.HistoryRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(history.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.history_revisions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "history_revisions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "history_revisions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *HistoryQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*History, init func(*History), assign func(*History, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*History)
+	nids := make(map[uuid.UUID]map[*History]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(history.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(history.TagsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(history.TagsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(history.TagsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*History]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *HistoryQuery) loadFolder(ctx context.Context, query *FolderQuery, nodes []*History, init func(*History), assign func(*History, *Folder)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*History)
+	for i := range nodes {
+		if nodes[i].folder_histories == nil {
+			continue
+		}
+		fk := *nodes[i].folder_histories
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(folder.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "folder_histories" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *HistoryQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -12,8 +12,11 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/folder"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/historyrevision"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/tag"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
 )
 
@@ -30,6 +33,26 @@ func (_u *HistoryUpdate) Where(ps ...predicate.History) *HistoryUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *HistoryUpdate) SetDeletedAt(v time.Time) *HistoryUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *HistoryUpdate) SetNillableDeletedAt(v *time.Time) *HistoryUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *HistoryUpdate) ClearDeletedAt() *HistoryUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetText sets the "text" field.
 func (_u *HistoryUpdate) SetText(v string) *HistoryUpdate {
 	_u.mutation.SetText(v)
@@ -44,6 +67,108 @@ func (_u *HistoryUpdate) SetNillableText(v *string) *HistoryUpdate {
 	return _u
 }
 
+// SetFormat sets the "format" field.
+func (_u *HistoryUpdate) SetFormat(v history.Format) *HistoryUpdate {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *HistoryUpdate) SetNillableFormat(v *history.Format) *HistoryUpdate {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetPlainText sets the "plain_text" field.
+func (_u *HistoryUpdate) SetPlainText(v string) *HistoryUpdate {
+	_u.mutation.SetPlainText(v)
+	return _u
+}
+
+// SetNillablePlainText sets the "plain_text" field if the given value is not nil.
+func (_u *HistoryUpdate) SetNillablePlainText(v *string) *HistoryUpdate {
+	if v != nil {
+		_u.SetPlainText(*v)
+	}
+	return _u
+}
+
+// ClearPlainText clears the value of the "plain_text" field.
+func (_u *HistoryUpdate) ClearPlainText() *HistoryUpdate {
+	_u.mutation.ClearPlainText()
+	return _u
+}
+
+// SetCharCount sets the "char_count" field.
+func (_u *HistoryUpdate) SetCharCount(v int) *HistoryUpdate {
+	_u.mutation.ResetCharCount()
+	_u.mutation.SetCharCount(v)
+	return _u
+}
+
+// SetNillableCharCount sets the "char_count" field if the given value is not nil.
+func (_u *HistoryUpdate) SetNillableCharCount(v *int) *HistoryUpdate {
+	if v != nil {
+		_u.SetCharCount(*v)
+	}
+	return _u
+}
+
+// AddCharCount adds value to the "char_count" field.
+func (_u *HistoryUpdate) AddCharCount(v int) *HistoryUpdate {
+	_u.mutation.AddCharCount(v)
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *HistoryUpdate) SetLanguage(v string) *HistoryUpdate {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *HistoryUpdate) SetNillableLanguage(v *string) *HistoryUpdate {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (_u *HistoryUpdate) ClearLanguage() *HistoryUpdate {
+	_u.mutation.ClearLanguage()
+	return _u
+}
+
+// SetLanguageConfidence sets the "language_confidence" field.
+func (_u *HistoryUpdate) SetLanguageConfidence(v float64) *HistoryUpdate {
+	_u.mutation.ResetLanguageConfidence()
+	_u.mutation.SetLanguageConfidence(v)
+	return _u
+}
+
+// SetNillableLanguageConfidence sets the "language_confidence" field if the given value is not nil.
+func (_u *HistoryUpdate) SetNillableLanguageConfidence(v *float64) *HistoryUpdate {
+	if v != nil {
+		_u.SetLanguageConfidence(*v)
+	}
+	return _u
+}
+
+// AddLanguageConfidence adds value to the "language_confidence" field.
+func (_u *HistoryUpdate) AddLanguageConfidence(v float64) *HistoryUpdate {
+	_u.mutation.AddLanguageConfidence(v)
+	return _u
+}
+
+// ClearLanguageConfidence clears the value of the "language_confidence" field.
+func (_u *HistoryUpdate) ClearLanguageConfidence() *HistoryUpdate {
+	_u.mutation.ClearLanguageConfidence()
+	return _u
+}
+
 // SetVoice sets the "voice" field.
 func (_u *HistoryUpdate) SetVoice(v string) *HistoryUpdate {
 	_u.mutation.SetVoice(v)
@@ -121,6 +246,108 @@ func (_u *HistoryUpdate) AddVolume(v float64) *HistoryUpdate {
 	return _u
 }
 
+// SetIsFavorite sets the "is_favorite" field.
+func (_u *HistoryUpdate) SetIsFavorite(v bool) *HistoryUpdate {
+	_u.mutation.SetIsFavorite(v)
+	return _u
+}
+
+// SetNillableIsFavorite sets the "is_favorite" field if the given value is not nil.
+func (_u *HistoryUpdate) SetNillableIsFavorite(v *bool) *HistoryUpdate {
+	if v != nil {
+		_u.SetIsFavorite(*v)
+	}
+	return _u
+}
+
+// SetPinnedOrder sets the "pinned_order" field.
+func (_u *HistoryUpdate) SetPinnedOrder(v int) *HistoryUpdate {
+	_u.mutation.ResetPinnedOrder()
+	_u.mutation.SetPinnedOrder(v)
+	return _u
+}
+
+// SetNillablePinnedOrder sets the "pinned_order" field if the given value is not nil.
+func (_u *HistoryUpdate) SetNillablePinnedOrder(v *int) *HistoryUpdate {
+	if v != nil {
+		_u.SetPinnedOrder(*v)
+	}
+	return _u
+}
+
+// AddPinnedOrder adds value to the "pinned_order" field.
+func (_u *HistoryUpdate) AddPinnedOrder(v int) *HistoryUpdate {
+	_u.mutation.AddPinnedOrder(v)
+	return _u
+}
+
+// ClearPinnedOrder clears the value of the "pinned_order" field.
+func (_u *HistoryUpdate) ClearPinnedOrder() *HistoryUpdate {
+	_u.mutation.ClearPinnedOrder()
+	return _u
+}
+
+// SetPlayCount sets the "play_count" field.
+func (_u *HistoryUpdate) SetPlayCount(v int) *HistoryUpdate {
+	_u.mutation.ResetPlayCount()
+	_u.mutation.SetPlayCount(v)
+	return _u
+}
+
+// SetNillablePlayCount sets the "play_count" field if the given value is not nil.
+func (_u *HistoryUpdate) SetNillablePlayCount(v *int) *HistoryUpdate {
+	if v != nil {
+		_u.SetPlayCount(*v)
+	}
+	return _u
+}
+
+// AddPlayCount adds value to the "play_count" field.
+func (_u *HistoryUpdate) AddPlayCount(v int) *HistoryUpdate {
+	_u.mutation.AddPlayCount(v)
+	return _u
+}
+
+// SetLastPlayedAt sets the "last_played_at" field.
+func (_u *HistoryUpdate) SetLastPlayedAt(v time.Time) *HistoryUpdate {
+	_u.mutation.SetLastPlayedAt(v)
+	return _u
+}
+
+// SetNillableLastPlayedAt sets the "last_played_at" field if the given value is not nil.
+func (_u *HistoryUpdate) SetNillableLastPlayedAt(v *time.Time) *HistoryUpdate {
+	if v != nil {
+		_u.SetLastPlayedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastPlayedAt clears the value of the "last_played_at" field.
+func (_u *HistoryUpdate) ClearLastPlayedAt() *HistoryUpdate {
+	_u.mutation.ClearLastPlayedAt()
+	return _u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_u *HistoryUpdate) SetContentHash(v string) *HistoryUpdate {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *HistoryUpdate) SetNillableContentHash(v *string) *HistoryUpdate {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
+	return _u
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (_u *HistoryUpdate) ClearContentHash() *HistoryUpdate {
+	_u.mutation.ClearContentHash()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *HistoryUpdate) SetCreatedAt(v time.Time) *HistoryUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -160,6 +387,55 @@ func (_u *HistoryUpdate) SetUser(v *User) *HistoryUpdate {
 	return _u.SetUserID(v.ID)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the HistoryRevision entity by IDs.
+func (_u *HistoryUpdate) AddRevisionIDs(ids ...uuid.UUID) *HistoryUpdate {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the HistoryRevision entity.
+func (_u *HistoryUpdate) AddRevisions(v ...*HistoryRevision) *HistoryUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *HistoryUpdate) AddTagIDs(ids ...uuid.UUID) *HistoryUpdate {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *HistoryUpdate) AddTags(v ...*Tag) *HistoryUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// SetFolderID sets the "folder" edge to the Folder entity by ID.
+func (_u *HistoryUpdate) SetFolderID(id uuid.UUID) *HistoryUpdate {
+	_u.mutation.SetFolderID(id)
+	return _u
+}
+
+// SetNillableFolderID sets the "folder" edge to the Folder entity by ID if the given value is not nil.
+func (_u *HistoryUpdate) SetNillableFolderID(id *uuid.UUID) *HistoryUpdate {
+	if id != nil {
+		_u = _u.SetFolderID(*id)
+	}
+	return _u
+}
+
+// SetFolder sets the "folder" edge to the Folder entity.
+func (_u *HistoryUpdate) SetFolder(v *Folder) *HistoryUpdate {
+	return _u.SetFolderID(v.ID)
+}
+
 // Mutation returns the HistoryMutation object of the builder.
 func (_u *HistoryUpdate) Mutation() *HistoryMutation {
 	return _u.mutation
@@ -171,9 +447,59 @@ func (_u *HistoryUpdate) ClearUser() *HistoryUpdate {
 	return _u
 }
 
+// ClearRevisions clears all "revisions" edges to the HistoryRevision entity.
+func (_u *HistoryUpdate) ClearRevisions() *HistoryUpdate {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to HistoryRevision entities by IDs.
+func (_u *HistoryUpdate) RemoveRevisionIDs(ids ...uuid.UUID) *HistoryUpdate {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to HistoryRevision entities.
+func (_u *HistoryUpdate) RemoveRevisions(v ...*HistoryRevision) *HistoryUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *HistoryUpdate) ClearTags() *HistoryUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *HistoryUpdate) RemoveTagIDs(ids ...uuid.UUID) *HistoryUpdate {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *HistoryUpdate) RemoveTags(v ...*Tag) *HistoryUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearFolder clears the "folder" edge to the Folder entity.
+func (_u *HistoryUpdate) ClearFolder() *HistoryUpdate {
+	_u.mutation.ClearFolder()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *HistoryUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -200,11 +526,15 @@ func (_u *HistoryUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *HistoryUpdate) defaults() {
+func (_u *HistoryUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if history.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized history.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
 		v := history.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -214,6 +544,21 @@ func (_u *HistoryUpdate) check() error {
 			return &ValidationError{Name: "text", err: fmt.Errorf(`generated: validator failed for field "History.text": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Format(); ok {
+		if err := history.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`generated: validator failed for field "History.format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CharCount(); ok {
+		if err := history.CharCountValidator(v); err != nil {
+			return &ValidationError{Name: "char_count", err: fmt.Errorf(`generated: validator failed for field "History.char_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LanguageConfidence(); ok {
+		if err := history.LanguageConfidenceValidator(v); err != nil {
+			return &ValidationError{Name: "language_confidence", err: fmt.Errorf(`generated: validator failed for field "History.language_confidence": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Voice(); ok {
 		if err := history.VoiceValidator(v); err != nil {
 			return &ValidationError{Name: "voice", err: fmt.Errorf(`generated: validator failed for field "History.voice": %w`, err)}
@@ -234,6 +579,16 @@ func (_u *HistoryUpdate) check() error {
 			return &ValidationError{Name: "volume", err: fmt.Errorf(`generated: validator failed for field "History.volume": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.PinnedOrder(); ok {
+		if err := history.PinnedOrderValidator(v); err != nil {
+			return &ValidationError{Name: "pinned_order", err: fmt.Errorf(`generated: validator failed for field "History.pinned_order": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PlayCount(); ok {
+		if err := history.PlayCountValidator(v); err != nil {
+			return &ValidationError{Name: "play_count", err: fmt.Errorf(`generated: validator failed for field "History.play_count": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -249,9 +604,45 @@ func (_u *HistoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(history.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(history.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Text(); ok {
 		_spec.SetField(history.FieldText, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(history.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PlainText(); ok {
+		_spec.SetField(history.FieldPlainText, field.TypeString, value)
+	}
+	if _u.mutation.PlainTextCleared() {
+		_spec.ClearField(history.FieldPlainText, field.TypeString)
+	}
+	if value, ok := _u.mutation.CharCount(); ok {
+		_spec.SetField(history.FieldCharCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCharCount(); ok {
+		_spec.AddField(history.FieldCharCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(history.FieldLanguage, field.TypeString, value)
+	}
+	if _u.mutation.LanguageCleared() {
+		_spec.ClearField(history.FieldLanguage, field.TypeString)
+	}
+	if value, ok := _u.mutation.LanguageConfidence(); ok {
+		_spec.SetField(history.FieldLanguageConfidence, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLanguageConfidence(); ok {
+		_spec.AddField(history.FieldLanguageConfidence, field.TypeFloat64, value)
+	}
+	if _u.mutation.LanguageConfidenceCleared() {
+		_spec.ClearField(history.FieldLanguageConfidence, field.TypeFloat64)
+	}
 	if value, ok := _u.mutation.Voice(); ok {
 		_spec.SetField(history.FieldVoice, field.TypeString, value)
 	}
@@ -273,6 +664,36 @@ func (_u *HistoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedVolume(); ok {
 		_spec.AddField(history.FieldVolume, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.IsFavorite(); ok {
+		_spec.SetField(history.FieldIsFavorite, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PinnedOrder(); ok {
+		_spec.SetField(history.FieldPinnedOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPinnedOrder(); ok {
+		_spec.AddField(history.FieldPinnedOrder, field.TypeInt, value)
+	}
+	if _u.mutation.PinnedOrderCleared() {
+		_spec.ClearField(history.FieldPinnedOrder, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PlayCount(); ok {
+		_spec.SetField(history.FieldPlayCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPlayCount(); ok {
+		_spec.AddField(history.FieldPlayCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastPlayedAt(); ok {
+		_spec.SetField(history.FieldLastPlayedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastPlayedAtCleared() {
+		_spec.ClearField(history.FieldLastPlayedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(history.FieldContentHash, field.TypeString, value)
+	}
+	if _u.mutation.ContentHashCleared() {
+		_spec.ClearField(history.FieldContentHash, field.TypeString)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(history.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -308,40 +729,281 @@ func (_u *HistoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{history.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   history.RevisionsTable,
+			Columns: []string{history.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(historyrevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   history.RevisionsTable,
+			Columns: []string{history.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(historyrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   history.RevisionsTable,
+			Columns: []string{history.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(historyrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   history.TagsTable,
+			Columns: history.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   history.TagsTable,
+			Columns: history.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   history.TagsTable,
+			Columns: history.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FolderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   history.FolderTable,
+			Columns: []string{history.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FolderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   history.FolderTable,
+			Columns: []string{history.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{history.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// HistoryUpdateOne is the builder for updating a single History entity.
+type HistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HistoryMutation
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *HistoryUpdateOne) SetDeletedAt(v time.Time) *HistoryUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *HistoryUpdateOne) SetNillableDeletedAt(v *time.Time) *HistoryUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *HistoryUpdateOne) ClearDeletedAt() *HistoryUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *HistoryUpdateOne) SetText(v string) *HistoryUpdateOne {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *HistoryUpdateOne) SetNillableText(v *string) *HistoryUpdateOne {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *HistoryUpdateOne) SetFormat(v history.Format) *HistoryUpdateOne {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *HistoryUpdateOne) SetNillableFormat(v *history.Format) *HistoryUpdateOne {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetPlainText sets the "plain_text" field.
+func (_u *HistoryUpdateOne) SetPlainText(v string) *HistoryUpdateOne {
+	_u.mutation.SetPlainText(v)
+	return _u
+}
+
+// SetNillablePlainText sets the "plain_text" field if the given value is not nil.
+func (_u *HistoryUpdateOne) SetNillablePlainText(v *string) *HistoryUpdateOne {
+	if v != nil {
+		_u.SetPlainText(*v)
+	}
+	return _u
+}
+
+// ClearPlainText clears the value of the "plain_text" field.
+func (_u *HistoryUpdateOne) ClearPlainText() *HistoryUpdateOne {
+	_u.mutation.ClearPlainText()
+	return _u
+}
+
+// SetCharCount sets the "char_count" field.
+func (_u *HistoryUpdateOne) SetCharCount(v int) *HistoryUpdateOne {
+	_u.mutation.ResetCharCount()
+	_u.mutation.SetCharCount(v)
+	return _u
+}
+
+// SetNillableCharCount sets the "char_count" field if the given value is not nil.
+func (_u *HistoryUpdateOne) SetNillableCharCount(v *int) *HistoryUpdateOne {
+	if v != nil {
+		_u.SetCharCount(*v)
+	}
+	return _u
+}
+
+// AddCharCount adds value to the "char_count" field.
+func (_u *HistoryUpdateOne) AddCharCount(v int) *HistoryUpdateOne {
+	_u.mutation.AddCharCount(v)
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *HistoryUpdateOne) SetLanguage(v string) *HistoryUpdateOne {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *HistoryUpdateOne) SetNillableLanguage(v *string) *HistoryUpdateOne {
+	if v != nil {
+		_u.SetLanguage(*v)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u
 }
 
-// HistoryUpdateOne is the builder for updating a single History entity.
-type HistoryUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *HistoryMutation
+// ClearLanguage clears the value of the "language" field.
+func (_u *HistoryUpdateOne) ClearLanguage() *HistoryUpdateOne {
+	_u.mutation.ClearLanguage()
+	return _u
 }
 
-// SetText sets the "text" field.
-func (_u *HistoryUpdateOne) SetText(v string) *HistoryUpdateOne {
-	_u.mutation.SetText(v)
+// SetLanguageConfidence sets the "language_confidence" field.
+func (_u *HistoryUpdateOne) SetLanguageConfidence(v float64) *HistoryUpdateOne {
+	_u.mutation.ResetLanguageConfidence()
+	_u.mutation.SetLanguageConfidence(v)
 	return _u
 }
 
-// SetNillableText sets the "text" field if the given value is not nil.
-func (_u *HistoryUpdateOne) SetNillableText(v *string) *HistoryUpdateOne {
+// SetNillableLanguageConfidence sets the "language_confidence" field if the given value is not nil.
+func (_u *HistoryUpdateOne) SetNillableLanguageConfidence(v *float64) *HistoryUpdateOne {
 	if v != nil {
-		_u.SetText(*v)
+		_u.SetLanguageConfidence(*v)
 	}
 	return _u
 }
 
+// AddLanguageConfidence adds value to the "language_confidence" field.
+func (_u *HistoryUpdateOne) AddLanguageConfidence(v float64) *HistoryUpdateOne {
+	_u.mutation.AddLanguageConfidence(v)
+	return _u
+}
+
+// ClearLanguageConfidence clears the value of the "language_confidence" field.
+func (_u *HistoryUpdateOne) ClearLanguageConfidence() *HistoryUpdateOne {
+	_u.mutation.ClearLanguageConfidence()
+	return _u
+}
+
 // SetVoice sets the "voice" field.
 func (_u *HistoryUpdateOne) SetVoice(v string) *HistoryUpdateOne {
 	_u.mutation.SetVoice(v)
@@ -419,6 +1081,108 @@ func (_u *HistoryUpdateOne) AddVolume(v float64) *HistoryUpdateOne {
 	return _u
 }
 
+// SetIsFavorite sets the "is_favorite" field.
+func (_u *HistoryUpdateOne) SetIsFavorite(v bool) *HistoryUpdateOne {
+	_u.mutation.SetIsFavorite(v)
+	return _u
+}
+
+// SetNillableIsFavorite sets the "is_favorite" field if the given value is not nil.
+func (_u *HistoryUpdateOne) SetNillableIsFavorite(v *bool) *HistoryUpdateOne {
+	if v != nil {
+		_u.SetIsFavorite(*v)
+	}
+	return _u
+}
+
+// SetPinnedOrder sets the "pinned_order" field.
+func (_u *HistoryUpdateOne) SetPinnedOrder(v int) *HistoryUpdateOne {
+	_u.mutation.ResetPinnedOrder()
+	_u.mutation.SetPinnedOrder(v)
+	return _u
+}
+
+// SetNillablePinnedOrder sets the "pinned_order" field if the given value is not nil.
+func (_u *HistoryUpdateOne) SetNillablePinnedOrder(v *int) *HistoryUpdateOne {
+	if v != nil {
+		_u.SetPinnedOrder(*v)
+	}
+	return _u
+}
+
+// AddPinnedOrder adds value to the "pinned_order" field.
+func (_u *HistoryUpdateOne) AddPinnedOrder(v int) *HistoryUpdateOne {
+	_u.mutation.AddPinnedOrder(v)
+	return _u
+}
+
+// ClearPinnedOrder clears the value of the "pinned_order" field.
+func (_u *HistoryUpdateOne) ClearPinnedOrder() *HistoryUpdateOne {
+	_u.mutation.ClearPinnedOrder()
+	return _u
+}
+
+// SetPlayCount sets the "play_count" field.
+func (_u *HistoryUpdateOne) SetPlayCount(v int) *HistoryUpdateOne {
+	_u.mutation.ResetPlayCount()
+	_u.mutation.SetPlayCount(v)
+	return _u
+}
+
+// SetNillablePlayCount sets the "play_count" field if the given value is not nil.
+func (_u *HistoryUpdateOne) SetNillablePlayCount(v *int) *HistoryUpdateOne {
+	if v != nil {
+		_u.SetPlayCount(*v)
+	}
+	return _u
+}
+
+// AddPlayCount adds value to the "play_count" field.
+func (_u *HistoryUpdateOne) AddPlayCount(v int) *HistoryUpdateOne {
+	_u.mutation.AddPlayCount(v)
+	return _u
+}
+
+// SetLastPlayedAt sets the "last_played_at" field.
+func (_u *HistoryUpdateOne) SetLastPlayedAt(v time.Time) *HistoryUpdateOne {
+	_u.mutation.SetLastPlayedAt(v)
+	return _u
+}
+
+// SetNillableLastPlayedAt sets the "last_played_at" field if the given value is not nil.
+func (_u *HistoryUpdateOne) SetNillableLastPlayedAt(v *time.Time) *HistoryUpdateOne {
+	if v != nil {
+		_u.SetLastPlayedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastPlayedAt clears the value of the "last_played_at" field.
+func (_u *HistoryUpdateOne) ClearLastPlayedAt() *HistoryUpdateOne {
+	_u.mutation.ClearLastPlayedAt()
+	return _u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_u *HistoryUpdateOne) SetContentHash(v string) *HistoryUpdateOne {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *HistoryUpdateOne) SetNillableContentHash(v *string) *HistoryUpdateOne {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
+	return _u
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (_u *HistoryUpdateOne) ClearContentHash() *HistoryUpdateOne {
+	_u.mutation.ClearContentHash()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *HistoryUpdateOne) SetCreatedAt(v time.Time) *HistoryUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -458,6 +1222,55 @@ func (_u *HistoryUpdateOne) SetUser(v *User) *HistoryUpdateOne {
 	return _u.SetUserID(v.ID)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the HistoryRevision entity by IDs.
+func (_u *HistoryUpdateOne) AddRevisionIDs(ids ...uuid.UUID) *HistoryUpdateOne {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the HistoryRevision entity.
+func (_u *HistoryUpdateOne) AddRevisions(v ...*HistoryRevision) *HistoryUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *HistoryUpdateOne) AddTagIDs(ids ...uuid.UUID) *HistoryUpdateOne {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *HistoryUpdateOne) AddTags(v ...*Tag) *HistoryUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// SetFolderID sets the "folder" edge to the Folder entity by ID.
+func (_u *HistoryUpdateOne) SetFolderID(id uuid.UUID) *HistoryUpdateOne {
+	_u.mutation.SetFolderID(id)
+	return _u
+}
+
+// SetNillableFolderID sets the "folder" edge to the Folder entity by ID if the given value is not nil.
+func (_u *HistoryUpdateOne) SetNillableFolderID(id *uuid.UUID) *HistoryUpdateOne {
+	if id != nil {
+		_u = _u.SetFolderID(*id)
+	}
+	return _u
+}
+
+// SetFolder sets the "folder" edge to the Folder entity.
+func (_u *HistoryUpdateOne) SetFolder(v *Folder) *HistoryUpdateOne {
+	return _u.SetFolderID(v.ID)
+}
+
 // Mutation returns the HistoryMutation object of the builder.
 func (_u *HistoryUpdateOne) Mutation() *HistoryMutation {
 	return _u.mutation
@@ -469,6 +1282,54 @@ func (_u *HistoryUpdateOne) ClearUser() *HistoryUpdateOne {
 	return _u
 }
 
+// ClearRevisions clears all "revisions" edges to the HistoryRevision entity.
+func (_u *HistoryUpdateOne) ClearRevisions() *HistoryUpdateOne {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to HistoryRevision entities by IDs.
+func (_u *HistoryUpdateOne) RemoveRevisionIDs(ids ...uuid.UUID) *HistoryUpdateOne {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to HistoryRevision entities.
+func (_u *HistoryUpdateOne) RemoveRevisions(v ...*HistoryRevision) *HistoryUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *HistoryUpdateOne) ClearTags() *HistoryUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *HistoryUpdateOne) RemoveTagIDs(ids ...uuid.UUID) *HistoryUpdateOne {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *HistoryUpdateOne) RemoveTags(v ...*Tag) *HistoryUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearFolder clears the "folder" edge to the Folder entity.
+func (_u *HistoryUpdateOne) ClearFolder() *HistoryUpdateOne {
+	_u.mutation.ClearFolder()
+	return _u
+}
+
 // Where appends a list predicates to the HistoryUpdate builder.
 func (_u *HistoryUpdateOne) Where(ps ...predicate.History) *HistoryUpdateOne {
 	_u.mutation.Where(ps...)
@@ -484,7 +1345,9 @@ func (_u *HistoryUpdateOne) Select(field string, fields ...string) *HistoryUpdat
 
 // Save executes the query and returns the updated History entity.
 func (_u *HistoryUpdateOne) Save(ctx context.Context) (*History, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -511,11 +1374,15 @@ func (_u *HistoryUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *HistoryUpdateOne) defaults() {
+func (_u *HistoryUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if history.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized history.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
 		v := history.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -525,6 +1392,21 @@ func (_u *HistoryUpdateOne) check() error {
 			return &ValidationError{Name: "text", err: fmt.Errorf(`generated: validator failed for field "History.text": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Format(); ok {
+		if err := history.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`generated: validator failed for field "History.format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CharCount(); ok {
+		if err := history.CharCountValidator(v); err != nil {
+			return &ValidationError{Name: "char_count", err: fmt.Errorf(`generated: validator failed for field "History.char_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LanguageConfidence(); ok {
+		if err := history.LanguageConfidenceValidator(v); err != nil {
+			return &ValidationError{Name: "language_confidence", err: fmt.Errorf(`generated: validator failed for field "History.language_confidence": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Voice(); ok {
 		if err := history.VoiceValidator(v); err != nil {
 			return &ValidationError{Name: "voice", err: fmt.Errorf(`generated: validator failed for field "History.voice": %w`, err)}
@@ -545,6 +1427,16 @@ func (_u *HistoryUpdateOne) check() error {
 			return &ValidationError{Name: "volume", err: fmt.Errorf(`generated: validator failed for field "History.volume": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.PinnedOrder(); ok {
+		if err := history.PinnedOrderValidator(v); err != nil {
+			return &ValidationError{Name: "pinned_order", err: fmt.Errorf(`generated: validator failed for field "History.pinned_order": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PlayCount(); ok {
+		if err := history.PlayCountValidator(v); err != nil {
+			return &ValidationError{Name: "play_count", err: fmt.Errorf(`generated: validator failed for field "History.play_count": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -577,9 +1469,45 @@ func (_u *HistoryUpdateOne) sqlSave(ctx context.Context) (_node *History, err er
 			}
 		}
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(history.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(history.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Text(); ok {
 		_spec.SetField(history.FieldText, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(history.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PlainText(); ok {
+		_spec.SetField(history.FieldPlainText, field.TypeString, value)
+	}
+	if _u.mutation.PlainTextCleared() {
+		_spec.ClearField(history.FieldPlainText, field.TypeString)
+	}
+	if value, ok := _u.mutation.CharCount(); ok {
+		_spec.SetField(history.FieldCharCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCharCount(); ok {
+		_spec.AddField(history.FieldCharCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(history.FieldLanguage, field.TypeString, value)
+	}
+	if _u.mutation.LanguageCleared() {
+		_spec.ClearField(history.FieldLanguage, field.TypeString)
+	}
+	if value, ok := _u.mutation.LanguageConfidence(); ok {
+		_spec.SetField(history.FieldLanguageConfidence, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLanguageConfidence(); ok {
+		_spec.AddField(history.FieldLanguageConfidence, field.TypeFloat64, value)
+	}
+	if _u.mutation.LanguageConfidenceCleared() {
+		_spec.ClearField(history.FieldLanguageConfidence, field.TypeFloat64)
+	}
 	if value, ok := _u.mutation.Voice(); ok {
 		_spec.SetField(history.FieldVoice, field.TypeString, value)
 	}
@@ -601,6 +1529,36 @@ func (_u *HistoryUpdateOne) sqlSave(ctx context.Context) (_node *History, err er
 	if value, ok := _u.mutation.AddedVolume(); ok {
 		_spec.AddField(history.FieldVolume, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.IsFavorite(); ok {
+		_spec.SetField(history.FieldIsFavorite, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PinnedOrder(); ok {
+		_spec.SetField(history.FieldPinnedOrder, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPinnedOrder(); ok {
+		_spec.AddField(history.FieldPinnedOrder, field.TypeInt, value)
+	}
+	if _u.mutation.PinnedOrderCleared() {
+		_spec.ClearField(history.FieldPinnedOrder, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PlayCount(); ok {
+		_spec.SetField(history.FieldPlayCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPlayCount(); ok {
+		_spec.AddField(history.FieldPlayCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastPlayedAt(); ok {
+		_spec.SetField(history.FieldLastPlayedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastPlayedAtCleared() {
+		_spec.ClearField(history.FieldLastPlayedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(history.FieldContentHash, field.TypeString, value)
+	}
+	if _u.mutation.ContentHashCleared() {
+		_spec.ClearField(history.FieldContentHash, field.TypeString)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(history.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -636,6 +1594,125 @@ func (_u *HistoryUpdateOne) sqlSave(ctx context.Context) (_node *History, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   history.RevisionsTable,
+			Columns: []string{history.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(historyrevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   history.RevisionsTable,
+			Columns: []string{history.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(historyrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   history.RevisionsTable,
+			Columns: []string{history.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(historyrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   history.TagsTable,
+			Columns: history.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   history.TagsTable,
+			Columns: history.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   history.TagsTable,
+			Columns: history.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FolderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   history.FolderTable,
+			Columns: []string{history.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FolderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   history.FolderTable,
+			Columns: []string{history.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &History{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
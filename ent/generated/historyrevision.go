@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/historyrevision"
+)
+
+// HistoryRevision is the model entity for the HistoryRevision schema.
+type HistoryRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// Format holds the value of the "format" field.
+	Format historyrevision.Format `json:"format,omitempty"`
+	// Voice holds the value of the "voice" field.
+	Voice string `json:"voice,omitempty"`
+	// Rate holds the value of the "rate" field.
+	Rate float64 `json:"rate,omitempty"`
+	// Pitch holds the value of the "pitch" field.
+	Pitch float64 `json:"pitch,omitempty"`
+	// Volume holds the value of the "volume" field.
+	Volume float64 `json:"volume,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HistoryRevisionQuery when eager-loading is set.
+	Edges             HistoryRevisionEdges `json:"edges"`
+	history_revisions *uuid.UUID
+	selectValues      sql.SelectValues
+}
+
+// HistoryRevisionEdges holds the relations/edges for other nodes in the graph.
+type HistoryRevisionEdges struct {
+	// History holds the value of the history edge.
+	History *History `json:"history,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// HistoryOrErr returns the History value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HistoryRevisionEdges) HistoryOrErr() (*History, error) {
+	if e.History != nil {
+		return e.History, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: history.Label}
+	}
+	return nil, &NotLoadedError{edge: "history"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*HistoryRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case historyrevision.FieldRate, historyrevision.FieldPitch, historyrevision.FieldVolume:
+			values[i] = new(sql.NullFloat64)
+		case historyrevision.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case historyrevision.FieldText, historyrevision.FieldFormat, historyrevision.FieldVoice:
+			values[i] = new(sql.NullString)
+		case historyrevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case historyrevision.FieldID:
+			values[i] = new(uuid.UUID)
+		case historyrevision.ForeignKeys[0]: // history_revisions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the HistoryRevision fields.
+func (_m *HistoryRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case historyrevision.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case historyrevision.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case historyrevision.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				_m.Text = value.String
+			}
+		case historyrevision.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = historyrevision.Format(value.String)
+			}
+		case historyrevision.FieldVoice:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field voice", values[i])
+			} else if value.Valid {
+				_m.Voice = value.String
+			}
+		case historyrevision.FieldRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value.Valid {
+				_m.Rate = value.Float64
+			}
+		case historyrevision.FieldPitch:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field pitch", values[i])
+			} else if value.Valid {
+				_m.Pitch = value.Float64
+			}
+		case historyrevision.FieldVolume:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field volume", values[i])
+			} else if value.Valid {
+				_m.Volume = value.Float64
+			}
+		case historyrevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case historyrevision.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field history_revisions", values[i])
+			} else if value.Valid {
+				_m.history_revisions = new(uuid.UUID)
+				*_m.history_revisions = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the HistoryRevision.
+// This includes values selected through modifiers, order, etc.
+func (_m *HistoryRevision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryHistory queries the "history" edge of the HistoryRevision entity.
+func (_m *HistoryRevision) QueryHistory() *HistoryQuery {
+	return NewHistoryRevisionClient(_m.config).QueryHistory(_m)
+}
+
+// Update returns a builder for updating this HistoryRevision.
+// Note that you need to call HistoryRevision.Unwrap() before calling this method if this HistoryRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *HistoryRevision) Update() *HistoryRevisionUpdateOne {
+	return NewHistoryRevisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the HistoryRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *HistoryRevision) Unwrap() *HistoryRevision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: HistoryRevision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *HistoryRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("HistoryRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(_m.Text)
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Format))
+	builder.WriteString(", ")
+	builder.WriteString("voice=")
+	builder.WriteString(_m.Voice)
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rate))
+	builder.WriteString(", ")
+	builder.WriteString("pitch=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Pitch))
+	builder.WriteString(", ")
+	builder.WriteString("volume=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Volume))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// HistoryRevisions is a parsable slice of HistoryRevision.
+type HistoryRevisions []*HistoryRevision
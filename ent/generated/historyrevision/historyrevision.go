@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package historyrevision
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the historyrevision type in the database.
+	Label = "history_revision"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldText holds the string denoting the text field in the database.
+	FieldText = "text"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
+	// FieldVoice holds the string denoting the voice field in the database.
+	FieldVoice = "voice"
+	// FieldRate holds the string denoting the rate field in the database.
+	FieldRate = "rate"
+	// FieldPitch holds the string denoting the pitch field in the database.
+	FieldPitch = "pitch"
+	// FieldVolume holds the string denoting the volume field in the database.
+	FieldVolume = "volume"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeHistory holds the string denoting the history edge name in mutations.
+	EdgeHistory = "history"
+	// Table holds the table name of the historyrevision in the database.
+	Table = "history_revisions"
+	// HistoryTable is the table that holds the history relation/edge.
+	HistoryTable = "history_revisions"
+	// HistoryInverseTable is the table name for the History entity.
+	// It exists in this package in order to avoid circular dependency with the "history" package.
+	HistoryInverseTable = "histories"
+	// HistoryColumn is the table column denoting the history relation/edge.
+	HistoryColumn = "history_revisions"
+)
+
+// Columns holds all SQL columns for historyrevision fields.
+var Columns = []string{
+	FieldID,
+	FieldVersion,
+	FieldText,
+	FieldFormat,
+	FieldVoice,
+	FieldRate,
+	FieldPitch,
+	FieldVolume,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "history_revisions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"history_revisions",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	VersionValidator func(int) error
+	// TextValidator is a validator for the "text" field. It is called by the builders before save.
+	TextValidator func(string) error
+	// VoiceValidator is a validator for the "voice" field. It is called by the builders before save.
+	VoiceValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Format defines the type for the "format" enum field.
+type Format string
+
+// FormatPlain is the default value of the Format enum.
+const DefaultFormat = FormatPlain
+
+// Format values.
+const (
+	FormatPlain Format = "plain"
+	FormatSsml  Format = "ssml"
+)
+
+func (f Format) String() string {
+	return string(f)
+}
+
+// FormatValidator is a validator for the "format" field enum values. It is called by the builders before save.
+func FormatValidator(f Format) error {
+	switch f {
+	case FormatPlain, FormatSsml:
+		return nil
+	default:
+		return fmt.Errorf("historyrevision: invalid enum value for format field: %q", f)
+	}
+}
+
+// OrderOption defines the ordering options for the HistoryRevision queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByText orders the results by the text field.
+func ByText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldText, opts...).ToFunc()
+}
+
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
+// ByVoice orders the results by the voice field.
+func ByVoice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVoice, opts...).ToFunc()
+}
+
+// ByRate orders the results by the rate field.
+func ByRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRate, opts...).ToFunc()
+}
+
+// ByPitch orders the results by the pitch field.
+func ByPitch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPitch, opts...).ToFunc()
+}
+
+// ByVolume orders the results by the volume field.
+func ByVolume(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVolume, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByHistoryField orders the results by history field.
+func ByHistoryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHistoryStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newHistoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HistoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, HistoryTable, HistoryColumn),
+	)
+}
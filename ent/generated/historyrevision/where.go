@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package historyrevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldLTE(FieldID, id))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldEQ(FieldVersion, v))
+}
+
+// Text applies equality check predicate on the "text" field. It's identical to TextEQ.
+func Text(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldEQ(FieldText, v))
+}
+
+// Voice applies equality check predicate on the "voice" field. It's identical to VoiceEQ.
+func Voice(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldEQ(FieldVoice, v))
+}
+
+// Rate applies equality check predicate on the "rate" field. It's identical to RateEQ.
+func Rate(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldEQ(FieldRate, v))
+}
+
+// Pitch applies equality check predicate on the "pitch" field. It's identical to PitchEQ.
+func Pitch(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldEQ(FieldPitch, v))
+}
+
+// Volume applies equality check predicate on the "volume" field. It's identical to VolumeEQ.
+func Volume(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldEQ(FieldVolume, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldLTE(FieldVersion, v))
+}
+
+// TextEQ applies the EQ predicate on the "text" field.
+func TextEQ(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldEQ(FieldText, v))
+}
+
+// TextNEQ applies the NEQ predicate on the "text" field.
+func TextNEQ(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldNEQ(FieldText, v))
+}
+
+// TextIn applies the In predicate on the "text" field.
+func TextIn(vs ...string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldIn(FieldText, vs...))
+}
+
+// TextNotIn applies the NotIn predicate on the "text" field.
+func TextNotIn(vs ...string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldNotIn(FieldText, vs...))
+}
+
+// TextGT applies the GT predicate on the "text" field.
+func TextGT(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldGT(FieldText, v))
+}
+
+// TextGTE applies the GTE predicate on the "text" field.
+func TextGTE(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldGTE(FieldText, v))
+}
+
+// TextLT applies the LT predicate on the "text" field.
+func TextLT(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldLT(FieldText, v))
+}
+
+// TextLTE applies the LTE predicate on the "text" field.
+func TextLTE(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldLTE(FieldText, v))
+}
+
+// TextContains applies the Contains predicate on the "text" field.
+func TextContains(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldContains(FieldText, v))
+}
+
+// TextHasPrefix applies the HasPrefix predicate on the "text" field.
+func TextHasPrefix(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldHasPrefix(FieldText, v))
+}
+
+// TextHasSuffix applies the HasSuffix predicate on the "text" field.
+func TextHasSuffix(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldHasSuffix(FieldText, v))
+}
+
+// TextEqualFold applies the EqualFold predicate on the "text" field.
+func TextEqualFold(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldEqualFold(FieldText, v))
+}
+
+// TextContainsFold applies the ContainsFold predicate on the "text" field.
+func TextContainsFold(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldContainsFold(FieldText, v))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v Format) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v Format) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...Format) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...Format) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// VoiceEQ applies the EQ predicate on the "voice" field.
+func VoiceEQ(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldEQ(FieldVoice, v))
+}
+
+// VoiceNEQ applies the NEQ predicate on the "voice" field.
+func VoiceNEQ(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldNEQ(FieldVoice, v))
+}
+
+// VoiceIn applies the In predicate on the "voice" field.
+func VoiceIn(vs ...string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldIn(FieldVoice, vs...))
+}
+
+// VoiceNotIn applies the NotIn predicate on the "voice" field.
+func VoiceNotIn(vs ...string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldNotIn(FieldVoice, vs...))
+}
+
+// VoiceGT applies the GT predicate on the "voice" field.
+func VoiceGT(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldGT(FieldVoice, v))
+}
+
+// VoiceGTE applies the GTE predicate on the "voice" field.
+func VoiceGTE(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldGTE(FieldVoice, v))
+}
+
+// VoiceLT applies the LT predicate on the "voice" field.
+func VoiceLT(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldLT(FieldVoice, v))
+}
+
+// VoiceLTE applies the LTE predicate on the "voice" field.
+func VoiceLTE(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldLTE(FieldVoice, v))
+}
+
+// VoiceContains applies the Contains predicate on the "voice" field.
+func VoiceContains(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldContains(FieldVoice, v))
+}
+
+// VoiceHasPrefix applies the HasPrefix predicate on the "voice" field.
+func VoiceHasPrefix(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldHasPrefix(FieldVoice, v))
+}
+
+// VoiceHasSuffix applies the HasSuffix predicate on the "voice" field.
+func VoiceHasSuffix(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldHasSuffix(FieldVoice, v))
+}
+
+// VoiceEqualFold applies the EqualFold predicate on the "voice" field.
+func VoiceEqualFold(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldEqualFold(FieldVoice, v))
+}
+
+// VoiceContainsFold applies the ContainsFold predicate on the "voice" field.
+func VoiceContainsFold(v string) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldContainsFold(FieldVoice, v))
+}
+
+// RateEQ applies the EQ predicate on the "rate" field.
+func RateEQ(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldEQ(FieldRate, v))
+}
+
+// RateNEQ applies the NEQ predicate on the "rate" field.
+func RateNEQ(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldNEQ(FieldRate, v))
+}
+
+// RateIn applies the In predicate on the "rate" field.
+func RateIn(vs ...float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldIn(FieldRate, vs...))
+}
+
+// RateNotIn applies the NotIn predicate on the "rate" field.
+func RateNotIn(vs ...float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldNotIn(FieldRate, vs...))
+}
+
+// RateGT applies the GT predicate on the "rate" field.
+func RateGT(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldGT(FieldRate, v))
+}
+
+// RateGTE applies the GTE predicate on the "rate" field.
+func RateGTE(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldGTE(FieldRate, v))
+}
+
+// RateLT applies the LT predicate on the "rate" field.
+func RateLT(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldLT(FieldRate, v))
+}
+
+// RateLTE applies the LTE predicate on the "rate" field.
+func RateLTE(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldLTE(FieldRate, v))
+}
+
+// PitchEQ applies the EQ predicate on the "pitch" field.
+func PitchEQ(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldEQ(FieldPitch, v))
+}
+
+// PitchNEQ applies the NEQ predicate on the "pitch" field.
+func PitchNEQ(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldNEQ(FieldPitch, v))
+}
+
+// PitchIn applies the In predicate on the "pitch" field.
+func PitchIn(vs ...float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldIn(FieldPitch, vs...))
+}
+
+// PitchNotIn applies the NotIn predicate on the "pitch" field.
+func PitchNotIn(vs ...float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldNotIn(FieldPitch, vs...))
+}
+
+// PitchGT applies the GT predicate on the "pitch" field.
+func PitchGT(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldGT(FieldPitch, v))
+}
+
+// PitchGTE applies the GTE predicate on the "pitch" field.
+func PitchGTE(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldGTE(FieldPitch, v))
+}
+
+// PitchLT applies the LT predicate on the "pitch" field.
+func PitchLT(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldLT(FieldPitch, v))
+}
+
+// PitchLTE applies the LTE predicate on the "pitch" field.
+func PitchLTE(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldLTE(FieldPitch, v))
+}
+
+// VolumeEQ applies the EQ predicate on the "volume" field.
+func VolumeEQ(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldEQ(FieldVolume, v))
+}
+
+// VolumeNEQ applies the NEQ predicate on the "volume" field.
+func VolumeNEQ(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldNEQ(FieldVolume, v))
+}
+
+// VolumeIn applies the In predicate on the "volume" field.
+func VolumeIn(vs ...float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldIn(FieldVolume, vs...))
+}
+
+// VolumeNotIn applies the NotIn predicate on the "volume" field.
+func VolumeNotIn(vs ...float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldNotIn(FieldVolume, vs...))
+}
+
+// VolumeGT applies the GT predicate on the "volume" field.
+func VolumeGT(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldGT(FieldVolume, v))
+}
+
+// VolumeGTE applies the GTE predicate on the "volume" field.
+func VolumeGTE(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldGTE(FieldVolume, v))
+}
+
+// VolumeLT applies the LT predicate on the "volume" field.
+func VolumeLT(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldLT(FieldVolume, v))
+}
+
+// VolumeLTE applies the LTE predicate on the "volume" field.
+func VolumeLTE(v float64) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldLTE(FieldVolume, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasHistory applies the HasEdge predicate on the "history" edge.
+func HasHistory() predicate.HistoryRevision {
+	return predicate.HistoryRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, HistoryTable, HistoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHistoryWith applies the HasEdge predicate on the "history" edge with a given conditions (other predicates).
+func HasHistoryWith(preds ...predicate.History) predicate.HistoryRevision {
+	return predicate.HistoryRevision(func(s *sql.Selector) {
+		step := newHistoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.HistoryRevision) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.HistoryRevision) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.HistoryRevision) predicate.HistoryRevision {
+	return predicate.HistoryRevision(sql.NotPredicates(p))
+}
@@ -0,0 +1,381 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/historyrevision"
+)
+
+// HistoryRevisionCreate is the builder for creating a HistoryRevision entity.
+type HistoryRevisionCreate struct {
+	config
+	mutation *HistoryRevisionMutation
+	hooks    []Hook
+}
+
+// SetVersion sets the "version" field.
+func (_c *HistoryRevisionCreate) SetVersion(v int) *HistoryRevisionCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetText sets the "text" field.
+func (_c *HistoryRevisionCreate) SetText(v string) *HistoryRevisionCreate {
+	_c.mutation.SetText(v)
+	return _c
+}
+
+// SetFormat sets the "format" field.
+func (_c *HistoryRevisionCreate) SetFormat(v historyrevision.Format) *HistoryRevisionCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_c *HistoryRevisionCreate) SetNillableFormat(v *historyrevision.Format) *HistoryRevisionCreate {
+	if v != nil {
+		_c.SetFormat(*v)
+	}
+	return _c
+}
+
+// SetVoice sets the "voice" field.
+func (_c *HistoryRevisionCreate) SetVoice(v string) *HistoryRevisionCreate {
+	_c.mutation.SetVoice(v)
+	return _c
+}
+
+// SetRate sets the "rate" field.
+func (_c *HistoryRevisionCreate) SetRate(v float64) *HistoryRevisionCreate {
+	_c.mutation.SetRate(v)
+	return _c
+}
+
+// SetPitch sets the "pitch" field.
+func (_c *HistoryRevisionCreate) SetPitch(v float64) *HistoryRevisionCreate {
+	_c.mutation.SetPitch(v)
+	return _c
+}
+
+// SetVolume sets the "volume" field.
+func (_c *HistoryRevisionCreate) SetVolume(v float64) *HistoryRevisionCreate {
+	_c.mutation.SetVolume(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *HistoryRevisionCreate) SetCreatedAt(v time.Time) *HistoryRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *HistoryRevisionCreate) SetNillableCreatedAt(v *time.Time) *HistoryRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *HistoryRevisionCreate) SetID(v uuid.UUID) *HistoryRevisionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *HistoryRevisionCreate) SetNillableID(v *uuid.UUID) *HistoryRevisionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetHistoryID sets the "history" edge to the History entity by ID.
+func (_c *HistoryRevisionCreate) SetHistoryID(id uuid.UUID) *HistoryRevisionCreate {
+	_c.mutation.SetHistoryID(id)
+	return _c
+}
+
+// SetHistory sets the "history" edge to the History entity.
+func (_c *HistoryRevisionCreate) SetHistory(v *History) *HistoryRevisionCreate {
+	return _c.SetHistoryID(v.ID)
+}
+
+// Mutation returns the HistoryRevisionMutation object of the builder.
+func (_c *HistoryRevisionCreate) Mutation() *HistoryRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the HistoryRevision in the database.
+func (_c *HistoryRevisionCreate) Save(ctx context.Context) (*HistoryRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *HistoryRevisionCreate) SaveX(ctx context.Context) *HistoryRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HistoryRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HistoryRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *HistoryRevisionCreate) defaults() {
+	if _, ok := _c.mutation.Format(); !ok {
+		v := historyrevision.DefaultFormat
+		_c.mutation.SetFormat(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := historyrevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := historyrevision.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *HistoryRevisionCreate) check() error {
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`generated: missing required field "HistoryRevision.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := historyrevision.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`generated: validator failed for field "HistoryRevision.version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`generated: missing required field "HistoryRevision.text"`)}
+	}
+	if v, ok := _c.mutation.Text(); ok {
+		if err := historyrevision.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf(`generated: validator failed for field "HistoryRevision.text": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`generated: missing required field "HistoryRevision.format"`)}
+	}
+	if v, ok := _c.mutation.Format(); ok {
+		if err := historyrevision.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`generated: validator failed for field "HistoryRevision.format": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Voice(); !ok {
+		return &ValidationError{Name: "voice", err: errors.New(`generated: missing required field "HistoryRevision.voice"`)}
+	}
+	if v, ok := _c.mutation.Voice(); ok {
+		if err := historyrevision.VoiceValidator(v); err != nil {
+			return &ValidationError{Name: "voice", err: fmt.Errorf(`generated: validator failed for field "HistoryRevision.voice": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`generated: missing required field "HistoryRevision.rate"`)}
+	}
+	if _, ok := _c.mutation.Pitch(); !ok {
+		return &ValidationError{Name: "pitch", err: errors.New(`generated: missing required field "HistoryRevision.pitch"`)}
+	}
+	if _, ok := _c.mutation.Volume(); !ok {
+		return &ValidationError{Name: "volume", err: errors.New(`generated: missing required field "HistoryRevision.volume"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "HistoryRevision.created_at"`)}
+	}
+	if len(_c.mutation.HistoryIDs()) == 0 {
+		return &ValidationError{Name: "history", err: errors.New(`generated: missing required edge "HistoryRevision.history"`)}
+	}
+	return nil
+}
+
+func (_c *HistoryRevisionCreate) sqlSave(ctx context.Context) (*HistoryRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *HistoryRevisionCreate) createSpec() (*HistoryRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &HistoryRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(historyrevision.Table, sqlgraph.NewFieldSpec(historyrevision.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(historyrevision.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Text(); ok {
+		_spec.SetField(historyrevision.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(historyrevision.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := _c.mutation.Voice(); ok {
+		_spec.SetField(historyrevision.FieldVoice, field.TypeString, value)
+		_node.Voice = value
+	}
+	if value, ok := _c.mutation.Rate(); ok {
+		_spec.SetField(historyrevision.FieldRate, field.TypeFloat64, value)
+		_node.Rate = value
+	}
+	if value, ok := _c.mutation.Pitch(); ok {
+		_spec.SetField(historyrevision.FieldPitch, field.TypeFloat64, value)
+		_node.Pitch = value
+	}
+	if value, ok := _c.mutation.Volume(); ok {
+		_spec.SetField(historyrevision.FieldVolume, field.TypeFloat64, value)
+		_node.Volume = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(historyrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.HistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   historyrevision.HistoryTable,
+			Columns: []string{historyrevision.HistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(history.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.history_revisions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// HistoryRevisionCreateBulk is the builder for creating many HistoryRevision entities in bulk.
+type HistoryRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*HistoryRevisionCreate
+}
+
+// Save creates the HistoryRevision entities in the database.
+func (_c *HistoryRevisionCreateBulk) Save(ctx context.Context) ([]*HistoryRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*HistoryRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HistoryRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *HistoryRevisionCreateBulk) SaveX(ctx context.Context) []*HistoryRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HistoryRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HistoryRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/historyrevision"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+)
+
+// HistoryRevisionDelete is the builder for deleting a HistoryRevision entity.
+type HistoryRevisionDelete struct {
+	config
+	hooks    []Hook
+	mutation *HistoryRevisionMutation
+}
+
+// Where appends a list predicates to the HistoryRevisionDelete builder.
+func (_d *HistoryRevisionDelete) Where(ps ...predicate.HistoryRevision) *HistoryRevisionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *HistoryRevisionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *HistoryRevisionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *HistoryRevisionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(historyrevision.Table, sqlgraph.NewFieldSpec(historyrevision.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// HistoryRevisionDeleteOne is the builder for deleting a single HistoryRevision entity.
+type HistoryRevisionDeleteOne struct {
+	_d *HistoryRevisionDelete
+}
+
+// Where appends a list predicates to the HistoryRevisionDelete builder.
+func (_d *HistoryRevisionDeleteOne) Where(ps ...predicate.HistoryRevision) *HistoryRevisionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *HistoryRevisionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{historyrevision.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *HistoryRevisionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
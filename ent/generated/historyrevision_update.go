@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/historyrevision"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+)
+
+// HistoryRevisionUpdate is the builder for updating HistoryRevision entities.
+type HistoryRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HistoryRevisionMutation
+}
+
+// Where appends a list predicates to the HistoryRevisionUpdate builder.
+func (_u *HistoryRevisionUpdate) Where(ps ...predicate.HistoryRevision) *HistoryRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the HistoryRevisionMutation object of the builder.
+func (_u *HistoryRevisionUpdate) Mutation() *HistoryRevisionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *HistoryRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HistoryRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *HistoryRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HistoryRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HistoryRevisionUpdate) check() error {
+	if _u.mutation.HistoryCleared() && len(_u.mutation.HistoryIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "HistoryRevision.history"`)
+	}
+	return nil
+}
+
+func (_u *HistoryRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(historyrevision.Table, historyrevision.Columns, sqlgraph.NewFieldSpec(historyrevision.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{historyrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// HistoryRevisionUpdateOne is the builder for updating a single HistoryRevision entity.
+type HistoryRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HistoryRevisionMutation
+}
+
+// Mutation returns the HistoryRevisionMutation object of the builder.
+func (_u *HistoryRevisionUpdateOne) Mutation() *HistoryRevisionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the HistoryRevisionUpdate builder.
+func (_u *HistoryRevisionUpdateOne) Where(ps ...predicate.HistoryRevision) *HistoryRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *HistoryRevisionUpdateOne) Select(field string, fields ...string) *HistoryRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated HistoryRevision entity.
+func (_u *HistoryRevisionUpdateOne) Save(ctx context.Context) (*HistoryRevision, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HistoryRevisionUpdateOne) SaveX(ctx context.Context) *HistoryRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *HistoryRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HistoryRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HistoryRevisionUpdateOne) check() error {
+	if _u.mutation.HistoryCleared() && len(_u.mutation.HistoryIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "HistoryRevision.history"`)
+	}
+	return nil
+}
+
+func (_u *HistoryRevisionUpdateOne) sqlSave(ctx context.Context) (_node *HistoryRevision, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(historyrevision.Table, historyrevision.Columns, sqlgraph.NewFieldSpec(historyrevision.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "HistoryRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, historyrevision.FieldID)
+		for _, f := range fields {
+			if !historyrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != historyrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &HistoryRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{historyrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
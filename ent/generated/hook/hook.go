@@ -9,6 +9,30 @@ import (
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
 )
 
+// The AudioRenderFunc type is an adapter to allow the use of ordinary
+// function as AudioRender mutator.
+type AudioRenderFunc func(context.Context, *generated.AudioRenderMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AudioRenderFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.AudioRenderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.AudioRenderMutation", m)
+}
+
+// The FolderFunc type is an adapter to allow the use of ordinary
+// function as Folder mutator.
+type FolderFunc func(context.Context, *generated.FolderMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FolderFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.FolderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.FolderMutation", m)
+}
+
 // The HistoryFunc type is an adapter to allow the use of ordinary
 // function as History mutator.
 type HistoryFunc func(context.Context, *generated.HistoryMutation) (generated.Value, error)
@@ -21,6 +45,126 @@ func (f HistoryFunc) Mutate(ctx context.Context, m generated.Mutation) (generate
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.HistoryMutation", m)
 }
 
+// The HistoryRevisionFunc type is an adapter to allow the use of ordinary
+// function as HistoryRevision mutator.
+type HistoryRevisionFunc func(context.Context, *generated.HistoryRevisionMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HistoryRevisionFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.HistoryRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.HistoryRevisionMutation", m)
+}
+
+// The IdempotencyKeyFunc type is an adapter to allow the use of ordinary
+// function as IdempotencyKey mutator.
+type IdempotencyKeyFunc func(context.Context, *generated.IdempotencyKeyMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdempotencyKeyFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.IdempotencyKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.IdempotencyKeyMutation", m)
+}
+
+// The LexiconEntryFunc type is an adapter to allow the use of ordinary
+// function as LexiconEntry mutator.
+type LexiconEntryFunc func(context.Context, *generated.LexiconEntryMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LexiconEntryFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.LexiconEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.LexiconEntryMutation", m)
+}
+
+// The ModerationRuleFunc type is an adapter to allow the use of ordinary
+// function as ModerationRule mutator.
+type ModerationRuleFunc func(context.Context, *generated.ModerationRuleMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ModerationRuleFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.ModerationRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.ModerationRuleMutation", m)
+}
+
+// The ModerationSettingFunc type is an adapter to allow the use of ordinary
+// function as ModerationSetting mutator.
+type ModerationSettingFunc func(context.Context, *generated.ModerationSettingMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ModerationSettingFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.ModerationSettingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.ModerationSettingMutation", m)
+}
+
+// The PlanFunc type is an adapter to allow the use of ordinary
+// function as Plan mutator.
+type PlanFunc func(context.Context, *generated.PlanMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PlanFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.PlanMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.PlanMutation", m)
+}
+
+// The PlayEventFunc type is an adapter to allow the use of ordinary
+// function as PlayEvent mutator.
+type PlayEventFunc func(context.Context, *generated.PlayEventMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PlayEventFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.PlayEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.PlayEventMutation", m)
+}
+
+// The TagFunc type is an adapter to allow the use of ordinary
+// function as Tag mutator.
+type TagFunc func(context.Context, *generated.TagMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TagFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.TagMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.TagMutation", m)
+}
+
+// The TemplateFunc type is an adapter to allow the use of ordinary
+// function as Template mutator.
+type TemplateFunc func(context.Context, *generated.TemplateMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TemplateFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.TemplateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.TemplateMutation", m)
+}
+
+// The UsageEntryFunc type is an adapter to allow the use of ordinary
+// function as UsageEntry mutator.
+type UsageEntryFunc func(context.Context, *generated.UsageEntryMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UsageEntryFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.UsageEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.UsageEntryMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *generated.UserMutation) (generated.Value, error)
@@ -33,6 +177,54 @@ func (f UserFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.UserMutation", m)
 }
 
+// The UserPreferenceFunc type is an adapter to allow the use of ordinary
+// function as UserPreference mutator.
+type UserPreferenceFunc func(context.Context, *generated.UserPreferenceMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserPreferenceFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.UserPreferenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.UserPreferenceMutation", m)
+}
+
+// The UserUsageFunc type is an adapter to allow the use of ordinary
+// function as UserUsage mutator.
+type UserUsageFunc func(context.Context, *generated.UserUsageMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserUsageFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.UserUsageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.UserUsageMutation", m)
+}
+
+// The VoiceFunc type is an adapter to allow the use of ordinary
+// function as Voice mutator.
+type VoiceFunc func(context.Context, *generated.VoiceMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VoiceFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.VoiceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.VoiceMutation", m)
+}
+
+// The VoicePresetFunc type is an adapter to allow the use of ordinary
+// function as VoicePreset mutator.
+type VoicePresetFunc func(context.Context, *generated.VoicePresetMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VoicePresetFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.VoicePresetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.VoicePresetMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, generated.Mutation) bool
 
@@ -0,0 +1,659 @@
+// Code generated by ent, DO NOT EDIT.
+
+package intercept
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/audiorender"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/folder"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/historyrevision"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/idempotencykey"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/lexiconentry"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationrule"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationsetting"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/plan"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/playevent"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/tag"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/template"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/usageentry"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userpreference"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userusage"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/voice"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/voicepreset"
+)
+
+// The Query interface represents an operation that queries a graph.
+// By using this interface, users can write generic code that manipulates
+// query builders of different types.
+type Query interface {
+	// Type returns the string representation of the query type.
+	Type() string
+	// Limit the number of records to be returned by this query.
+	Limit(int)
+	// Offset to start from.
+	Offset(int)
+	// Unique configures the query builder to filter duplicate records.
+	Unique(bool)
+	// Order specifies how the records should be ordered.
+	Order(...func(*sql.Selector))
+	// WhereP appends storage-level predicates to the query builder. Using this method, users
+	// can use type-assertion to append predicates that do not depend on any generated package.
+	WhereP(...func(*sql.Selector))
+}
+
+// The Func type is an adapter that allows ordinary functions to be used as interceptors.
+// Unlike traversal functions, interceptors are skipped during graph traversals. Note that the
+// implementation of Func is different from the one defined in entgo.io/ent.InterceptFunc.
+type Func func(context.Context, Query) error
+
+// Intercept calls f(ctx, q) and then applied the next Querier.
+func (f Func) Intercept(next generated.Querier) generated.Querier {
+	return generated.QuerierFunc(func(ctx context.Context, q generated.Query) (generated.Value, error) {
+		query, err := NewQuery(q)
+		if err != nil {
+			return nil, err
+		}
+		if err := f(ctx, query); err != nil {
+			return nil, err
+		}
+		return next.Query(ctx, q)
+	})
+}
+
+// The TraverseFunc type is an adapter to allow the use of ordinary function as Traverser.
+// If f is a function with the appropriate signature, TraverseFunc(f) is a Traverser that calls f.
+type TraverseFunc func(context.Context, Query) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFunc) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFunc) Traverse(ctx context.Context, q generated.Query) error {
+	query, err := NewQuery(q)
+	if err != nil {
+		return err
+	}
+	return f(ctx, query)
+}
+
+// The AudioRenderFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AudioRenderFunc func(context.Context, *generated.AudioRenderQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f AudioRenderFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.AudioRenderQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.AudioRenderQuery", q)
+}
+
+// The TraverseAudioRender type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAudioRender func(context.Context, *generated.AudioRenderQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAudioRender) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAudioRender) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.AudioRenderQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.AudioRenderQuery", q)
+}
+
+// The FolderFunc type is an adapter to allow the use of ordinary function as a Querier.
+type FolderFunc func(context.Context, *generated.FolderQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f FolderFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.FolderQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.FolderQuery", q)
+}
+
+// The TraverseFolder type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseFolder func(context.Context, *generated.FolderQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFolder) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFolder) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.FolderQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.FolderQuery", q)
+}
+
+// The HistoryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type HistoryFunc func(context.Context, *generated.HistoryQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f HistoryFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.HistoryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.HistoryQuery", q)
+}
+
+// The TraverseHistory type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseHistory func(context.Context, *generated.HistoryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseHistory) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseHistory) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.HistoryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.HistoryQuery", q)
+}
+
+// The HistoryRevisionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type HistoryRevisionFunc func(context.Context, *generated.HistoryRevisionQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f HistoryRevisionFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.HistoryRevisionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.HistoryRevisionQuery", q)
+}
+
+// The TraverseHistoryRevision type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseHistoryRevision func(context.Context, *generated.HistoryRevisionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseHistoryRevision) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseHistoryRevision) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.HistoryRevisionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.HistoryRevisionQuery", q)
+}
+
+// The IdempotencyKeyFunc type is an adapter to allow the use of ordinary function as a Querier.
+type IdempotencyKeyFunc func(context.Context, *generated.IdempotencyKeyQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f IdempotencyKeyFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.IdempotencyKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.IdempotencyKeyQuery", q)
+}
+
+// The TraverseIdempotencyKey type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseIdempotencyKey func(context.Context, *generated.IdempotencyKeyQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseIdempotencyKey) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseIdempotencyKey) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.IdempotencyKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.IdempotencyKeyQuery", q)
+}
+
+// The LexiconEntryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LexiconEntryFunc func(context.Context, *generated.LexiconEntryQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f LexiconEntryFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.LexiconEntryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.LexiconEntryQuery", q)
+}
+
+// The TraverseLexiconEntry type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLexiconEntry func(context.Context, *generated.LexiconEntryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLexiconEntry) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLexiconEntry) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.LexiconEntryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.LexiconEntryQuery", q)
+}
+
+// The ModerationRuleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ModerationRuleFunc func(context.Context, *generated.ModerationRuleQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f ModerationRuleFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.ModerationRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.ModerationRuleQuery", q)
+}
+
+// The TraverseModerationRule type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseModerationRule func(context.Context, *generated.ModerationRuleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseModerationRule) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseModerationRule) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.ModerationRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.ModerationRuleQuery", q)
+}
+
+// The ModerationSettingFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ModerationSettingFunc func(context.Context, *generated.ModerationSettingQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f ModerationSettingFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.ModerationSettingQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.ModerationSettingQuery", q)
+}
+
+// The TraverseModerationSetting type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseModerationSetting func(context.Context, *generated.ModerationSettingQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseModerationSetting) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseModerationSetting) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.ModerationSettingQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.ModerationSettingQuery", q)
+}
+
+// The PlanFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PlanFunc func(context.Context, *generated.PlanQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f PlanFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.PlanQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.PlanQuery", q)
+}
+
+// The TraversePlan type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePlan func(context.Context, *generated.PlanQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePlan) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePlan) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.PlanQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.PlanQuery", q)
+}
+
+// The PlayEventFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PlayEventFunc func(context.Context, *generated.PlayEventQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f PlayEventFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.PlayEventQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.PlayEventQuery", q)
+}
+
+// The TraversePlayEvent type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePlayEvent func(context.Context, *generated.PlayEventQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePlayEvent) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePlayEvent) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.PlayEventQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.PlayEventQuery", q)
+}
+
+// The TagFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TagFunc func(context.Context, *generated.TagQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f TagFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.TagQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.TagQuery", q)
+}
+
+// The TraverseTag type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTag func(context.Context, *generated.TagQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTag) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTag) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.TagQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.TagQuery", q)
+}
+
+// The TemplateFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TemplateFunc func(context.Context, *generated.TemplateQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f TemplateFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.TemplateQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.TemplateQuery", q)
+}
+
+// The TraverseTemplate type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTemplate func(context.Context, *generated.TemplateQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTemplate) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTemplate) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.TemplateQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.TemplateQuery", q)
+}
+
+// The UsageEntryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UsageEntryFunc func(context.Context, *generated.UsageEntryQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f UsageEntryFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.UsageEntryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.UsageEntryQuery", q)
+}
+
+// The TraverseUsageEntry type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUsageEntry func(context.Context, *generated.UsageEntryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUsageEntry) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUsageEntry) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.UsageEntryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.UsageEntryQuery", q)
+}
+
+// The UserFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UserFunc func(context.Context, *generated.UserQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f UserFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.UserQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.UserQuery", q)
+}
+
+// The TraverseUser type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUser func(context.Context, *generated.UserQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUser) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUser) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.UserQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.UserQuery", q)
+}
+
+// The UserPreferenceFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UserPreferenceFunc func(context.Context, *generated.UserPreferenceQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f UserPreferenceFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.UserPreferenceQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.UserPreferenceQuery", q)
+}
+
+// The TraverseUserPreference type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUserPreference func(context.Context, *generated.UserPreferenceQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUserPreference) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUserPreference) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.UserPreferenceQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.UserPreferenceQuery", q)
+}
+
+// The UserUsageFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UserUsageFunc func(context.Context, *generated.UserUsageQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f UserUsageFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.UserUsageQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.UserUsageQuery", q)
+}
+
+// The TraverseUserUsage type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUserUsage func(context.Context, *generated.UserUsageQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUserUsage) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUserUsage) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.UserUsageQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.UserUsageQuery", q)
+}
+
+// The VoiceFunc type is an adapter to allow the use of ordinary function as a Querier.
+type VoiceFunc func(context.Context, *generated.VoiceQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f VoiceFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.VoiceQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.VoiceQuery", q)
+}
+
+// The TraverseVoice type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseVoice func(context.Context, *generated.VoiceQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseVoice) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseVoice) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.VoiceQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.VoiceQuery", q)
+}
+
+// The VoicePresetFunc type is an adapter to allow the use of ordinary function as a Querier.
+type VoicePresetFunc func(context.Context, *generated.VoicePresetQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f VoicePresetFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.VoicePresetQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.VoicePresetQuery", q)
+}
+
+// The TraverseVoicePreset type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseVoicePreset func(context.Context, *generated.VoicePresetQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseVoicePreset) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseVoicePreset) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.VoicePresetQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.VoicePresetQuery", q)
+}
+
+// NewQuery returns the generic Query interface for the given typed query.
+func NewQuery(q generated.Query) (Query, error) {
+	switch q := q.(type) {
+	case *generated.AudioRenderQuery:
+		return &query[*generated.AudioRenderQuery, predicate.AudioRender, audiorender.OrderOption]{typ: generated.TypeAudioRender, tq: q}, nil
+	case *generated.FolderQuery:
+		return &query[*generated.FolderQuery, predicate.Folder, folder.OrderOption]{typ: generated.TypeFolder, tq: q}, nil
+	case *generated.HistoryQuery:
+		return &query[*generated.HistoryQuery, predicate.History, history.OrderOption]{typ: generated.TypeHistory, tq: q}, nil
+	case *generated.HistoryRevisionQuery:
+		return &query[*generated.HistoryRevisionQuery, predicate.HistoryRevision, historyrevision.OrderOption]{typ: generated.TypeHistoryRevision, tq: q}, nil
+	case *generated.IdempotencyKeyQuery:
+		return &query[*generated.IdempotencyKeyQuery, predicate.IdempotencyKey, idempotencykey.OrderOption]{typ: generated.TypeIdempotencyKey, tq: q}, nil
+	case *generated.LexiconEntryQuery:
+		return &query[*generated.LexiconEntryQuery, predicate.LexiconEntry, lexiconentry.OrderOption]{typ: generated.TypeLexiconEntry, tq: q}, nil
+	case *generated.ModerationRuleQuery:
+		return &query[*generated.ModerationRuleQuery, predicate.ModerationRule, moderationrule.OrderOption]{typ: generated.TypeModerationRule, tq: q}, nil
+	case *generated.ModerationSettingQuery:
+		return &query[*generated.ModerationSettingQuery, predicate.ModerationSetting, moderationsetting.OrderOption]{typ: generated.TypeModerationSetting, tq: q}, nil
+	case *generated.PlanQuery:
+		return &query[*generated.PlanQuery, predicate.Plan, plan.OrderOption]{typ: generated.TypePlan, tq: q}, nil
+	case *generated.PlayEventQuery:
+		return &query[*generated.PlayEventQuery, predicate.PlayEvent, playevent.OrderOption]{typ: generated.TypePlayEvent, tq: q}, nil
+	case *generated.TagQuery:
+		return &query[*generated.TagQuery, predicate.Tag, tag.OrderOption]{typ: generated.TypeTag, tq: q}, nil
+	case *generated.TemplateQuery:
+		return &query[*generated.TemplateQuery, predicate.Template, template.OrderOption]{typ: generated.TypeTemplate, tq: q}, nil
+	case *generated.UsageEntryQuery:
+		return &query[*generated.UsageEntryQuery, predicate.UsageEntry, usageentry.OrderOption]{typ: generated.TypeUsageEntry, tq: q}, nil
+	case *generated.UserQuery:
+		return &query[*generated.UserQuery, predicate.User, user.OrderOption]{typ: generated.TypeUser, tq: q}, nil
+	case *generated.UserPreferenceQuery:
+		return &query[*generated.UserPreferenceQuery, predicate.UserPreference, userpreference.OrderOption]{typ: generated.TypeUserPreference, tq: q}, nil
+	case *generated.UserUsageQuery:
+		return &query[*generated.UserUsageQuery, predicate.UserUsage, userusage.OrderOption]{typ: generated.TypeUserUsage, tq: q}, nil
+	case *generated.VoiceQuery:
+		return &query[*generated.VoiceQuery, predicate.Voice, voice.OrderOption]{typ: generated.TypeVoice, tq: q}, nil
+	case *generated.VoicePresetQuery:
+		return &query[*generated.VoicePresetQuery, predicate.VoicePreset, voicepreset.OrderOption]{typ: generated.TypeVoicePreset, tq: q}, nil
+	default:
+		return nil, fmt.Errorf("unknown query type %T", q)
+	}
+}
+
+type query[T any, P ~func(*sql.Selector), R ~func(*sql.Selector)] struct {
+	typ string
+	tq  interface {
+		Limit(int) T
+		Offset(int) T
+		Unique(bool) T
+		Order(...R) T
+		Where(...P) T
+	}
+}
+
+func (q query[T, P, R]) Type() string {
+	return q.typ
+}
+
+func (q query[T, P, R]) Limit(limit int) {
+	q.tq.Limit(limit)
+}
+
+func (q query[T, P, R]) Offset(offset int) {
+	q.tq.Offset(offset)
+}
+
+func (q query[T, P, R]) Unique(unique bool) {
+	q.tq.Unique(unique)
+}
+
+func (q query[T, P, R]) Order(orders ...func(*sql.Selector)) {
+	rs := make([]R, len(orders))
+	for i := range orders {
+		rs[i] = orders[i]
+	}
+	q.tq.Order(rs...)
+}
+
+func (q query[T, P, R]) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]P, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	q.tq.Where(p...)
+}
@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/lexiconentry"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+)
+
+// LexiconEntry is the model entity for the LexiconEntry schema.
+type LexiconEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Grapheme holds the value of the "grapheme" field.
+	Grapheme string `json:"grapheme,omitempty"`
+	// Alias holds the value of the "alias" field.
+	Alias string `json:"alias,omitempty"`
+	// Phoneme holds the value of the "phoneme" field.
+	Phoneme string `json:"phoneme,omitempty"`
+	// Alphabet holds the value of the "alphabet" field.
+	Alphabet lexiconentry.Alphabet `json:"alphabet,omitempty"`
+	// CaseSensitive holds the value of the "case_sensitive" field.
+	CaseSensitive bool `json:"caseSensitive"`
+	// WholeWord holds the value of the "whole_word" field.
+	WholeWord bool `json:"wholeWord"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LexiconEntryQuery when eager-loading is set.
+	Edges                LexiconEntryEdges `json:"edges"`
+	user_lexicon_entries *uuid.UUID
+	selectValues         sql.SelectValues
+}
+
+// LexiconEntryEdges holds the relations/edges for other nodes in the graph.
+type LexiconEntryEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LexiconEntryEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LexiconEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case lexiconentry.FieldCaseSensitive, lexiconentry.FieldWholeWord:
+			values[i] = new(sql.NullBool)
+		case lexiconentry.FieldGrapheme, lexiconentry.FieldAlias, lexiconentry.FieldPhoneme, lexiconentry.FieldAlphabet:
+			values[i] = new(sql.NullString)
+		case lexiconentry.FieldCreatedAt, lexiconentry.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case lexiconentry.FieldID:
+			values[i] = new(uuid.UUID)
+		case lexiconentry.ForeignKeys[0]: // user_lexicon_entries
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LexiconEntry fields.
+func (_m *LexiconEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case lexiconentry.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case lexiconentry.FieldGrapheme:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field grapheme", values[i])
+			} else if value.Valid {
+				_m.Grapheme = value.String
+			}
+		case lexiconentry.FieldAlias:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alias", values[i])
+			} else if value.Valid {
+				_m.Alias = value.String
+			}
+		case lexiconentry.FieldPhoneme:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phoneme", values[i])
+			} else if value.Valid {
+				_m.Phoneme = value.String
+			}
+		case lexiconentry.FieldAlphabet:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alphabet", values[i])
+			} else if value.Valid {
+				_m.Alphabet = lexiconentry.Alphabet(value.String)
+			}
+		case lexiconentry.FieldCaseSensitive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field case_sensitive", values[i])
+			} else if value.Valid {
+				_m.CaseSensitive = value.Bool
+			}
+		case lexiconentry.FieldWholeWord:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field whole_word", values[i])
+			} else if value.Valid {
+				_m.WholeWord = value.Bool
+			}
+		case lexiconentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case lexiconentry.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case lexiconentry.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_lexicon_entries", values[i])
+			} else if value.Valid {
+				_m.user_lexicon_entries = new(uuid.UUID)
+				*_m.user_lexicon_entries = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LexiconEntry.
+// This includes values selected through modifiers, order, etc.
+func (_m *LexiconEntry) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the LexiconEntry entity.
+func (_m *LexiconEntry) QueryUser() *UserQuery {
+	return NewLexiconEntryClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this LexiconEntry.
+// Note that you need to call LexiconEntry.Unwrap() before calling this method if this LexiconEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LexiconEntry) Update() *LexiconEntryUpdateOne {
+	return NewLexiconEntryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LexiconEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LexiconEntry) Unwrap() *LexiconEntry {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: LexiconEntry is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LexiconEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("LexiconEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("grapheme=")
+	builder.WriteString(_m.Grapheme)
+	builder.WriteString(", ")
+	builder.WriteString("alias=")
+	builder.WriteString(_m.Alias)
+	builder.WriteString(", ")
+	builder.WriteString("phoneme=")
+	builder.WriteString(_m.Phoneme)
+	builder.WriteString(", ")
+	builder.WriteString("alphabet=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Alphabet))
+	builder.WriteString(", ")
+	builder.WriteString("case_sensitive=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CaseSensitive))
+	builder.WriteString(", ")
+	builder.WriteString("whole_word=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WholeWord))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LexiconEntries is a parsable slice of LexiconEntry.
+type LexiconEntries []*LexiconEntry
@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package lexiconentry
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the lexiconentry type in the database.
+	Label = "lexicon_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldGrapheme holds the string denoting the grapheme field in the database.
+	FieldGrapheme = "grapheme"
+	// FieldAlias holds the string denoting the alias field in the database.
+	FieldAlias = "alias"
+	// FieldPhoneme holds the string denoting the phoneme field in the database.
+	FieldPhoneme = "phoneme"
+	// FieldAlphabet holds the string denoting the alphabet field in the database.
+	FieldAlphabet = "alphabet"
+	// FieldCaseSensitive holds the string denoting the case_sensitive field in the database.
+	FieldCaseSensitive = "case_sensitive"
+	// FieldWholeWord holds the string denoting the whole_word field in the database.
+	FieldWholeWord = "whole_word"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the lexiconentry in the database.
+	Table = "lexicon_entries"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "lexicon_entries"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_lexicon_entries"
+)
+
+// Columns holds all SQL columns for lexiconentry fields.
+var Columns = []string{
+	FieldID,
+	FieldGrapheme,
+	FieldAlias,
+	FieldPhoneme,
+	FieldAlphabet,
+	FieldCaseSensitive,
+	FieldWholeWord,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "lexicon_entries"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_lexicon_entries",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// GraphemeValidator is a validator for the "grapheme" field. It is called by the builders before save.
+	GraphemeValidator func(string) error
+	// AliasValidator is a validator for the "alias" field. It is called by the builders before save.
+	AliasValidator func(string) error
+	// PhonemeValidator is a validator for the "phoneme" field. It is called by the builders before save.
+	PhonemeValidator func(string) error
+	// DefaultCaseSensitive holds the default value on creation for the "case_sensitive" field.
+	DefaultCaseSensitive bool
+	// DefaultWholeWord holds the default value on creation for the "whole_word" field.
+	DefaultWholeWord bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Alphabet defines the type for the "alphabet" enum field.
+type Alphabet string
+
+// AlphabetIpa is the default value of the Alphabet enum.
+const DefaultAlphabet = AlphabetIpa
+
+// Alphabet values.
+const (
+	AlphabetIpa    Alphabet = "ipa"
+	AlphabetXSampa Alphabet = "x-sampa"
+)
+
+func (a Alphabet) String() string {
+	return string(a)
+}
+
+// AlphabetValidator is a validator for the "alphabet" field enum values. It is called by the builders before save.
+func AlphabetValidator(a Alphabet) error {
+	switch a {
+	case AlphabetIpa, AlphabetXSampa:
+		return nil
+	default:
+		return fmt.Errorf("lexiconentry: invalid enum value for alphabet field: %q", a)
+	}
+}
+
+// OrderOption defines the ordering options for the LexiconEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByGrapheme orders the results by the grapheme field.
+func ByGrapheme(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGrapheme, opts...).ToFunc()
+}
+
+// ByAlias orders the results by the alias field.
+func ByAlias(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlias, opts...).ToFunc()
+}
+
+// ByPhoneme orders the results by the phoneme field.
+func ByPhoneme(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhoneme, opts...).ToFunc()
+}
+
+// ByAlphabet orders the results by the alphabet field.
+func ByAlphabet(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlphabet, opts...).ToFunc()
+}
+
+// ByCaseSensitive orders the results by the case_sensitive field.
+func ByCaseSensitive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCaseSensitive, opts...).ToFunc()
+}
+
+// ByWholeWord orders the results by the whole_word field.
+func ByWholeWord(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWholeWord, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
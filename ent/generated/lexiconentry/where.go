@@ -0,0 +1,465 @@
+// Code generated by ent, DO NOT EDIT.
+
+package lexiconentry
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldLTE(FieldID, id))
+}
+
+// Grapheme applies equality check predicate on the "grapheme" field. It's identical to GraphemeEQ.
+func Grapheme(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEQ(FieldGrapheme, v))
+}
+
+// Alias applies equality check predicate on the "alias" field. It's identical to AliasEQ.
+func Alias(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEQ(FieldAlias, v))
+}
+
+// Phoneme applies equality check predicate on the "phoneme" field. It's identical to PhonemeEQ.
+func Phoneme(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEQ(FieldPhoneme, v))
+}
+
+// CaseSensitive applies equality check predicate on the "case_sensitive" field. It's identical to CaseSensitiveEQ.
+func CaseSensitive(v bool) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEQ(FieldCaseSensitive, v))
+}
+
+// WholeWord applies equality check predicate on the "whole_word" field. It's identical to WholeWordEQ.
+func WholeWord(v bool) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEQ(FieldWholeWord, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// GraphemeEQ applies the EQ predicate on the "grapheme" field.
+func GraphemeEQ(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEQ(FieldGrapheme, v))
+}
+
+// GraphemeNEQ applies the NEQ predicate on the "grapheme" field.
+func GraphemeNEQ(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldNEQ(FieldGrapheme, v))
+}
+
+// GraphemeIn applies the In predicate on the "grapheme" field.
+func GraphemeIn(vs ...string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldIn(FieldGrapheme, vs...))
+}
+
+// GraphemeNotIn applies the NotIn predicate on the "grapheme" field.
+func GraphemeNotIn(vs ...string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldNotIn(FieldGrapheme, vs...))
+}
+
+// GraphemeGT applies the GT predicate on the "grapheme" field.
+func GraphemeGT(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldGT(FieldGrapheme, v))
+}
+
+// GraphemeGTE applies the GTE predicate on the "grapheme" field.
+func GraphemeGTE(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldGTE(FieldGrapheme, v))
+}
+
+// GraphemeLT applies the LT predicate on the "grapheme" field.
+func GraphemeLT(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldLT(FieldGrapheme, v))
+}
+
+// GraphemeLTE applies the LTE predicate on the "grapheme" field.
+func GraphemeLTE(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldLTE(FieldGrapheme, v))
+}
+
+// GraphemeContains applies the Contains predicate on the "grapheme" field.
+func GraphemeContains(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldContains(FieldGrapheme, v))
+}
+
+// GraphemeHasPrefix applies the HasPrefix predicate on the "grapheme" field.
+func GraphemeHasPrefix(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldHasPrefix(FieldGrapheme, v))
+}
+
+// GraphemeHasSuffix applies the HasSuffix predicate on the "grapheme" field.
+func GraphemeHasSuffix(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldHasSuffix(FieldGrapheme, v))
+}
+
+// GraphemeEqualFold applies the EqualFold predicate on the "grapheme" field.
+func GraphemeEqualFold(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEqualFold(FieldGrapheme, v))
+}
+
+// GraphemeContainsFold applies the ContainsFold predicate on the "grapheme" field.
+func GraphemeContainsFold(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldContainsFold(FieldGrapheme, v))
+}
+
+// AliasEQ applies the EQ predicate on the "alias" field.
+func AliasEQ(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEQ(FieldAlias, v))
+}
+
+// AliasNEQ applies the NEQ predicate on the "alias" field.
+func AliasNEQ(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldNEQ(FieldAlias, v))
+}
+
+// AliasIn applies the In predicate on the "alias" field.
+func AliasIn(vs ...string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldIn(FieldAlias, vs...))
+}
+
+// AliasNotIn applies the NotIn predicate on the "alias" field.
+func AliasNotIn(vs ...string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldNotIn(FieldAlias, vs...))
+}
+
+// AliasGT applies the GT predicate on the "alias" field.
+func AliasGT(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldGT(FieldAlias, v))
+}
+
+// AliasGTE applies the GTE predicate on the "alias" field.
+func AliasGTE(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldGTE(FieldAlias, v))
+}
+
+// AliasLT applies the LT predicate on the "alias" field.
+func AliasLT(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldLT(FieldAlias, v))
+}
+
+// AliasLTE applies the LTE predicate on the "alias" field.
+func AliasLTE(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldLTE(FieldAlias, v))
+}
+
+// AliasContains applies the Contains predicate on the "alias" field.
+func AliasContains(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldContains(FieldAlias, v))
+}
+
+// AliasHasPrefix applies the HasPrefix predicate on the "alias" field.
+func AliasHasPrefix(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldHasPrefix(FieldAlias, v))
+}
+
+// AliasHasSuffix applies the HasSuffix predicate on the "alias" field.
+func AliasHasSuffix(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldHasSuffix(FieldAlias, v))
+}
+
+// AliasIsNil applies the IsNil predicate on the "alias" field.
+func AliasIsNil() predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldIsNull(FieldAlias))
+}
+
+// AliasNotNil applies the NotNil predicate on the "alias" field.
+func AliasNotNil() predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldNotNull(FieldAlias))
+}
+
+// AliasEqualFold applies the EqualFold predicate on the "alias" field.
+func AliasEqualFold(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEqualFold(FieldAlias, v))
+}
+
+// AliasContainsFold applies the ContainsFold predicate on the "alias" field.
+func AliasContainsFold(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldContainsFold(FieldAlias, v))
+}
+
+// PhonemeEQ applies the EQ predicate on the "phoneme" field.
+func PhonemeEQ(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEQ(FieldPhoneme, v))
+}
+
+// PhonemeNEQ applies the NEQ predicate on the "phoneme" field.
+func PhonemeNEQ(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldNEQ(FieldPhoneme, v))
+}
+
+// PhonemeIn applies the In predicate on the "phoneme" field.
+func PhonemeIn(vs ...string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldIn(FieldPhoneme, vs...))
+}
+
+// PhonemeNotIn applies the NotIn predicate on the "phoneme" field.
+func PhonemeNotIn(vs ...string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldNotIn(FieldPhoneme, vs...))
+}
+
+// PhonemeGT applies the GT predicate on the "phoneme" field.
+func PhonemeGT(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldGT(FieldPhoneme, v))
+}
+
+// PhonemeGTE applies the GTE predicate on the "phoneme" field.
+func PhonemeGTE(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldGTE(FieldPhoneme, v))
+}
+
+// PhonemeLT applies the LT predicate on the "phoneme" field.
+func PhonemeLT(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldLT(FieldPhoneme, v))
+}
+
+// PhonemeLTE applies the LTE predicate on the "phoneme" field.
+func PhonemeLTE(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldLTE(FieldPhoneme, v))
+}
+
+// PhonemeContains applies the Contains predicate on the "phoneme" field.
+func PhonemeContains(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldContains(FieldPhoneme, v))
+}
+
+// PhonemeHasPrefix applies the HasPrefix predicate on the "phoneme" field.
+func PhonemeHasPrefix(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldHasPrefix(FieldPhoneme, v))
+}
+
+// PhonemeHasSuffix applies the HasSuffix predicate on the "phoneme" field.
+func PhonemeHasSuffix(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldHasSuffix(FieldPhoneme, v))
+}
+
+// PhonemeIsNil applies the IsNil predicate on the "phoneme" field.
+func PhonemeIsNil() predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldIsNull(FieldPhoneme))
+}
+
+// PhonemeNotNil applies the NotNil predicate on the "phoneme" field.
+func PhonemeNotNil() predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldNotNull(FieldPhoneme))
+}
+
+// PhonemeEqualFold applies the EqualFold predicate on the "phoneme" field.
+func PhonemeEqualFold(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEqualFold(FieldPhoneme, v))
+}
+
+// PhonemeContainsFold applies the ContainsFold predicate on the "phoneme" field.
+func PhonemeContainsFold(v string) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldContainsFold(FieldPhoneme, v))
+}
+
+// AlphabetEQ applies the EQ predicate on the "alphabet" field.
+func AlphabetEQ(v Alphabet) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEQ(FieldAlphabet, v))
+}
+
+// AlphabetNEQ applies the NEQ predicate on the "alphabet" field.
+func AlphabetNEQ(v Alphabet) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldNEQ(FieldAlphabet, v))
+}
+
+// AlphabetIn applies the In predicate on the "alphabet" field.
+func AlphabetIn(vs ...Alphabet) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldIn(FieldAlphabet, vs...))
+}
+
+// AlphabetNotIn applies the NotIn predicate on the "alphabet" field.
+func AlphabetNotIn(vs ...Alphabet) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldNotIn(FieldAlphabet, vs...))
+}
+
+// CaseSensitiveEQ applies the EQ predicate on the "case_sensitive" field.
+func CaseSensitiveEQ(v bool) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEQ(FieldCaseSensitive, v))
+}
+
+// CaseSensitiveNEQ applies the NEQ predicate on the "case_sensitive" field.
+func CaseSensitiveNEQ(v bool) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldNEQ(FieldCaseSensitive, v))
+}
+
+// WholeWordEQ applies the EQ predicate on the "whole_word" field.
+func WholeWordEQ(v bool) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEQ(FieldWholeWord, v))
+}
+
+// WholeWordNEQ applies the NEQ predicate on the "whole_word" field.
+func WholeWordNEQ(v bool) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldNEQ(FieldWholeWord, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.LexiconEntry {
+	return predicate.LexiconEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.LexiconEntry {
+	return predicate.LexiconEntry(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LexiconEntry) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LexiconEntry) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LexiconEntry) predicate.LexiconEntry {
+	return predicate.LexiconEntry(sql.NotPredicates(p))
+}
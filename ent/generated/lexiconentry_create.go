@@ -0,0 +1,427 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/lexiconentry"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+)
+
+// LexiconEntryCreate is the builder for creating a LexiconEntry entity.
+type LexiconEntryCreate struct {
+	config
+	mutation *LexiconEntryMutation
+	hooks    []Hook
+}
+
+// SetGrapheme sets the "grapheme" field.
+func (_c *LexiconEntryCreate) SetGrapheme(v string) *LexiconEntryCreate {
+	_c.mutation.SetGrapheme(v)
+	return _c
+}
+
+// SetAlias sets the "alias" field.
+func (_c *LexiconEntryCreate) SetAlias(v string) *LexiconEntryCreate {
+	_c.mutation.SetAlias(v)
+	return _c
+}
+
+// SetNillableAlias sets the "alias" field if the given value is not nil.
+func (_c *LexiconEntryCreate) SetNillableAlias(v *string) *LexiconEntryCreate {
+	if v != nil {
+		_c.SetAlias(*v)
+	}
+	return _c
+}
+
+// SetPhoneme sets the "phoneme" field.
+func (_c *LexiconEntryCreate) SetPhoneme(v string) *LexiconEntryCreate {
+	_c.mutation.SetPhoneme(v)
+	return _c
+}
+
+// SetNillablePhoneme sets the "phoneme" field if the given value is not nil.
+func (_c *LexiconEntryCreate) SetNillablePhoneme(v *string) *LexiconEntryCreate {
+	if v != nil {
+		_c.SetPhoneme(*v)
+	}
+	return _c
+}
+
+// SetAlphabet sets the "alphabet" field.
+func (_c *LexiconEntryCreate) SetAlphabet(v lexiconentry.Alphabet) *LexiconEntryCreate {
+	_c.mutation.SetAlphabet(v)
+	return _c
+}
+
+// SetNillableAlphabet sets the "alphabet" field if the given value is not nil.
+func (_c *LexiconEntryCreate) SetNillableAlphabet(v *lexiconentry.Alphabet) *LexiconEntryCreate {
+	if v != nil {
+		_c.SetAlphabet(*v)
+	}
+	return _c
+}
+
+// SetCaseSensitive sets the "case_sensitive" field.
+func (_c *LexiconEntryCreate) SetCaseSensitive(v bool) *LexiconEntryCreate {
+	_c.mutation.SetCaseSensitive(v)
+	return _c
+}
+
+// SetNillableCaseSensitive sets the "case_sensitive" field if the given value is not nil.
+func (_c *LexiconEntryCreate) SetNillableCaseSensitive(v *bool) *LexiconEntryCreate {
+	if v != nil {
+		_c.SetCaseSensitive(*v)
+	}
+	return _c
+}
+
+// SetWholeWord sets the "whole_word" field.
+func (_c *LexiconEntryCreate) SetWholeWord(v bool) *LexiconEntryCreate {
+	_c.mutation.SetWholeWord(v)
+	return _c
+}
+
+// SetNillableWholeWord sets the "whole_word" field if the given value is not nil.
+func (_c *LexiconEntryCreate) SetNillableWholeWord(v *bool) *LexiconEntryCreate {
+	if v != nil {
+		_c.SetWholeWord(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LexiconEntryCreate) SetCreatedAt(v time.Time) *LexiconEntryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LexiconEntryCreate) SetNillableCreatedAt(v *time.Time) *LexiconEntryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LexiconEntryCreate) SetUpdatedAt(v time.Time) *LexiconEntryCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LexiconEntryCreate) SetNillableUpdatedAt(v *time.Time) *LexiconEntryCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LexiconEntryCreate) SetID(v uuid.UUID) *LexiconEntryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LexiconEntryCreate) SetNillableID(v *uuid.UUID) *LexiconEntryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *LexiconEntryCreate) SetUserID(id uuid.UUID) *LexiconEntryCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *LexiconEntryCreate) SetUser(v *User) *LexiconEntryCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the LexiconEntryMutation object of the builder.
+func (_c *LexiconEntryCreate) Mutation() *LexiconEntryMutation {
+	return _c.mutation
+}
+
+// Save creates the LexiconEntry in the database.
+func (_c *LexiconEntryCreate) Save(ctx context.Context) (*LexiconEntry, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LexiconEntryCreate) SaveX(ctx context.Context) *LexiconEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LexiconEntryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LexiconEntryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LexiconEntryCreate) defaults() {
+	if _, ok := _c.mutation.Alphabet(); !ok {
+		v := lexiconentry.DefaultAlphabet
+		_c.mutation.SetAlphabet(v)
+	}
+	if _, ok := _c.mutation.CaseSensitive(); !ok {
+		v := lexiconentry.DefaultCaseSensitive
+		_c.mutation.SetCaseSensitive(v)
+	}
+	if _, ok := _c.mutation.WholeWord(); !ok {
+		v := lexiconentry.DefaultWholeWord
+		_c.mutation.SetWholeWord(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := lexiconentry.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := lexiconentry.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := lexiconentry.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LexiconEntryCreate) check() error {
+	if _, ok := _c.mutation.Grapheme(); !ok {
+		return &ValidationError{Name: "grapheme", err: errors.New(`generated: missing required field "LexiconEntry.grapheme"`)}
+	}
+	if v, ok := _c.mutation.Grapheme(); ok {
+		if err := lexiconentry.GraphemeValidator(v); err != nil {
+			return &ValidationError{Name: "grapheme", err: fmt.Errorf(`generated: validator failed for field "LexiconEntry.grapheme": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Alias(); ok {
+		if err := lexiconentry.AliasValidator(v); err != nil {
+			return &ValidationError{Name: "alias", err: fmt.Errorf(`generated: validator failed for field "LexiconEntry.alias": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Phoneme(); ok {
+		if err := lexiconentry.PhonemeValidator(v); err != nil {
+			return &ValidationError{Name: "phoneme", err: fmt.Errorf(`generated: validator failed for field "LexiconEntry.phoneme": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Alphabet(); !ok {
+		return &ValidationError{Name: "alphabet", err: errors.New(`generated: missing required field "LexiconEntry.alphabet"`)}
+	}
+	if v, ok := _c.mutation.Alphabet(); ok {
+		if err := lexiconentry.AlphabetValidator(v); err != nil {
+			return &ValidationError{Name: "alphabet", err: fmt.Errorf(`generated: validator failed for field "LexiconEntry.alphabet": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CaseSensitive(); !ok {
+		return &ValidationError{Name: "case_sensitive", err: errors.New(`generated: missing required field "LexiconEntry.case_sensitive"`)}
+	}
+	if _, ok := _c.mutation.WholeWord(); !ok {
+		return &ValidationError{Name: "whole_word", err: errors.New(`generated: missing required field "LexiconEntry.whole_word"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "LexiconEntry.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "LexiconEntry.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`generated: missing required edge "LexiconEntry.user"`)}
+	}
+	return nil
+}
+
+func (_c *LexiconEntryCreate) sqlSave(ctx context.Context) (*LexiconEntry, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LexiconEntryCreate) createSpec() (*LexiconEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LexiconEntry{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(lexiconentry.Table, sqlgraph.NewFieldSpec(lexiconentry.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Grapheme(); ok {
+		_spec.SetField(lexiconentry.FieldGrapheme, field.TypeString, value)
+		_node.Grapheme = value
+	}
+	if value, ok := _c.mutation.Alias(); ok {
+		_spec.SetField(lexiconentry.FieldAlias, field.TypeString, value)
+		_node.Alias = value
+	}
+	if value, ok := _c.mutation.Phoneme(); ok {
+		_spec.SetField(lexiconentry.FieldPhoneme, field.TypeString, value)
+		_node.Phoneme = value
+	}
+	if value, ok := _c.mutation.Alphabet(); ok {
+		_spec.SetField(lexiconentry.FieldAlphabet, field.TypeEnum, value)
+		_node.Alphabet = value
+	}
+	if value, ok := _c.mutation.CaseSensitive(); ok {
+		_spec.SetField(lexiconentry.FieldCaseSensitive, field.TypeBool, value)
+		_node.CaseSensitive = value
+	}
+	if value, ok := _c.mutation.WholeWord(); ok {
+		_spec.SetField(lexiconentry.FieldWholeWord, field.TypeBool, value)
+		_node.WholeWord = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(lexiconentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(lexiconentry.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lexiconentry.UserTable,
+			Columns: []string{lexiconentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_lexicon_entries = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LexiconEntryCreateBulk is the builder for creating many LexiconEntry entities in bulk.
+type LexiconEntryCreateBulk struct {
+	config
+	err      error
+	builders []*LexiconEntryCreate
+}
+
+// Save creates the LexiconEntry entities in the database.
+func (_c *LexiconEntryCreateBulk) Save(ctx context.Context) ([]*LexiconEntry, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LexiconEntry, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LexiconEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LexiconEntryCreateBulk) SaveX(ctx context.Context) []*LexiconEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LexiconEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LexiconEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,642 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/lexiconentry"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+)
+
+// LexiconEntryUpdate is the builder for updating LexiconEntry entities.
+type LexiconEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LexiconEntryMutation
+}
+
+// Where appends a list predicates to the LexiconEntryUpdate builder.
+func (_u *LexiconEntryUpdate) Where(ps ...predicate.LexiconEntry) *LexiconEntryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetGrapheme sets the "grapheme" field.
+func (_u *LexiconEntryUpdate) SetGrapheme(v string) *LexiconEntryUpdate {
+	_u.mutation.SetGrapheme(v)
+	return _u
+}
+
+// SetNillableGrapheme sets the "grapheme" field if the given value is not nil.
+func (_u *LexiconEntryUpdate) SetNillableGrapheme(v *string) *LexiconEntryUpdate {
+	if v != nil {
+		_u.SetGrapheme(*v)
+	}
+	return _u
+}
+
+// SetAlias sets the "alias" field.
+func (_u *LexiconEntryUpdate) SetAlias(v string) *LexiconEntryUpdate {
+	_u.mutation.SetAlias(v)
+	return _u
+}
+
+// SetNillableAlias sets the "alias" field if the given value is not nil.
+func (_u *LexiconEntryUpdate) SetNillableAlias(v *string) *LexiconEntryUpdate {
+	if v != nil {
+		_u.SetAlias(*v)
+	}
+	return _u
+}
+
+// ClearAlias clears the value of the "alias" field.
+func (_u *LexiconEntryUpdate) ClearAlias() *LexiconEntryUpdate {
+	_u.mutation.ClearAlias()
+	return _u
+}
+
+// SetPhoneme sets the "phoneme" field.
+func (_u *LexiconEntryUpdate) SetPhoneme(v string) *LexiconEntryUpdate {
+	_u.mutation.SetPhoneme(v)
+	return _u
+}
+
+// SetNillablePhoneme sets the "phoneme" field if the given value is not nil.
+func (_u *LexiconEntryUpdate) SetNillablePhoneme(v *string) *LexiconEntryUpdate {
+	if v != nil {
+		_u.SetPhoneme(*v)
+	}
+	return _u
+}
+
+// ClearPhoneme clears the value of the "phoneme" field.
+func (_u *LexiconEntryUpdate) ClearPhoneme() *LexiconEntryUpdate {
+	_u.mutation.ClearPhoneme()
+	return _u
+}
+
+// SetAlphabet sets the "alphabet" field.
+func (_u *LexiconEntryUpdate) SetAlphabet(v lexiconentry.Alphabet) *LexiconEntryUpdate {
+	_u.mutation.SetAlphabet(v)
+	return _u
+}
+
+// SetNillableAlphabet sets the "alphabet" field if the given value is not nil.
+func (_u *LexiconEntryUpdate) SetNillableAlphabet(v *lexiconentry.Alphabet) *LexiconEntryUpdate {
+	if v != nil {
+		_u.SetAlphabet(*v)
+	}
+	return _u
+}
+
+// SetCaseSensitive sets the "case_sensitive" field.
+func (_u *LexiconEntryUpdate) SetCaseSensitive(v bool) *LexiconEntryUpdate {
+	_u.mutation.SetCaseSensitive(v)
+	return _u
+}
+
+// SetNillableCaseSensitive sets the "case_sensitive" field if the given value is not nil.
+func (_u *LexiconEntryUpdate) SetNillableCaseSensitive(v *bool) *LexiconEntryUpdate {
+	if v != nil {
+		_u.SetCaseSensitive(*v)
+	}
+	return _u
+}
+
+// SetWholeWord sets the "whole_word" field.
+func (_u *LexiconEntryUpdate) SetWholeWord(v bool) *LexiconEntryUpdate {
+	_u.mutation.SetWholeWord(v)
+	return _u
+}
+
+// SetNillableWholeWord sets the "whole_word" field if the given value is not nil.
+func (_u *LexiconEntryUpdate) SetNillableWholeWord(v *bool) *LexiconEntryUpdate {
+	if v != nil {
+		_u.SetWholeWord(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LexiconEntryUpdate) SetCreatedAt(v time.Time) *LexiconEntryUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LexiconEntryUpdate) SetNillableCreatedAt(v *time.Time) *LexiconEntryUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LexiconEntryUpdate) SetUpdatedAt(v time.Time) *LexiconEntryUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *LexiconEntryUpdate) SetUserID(id uuid.UUID) *LexiconEntryUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LexiconEntryUpdate) SetUser(v *User) *LexiconEntryUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the LexiconEntryMutation object of the builder.
+func (_u *LexiconEntryUpdate) Mutation() *LexiconEntryMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LexiconEntryUpdate) ClearUser() *LexiconEntryUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LexiconEntryUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LexiconEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LexiconEntryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LexiconEntryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LexiconEntryUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := lexiconentry.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LexiconEntryUpdate) check() error {
+	if v, ok := _u.mutation.Grapheme(); ok {
+		if err := lexiconentry.GraphemeValidator(v); err != nil {
+			return &ValidationError{Name: "grapheme", err: fmt.Errorf(`generated: validator failed for field "LexiconEntry.grapheme": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Alias(); ok {
+		if err := lexiconentry.AliasValidator(v); err != nil {
+			return &ValidationError{Name: "alias", err: fmt.Errorf(`generated: validator failed for field "LexiconEntry.alias": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Phoneme(); ok {
+		if err := lexiconentry.PhonemeValidator(v); err != nil {
+			return &ValidationError{Name: "phoneme", err: fmt.Errorf(`generated: validator failed for field "LexiconEntry.phoneme": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Alphabet(); ok {
+		if err := lexiconentry.AlphabetValidator(v); err != nil {
+			return &ValidationError{Name: "alphabet", err: fmt.Errorf(`generated: validator failed for field "LexiconEntry.alphabet": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "LexiconEntry.user"`)
+	}
+	return nil
+}
+
+func (_u *LexiconEntryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lexiconentry.Table, lexiconentry.Columns, sqlgraph.NewFieldSpec(lexiconentry.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Grapheme(); ok {
+		_spec.SetField(lexiconentry.FieldGrapheme, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Alias(); ok {
+		_spec.SetField(lexiconentry.FieldAlias, field.TypeString, value)
+	}
+	if _u.mutation.AliasCleared() {
+		_spec.ClearField(lexiconentry.FieldAlias, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phoneme(); ok {
+		_spec.SetField(lexiconentry.FieldPhoneme, field.TypeString, value)
+	}
+	if _u.mutation.PhonemeCleared() {
+		_spec.ClearField(lexiconentry.FieldPhoneme, field.TypeString)
+	}
+	if value, ok := _u.mutation.Alphabet(); ok {
+		_spec.SetField(lexiconentry.FieldAlphabet, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CaseSensitive(); ok {
+		_spec.SetField(lexiconentry.FieldCaseSensitive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.WholeWord(); ok {
+		_spec.SetField(lexiconentry.FieldWholeWord, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(lexiconentry.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(lexiconentry.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lexiconentry.UserTable,
+			Columns: []string{lexiconentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lexiconentry.UserTable,
+			Columns: []string{lexiconentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lexiconentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LexiconEntryUpdateOne is the builder for updating a single LexiconEntry entity.
+type LexiconEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LexiconEntryMutation
+}
+
+// SetGrapheme sets the "grapheme" field.
+func (_u *LexiconEntryUpdateOne) SetGrapheme(v string) *LexiconEntryUpdateOne {
+	_u.mutation.SetGrapheme(v)
+	return _u
+}
+
+// SetNillableGrapheme sets the "grapheme" field if the given value is not nil.
+func (_u *LexiconEntryUpdateOne) SetNillableGrapheme(v *string) *LexiconEntryUpdateOne {
+	if v != nil {
+		_u.SetGrapheme(*v)
+	}
+	return _u
+}
+
+// SetAlias sets the "alias" field.
+func (_u *LexiconEntryUpdateOne) SetAlias(v string) *LexiconEntryUpdateOne {
+	_u.mutation.SetAlias(v)
+	return _u
+}
+
+// SetNillableAlias sets the "alias" field if the given value is not nil.
+func (_u *LexiconEntryUpdateOne) SetNillableAlias(v *string) *LexiconEntryUpdateOne {
+	if v != nil {
+		_u.SetAlias(*v)
+	}
+	return _u
+}
+
+// ClearAlias clears the value of the "alias" field.
+func (_u *LexiconEntryUpdateOne) ClearAlias() *LexiconEntryUpdateOne {
+	_u.mutation.ClearAlias()
+	return _u
+}
+
+// SetPhoneme sets the "phoneme" field.
+func (_u *LexiconEntryUpdateOne) SetPhoneme(v string) *LexiconEntryUpdateOne {
+	_u.mutation.SetPhoneme(v)
+	return _u
+}
+
+// SetNillablePhoneme sets the "phoneme" field if the given value is not nil.
+func (_u *LexiconEntryUpdateOne) SetNillablePhoneme(v *string) *LexiconEntryUpdateOne {
+	if v != nil {
+		_u.SetPhoneme(*v)
+	}
+	return _u
+}
+
+// ClearPhoneme clears the value of the "phoneme" field.
+func (_u *LexiconEntryUpdateOne) ClearPhoneme() *LexiconEntryUpdateOne {
+	_u.mutation.ClearPhoneme()
+	return _u
+}
+
+// SetAlphabet sets the "alphabet" field.
+func (_u *LexiconEntryUpdateOne) SetAlphabet(v lexiconentry.Alphabet) *LexiconEntryUpdateOne {
+	_u.mutation.SetAlphabet(v)
+	return _u
+}
+
+// SetNillableAlphabet sets the "alphabet" field if the given value is not nil.
+func (_u *LexiconEntryUpdateOne) SetNillableAlphabet(v *lexiconentry.Alphabet) *LexiconEntryUpdateOne {
+	if v != nil {
+		_u.SetAlphabet(*v)
+	}
+	return _u
+}
+
+// SetCaseSensitive sets the "case_sensitive" field.
+func (_u *LexiconEntryUpdateOne) SetCaseSensitive(v bool) *LexiconEntryUpdateOne {
+	_u.mutation.SetCaseSensitive(v)
+	return _u
+}
+
+// SetNillableCaseSensitive sets the "case_sensitive" field if the given value is not nil.
+func (_u *LexiconEntryUpdateOne) SetNillableCaseSensitive(v *bool) *LexiconEntryUpdateOne {
+	if v != nil {
+		_u.SetCaseSensitive(*v)
+	}
+	return _u
+}
+
+// SetWholeWord sets the "whole_word" field.
+func (_u *LexiconEntryUpdateOne) SetWholeWord(v bool) *LexiconEntryUpdateOne {
+	_u.mutation.SetWholeWord(v)
+	return _u
+}
+
+// SetNillableWholeWord sets the "whole_word" field if the given value is not nil.
+func (_u *LexiconEntryUpdateOne) SetNillableWholeWord(v *bool) *LexiconEntryUpdateOne {
+	if v != nil {
+		_u.SetWholeWord(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LexiconEntryUpdateOne) SetCreatedAt(v time.Time) *LexiconEntryUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LexiconEntryUpdateOne) SetNillableCreatedAt(v *time.Time) *LexiconEntryUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LexiconEntryUpdateOne) SetUpdatedAt(v time.Time) *LexiconEntryUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *LexiconEntryUpdateOne) SetUserID(id uuid.UUID) *LexiconEntryUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LexiconEntryUpdateOne) SetUser(v *User) *LexiconEntryUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the LexiconEntryMutation object of the builder.
+func (_u *LexiconEntryUpdateOne) Mutation() *LexiconEntryMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LexiconEntryUpdateOne) ClearUser() *LexiconEntryUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the LexiconEntryUpdate builder.
+func (_u *LexiconEntryUpdateOne) Where(ps ...predicate.LexiconEntry) *LexiconEntryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LexiconEntryUpdateOne) Select(field string, fields ...string) *LexiconEntryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LexiconEntry entity.
+func (_u *LexiconEntryUpdateOne) Save(ctx context.Context) (*LexiconEntry, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LexiconEntryUpdateOne) SaveX(ctx context.Context) *LexiconEntry {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LexiconEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LexiconEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LexiconEntryUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := lexiconentry.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LexiconEntryUpdateOne) check() error {
+	if v, ok := _u.mutation.Grapheme(); ok {
+		if err := lexiconentry.GraphemeValidator(v); err != nil {
+			return &ValidationError{Name: "grapheme", err: fmt.Errorf(`generated: validator failed for field "LexiconEntry.grapheme": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Alias(); ok {
+		if err := lexiconentry.AliasValidator(v); err != nil {
+			return &ValidationError{Name: "alias", err: fmt.Errorf(`generated: validator failed for field "LexiconEntry.alias": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Phoneme(); ok {
+		if err := lexiconentry.PhonemeValidator(v); err != nil {
+			return &ValidationError{Name: "phoneme", err: fmt.Errorf(`generated: validator failed for field "LexiconEntry.phoneme": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Alphabet(); ok {
+		if err := lexiconentry.AlphabetValidator(v); err != nil {
+			return &ValidationError{Name: "alphabet", err: fmt.Errorf(`generated: validator failed for field "LexiconEntry.alphabet": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "LexiconEntry.user"`)
+	}
+	return nil
+}
+
+func (_u *LexiconEntryUpdateOne) sqlSave(ctx context.Context) (_node *LexiconEntry, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lexiconentry.Table, lexiconentry.Columns, sqlgraph.NewFieldSpec(lexiconentry.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "LexiconEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, lexiconentry.FieldID)
+		for _, f := range fields {
+			if !lexiconentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != lexiconentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Grapheme(); ok {
+		_spec.SetField(lexiconentry.FieldGrapheme, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Alias(); ok {
+		_spec.SetField(lexiconentry.FieldAlias, field.TypeString, value)
+	}
+	if _u.mutation.AliasCleared() {
+		_spec.ClearField(lexiconentry.FieldAlias, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phoneme(); ok {
+		_spec.SetField(lexiconentry.FieldPhoneme, field.TypeString, value)
+	}
+	if _u.mutation.PhonemeCleared() {
+		_spec.ClearField(lexiconentry.FieldPhoneme, field.TypeString)
+	}
+	if value, ok := _u.mutation.Alphabet(); ok {
+		_spec.SetField(lexiconentry.FieldAlphabet, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CaseSensitive(); ok {
+		_spec.SetField(lexiconentry.FieldCaseSensitive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.WholeWord(); ok {
+		_spec.SetField(lexiconentry.FieldWholeWord, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(lexiconentry.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(lexiconentry.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lexiconentry.UserTable,
+			Columns: []string{lexiconentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lexiconentry.UserTable,
+			Columns: []string{lexiconentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LexiconEntry{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lexiconentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
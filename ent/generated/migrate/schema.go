@@ -8,16 +8,82 @@ import (
 )
 
 var (
+	// AudioRendersColumns holds the columns for the "audio_renders" table.
+	AudioRendersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "cache_key", Type: field.TypeString, Unique: true},
+		{Name: "blob_key", Type: field.TypeString},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "duration_ms", Type: field.TypeInt64},
+		{Name: "words", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// AudioRendersTable holds the schema information for the "audio_renders" table.
+	AudioRendersTable = &schema.Table{
+		Name:       "audio_renders",
+		Columns:    AudioRendersColumns,
+		PrimaryKey: []*schema.Column{AudioRendersColumns[0]},
+	}
+	// FoldersColumns holds the columns for the "folders" table.
+	FoldersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_folders", Type: field.TypeUUID},
+	}
+	// FoldersTable holds the schema information for the "folders" table.
+	FoldersTable = &schema.Table{
+		Name:       "folders",
+		Columns:    FoldersColumns,
+		PrimaryKey: []*schema.Column{FoldersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "folders_folders_children",
+				Columns:    []*schema.Column{FoldersColumns[4]},
+				RefColumns: []*schema.Column{FoldersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "folders_users_folders",
+				Columns:    []*schema.Column{FoldersColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "folder_name_parent_id_user_folders",
+				Unique:  true,
+				Columns: []*schema.Column{FoldersColumns[1], FoldersColumns[4], FoldersColumns[5]},
+			},
+		},
+	}
 	// HistoriesColumns holds the columns for the "histories" table.
 	HistoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "text", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"plain", "ssml"}, Default: "plain"},
+		{Name: "plain_text", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "char_count", Type: field.TypeInt, Default: 0},
+		{Name: "language", Type: field.TypeString, Nullable: true},
+		{Name: "language_confidence", Type: field.TypeFloat64, Nullable: true},
 		{Name: "voice", Type: field.TypeString},
 		{Name: "rate", Type: field.TypeFloat64, Default: 1},
 		{Name: "pitch", Type: field.TypeFloat64, Default: 1},
 		{Name: "volume", Type: field.TypeFloat64, Default: 1},
+		{Name: "is_favorite", Type: field.TypeBool, Default: false},
+		{Name: "pinned_order", Type: field.TypeInt, Nullable: true},
+		{Name: "play_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_played_at", Type: field.TypeTime, Nullable: true},
+		{Name: "content_hash", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "folder_histories", Type: field.TypeUUID, Nullable: true},
 		{Name: "user_histories", Type: field.TypeUUID, Nullable: true},
 	}
 	// HistoriesTable holds the schema information for the "histories" table.
@@ -26,13 +92,343 @@ var (
 		Columns:    HistoriesColumns,
 		PrimaryKey: []*schema.Column{HistoriesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "histories_folders_histories",
+				Columns:    []*schema.Column{HistoriesColumns[19]},
+				RefColumns: []*schema.Column{FoldersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "histories_users_histories",
-				Columns:    []*schema.Column{HistoriesColumns[8]},
+				Columns:    []*schema.Column{HistoriesColumns[20]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "history_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{HistoriesColumns[1]},
+			},
+			{
+				Name:    "history_content_hash",
+				Unique:  false,
+				Columns: []*schema.Column{HistoriesColumns[16]},
+			},
+			{
+				Name:    "history_language",
+				Unique:  false,
+				Columns: []*schema.Column{HistoriesColumns[6]},
+			},
+		},
+	}
+	// HistoryRevisionsColumns holds the columns for the "history_revisions" table.
+	HistoryRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "text", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"plain", "ssml"}, Default: "plain"},
+		{Name: "voice", Type: field.TypeString},
+		{Name: "rate", Type: field.TypeFloat64},
+		{Name: "pitch", Type: field.TypeFloat64},
+		{Name: "volume", Type: field.TypeFloat64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "history_revisions", Type: field.TypeUUID},
+	}
+	// HistoryRevisionsTable holds the schema information for the "history_revisions" table.
+	HistoryRevisionsTable = &schema.Table{
+		Name:       "history_revisions",
+		Columns:    HistoryRevisionsColumns,
+		PrimaryKey: []*schema.Column{HistoryRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "history_revisions_histories_revisions",
+				Columns:    []*schema.Column{HistoryRevisionsColumns[9]},
+				RefColumns: []*schema.Column{HistoriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "historyrevision_version_history_revisions",
+				Unique:  true,
+				Columns: []*schema.Column{HistoryRevisionsColumns[1], HistoryRevisionsColumns[9]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "key", Type: field.TypeString, Size: 255},
+		{Name: "request_hash", Type: field.TypeString},
+		{Name: "response_status", Type: field.TypeInt, Default: 0},
+		{Name: "response_body", Type: field.TypeBytes, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_idempotency_keys", Type: field.TypeUUID},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "idempotency_keys_users_idempotency_keys",
+				Columns:    []*schema.Column{IdempotencyKeysColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_key_user_idempotency_keys",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[1], IdempotencyKeysColumns[7]},
+			},
+			{
+				Name:    "idempotencykey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[5]},
+			},
+		},
+	}
+	// LexiconEntriesColumns holds the columns for the "lexicon_entries" table.
+	LexiconEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "grapheme", Type: field.TypeString, Size: 100},
+		{Name: "alias", Type: field.TypeString, Nullable: true, Size: 200},
+		{Name: "phoneme", Type: field.TypeString, Nullable: true, Size: 200},
+		{Name: "alphabet", Type: field.TypeEnum, Enums: []string{"ipa", "x-sampa"}, Default: "ipa"},
+		{Name: "case_sensitive", Type: field.TypeBool, Default: false},
+		{Name: "whole_word", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_lexicon_entries", Type: field.TypeUUID},
+	}
+	// LexiconEntriesTable holds the schema information for the "lexicon_entries" table.
+	LexiconEntriesTable = &schema.Table{
+		Name:       "lexicon_entries",
+		Columns:    LexiconEntriesColumns,
+		PrimaryKey: []*schema.Column{LexiconEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "lexicon_entries_users_lexicon_entries",
+				Columns:    []*schema.Column{LexiconEntriesColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "lexiconentry_grapheme_user_lexicon_entries",
+				Unique:  true,
+				Columns: []*schema.Column{LexiconEntriesColumns[1], LexiconEntriesColumns[9]},
+			},
+		},
+	}
+	// ModerationRulesColumns holds the columns for the "moderation_rules" table.
+	ModerationRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"word", "regex", "allow"}},
+		{Name: "pattern", Type: field.TypeString, Size: 200},
+		{Name: "action", Type: field.TypeEnum, Nullable: true, Enums: []string{"reject", "mask", "replace"}},
+		{Name: "replacement", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_moderation_rules", Type: field.TypeUUID},
+	}
+	// ModerationRulesTable holds the schema information for the "moderation_rules" table.
+	ModerationRulesTable = &schema.Table{
+		Name:       "moderation_rules",
+		Columns:    ModerationRulesColumns,
+		PrimaryKey: []*schema.Column{ModerationRulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "moderation_rules_users_moderation_rules",
+				Columns:    []*schema.Column{ModerationRulesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "moderationrule_kind_pattern_user_moderation_rules",
+				Unique:  true,
+				Columns: []*schema.Column{ModerationRulesColumns[1], ModerationRulesColumns[2], ModerationRulesColumns[7]},
+			},
+		},
+	}
+	// ModerationSettingsColumns holds the columns for the "moderation_settings" table.
+	ModerationSettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "enabled", Type: field.TypeBool, Default: false},
+		{Name: "builtin_lists", Type: field.TypeJSON},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"reject", "mask", "replace"}, Default: "mask"},
+		{Name: "replacement", Type: field.TypeString, Size: 100, Default: "beep"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_moderation_setting", Type: field.TypeUUID, Unique: true},
+	}
+	// ModerationSettingsTable holds the schema information for the "moderation_settings" table.
+	ModerationSettingsTable = &schema.Table{
+		Name:       "moderation_settings",
+		Columns:    ModerationSettingsColumns,
+		PrimaryKey: []*schema.Column{ModerationSettingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "moderation_settings_users_moderation_setting",
+				Columns:    []*schema.Column{ModerationSettingsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// PlansColumns holds the columns for the "plans" table.
+	PlansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 50},
+		{Name: "name", Type: field.TypeString},
+		{Name: "monthly_characters", Type: field.TypeInt},
+		{Name: "monthly_entries", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// PlansTable holds the schema information for the "plans" table.
+	PlansTable = &schema.Table{
+		Name:       "plans",
+		Columns:    PlansColumns,
+		PrimaryKey: []*schema.Column{PlansColumns[0]},
+	}
+	// PlayEventsColumns holds the columns for the "play_events" table.
+	PlayEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "voice", Type: field.TypeString},
+		{Name: "history_id", Type: field.TypeUUID},
+		{Name: "played_at", Type: field.TypeTime},
+		{Name: "user_play_events", Type: field.TypeUUID},
+	}
+	// PlayEventsTable holds the schema information for the "play_events" table.
+	PlayEventsTable = &schema.Table{
+		Name:       "play_events",
+		Columns:    PlayEventsColumns,
+		PrimaryKey: []*schema.Column{PlayEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "play_events_users_play_events",
+				Columns:    []*schema.Column{PlayEventsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "playevent_played_at_user_play_events",
+				Unique:  false,
+				Columns: []*schema.Column{PlayEventsColumns[3], PlayEventsColumns[4]},
+			},
+			{
+				Name:    "playevent_history_id",
+				Unique:  false,
+				Columns: []*schema.Column{PlayEventsColumns[2]},
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString, Size: 50},
+		{Name: "color", Type: field.TypeString, Nullable: true, Size: 7},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_tags", Type: field.TypeUUID},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tags_users_tags",
+				Columns:    []*schema.Column{TagsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tag_name_user_tags",
+				Unique:  true,
+				Columns: []*schema.Column{TagsColumns[1], TagsColumns[5]},
+			},
+		},
+	}
+	// TemplatesColumns holds the columns for the "templates" table.
+	TemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString, Size: 50},
+		{Name: "text", Type: field.TypeString, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"plain", "ssml"}, Default: "plain"},
+		{Name: "language", Type: field.TypeString, Default: "id-ID"},
+		{Name: "currency", Type: field.TypeString, Size: 3, Default: "IDR"},
+		{Name: "preset_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_templates", Type: field.TypeUUID},
+	}
+	// TemplatesTable holds the schema information for the "templates" table.
+	TemplatesTable = &schema.Table{
+		Name:       "templates",
+		Columns:    TemplatesColumns,
+		PrimaryKey: []*schema.Column{TemplatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "templates_users_templates",
+				Columns:    []*schema.Column{TemplatesColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "template_name_user_templates",
+				Unique:  true,
+				Columns: []*schema.Column{TemplatesColumns[1], TemplatesColumns[9]},
+			},
+		},
+	}
+	// UsageEntriesColumns holds the columns for the "usage_entries" table.
+	UsageEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"create", "update", "import", "refund"}},
+		{Name: "characters", Type: field.TypeInt},
+		{Name: "entries", Type: field.TypeInt},
+		{Name: "history_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "period_start", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_usage_entries", Type: field.TypeUUID},
+	}
+	// UsageEntriesTable holds the schema information for the "usage_entries" table.
+	UsageEntriesTable = &schema.Table{
+		Name:       "usage_entries",
+		Columns:    UsageEntriesColumns,
+		PrimaryKey: []*schema.Column{UsageEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "usage_entries_users_usage_entries",
+				Columns:    []*schema.Column{UsageEntriesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usageentry_period_start_user_usage_entries",
+				Unique:  false,
+				Columns: []*schema.Column{UsageEntriesColumns[5], UsageEntriesColumns[7]},
+			},
+		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
@@ -42,20 +438,200 @@ var (
 		{Name: "password", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "plan_users", Type: field.TypeString, Nullable: true, Size: 50},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
 		Name:       "users",
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "users_plans_users",
+				Columns:    []*schema.Column{UsersColumns[6]},
+				RefColumns: []*schema.Column{PlansColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// UserPreferencesColumns holds the columns for the "user_preferences" table.
+	UserPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "voice", Type: field.TypeString, Nullable: true},
+		{Name: "rate", Type: field.TypeFloat64, Default: 1},
+		{Name: "pitch", Type: field.TypeFloat64, Default: 1},
+		{Name: "volume", Type: field.TypeFloat64, Default: 1},
+		{Name: "language", Type: field.TypeString, Nullable: true},
+		{Name: "max_text_length", Type: field.TypeInt, Default: 5000},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_preference", Type: field.TypeUUID, Unique: true},
+	}
+	// UserPreferencesTable holds the schema information for the "user_preferences" table.
+	UserPreferencesTable = &schema.Table{
+		Name:       "user_preferences",
+		Columns:    UserPreferencesColumns,
+		PrimaryKey: []*schema.Column{UserPreferencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_preferences_users_preference",
+				Columns:    []*schema.Column{UserPreferencesColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// UserUsagesColumns holds the columns for the "user_usages" table.
+	UserUsagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "period_start", Type: field.TypeTime},
+		{Name: "characters", Type: field.TypeInt, Default: 0},
+		{Name: "entries", Type: field.TypeInt, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_usage", Type: field.TypeUUID, Unique: true},
+	}
+	// UserUsagesTable holds the schema information for the "user_usages" table.
+	UserUsagesTable = &schema.Table{
+		Name:       "user_usages",
+		Columns:    UserUsagesColumns,
+		PrimaryKey: []*schema.Column{UserUsagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_usages_users_usage",
+				Columns:    []*schema.Column{UserUsagesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// VoicesColumns holds the columns for the "voices" table.
+	VoicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Size: 100},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "language", Type: field.TypeString},
+		{Name: "gender", Type: field.TypeEnum, Enums: []string{"male", "female", "neutral"}},
+		{Name: "display_name", Type: field.TypeString},
+		{Name: "min_rate", Type: field.TypeFloat64, Default: 0.1},
+		{Name: "max_rate", Type: field.TypeFloat64, Default: 5},
+		{Name: "min_pitch", Type: field.TypeFloat64, Default: 0},
+		{Name: "max_pitch", Type: field.TypeFloat64, Default: 2},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// VoicesTable holds the schema information for the "voices" table.
+	VoicesTable = &schema.Table{
+		Name:       "voices",
+		Columns:    VoicesColumns,
+		PrimaryKey: []*schema.Column{VoicesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "voice_language",
+				Unique:  false,
+				Columns: []*schema.Column{VoicesColumns[2]},
+			},
+		},
+	}
+	// VoicePresetsColumns holds the columns for the "voice_presets" table.
+	VoicePresetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString, Size: 50},
+		{Name: "voice", Type: field.TypeString},
+		{Name: "rate", Type: field.TypeFloat64, Default: 1},
+		{Name: "pitch", Type: field.TypeFloat64, Default: 1},
+		{Name: "volume", Type: field.TypeFloat64, Default: 1},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_voice_presets", Type: field.TypeUUID},
+	}
+	// VoicePresetsTable holds the schema information for the "voice_presets" table.
+	VoicePresetsTable = &schema.Table{
+		Name:       "voice_presets",
+		Columns:    VoicePresetsColumns,
+		PrimaryKey: []*schema.Column{VoicePresetsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "voice_presets_users_voice_presets",
+				Columns:    []*schema.Column{VoicePresetsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "voicepreset_name_user_voice_presets",
+				Unique:  true,
+				Columns: []*schema.Column{VoicePresetsColumns[1], VoicePresetsColumns[8]},
+			},
+		},
+	}
+	// TagHistoriesColumns holds the columns for the "tag_histories" table.
+	TagHistoriesColumns = []*schema.Column{
+		{Name: "tag_id", Type: field.TypeUUID},
+		{Name: "history_id", Type: field.TypeUUID},
+	}
+	// TagHistoriesTable holds the schema information for the "tag_histories" table.
+	TagHistoriesTable = &schema.Table{
+		Name:       "tag_histories",
+		Columns:    TagHistoriesColumns,
+		PrimaryKey: []*schema.Column{TagHistoriesColumns[0], TagHistoriesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_histories_tag_id",
+				Columns:    []*schema.Column{TagHistoriesColumns[0]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_histories_history_id",
+				Columns:    []*schema.Column{TagHistoriesColumns[1]},
+				RefColumns: []*schema.Column{HistoriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AudioRendersTable,
+		FoldersTable,
 		HistoriesTable,
+		HistoryRevisionsTable,
+		IdempotencyKeysTable,
+		LexiconEntriesTable,
+		ModerationRulesTable,
+		ModerationSettingsTable,
+		PlansTable,
+		PlayEventsTable,
+		TagsTable,
+		TemplatesTable,
+		UsageEntriesTable,
 		UsersTable,
+		UserPreferencesTable,
+		UserUsagesTable,
+		VoicesTable,
+		VoicePresetsTable,
+		TagHistoriesTable,
 	}
 )
 
 func init() {
-	HistoriesTable.ForeignKeys[0].RefTable = UsersTable
+	FoldersTable.ForeignKeys[0].RefTable = FoldersTable
+	FoldersTable.ForeignKeys[1].RefTable = UsersTable
+	HistoriesTable.ForeignKeys[0].RefTable = FoldersTable
+	HistoriesTable.ForeignKeys[1].RefTable = UsersTable
+	HistoryRevisionsTable.ForeignKeys[0].RefTable = HistoriesTable
+	IdempotencyKeysTable.ForeignKeys[0].RefTable = UsersTable
+	LexiconEntriesTable.ForeignKeys[0].RefTable = UsersTable
+	ModerationRulesTable.ForeignKeys[0].RefTable = UsersTable
+	ModerationSettingsTable.ForeignKeys[0].RefTable = UsersTable
+	PlayEventsTable.ForeignKeys[0].RefTable = UsersTable
+	TagsTable.ForeignKeys[0].RefTable = UsersTable
+	TemplatesTable.ForeignKeys[0].RefTable = UsersTable
+	UsageEntriesTable.ForeignKeys[0].RefTable = UsersTable
+	UsersTable.ForeignKeys[0].RefTable = PlansTable
+	UserPreferencesTable.ForeignKeys[0].RefTable = UsersTable
+	UserUsagesTable.ForeignKeys[0].RefTable = UsersTable
+	VoicePresetsTable.ForeignKeys[0].RefTable = UsersTable
+	TagHistoriesTable.ForeignKeys[0].RefTable = TagsTable
+	TagHistoriesTable.ForeignKeys[1].RefTable = HistoriesTable
 }
@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationrule"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+)
+
+// ModerationRule is the model entity for the ModerationRule schema.
+type ModerationRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind moderationrule.Kind `json:"kind,omitempty"`
+	// Pattern holds the value of the "pattern" field.
+	Pattern string `json:"pattern,omitempty"`
+	// Action holds the value of the "action" field.
+	Action *moderationrule.Action `json:"action,omitempty"`
+	// Replacement holds the value of the "replacement" field.
+	Replacement *string `json:"replacement,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ModerationRuleQuery when eager-loading is set.
+	Edges                 ModerationRuleEdges `json:"edges"`
+	user_moderation_rules *uuid.UUID
+	selectValues          sql.SelectValues
+}
+
+// ModerationRuleEdges holds the relations/edges for other nodes in the graph.
+type ModerationRuleEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ModerationRuleEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ModerationRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case moderationrule.FieldKind, moderationrule.FieldPattern, moderationrule.FieldAction, moderationrule.FieldReplacement:
+			values[i] = new(sql.NullString)
+		case moderationrule.FieldCreatedAt, moderationrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case moderationrule.FieldID:
+			values[i] = new(uuid.UUID)
+		case moderationrule.ForeignKeys[0]: // user_moderation_rules
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ModerationRule fields.
+func (_m *ModerationRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case moderationrule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case moderationrule.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = moderationrule.Kind(value.String)
+			}
+		case moderationrule.FieldPattern:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pattern", values[i])
+			} else if value.Valid {
+				_m.Pattern = value.String
+			}
+		case moderationrule.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = new(moderationrule.Action)
+				*_m.Action = moderationrule.Action(value.String)
+			}
+		case moderationrule.FieldReplacement:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field replacement", values[i])
+			} else if value.Valid {
+				_m.Replacement = new(string)
+				*_m.Replacement = value.String
+			}
+		case moderationrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case moderationrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case moderationrule.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_moderation_rules", values[i])
+			} else if value.Valid {
+				_m.user_moderation_rules = new(uuid.UUID)
+				*_m.user_moderation_rules = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ModerationRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *ModerationRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the ModerationRule entity.
+func (_m *ModerationRule) QueryUser() *UserQuery {
+	return NewModerationRuleClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this ModerationRule.
+// Note that you need to call ModerationRule.Unwrap() before calling this method if this ModerationRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ModerationRule) Update() *ModerationRuleUpdateOne {
+	return NewModerationRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ModerationRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ModerationRule) Unwrap() *ModerationRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: ModerationRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ModerationRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("ModerationRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("pattern=")
+	builder.WriteString(_m.Pattern)
+	builder.WriteString(", ")
+	if v := _m.Action; v != nil {
+		builder.WriteString("action=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Replacement; v != nil {
+		builder.WriteString("replacement=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ModerationRules is a parsable slice of ModerationRule.
+type ModerationRules []*ModerationRule
@@ -0,0 +1,385 @@
+// Code generated by ent, DO NOT EDIT.
+
+package moderationrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldLTE(FieldID, id))
+}
+
+// Pattern applies equality check predicate on the "pattern" field. It's identical to PatternEQ.
+func Pattern(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldEQ(FieldPattern, v))
+}
+
+// Replacement applies equality check predicate on the "replacement" field. It's identical to ReplacementEQ.
+func Replacement(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldEQ(FieldReplacement, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// PatternEQ applies the EQ predicate on the "pattern" field.
+func PatternEQ(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldEQ(FieldPattern, v))
+}
+
+// PatternNEQ applies the NEQ predicate on the "pattern" field.
+func PatternNEQ(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldNEQ(FieldPattern, v))
+}
+
+// PatternIn applies the In predicate on the "pattern" field.
+func PatternIn(vs ...string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldIn(FieldPattern, vs...))
+}
+
+// PatternNotIn applies the NotIn predicate on the "pattern" field.
+func PatternNotIn(vs ...string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldNotIn(FieldPattern, vs...))
+}
+
+// PatternGT applies the GT predicate on the "pattern" field.
+func PatternGT(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldGT(FieldPattern, v))
+}
+
+// PatternGTE applies the GTE predicate on the "pattern" field.
+func PatternGTE(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldGTE(FieldPattern, v))
+}
+
+// PatternLT applies the LT predicate on the "pattern" field.
+func PatternLT(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldLT(FieldPattern, v))
+}
+
+// PatternLTE applies the LTE predicate on the "pattern" field.
+func PatternLTE(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldLTE(FieldPattern, v))
+}
+
+// PatternContains applies the Contains predicate on the "pattern" field.
+func PatternContains(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldContains(FieldPattern, v))
+}
+
+// PatternHasPrefix applies the HasPrefix predicate on the "pattern" field.
+func PatternHasPrefix(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldHasPrefix(FieldPattern, v))
+}
+
+// PatternHasSuffix applies the HasSuffix predicate on the "pattern" field.
+func PatternHasSuffix(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldHasSuffix(FieldPattern, v))
+}
+
+// PatternEqualFold applies the EqualFold predicate on the "pattern" field.
+func PatternEqualFold(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldEqualFold(FieldPattern, v))
+}
+
+// PatternContainsFold applies the ContainsFold predicate on the "pattern" field.
+func PatternContainsFold(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldContainsFold(FieldPattern, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionIsNil applies the IsNil predicate on the "action" field.
+func ActionIsNil() predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldIsNull(FieldAction))
+}
+
+// ActionNotNil applies the NotNil predicate on the "action" field.
+func ActionNotNil() predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldNotNull(FieldAction))
+}
+
+// ReplacementEQ applies the EQ predicate on the "replacement" field.
+func ReplacementEQ(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldEQ(FieldReplacement, v))
+}
+
+// ReplacementNEQ applies the NEQ predicate on the "replacement" field.
+func ReplacementNEQ(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldNEQ(FieldReplacement, v))
+}
+
+// ReplacementIn applies the In predicate on the "replacement" field.
+func ReplacementIn(vs ...string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldIn(FieldReplacement, vs...))
+}
+
+// ReplacementNotIn applies the NotIn predicate on the "replacement" field.
+func ReplacementNotIn(vs ...string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldNotIn(FieldReplacement, vs...))
+}
+
+// ReplacementGT applies the GT predicate on the "replacement" field.
+func ReplacementGT(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldGT(FieldReplacement, v))
+}
+
+// ReplacementGTE applies the GTE predicate on the "replacement" field.
+func ReplacementGTE(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldGTE(FieldReplacement, v))
+}
+
+// ReplacementLT applies the LT predicate on the "replacement" field.
+func ReplacementLT(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldLT(FieldReplacement, v))
+}
+
+// ReplacementLTE applies the LTE predicate on the "replacement" field.
+func ReplacementLTE(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldLTE(FieldReplacement, v))
+}
+
+// ReplacementContains applies the Contains predicate on the "replacement" field.
+func ReplacementContains(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldContains(FieldReplacement, v))
+}
+
+// ReplacementHasPrefix applies the HasPrefix predicate on the "replacement" field.
+func ReplacementHasPrefix(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldHasPrefix(FieldReplacement, v))
+}
+
+// ReplacementHasSuffix applies the HasSuffix predicate on the "replacement" field.
+func ReplacementHasSuffix(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldHasSuffix(FieldReplacement, v))
+}
+
+// ReplacementIsNil applies the IsNil predicate on the "replacement" field.
+func ReplacementIsNil() predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldIsNull(FieldReplacement))
+}
+
+// ReplacementNotNil applies the NotNil predicate on the "replacement" field.
+func ReplacementNotNil() predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldNotNull(FieldReplacement))
+}
+
+// ReplacementEqualFold applies the EqualFold predicate on the "replacement" field.
+func ReplacementEqualFold(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldEqualFold(FieldReplacement, v))
+}
+
+// ReplacementContainsFold applies the ContainsFold predicate on the "replacement" field.
+func ReplacementContainsFold(v string) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldContainsFold(FieldReplacement, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.ModerationRule {
+	return predicate.ModerationRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.ModerationRule {
+	return predicate.ModerationRule(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ModerationRule) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ModerationRule) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ModerationRule) predicate.ModerationRule {
+	return predicate.ModerationRule(sql.NotPredicates(p))
+}
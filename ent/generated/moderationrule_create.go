@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationrule"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+)
+
+// ModerationRuleCreate is the builder for creating a ModerationRule entity.
+type ModerationRuleCreate struct {
+	config
+	mutation *ModerationRuleMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (_c *ModerationRuleCreate) SetKind(v moderationrule.Kind) *ModerationRuleCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetPattern sets the "pattern" field.
+func (_c *ModerationRuleCreate) SetPattern(v string) *ModerationRuleCreate {
+	_c.mutation.SetPattern(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *ModerationRuleCreate) SetAction(v moderationrule.Action) *ModerationRuleCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_c *ModerationRuleCreate) SetNillableAction(v *moderationrule.Action) *ModerationRuleCreate {
+	if v != nil {
+		_c.SetAction(*v)
+	}
+	return _c
+}
+
+// SetReplacement sets the "replacement" field.
+func (_c *ModerationRuleCreate) SetReplacement(v string) *ModerationRuleCreate {
+	_c.mutation.SetReplacement(v)
+	return _c
+}
+
+// SetNillableReplacement sets the "replacement" field if the given value is not nil.
+func (_c *ModerationRuleCreate) SetNillableReplacement(v *string) *ModerationRuleCreate {
+	if v != nil {
+		_c.SetReplacement(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ModerationRuleCreate) SetCreatedAt(v time.Time) *ModerationRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ModerationRuleCreate) SetNillableCreatedAt(v *time.Time) *ModerationRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ModerationRuleCreate) SetUpdatedAt(v time.Time) *ModerationRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ModerationRuleCreate) SetNillableUpdatedAt(v *time.Time) *ModerationRuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ModerationRuleCreate) SetID(v uuid.UUID) *ModerationRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ModerationRuleCreate) SetNillableID(v *uuid.UUID) *ModerationRuleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *ModerationRuleCreate) SetUserID(id uuid.UUID) *ModerationRuleCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ModerationRuleCreate) SetUser(v *User) *ModerationRuleCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the ModerationRuleMutation object of the builder.
+func (_c *ModerationRuleCreate) Mutation() *ModerationRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the ModerationRule in the database.
+func (_c *ModerationRuleCreate) Save(ctx context.Context) (*ModerationRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ModerationRuleCreate) SaveX(ctx context.Context) *ModerationRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModerationRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModerationRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ModerationRuleCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := moderationrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := moderationrule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := moderationrule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ModerationRuleCreate) check() error {
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`generated: missing required field "ModerationRule.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := moderationrule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`generated: validator failed for field "ModerationRule.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Pattern(); !ok {
+		return &ValidationError{Name: "pattern", err: errors.New(`generated: missing required field "ModerationRule.pattern"`)}
+	}
+	if v, ok := _c.mutation.Pattern(); ok {
+		if err := moderationrule.PatternValidator(v); err != nil {
+			return &ValidationError{Name: "pattern", err: fmt.Errorf(`generated: validator failed for field "ModerationRule.pattern": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := moderationrule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`generated: validator failed for field "ModerationRule.action": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Replacement(); ok {
+		if err := moderationrule.ReplacementValidator(v); err != nil {
+			return &ValidationError{Name: "replacement", err: fmt.Errorf(`generated: validator failed for field "ModerationRule.replacement": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "ModerationRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "ModerationRule.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`generated: missing required edge "ModerationRule.user"`)}
+	}
+	return nil
+}
+
+func (_c *ModerationRuleCreate) sqlSave(ctx context.Context) (*ModerationRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ModerationRuleCreate) createSpec() (*ModerationRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ModerationRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(moderationrule.Table, sqlgraph.NewFieldSpec(moderationrule.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(moderationrule.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Pattern(); ok {
+		_spec.SetField(moderationrule.FieldPattern, field.TypeString, value)
+		_node.Pattern = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(moderationrule.FieldAction, field.TypeEnum, value)
+		_node.Action = &value
+	}
+	if value, ok := _c.mutation.Replacement(); ok {
+		_spec.SetField(moderationrule.FieldReplacement, field.TypeString, value)
+		_node.Replacement = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(moderationrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(moderationrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationrule.UserTable,
+			Columns: []string{moderationrule.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_moderation_rules = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ModerationRuleCreateBulk is the builder for creating many ModerationRule entities in bulk.
+type ModerationRuleCreateBulk struct {
+	config
+	err      error
+	builders []*ModerationRuleCreate
+}
+
+// Save creates the ModerationRule entities in the database.
+func (_c *ModerationRuleCreateBulk) Save(ctx context.Context) ([]*ModerationRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ModerationRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModerationRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ModerationRuleCreateBulk) SaveX(ctx context.Context) []*ModerationRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModerationRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModerationRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,574 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationrule"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+)
+
+// ModerationRuleUpdate is the builder for updating ModerationRule entities.
+type ModerationRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ModerationRuleMutation
+}
+
+// Where appends a list predicates to the ModerationRuleUpdate builder.
+func (_u *ModerationRuleUpdate) Where(ps ...predicate.ModerationRule) *ModerationRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ModerationRuleUpdate) SetKind(v moderationrule.Kind) *ModerationRuleUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ModerationRuleUpdate) SetNillableKind(v *moderationrule.Kind) *ModerationRuleUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetPattern sets the "pattern" field.
+func (_u *ModerationRuleUpdate) SetPattern(v string) *ModerationRuleUpdate {
+	_u.mutation.SetPattern(v)
+	return _u
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (_u *ModerationRuleUpdate) SetNillablePattern(v *string) *ModerationRuleUpdate {
+	if v != nil {
+		_u.SetPattern(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *ModerationRuleUpdate) SetAction(v moderationrule.Action) *ModerationRuleUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *ModerationRuleUpdate) SetNillableAction(v *moderationrule.Action) *ModerationRuleUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// ClearAction clears the value of the "action" field.
+func (_u *ModerationRuleUpdate) ClearAction() *ModerationRuleUpdate {
+	_u.mutation.ClearAction()
+	return _u
+}
+
+// SetReplacement sets the "replacement" field.
+func (_u *ModerationRuleUpdate) SetReplacement(v string) *ModerationRuleUpdate {
+	_u.mutation.SetReplacement(v)
+	return _u
+}
+
+// SetNillableReplacement sets the "replacement" field if the given value is not nil.
+func (_u *ModerationRuleUpdate) SetNillableReplacement(v *string) *ModerationRuleUpdate {
+	if v != nil {
+		_u.SetReplacement(*v)
+	}
+	return _u
+}
+
+// ClearReplacement clears the value of the "replacement" field.
+func (_u *ModerationRuleUpdate) ClearReplacement() *ModerationRuleUpdate {
+	_u.mutation.ClearReplacement()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ModerationRuleUpdate) SetCreatedAt(v time.Time) *ModerationRuleUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ModerationRuleUpdate) SetNillableCreatedAt(v *time.Time) *ModerationRuleUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ModerationRuleUpdate) SetUpdatedAt(v time.Time) *ModerationRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *ModerationRuleUpdate) SetUserID(id uuid.UUID) *ModerationRuleUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ModerationRuleUpdate) SetUser(v *User) *ModerationRuleUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ModerationRuleMutation object of the builder.
+func (_u *ModerationRuleUpdate) Mutation() *ModerationRuleMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ModerationRuleUpdate) ClearUser() *ModerationRuleUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ModerationRuleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModerationRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ModerationRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModerationRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ModerationRuleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := moderationrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ModerationRuleUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := moderationrule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`generated: validator failed for field "ModerationRule.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Pattern(); ok {
+		if err := moderationrule.PatternValidator(v); err != nil {
+			return &ValidationError{Name: "pattern", err: fmt.Errorf(`generated: validator failed for field "ModerationRule.pattern": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := moderationrule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`generated: validator failed for field "ModerationRule.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Replacement(); ok {
+		if err := moderationrule.ReplacementValidator(v); err != nil {
+			return &ValidationError{Name: "replacement", err: fmt.Errorf(`generated: validator failed for field "ModerationRule.replacement": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "ModerationRule.user"`)
+	}
+	return nil
+}
+
+func (_u *ModerationRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(moderationrule.Table, moderationrule.Columns, sqlgraph.NewFieldSpec(moderationrule.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(moderationrule.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Pattern(); ok {
+		_spec.SetField(moderationrule.FieldPattern, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(moderationrule.FieldAction, field.TypeEnum, value)
+	}
+	if _u.mutation.ActionCleared() {
+		_spec.ClearField(moderationrule.FieldAction, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Replacement(); ok {
+		_spec.SetField(moderationrule.FieldReplacement, field.TypeString, value)
+	}
+	if _u.mutation.ReplacementCleared() {
+		_spec.ClearField(moderationrule.FieldReplacement, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(moderationrule.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(moderationrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationrule.UserTable,
+			Columns: []string{moderationrule.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationrule.UserTable,
+			Columns: []string{moderationrule.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ModerationRuleUpdateOne is the builder for updating a single ModerationRule entity.
+type ModerationRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ModerationRuleMutation
+}
+
+// SetKind sets the "kind" field.
+func (_u *ModerationRuleUpdateOne) SetKind(v moderationrule.Kind) *ModerationRuleUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ModerationRuleUpdateOne) SetNillableKind(v *moderationrule.Kind) *ModerationRuleUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetPattern sets the "pattern" field.
+func (_u *ModerationRuleUpdateOne) SetPattern(v string) *ModerationRuleUpdateOne {
+	_u.mutation.SetPattern(v)
+	return _u
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (_u *ModerationRuleUpdateOne) SetNillablePattern(v *string) *ModerationRuleUpdateOne {
+	if v != nil {
+		_u.SetPattern(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *ModerationRuleUpdateOne) SetAction(v moderationrule.Action) *ModerationRuleUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *ModerationRuleUpdateOne) SetNillableAction(v *moderationrule.Action) *ModerationRuleUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// ClearAction clears the value of the "action" field.
+func (_u *ModerationRuleUpdateOne) ClearAction() *ModerationRuleUpdateOne {
+	_u.mutation.ClearAction()
+	return _u
+}
+
+// SetReplacement sets the "replacement" field.
+func (_u *ModerationRuleUpdateOne) SetReplacement(v string) *ModerationRuleUpdateOne {
+	_u.mutation.SetReplacement(v)
+	return _u
+}
+
+// SetNillableReplacement sets the "replacement" field if the given value is not nil.
+func (_u *ModerationRuleUpdateOne) SetNillableReplacement(v *string) *ModerationRuleUpdateOne {
+	if v != nil {
+		_u.SetReplacement(*v)
+	}
+	return _u
+}
+
+// ClearReplacement clears the value of the "replacement" field.
+func (_u *ModerationRuleUpdateOne) ClearReplacement() *ModerationRuleUpdateOne {
+	_u.mutation.ClearReplacement()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ModerationRuleUpdateOne) SetCreatedAt(v time.Time) *ModerationRuleUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ModerationRuleUpdateOne) SetNillableCreatedAt(v *time.Time) *ModerationRuleUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ModerationRuleUpdateOne) SetUpdatedAt(v time.Time) *ModerationRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *ModerationRuleUpdateOne) SetUserID(id uuid.UUID) *ModerationRuleUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ModerationRuleUpdateOne) SetUser(v *User) *ModerationRuleUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ModerationRuleMutation object of the builder.
+func (_u *ModerationRuleUpdateOne) Mutation() *ModerationRuleMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ModerationRuleUpdateOne) ClearUser() *ModerationRuleUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the ModerationRuleUpdate builder.
+func (_u *ModerationRuleUpdateOne) Where(ps ...predicate.ModerationRule) *ModerationRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ModerationRuleUpdateOne) Select(field string, fields ...string) *ModerationRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ModerationRule entity.
+func (_u *ModerationRuleUpdateOne) Save(ctx context.Context) (*ModerationRule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModerationRuleUpdateOne) SaveX(ctx context.Context) *ModerationRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ModerationRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModerationRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ModerationRuleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := moderationrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ModerationRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := moderationrule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`generated: validator failed for field "ModerationRule.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Pattern(); ok {
+		if err := moderationrule.PatternValidator(v); err != nil {
+			return &ValidationError{Name: "pattern", err: fmt.Errorf(`generated: validator failed for field "ModerationRule.pattern": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := moderationrule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`generated: validator failed for field "ModerationRule.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Replacement(); ok {
+		if err := moderationrule.ReplacementValidator(v); err != nil {
+			return &ValidationError{Name: "replacement", err: fmt.Errorf(`generated: validator failed for field "ModerationRule.replacement": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "ModerationRule.user"`)
+	}
+	return nil
+}
+
+func (_u *ModerationRuleUpdateOne) sqlSave(ctx context.Context) (_node *ModerationRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(moderationrule.Table, moderationrule.Columns, sqlgraph.NewFieldSpec(moderationrule.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "ModerationRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, moderationrule.FieldID)
+		for _, f := range fields {
+			if !moderationrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != moderationrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(moderationrule.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Pattern(); ok {
+		_spec.SetField(moderationrule.FieldPattern, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(moderationrule.FieldAction, field.TypeEnum, value)
+	}
+	if _u.mutation.ActionCleared() {
+		_spec.ClearField(moderationrule.FieldAction, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Replacement(); ok {
+		_spec.SetField(moderationrule.FieldReplacement, field.TypeString, value)
+	}
+	if _u.mutation.ReplacementCleared() {
+		_spec.ClearField(moderationrule.FieldReplacement, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(moderationrule.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(moderationrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationrule.UserTable,
+			Columns: []string{moderationrule.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationrule.UserTable,
+			Columns: []string{moderationrule.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ModerationRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationsetting"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+)
+
+// ModerationSetting is the model entity for the ModerationSetting schema.
+type ModerationSetting struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// BuiltinLists holds the value of the "builtin_lists" field.
+	BuiltinLists []string `json:"builtinLists"`
+	// Action holds the value of the "action" field.
+	Action moderationsetting.Action `json:"action,omitempty"`
+	// Replacement holds the value of the "replacement" field.
+	Replacement string `json:"replacement,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ModerationSettingQuery when eager-loading is set.
+	Edges                   ModerationSettingEdges `json:"edges"`
+	user_moderation_setting *uuid.UUID
+	selectValues            sql.SelectValues
+}
+
+// ModerationSettingEdges holds the relations/edges for other nodes in the graph.
+type ModerationSettingEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ModerationSettingEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ModerationSetting) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case moderationsetting.FieldBuiltinLists:
+			values[i] = new([]byte)
+		case moderationsetting.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case moderationsetting.FieldAction, moderationsetting.FieldReplacement:
+			values[i] = new(sql.NullString)
+		case moderationsetting.FieldCreatedAt, moderationsetting.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case moderationsetting.FieldID:
+			values[i] = new(uuid.UUID)
+		case moderationsetting.ForeignKeys[0]: // user_moderation_setting
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ModerationSetting fields.
+func (_m *ModerationSetting) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case moderationsetting.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case moderationsetting.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case moderationsetting.FieldBuiltinLists:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field builtin_lists", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.BuiltinLists); err != nil {
+					return fmt.Errorf("unmarshal field builtin_lists: %w", err)
+				}
+			}
+		case moderationsetting.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = moderationsetting.Action(value.String)
+			}
+		case moderationsetting.FieldReplacement:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field replacement", values[i])
+			} else if value.Valid {
+				_m.Replacement = value.String
+			}
+		case moderationsetting.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case moderationsetting.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case moderationsetting.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_moderation_setting", values[i])
+			} else if value.Valid {
+				_m.user_moderation_setting = new(uuid.UUID)
+				*_m.user_moderation_setting = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ModerationSetting.
+// This includes values selected through modifiers, order, etc.
+func (_m *ModerationSetting) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the ModerationSetting entity.
+func (_m *ModerationSetting) QueryUser() *UserQuery {
+	return NewModerationSettingClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this ModerationSetting.
+// Note that you need to call ModerationSetting.Unwrap() before calling this method if this ModerationSetting
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ModerationSetting) Update() *ModerationSettingUpdateOne {
+	return NewModerationSettingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ModerationSetting entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ModerationSetting) Unwrap() *ModerationSetting {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: ModerationSetting is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ModerationSetting) String() string {
+	var builder strings.Builder
+	builder.WriteString("ModerationSetting(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("builtin_lists=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BuiltinLists))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	builder.WriteString("replacement=")
+	builder.WriteString(_m.Replacement)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ModerationSettings is a parsable slice of ModerationSetting.
+type ModerationSettings []*ModerationSetting
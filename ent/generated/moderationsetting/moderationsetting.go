@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package moderationsetting
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the moderationsetting type in the database.
+	Label = "moderation_setting"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldBuiltinLists holds the string denoting the builtin_lists field in the database.
+	FieldBuiltinLists = "builtin_lists"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldReplacement holds the string denoting the replacement field in the database.
+	FieldReplacement = "replacement"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the moderationsetting in the database.
+	Table = "moderation_settings"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "moderation_settings"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_moderation_setting"
+)
+
+// Columns holds all SQL columns for moderationsetting fields.
+var Columns = []string{
+	FieldID,
+	FieldEnabled,
+	FieldBuiltinLists,
+	FieldAction,
+	FieldReplacement,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "moderation_settings"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_moderation_setting",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultBuiltinLists holds the default value on creation for the "builtin_lists" field.
+	DefaultBuiltinLists []string
+	// DefaultReplacement holds the default value on creation for the "replacement" field.
+	DefaultReplacement string
+	// ReplacementValidator is a validator for the "replacement" field. It is called by the builders before save.
+	ReplacementValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// ActionMask is the default value of the Action enum.
+const DefaultAction = ActionMask
+
+// Action values.
+const (
+	ActionReject  Action = "reject"
+	ActionMask    Action = "mask"
+	ActionReplace Action = "replace"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionReject, ActionMask, ActionReplace:
+		return nil
+	default:
+		return fmt.Errorf("moderationsetting: invalid enum value for action field: %q", a)
+	}
+}
+
+// OrderOption defines the ordering options for the ModerationSetting queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByReplacement orders the results by the replacement field.
+func ByReplacement(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplacement, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+	)
+}
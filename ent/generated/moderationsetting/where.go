@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package moderationsetting
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldLTE(FieldID, id))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Replacement applies equality check predicate on the "replacement" field. It's identical to ReplacementEQ.
+func Replacement(v string) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldEQ(FieldReplacement, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ReplacementEQ applies the EQ predicate on the "replacement" field.
+func ReplacementEQ(v string) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldEQ(FieldReplacement, v))
+}
+
+// ReplacementNEQ applies the NEQ predicate on the "replacement" field.
+func ReplacementNEQ(v string) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldNEQ(FieldReplacement, v))
+}
+
+// ReplacementIn applies the In predicate on the "replacement" field.
+func ReplacementIn(vs ...string) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldIn(FieldReplacement, vs...))
+}
+
+// ReplacementNotIn applies the NotIn predicate on the "replacement" field.
+func ReplacementNotIn(vs ...string) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldNotIn(FieldReplacement, vs...))
+}
+
+// ReplacementGT applies the GT predicate on the "replacement" field.
+func ReplacementGT(v string) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldGT(FieldReplacement, v))
+}
+
+// ReplacementGTE applies the GTE predicate on the "replacement" field.
+func ReplacementGTE(v string) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldGTE(FieldReplacement, v))
+}
+
+// ReplacementLT applies the LT predicate on the "replacement" field.
+func ReplacementLT(v string) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldLT(FieldReplacement, v))
+}
+
+// ReplacementLTE applies the LTE predicate on the "replacement" field.
+func ReplacementLTE(v string) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldLTE(FieldReplacement, v))
+}
+
+// ReplacementContains applies the Contains predicate on the "replacement" field.
+func ReplacementContains(v string) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldContains(FieldReplacement, v))
+}
+
+// ReplacementHasPrefix applies the HasPrefix predicate on the "replacement" field.
+func ReplacementHasPrefix(v string) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldHasPrefix(FieldReplacement, v))
+}
+
+// ReplacementHasSuffix applies the HasSuffix predicate on the "replacement" field.
+func ReplacementHasSuffix(v string) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldHasSuffix(FieldReplacement, v))
+}
+
+// ReplacementEqualFold applies the EqualFold predicate on the "replacement" field.
+func ReplacementEqualFold(v string) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldEqualFold(FieldReplacement, v))
+}
+
+// ReplacementContainsFold applies the ContainsFold predicate on the "replacement" field.
+func ReplacementContainsFold(v string) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldContainsFold(FieldReplacement, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.ModerationSetting {
+	return predicate.ModerationSetting(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.ModerationSetting {
+	return predicate.ModerationSetting(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ModerationSetting) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ModerationSetting) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ModerationSetting) predicate.ModerationSetting {
+	return predicate.ModerationSetting(sql.NotPredicates(p))
+}
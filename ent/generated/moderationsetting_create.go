@@ -0,0 +1,385 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationsetting"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+)
+
+// ModerationSettingCreate is the builder for creating a ModerationSetting entity.
+type ModerationSettingCreate struct {
+	config
+	mutation *ModerationSettingMutation
+	hooks    []Hook
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *ModerationSettingCreate) SetEnabled(v bool) *ModerationSettingCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *ModerationSettingCreate) SetNillableEnabled(v *bool) *ModerationSettingCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetBuiltinLists sets the "builtin_lists" field.
+func (_c *ModerationSettingCreate) SetBuiltinLists(v []string) *ModerationSettingCreate {
+	_c.mutation.SetBuiltinLists(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *ModerationSettingCreate) SetAction(v moderationsetting.Action) *ModerationSettingCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_c *ModerationSettingCreate) SetNillableAction(v *moderationsetting.Action) *ModerationSettingCreate {
+	if v != nil {
+		_c.SetAction(*v)
+	}
+	return _c
+}
+
+// SetReplacement sets the "replacement" field.
+func (_c *ModerationSettingCreate) SetReplacement(v string) *ModerationSettingCreate {
+	_c.mutation.SetReplacement(v)
+	return _c
+}
+
+// SetNillableReplacement sets the "replacement" field if the given value is not nil.
+func (_c *ModerationSettingCreate) SetNillableReplacement(v *string) *ModerationSettingCreate {
+	if v != nil {
+		_c.SetReplacement(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ModerationSettingCreate) SetCreatedAt(v time.Time) *ModerationSettingCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ModerationSettingCreate) SetNillableCreatedAt(v *time.Time) *ModerationSettingCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ModerationSettingCreate) SetUpdatedAt(v time.Time) *ModerationSettingCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ModerationSettingCreate) SetNillableUpdatedAt(v *time.Time) *ModerationSettingCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ModerationSettingCreate) SetID(v uuid.UUID) *ModerationSettingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ModerationSettingCreate) SetNillableID(v *uuid.UUID) *ModerationSettingCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *ModerationSettingCreate) SetUserID(id uuid.UUID) *ModerationSettingCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ModerationSettingCreate) SetUser(v *User) *ModerationSettingCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the ModerationSettingMutation object of the builder.
+func (_c *ModerationSettingCreate) Mutation() *ModerationSettingMutation {
+	return _c.mutation
+}
+
+// Save creates the ModerationSetting in the database.
+func (_c *ModerationSettingCreate) Save(ctx context.Context) (*ModerationSetting, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ModerationSettingCreate) SaveX(ctx context.Context) *ModerationSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModerationSettingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModerationSettingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ModerationSettingCreate) defaults() {
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := moderationsetting.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.BuiltinLists(); !ok {
+		v := moderationsetting.DefaultBuiltinLists
+		_c.mutation.SetBuiltinLists(v)
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		v := moderationsetting.DefaultAction
+		_c.mutation.SetAction(v)
+	}
+	if _, ok := _c.mutation.Replacement(); !ok {
+		v := moderationsetting.DefaultReplacement
+		_c.mutation.SetReplacement(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := moderationsetting.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := moderationsetting.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := moderationsetting.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ModerationSettingCreate) check() error {
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`generated: missing required field "ModerationSetting.enabled"`)}
+	}
+	if _, ok := _c.mutation.BuiltinLists(); !ok {
+		return &ValidationError{Name: "builtin_lists", err: errors.New(`generated: missing required field "ModerationSetting.builtin_lists"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`generated: missing required field "ModerationSetting.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := moderationsetting.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`generated: validator failed for field "ModerationSetting.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Replacement(); !ok {
+		return &ValidationError{Name: "replacement", err: errors.New(`generated: missing required field "ModerationSetting.replacement"`)}
+	}
+	if v, ok := _c.mutation.Replacement(); ok {
+		if err := moderationsetting.ReplacementValidator(v); err != nil {
+			return &ValidationError{Name: "replacement", err: fmt.Errorf(`generated: validator failed for field "ModerationSetting.replacement": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "ModerationSetting.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "ModerationSetting.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`generated: missing required edge "ModerationSetting.user"`)}
+	}
+	return nil
+}
+
+func (_c *ModerationSettingCreate) sqlSave(ctx context.Context) (*ModerationSetting, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ModerationSettingCreate) createSpec() (*ModerationSetting, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ModerationSetting{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(moderationsetting.Table, sqlgraph.NewFieldSpec(moderationsetting.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(moderationsetting.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.BuiltinLists(); ok {
+		_spec.SetField(moderationsetting.FieldBuiltinLists, field.TypeJSON, value)
+		_node.BuiltinLists = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(moderationsetting.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Replacement(); ok {
+		_spec.SetField(moderationsetting.FieldReplacement, field.TypeString, value)
+		_node.Replacement = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(moderationsetting.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(moderationsetting.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   moderationsetting.UserTable,
+			Columns: []string{moderationsetting.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_moderation_setting = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ModerationSettingCreateBulk is the builder for creating many ModerationSetting entities in bulk.
+type ModerationSettingCreateBulk struct {
+	config
+	err      error
+	builders []*ModerationSettingCreate
+}
+
+// Save creates the ModerationSetting entities in the database.
+func (_c *ModerationSettingCreateBulk) Save(ctx context.Context) ([]*ModerationSetting, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ModerationSetting, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModerationSettingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ModerationSettingCreateBulk) SaveX(ctx context.Context) []*ModerationSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModerationSettingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModerationSettingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
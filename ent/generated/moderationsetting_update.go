@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationsetting"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+)
+
+// ModerationSettingUpdate is the builder for updating ModerationSetting entities.
+type ModerationSettingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ModerationSettingMutation
+}
+
+// Where appends a list predicates to the ModerationSettingUpdate builder.
+func (_u *ModerationSettingUpdate) Where(ps ...predicate.ModerationSetting) *ModerationSettingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ModerationSettingUpdate) SetEnabled(v bool) *ModerationSettingUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ModerationSettingUpdate) SetNillableEnabled(v *bool) *ModerationSettingUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetBuiltinLists sets the "builtin_lists" field.
+func (_u *ModerationSettingUpdate) SetBuiltinLists(v []string) *ModerationSettingUpdate {
+	_u.mutation.SetBuiltinLists(v)
+	return _u
+}
+
+// AppendBuiltinLists appends value to the "builtin_lists" field.
+func (_u *ModerationSettingUpdate) AppendBuiltinLists(v []string) *ModerationSettingUpdate {
+	_u.mutation.AppendBuiltinLists(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *ModerationSettingUpdate) SetAction(v moderationsetting.Action) *ModerationSettingUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *ModerationSettingUpdate) SetNillableAction(v *moderationsetting.Action) *ModerationSettingUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetReplacement sets the "replacement" field.
+func (_u *ModerationSettingUpdate) SetReplacement(v string) *ModerationSettingUpdate {
+	_u.mutation.SetReplacement(v)
+	return _u
+}
+
+// SetNillableReplacement sets the "replacement" field if the given value is not nil.
+func (_u *ModerationSettingUpdate) SetNillableReplacement(v *string) *ModerationSettingUpdate {
+	if v != nil {
+		_u.SetReplacement(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ModerationSettingUpdate) SetCreatedAt(v time.Time) *ModerationSettingUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ModerationSettingUpdate) SetNillableCreatedAt(v *time.Time) *ModerationSettingUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ModerationSettingUpdate) SetUpdatedAt(v time.Time) *ModerationSettingUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *ModerationSettingUpdate) SetUserID(id uuid.UUID) *ModerationSettingUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ModerationSettingUpdate) SetUser(v *User) *ModerationSettingUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ModerationSettingMutation object of the builder.
+func (_u *ModerationSettingUpdate) Mutation() *ModerationSettingMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ModerationSettingUpdate) ClearUser() *ModerationSettingUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ModerationSettingUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModerationSettingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ModerationSettingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModerationSettingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ModerationSettingUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := moderationsetting.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ModerationSettingUpdate) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := moderationsetting.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`generated: validator failed for field "ModerationSetting.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Replacement(); ok {
+		if err := moderationsetting.ReplacementValidator(v); err != nil {
+			return &ValidationError{Name: "replacement", err: fmt.Errorf(`generated: validator failed for field "ModerationSetting.replacement": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "ModerationSetting.user"`)
+	}
+	return nil
+}
+
+func (_u *ModerationSettingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(moderationsetting.Table, moderationsetting.Columns, sqlgraph.NewFieldSpec(moderationsetting.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(moderationsetting.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BuiltinLists(); ok {
+		_spec.SetField(moderationsetting.FieldBuiltinLists, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBuiltinLists(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, moderationsetting.FieldBuiltinLists, value)
+		})
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(moderationsetting.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Replacement(); ok {
+		_spec.SetField(moderationsetting.FieldReplacement, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(moderationsetting.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(moderationsetting.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   moderationsetting.UserTable,
+			Columns: []string{moderationsetting.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   moderationsetting.UserTable,
+			Columns: []string{moderationsetting.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationsetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ModerationSettingUpdateOne is the builder for updating a single ModerationSetting entity.
+type ModerationSettingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ModerationSettingMutation
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ModerationSettingUpdateOne) SetEnabled(v bool) *ModerationSettingUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ModerationSettingUpdateOne) SetNillableEnabled(v *bool) *ModerationSettingUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetBuiltinLists sets the "builtin_lists" field.
+func (_u *ModerationSettingUpdateOne) SetBuiltinLists(v []string) *ModerationSettingUpdateOne {
+	_u.mutation.SetBuiltinLists(v)
+	return _u
+}
+
+// AppendBuiltinLists appends value to the "builtin_lists" field.
+func (_u *ModerationSettingUpdateOne) AppendBuiltinLists(v []string) *ModerationSettingUpdateOne {
+	_u.mutation.AppendBuiltinLists(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *ModerationSettingUpdateOne) SetAction(v moderationsetting.Action) *ModerationSettingUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *ModerationSettingUpdateOne) SetNillableAction(v *moderationsetting.Action) *ModerationSettingUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetReplacement sets the "replacement" field.
+func (_u *ModerationSettingUpdateOne) SetReplacement(v string) *ModerationSettingUpdateOne {
+	_u.mutation.SetReplacement(v)
+	return _u
+}
+
+// SetNillableReplacement sets the "replacement" field if the given value is not nil.
+func (_u *ModerationSettingUpdateOne) SetNillableReplacement(v *string) *ModerationSettingUpdateOne {
+	if v != nil {
+		_u.SetReplacement(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ModerationSettingUpdateOne) SetCreatedAt(v time.Time) *ModerationSettingUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ModerationSettingUpdateOne) SetNillableCreatedAt(v *time.Time) *ModerationSettingUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ModerationSettingUpdateOne) SetUpdatedAt(v time.Time) *ModerationSettingUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *ModerationSettingUpdateOne) SetUserID(id uuid.UUID) *ModerationSettingUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ModerationSettingUpdateOne) SetUser(v *User) *ModerationSettingUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ModerationSettingMutation object of the builder.
+func (_u *ModerationSettingUpdateOne) Mutation() *ModerationSettingMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ModerationSettingUpdateOne) ClearUser() *ModerationSettingUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the ModerationSettingUpdate builder.
+func (_u *ModerationSettingUpdateOne) Where(ps ...predicate.ModerationSetting) *ModerationSettingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ModerationSettingUpdateOne) Select(field string, fields ...string) *ModerationSettingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ModerationSetting entity.
+func (_u *ModerationSettingUpdateOne) Save(ctx context.Context) (*ModerationSetting, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModerationSettingUpdateOne) SaveX(ctx context.Context) *ModerationSetting {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ModerationSettingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModerationSettingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ModerationSettingUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := moderationsetting.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ModerationSettingUpdateOne) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := moderationsetting.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`generated: validator failed for field "ModerationSetting.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Replacement(); ok {
+		if err := moderationsetting.ReplacementValidator(v); err != nil {
+			return &ValidationError{Name: "replacement", err: fmt.Errorf(`generated: validator failed for field "ModerationSetting.replacement": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "ModerationSetting.user"`)
+	}
+	return nil
+}
+
+func (_u *ModerationSettingUpdateOne) sqlSave(ctx context.Context) (_node *ModerationSetting, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(moderationsetting.Table, moderationsetting.Columns, sqlgraph.NewFieldSpec(moderationsetting.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "ModerationSetting.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, moderationsetting.FieldID)
+		for _, f := range fields {
+			if !moderationsetting.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != moderationsetting.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(moderationsetting.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BuiltinLists(); ok {
+		_spec.SetField(moderationsetting.FieldBuiltinLists, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBuiltinLists(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, moderationsetting.FieldBuiltinLists, value)
+		})
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(moderationsetting.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Replacement(); ok {
+		_spec.SetField(moderationsetting.FieldReplacement, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(moderationsetting.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(moderationsetting.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   moderationsetting.UserTable,
+			Columns: []string{moderationsetting.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   moderationsetting.UserTable,
+			Columns: []string{moderationsetting.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ModerationSetting{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationsetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
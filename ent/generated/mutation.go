@@ -12,9 +12,26 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/audiorender"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/folder"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/historyrevision"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/idempotencykey"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/lexiconentry"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationrule"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationsetting"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/plan"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/playevent"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/tag"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/template"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/usageentry"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userpreference"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userusage"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/voice"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/voicepreset"
+	"github.com/kiminodare/HOVARLAY-BE/internal/synth"
 )
 
 const (
@@ -26,45 +43,60 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeHistory = "History"
-	TypeUser    = "User"
+	TypeAudioRender       = "AudioRender"
+	TypeFolder            = "Folder"
+	TypeHistory           = "History"
+	TypeHistoryRevision   = "HistoryRevision"
+	TypeIdempotencyKey    = "IdempotencyKey"
+	TypeLexiconEntry      = "LexiconEntry"
+	TypeModerationRule    = "ModerationRule"
+	TypeModerationSetting = "ModerationSetting"
+	TypePlan              = "Plan"
+	TypePlayEvent         = "PlayEvent"
+	TypeTag               = "Tag"
+	TypeTemplate          = "Template"
+	TypeUsageEntry        = "UsageEntry"
+	TypeUser              = "User"
+	TypeUserPreference    = "UserPreference"
+	TypeUserUsage         = "UserUsage"
+	TypeVoice             = "Voice"
+	TypeVoicePreset       = "VoicePreset"
 )
 
-// HistoryMutation represents an operation that mutates the History nodes in the graph.
-type HistoryMutation struct {
+// AudioRenderMutation represents an operation that mutates the AudioRender nodes in the graph.
+type AudioRenderMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	text          *string
-	voice         *string
-	rate          *float64
-	addrate       *float64
-	pitch         *float64
-	addpitch      *float64
-	volume        *float64
-	addvolume     *float64
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *uuid.UUID
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*History, error)
-	predicates    []predicate.History
-}
-
-var _ ent.Mutation = (*HistoryMutation)(nil)
-
-// historyOption allows management of the mutation configuration using functional options.
-type historyOption func(*HistoryMutation)
-
-// newHistoryMutation creates new mutation for the History entity.
-func newHistoryMutation(c config, op Op, opts ...historyOption) *HistoryMutation {
-	m := &HistoryMutation{
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	cache_key      *string
+	blob_key       *string
+	content_type   *string
+	size           *int64
+	addsize        *int64
+	duration_ms    *int64
+	addduration_ms *int64
+	words          *[]synth.WordTiming
+	appendwords    []synth.WordTiming
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*AudioRender, error)
+	predicates     []predicate.AudioRender
+}
+
+var _ ent.Mutation = (*AudioRenderMutation)(nil)
+
+// audiorenderOption allows management of the mutation configuration using functional options.
+type audiorenderOption func(*AudioRenderMutation)
+
+// newAudioRenderMutation creates new mutation for the AudioRender entity.
+func newAudioRenderMutation(c config, op Op, opts ...audiorenderOption) *AudioRenderMutation {
+	m := &AudioRenderMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeHistory,
+		typ:           TypeAudioRender,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -73,20 +105,20 @@ func newHistoryMutation(c config, op Op, opts ...historyOption) *HistoryMutation
 	return m
 }
 
-// withHistoryID sets the ID field of the mutation.
-func withHistoryID(id uuid.UUID) historyOption {
-	return func(m *HistoryMutation) {
+// withAudioRenderID sets the ID field of the mutation.
+func withAudioRenderID(id uuid.UUID) audiorenderOption {
+	return func(m *AudioRenderMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *History
+			value *AudioRender
 		)
-		m.oldValue = func(ctx context.Context) (*History, error) {
+		m.oldValue = func(ctx context.Context) (*AudioRender, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().History.Get(ctx, id)
+					value, err = m.Client().AudioRender.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -95,10 +127,10 @@ func withHistoryID(id uuid.UUID) historyOption {
 	}
 }
 
-// withHistory sets the old History of the mutation.
-func withHistory(node *History) historyOption {
-	return func(m *HistoryMutation) {
-		m.oldValue = func(context.Context) (*History, error) {
+// withAudioRender sets the old AudioRender of the mutation.
+func withAudioRender(node *AudioRender) audiorenderOption {
+	return func(m *AudioRenderMutation) {
+		m.oldValue = func(context.Context) (*AudioRender, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -107,7 +139,7 @@ func withHistory(node *History) historyOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m HistoryMutation) Client() *Client {
+func (m AudioRenderMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -115,7 +147,7 @@ func (m HistoryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m HistoryMutation) Tx() (*Tx, error) {
+func (m AudioRenderMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -125,14 +157,14 @@ func (m HistoryMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of History entities.
-func (m *HistoryMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of AudioRender entities.
+func (m *AudioRenderMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *HistoryMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AudioRenderMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -143,7 +175,7 @@ func (m *HistoryMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *HistoryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AudioRenderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -152,259 +184,304 @@ func (m *HistoryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().History.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AudioRender.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetText sets the "text" field.
-func (m *HistoryMutation) SetText(s string) {
-	m.text = &s
+// SetCacheKey sets the "cache_key" field.
+func (m *AudioRenderMutation) SetCacheKey(s string) {
+	m.cache_key = &s
 }
 
-// Text returns the value of the "text" field in the mutation.
-func (m *HistoryMutation) Text() (r string, exists bool) {
-	v := m.text
+// CacheKey returns the value of the "cache_key" field in the mutation.
+func (m *AudioRenderMutation) CacheKey() (r string, exists bool) {
+	v := m.cache_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldText returns the old "text" field's value of the History entity.
-// If the History object wasn't provided to the builder, the object is fetched from the database.
+// OldCacheKey returns the old "cache_key" field's value of the AudioRender entity.
+// If the AudioRender object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoryMutation) OldText(ctx context.Context) (v string, err error) {
+func (m *AudioRenderMutation) OldCacheKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldText is only allowed on UpdateOne operations")
+		return v, errors.New("OldCacheKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldText requires an ID field in the mutation")
+		return v, errors.New("OldCacheKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldText: %w", err)
+		return v, fmt.Errorf("querying old value for OldCacheKey: %w", err)
 	}
-	return oldValue.Text, nil
+	return oldValue.CacheKey, nil
 }
 
-// ResetText resets all changes to the "text" field.
-func (m *HistoryMutation) ResetText() {
-	m.text = nil
+// ResetCacheKey resets all changes to the "cache_key" field.
+func (m *AudioRenderMutation) ResetCacheKey() {
+	m.cache_key = nil
 }
 
-// SetVoice sets the "voice" field.
-func (m *HistoryMutation) SetVoice(s string) {
-	m.voice = &s
+// SetBlobKey sets the "blob_key" field.
+func (m *AudioRenderMutation) SetBlobKey(s string) {
+	m.blob_key = &s
 }
 
-// Voice returns the value of the "voice" field in the mutation.
-func (m *HistoryMutation) Voice() (r string, exists bool) {
-	v := m.voice
+// BlobKey returns the value of the "blob_key" field in the mutation.
+func (m *AudioRenderMutation) BlobKey() (r string, exists bool) {
+	v := m.blob_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVoice returns the old "voice" field's value of the History entity.
-// If the History object wasn't provided to the builder, the object is fetched from the database.
+// OldBlobKey returns the old "blob_key" field's value of the AudioRender entity.
+// If the AudioRender object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoryMutation) OldVoice(ctx context.Context) (v string, err error) {
+func (m *AudioRenderMutation) OldBlobKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVoice is only allowed on UpdateOne operations")
+		return v, errors.New("OldBlobKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVoice requires an ID field in the mutation")
+		return v, errors.New("OldBlobKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVoice: %w", err)
+		return v, fmt.Errorf("querying old value for OldBlobKey: %w", err)
 	}
-	return oldValue.Voice, nil
+	return oldValue.BlobKey, nil
 }
 
-// ResetVoice resets all changes to the "voice" field.
-func (m *HistoryMutation) ResetVoice() {
-	m.voice = nil
+// ResetBlobKey resets all changes to the "blob_key" field.
+func (m *AudioRenderMutation) ResetBlobKey() {
+	m.blob_key = nil
 }
 
-// SetRate sets the "rate" field.
-func (m *HistoryMutation) SetRate(f float64) {
-	m.rate = &f
-	m.addrate = nil
+// SetContentType sets the "content_type" field.
+func (m *AudioRenderMutation) SetContentType(s string) {
+	m.content_type = &s
 }
 
-// Rate returns the value of the "rate" field in the mutation.
-func (m *HistoryMutation) Rate() (r float64, exists bool) {
-	v := m.rate
+// ContentType returns the value of the "content_type" field in the mutation.
+func (m *AudioRenderMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRate returns the old "rate" field's value of the History entity.
-// If the History object wasn't provided to the builder, the object is fetched from the database.
+// OldContentType returns the old "content_type" field's value of the AudioRender entity.
+// If the AudioRender object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoryMutation) OldRate(ctx context.Context) (v float64, err error) {
+func (m *AudioRenderMutation) OldContentType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRate is only allowed on UpdateOne operations")
+		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRate requires an ID field in the mutation")
+		return v, errors.New("OldContentType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRate: %w", err)
+		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
 	}
-	return oldValue.Rate, nil
+	return oldValue.ContentType, nil
 }
 
-// AddRate adds f to the "rate" field.
-func (m *HistoryMutation) AddRate(f float64) {
-	if m.addrate != nil {
-		*m.addrate += f
+// ResetContentType resets all changes to the "content_type" field.
+func (m *AudioRenderMutation) ResetContentType() {
+	m.content_type = nil
+}
+
+// SetSize sets the "size" field.
+func (m *AudioRenderMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *AudioRenderMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the AudioRender entity.
+// If the AudioRender object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AudioRenderMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *AudioRenderMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
 	} else {
-		m.addrate = &f
+		m.addsize = &i
 	}
 }
 
-// AddedRate returns the value that was added to the "rate" field in this mutation.
-func (m *HistoryMutation) AddedRate() (r float64, exists bool) {
-	v := m.addrate
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *AudioRenderMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRate resets all changes to the "rate" field.
-func (m *HistoryMutation) ResetRate() {
-	m.rate = nil
-	m.addrate = nil
+// ResetSize resets all changes to the "size" field.
+func (m *AudioRenderMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
 }
 
-// SetPitch sets the "pitch" field.
-func (m *HistoryMutation) SetPitch(f float64) {
-	m.pitch = &f
-	m.addpitch = nil
+// SetDurationMs sets the "duration_ms" field.
+func (m *AudioRenderMutation) SetDurationMs(i int64) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
 }
 
-// Pitch returns the value of the "pitch" field in the mutation.
-func (m *HistoryMutation) Pitch() (r float64, exists bool) {
-	v := m.pitch
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *AudioRenderMutation) DurationMs() (r int64, exists bool) {
+	v := m.duration_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPitch returns the old "pitch" field's value of the History entity.
-// If the History object wasn't provided to the builder, the object is fetched from the database.
+// OldDurationMs returns the old "duration_ms" field's value of the AudioRender entity.
+// If the AudioRender object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoryMutation) OldPitch(ctx context.Context) (v float64, err error) {
+func (m *AudioRenderMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPitch is only allowed on UpdateOne operations")
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPitch requires an ID field in the mutation")
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPitch: %w", err)
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
 	}
-	return oldValue.Pitch, nil
+	return oldValue.DurationMs, nil
 }
 
-// AddPitch adds f to the "pitch" field.
-func (m *HistoryMutation) AddPitch(f float64) {
-	if m.addpitch != nil {
-		*m.addpitch += f
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *AudioRenderMutation) AddDurationMs(i int64) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
 	} else {
-		m.addpitch = &f
+		m.addduration_ms = &i
 	}
 }
 
-// AddedPitch returns the value that was added to the "pitch" field in this mutation.
-func (m *HistoryMutation) AddedPitch() (r float64, exists bool) {
-	v := m.addpitch
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *AudioRenderMutation) AddedDurationMs() (r int64, exists bool) {
+	v := m.addduration_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPitch resets all changes to the "pitch" field.
-func (m *HistoryMutation) ResetPitch() {
-	m.pitch = nil
-	m.addpitch = nil
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *AudioRenderMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
 }
 
-// SetVolume sets the "volume" field.
-func (m *HistoryMutation) SetVolume(f float64) {
-	m.volume = &f
-	m.addvolume = nil
+// SetWords sets the "words" field.
+func (m *AudioRenderMutation) SetWords(st []synth.WordTiming) {
+	m.words = &st
+	m.appendwords = nil
 }
 
-// Volume returns the value of the "volume" field in the mutation.
-func (m *HistoryMutation) Volume() (r float64, exists bool) {
-	v := m.volume
+// Words returns the value of the "words" field in the mutation.
+func (m *AudioRenderMutation) Words() (r []synth.WordTiming, exists bool) {
+	v := m.words
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVolume returns the old "volume" field's value of the History entity.
-// If the History object wasn't provided to the builder, the object is fetched from the database.
+// OldWords returns the old "words" field's value of the AudioRender entity.
+// If the AudioRender object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoryMutation) OldVolume(ctx context.Context) (v float64, err error) {
+func (m *AudioRenderMutation) OldWords(ctx context.Context) (v []synth.WordTiming, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVolume is only allowed on UpdateOne operations")
+		return v, errors.New("OldWords is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVolume requires an ID field in the mutation")
+		return v, errors.New("OldWords requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVolume: %w", err)
+		return v, fmt.Errorf("querying old value for OldWords: %w", err)
 	}
-	return oldValue.Volume, nil
+	return oldValue.Words, nil
 }
 
-// AddVolume adds f to the "volume" field.
-func (m *HistoryMutation) AddVolume(f float64) {
-	if m.addvolume != nil {
-		*m.addvolume += f
-	} else {
-		m.addvolume = &f
-	}
+// AppendWords adds st to the "words" field.
+func (m *AudioRenderMutation) AppendWords(st []synth.WordTiming) {
+	m.appendwords = append(m.appendwords, st...)
 }
 
-// AddedVolume returns the value that was added to the "volume" field in this mutation.
-func (m *HistoryMutation) AddedVolume() (r float64, exists bool) {
-	v := m.addvolume
-	if v == nil {
-		return
+// AppendedWords returns the list of values that were appended to the "words" field in this mutation.
+func (m *AudioRenderMutation) AppendedWords() ([]synth.WordTiming, bool) {
+	if len(m.appendwords) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendwords, true
 }
 
-// ResetVolume resets all changes to the "volume" field.
-func (m *HistoryMutation) ResetVolume() {
-	m.volume = nil
-	m.addvolume = nil
+// ClearWords clears the value of the "words" field.
+func (m *AudioRenderMutation) ClearWords() {
+	m.words = nil
+	m.appendwords = nil
+	m.clearedFields[audiorender.FieldWords] = struct{}{}
+}
+
+// WordsCleared returns if the "words" field was cleared in this mutation.
+func (m *AudioRenderMutation) WordsCleared() bool {
+	_, ok := m.clearedFields[audiorender.FieldWords]
+	return ok
+}
+
+// ResetWords resets all changes to the "words" field.
+func (m *AudioRenderMutation) ResetWords() {
+	m.words = nil
+	m.appendwords = nil
+	delete(m.clearedFields, audiorender.FieldWords)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *HistoryMutation) SetCreatedAt(t time.Time) {
+func (m *AudioRenderMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *HistoryMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *AudioRenderMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -412,10 +489,10 @@ func (m *HistoryMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the History entity.
-// If the History object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AudioRender entity.
+// If the AudioRender object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AudioRenderMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -430,17 +507,17 @@ func (m *HistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *HistoryMutation) ResetCreatedAt() {
+func (m *AudioRenderMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *HistoryMutation) SetUpdatedAt(t time.Time) {
+func (m *AudioRenderMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *HistoryMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *AudioRenderMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -448,10 +525,10 @@ func (m *HistoryMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the History entity.
-// If the History object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the AudioRender entity.
+// If the AudioRender object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AudioRenderMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -466,58 +543,19 @@ func (m *HistoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *HistoryMutation) ResetUpdatedAt() {
+func (m *AudioRenderMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *HistoryMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *HistoryMutation) ClearUser() {
-	m.cleareduser = true
-}
-
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *HistoryMutation) UserCleared() bool {
-	return m.cleareduser
-}
-
-// UserID returns the "user" edge ID in the mutation.
-func (m *HistoryMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
-	}
-	return
-}
-
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *HistoryMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *HistoryMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-}
-
-// Where appends a list predicates to the HistoryMutation builder.
-func (m *HistoryMutation) Where(ps ...predicate.History) {
+// Where appends a list predicates to the AudioRenderMutation builder.
+func (m *AudioRenderMutation) Where(ps ...predicate.AudioRender) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the HistoryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AudioRenderMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *HistoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.History, len(ps))
+func (m *AudioRenderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AudioRender, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -525,45 +563,48 @@ func (m *HistoryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *HistoryMutation) Op() Op {
+func (m *AudioRenderMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *HistoryMutation) SetOp(op Op) {
+func (m *AudioRenderMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (History).
-func (m *HistoryMutation) Type() string {
+// Type returns the node type of this mutation (AudioRender).
+func (m *AudioRenderMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *HistoryMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.text != nil {
-		fields = append(fields, history.FieldText)
+func (m *AudioRenderMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.cache_key != nil {
+		fields = append(fields, audiorender.FieldCacheKey)
 	}
-	if m.voice != nil {
-		fields = append(fields, history.FieldVoice)
+	if m.blob_key != nil {
+		fields = append(fields, audiorender.FieldBlobKey)
 	}
-	if m.rate != nil {
-		fields = append(fields, history.FieldRate)
+	if m.content_type != nil {
+		fields = append(fields, audiorender.FieldContentType)
 	}
-	if m.pitch != nil {
-		fields = append(fields, history.FieldPitch)
+	if m.size != nil {
+		fields = append(fields, audiorender.FieldSize)
 	}
-	if m.volume != nil {
-		fields = append(fields, history.FieldVolume)
+	if m.duration_ms != nil {
+		fields = append(fields, audiorender.FieldDurationMs)
+	}
+	if m.words != nil {
+		fields = append(fields, audiorender.FieldWords)
 	}
 	if m.created_at != nil {
-		fields = append(fields, history.FieldCreatedAt)
+		fields = append(fields, audiorender.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, history.FieldUpdatedAt)
+		fields = append(fields, audiorender.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -571,21 +612,23 @@ func (m *HistoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *HistoryMutation) Field(name string) (ent.Value, bool) {
+func (m *AudioRenderMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case history.FieldText:
-		return m.Text()
-	case history.FieldVoice:
-		return m.Voice()
-	case history.FieldRate:
-		return m.Rate()
-	case history.FieldPitch:
-		return m.Pitch()
-	case history.FieldVolume:
-		return m.Volume()
-	case history.FieldCreatedAt:
+	case audiorender.FieldCacheKey:
+		return m.CacheKey()
+	case audiorender.FieldBlobKey:
+		return m.BlobKey()
+	case audiorender.FieldContentType:
+		return m.ContentType()
+	case audiorender.FieldSize:
+		return m.Size()
+	case audiorender.FieldDurationMs:
+		return m.DurationMs()
+	case audiorender.FieldWords:
+		return m.Words()
+	case audiorender.FieldCreatedAt:
 		return m.CreatedAt()
-	case history.FieldUpdatedAt:
+	case audiorender.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -594,74 +637,83 @@ func (m *HistoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *HistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AudioRenderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case history.FieldText:
-		return m.OldText(ctx)
-	case history.FieldVoice:
-		return m.OldVoice(ctx)
-	case history.FieldRate:
-		return m.OldRate(ctx)
-	case history.FieldPitch:
-		return m.OldPitch(ctx)
-	case history.FieldVolume:
-		return m.OldVolume(ctx)
-	case history.FieldCreatedAt:
+	case audiorender.FieldCacheKey:
+		return m.OldCacheKey(ctx)
+	case audiorender.FieldBlobKey:
+		return m.OldBlobKey(ctx)
+	case audiorender.FieldContentType:
+		return m.OldContentType(ctx)
+	case audiorender.FieldSize:
+		return m.OldSize(ctx)
+	case audiorender.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case audiorender.FieldWords:
+		return m.OldWords(ctx)
+	case audiorender.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case history.FieldUpdatedAt:
+	case audiorender.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown History field %s", name)
+	return nil, fmt.Errorf("unknown AudioRender field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HistoryMutation) SetField(name string, value ent.Value) error {
+func (m *AudioRenderMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case history.FieldText:
+	case audiorender.FieldCacheKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetText(v)
+		m.SetCacheKey(v)
 		return nil
-	case history.FieldVoice:
+	case audiorender.FieldBlobKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVoice(v)
+		m.SetBlobKey(v)
 		return nil
-	case history.FieldRate:
-		v, ok := value.(float64)
+	case audiorender.FieldContentType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRate(v)
+		m.SetContentType(v)
 		return nil
-	case history.FieldPitch:
-		v, ok := value.(float64)
+	case audiorender.FieldSize:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPitch(v)
+		m.SetSize(v)
 		return nil
-	case history.FieldVolume:
-		v, ok := value.(float64)
+	case audiorender.FieldDurationMs:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVolume(v)
+		m.SetDurationMs(v)
 		return nil
-	case history.FieldCreatedAt:
+	case audiorender.FieldWords:
+		v, ok := value.([]synth.WordTiming)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWords(v)
+		return nil
+	case audiorender.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case history.FieldUpdatedAt:
+	case audiorender.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -669,21 +721,18 @@ func (m *HistoryMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown History field %s", name)
+	return fmt.Errorf("unknown AudioRender field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *HistoryMutation) AddedFields() []string {
+func (m *AudioRenderMutation) AddedFields() []string {
 	var fields []string
-	if m.addrate != nil {
-		fields = append(fields, history.FieldRate)
-	}
-	if m.addpitch != nil {
-		fields = append(fields, history.FieldPitch)
+	if m.addsize != nil {
+		fields = append(fields, audiorender.FieldSize)
 	}
-	if m.addvolume != nil {
-		fields = append(fields, history.FieldVolume)
+	if m.addduration_ms != nil {
+		fields = append(fields, audiorender.FieldDurationMs)
 	}
 	return fields
 }
@@ -691,14 +740,12 @@ func (m *HistoryMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *HistoryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AudioRenderMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case history.FieldRate:
-		return m.AddedRate()
-	case history.FieldPitch:
-		return m.AddedPitch()
-	case history.FieldVolume:
-		return m.AddedVolume()
+	case audiorender.FieldSize:
+		return m.AddedSize()
+	case audiorender.FieldDurationMs:
+		return m.AddedDurationMs()
 	}
 	return nil, false
 }
@@ -706,186 +753,170 @@ func (m *HistoryMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HistoryMutation) AddField(name string, value ent.Value) error {
+func (m *AudioRenderMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case history.FieldRate:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRate(v)
-		return nil
-	case history.FieldPitch:
-		v, ok := value.(float64)
+	case audiorender.FieldSize:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPitch(v)
+		m.AddSize(v)
 		return nil
-	case history.FieldVolume:
-		v, ok := value.(float64)
+	case audiorender.FieldDurationMs:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddVolume(v)
+		m.AddDurationMs(v)
 		return nil
 	}
-	return fmt.Errorf("unknown History numeric field %s", name)
+	return fmt.Errorf("unknown AudioRender numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *HistoryMutation) ClearedFields() []string {
-	return nil
+func (m *AudioRenderMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(audiorender.FieldWords) {
+		fields = append(fields, audiorender.FieldWords)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *HistoryMutation) FieldCleared(name string) bool {
+func (m *AudioRenderMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *HistoryMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown History nullable field %s", name)
+func (m *AudioRenderMutation) ClearField(name string) error {
+	switch name {
+	case audiorender.FieldWords:
+		m.ClearWords()
+		return nil
+	}
+	return fmt.Errorf("unknown AudioRender nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *HistoryMutation) ResetField(name string) error {
+func (m *AudioRenderMutation) ResetField(name string) error {
 	switch name {
-	case history.FieldText:
-		m.ResetText()
+	case audiorender.FieldCacheKey:
+		m.ResetCacheKey()
 		return nil
-	case history.FieldVoice:
-		m.ResetVoice()
+	case audiorender.FieldBlobKey:
+		m.ResetBlobKey()
 		return nil
-	case history.FieldRate:
-		m.ResetRate()
+	case audiorender.FieldContentType:
+		m.ResetContentType()
 		return nil
-	case history.FieldPitch:
-		m.ResetPitch()
+	case audiorender.FieldSize:
+		m.ResetSize()
 		return nil
-	case history.FieldVolume:
-		m.ResetVolume()
+	case audiorender.FieldDurationMs:
+		m.ResetDurationMs()
 		return nil
-	case history.FieldCreatedAt:
+	case audiorender.FieldWords:
+		m.ResetWords()
+		return nil
+	case audiorender.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case history.FieldUpdatedAt:
+	case audiorender.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown History field %s", name)
+	return fmt.Errorf("unknown AudioRender field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *HistoryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, history.EdgeUser)
-	}
+func (m *AudioRenderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *HistoryMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case history.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *AudioRenderMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *HistoryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *AudioRenderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *HistoryMutation) RemovedIDs(name string) []ent.Value {
+func (m *AudioRenderMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *HistoryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, history.EdgeUser)
-	}
+func (m *AudioRenderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *HistoryMutation) EdgeCleared(name string) bool {
-	switch name {
-	case history.EdgeUser:
-		return m.cleareduser
-	}
+func (m *AudioRenderMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *HistoryMutation) ClearEdge(name string) error {
-	switch name {
-	case history.EdgeUser:
-		m.ClearUser()
-		return nil
-	}
-	return fmt.Errorf("unknown History unique edge %s", name)
+func (m *AudioRenderMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AudioRender unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *HistoryMutation) ResetEdge(name string) error {
-	switch name {
-	case history.EdgeUser:
-		m.ResetUser()
-		return nil
-	}
-	return fmt.Errorf("unknown History edge %s", name)
+func (m *AudioRenderMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AudioRender edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// FolderMutation represents an operation that mutates the Folder nodes in the graph.
+type FolderMutation struct {
 	config
 	op               Op
 	typ              string
 	id               *uuid.UUID
 	name             *string
-	email            *string
-	password         *string
 	created_at       *time.Time
 	updated_at       *time.Time
 	clearedFields    map[string]struct{}
+	user             *uuid.UUID
+	cleareduser      bool
+	parent           *uuid.UUID
+	clearedparent    bool
+	children         map[uuid.UUID]struct{}
+	removedchildren  map[uuid.UUID]struct{}
+	clearedchildren  bool
 	histories        map[uuid.UUID]struct{}
 	removedhistories map[uuid.UUID]struct{}
 	clearedhistories bool
 	done             bool
-	oldValue         func(context.Context) (*User, error)
-	predicates       []predicate.User
+	oldValue         func(context.Context) (*Folder, error)
+	predicates       []predicate.Folder
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*FolderMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// folderOption allows management of the mutation configuration using functional options.
+type folderOption func(*FolderMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newFolderMutation creates new mutation for the Folder entity.
+func newFolderMutation(c config, op Op, opts ...folderOption) *FolderMutation {
+	m := &FolderMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeFolder,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -894,20 +925,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
+// withFolderID sets the ID field of the mutation.
+func withFolderID(id uuid.UUID) folderOption {
+	return func(m *FolderMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *Folder
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*Folder, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().Folder.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -916,10 +947,10 @@ func withUserID(id uuid.UUID) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withFolder sets the old Folder of the mutation.
+func withFolder(node *Folder) folderOption {
+	return func(m *FolderMutation) {
+		m.oldValue = func(context.Context) (*Folder, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -928,7 +959,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m FolderMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -936,7 +967,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m FolderMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -946,14 +977,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Folder entities.
+func (m *FolderMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+func (m *FolderMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -964,7 +995,7 @@ func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *FolderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -973,19 +1004,19 @@ func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Folder.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *UserMutation) SetName(s string) {
+func (m *FolderMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *UserMutation) Name() (r string, exists bool) {
+func (m *FolderMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -993,10 +1024,10 @@ func (m *UserMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *FolderMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -1011,89 +1042,66 @@ func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *UserMutation) ResetName() {
+func (m *FolderMutation) ResetName() {
 	m.name = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetParentID sets the "parent_id" field.
+func (m *FolderMutation) SetParentID(u uuid.UUID) {
+	m.parent = &u
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *FolderMutation) ParentID() (r uuid.UUID, exists bool) {
+	v := m.parent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldParentID returns the old "parent_id" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *FolderMutation) OldParentID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldParentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
 	}
-	return oldValue.Email, nil
-}
-
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+	return oldValue.ParentID, nil
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
-}
-
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearParentID clears the value of the "parent_id" field.
+func (m *FolderMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[folder.FieldParentID] = struct{}{}
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
-	}
-	return oldValue.Password, nil
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *FolderMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[folder.FieldParentID]
+	return ok
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *FolderMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, folder.FieldParentID)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
+func (m *FolderMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *FolderMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1101,10 +1109,10 @@ func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FolderMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1119,17 +1127,17 @@ func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
+func (m *FolderMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
+func (m *FolderMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *FolderMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1137,10 +1145,10 @@ func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FolderMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1155,32 +1163,152 @@ func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
+func (m *FolderMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// AddHistoryIDs adds the "histories" edge to the History entity by ids.
-func (m *UserMutation) AddHistoryIDs(ids ...uuid.UUID) {
-	if m.histories == nil {
-		m.histories = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.histories[ids[i]] = struct{}{}
-	}
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *FolderMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
 }
 
-// ClearHistories clears the "histories" edge to the History entity.
-func (m *UserMutation) ClearHistories() {
-	m.clearedhistories = true
+// ClearUser clears the "user" edge to the User entity.
+func (m *FolderMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *FolderMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *FolderMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *FolderMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *FolderMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearParent clears the "parent" edge to the Folder entity.
+func (m *FolderMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[folder.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Folder entity was cleared.
+func (m *FolderMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *FolderMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *FolderMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Folder entity by ids.
+func (m *FolderMutation) AddChildIDs(ids ...uuid.UUID) {
+	if m.children == nil {
+		m.children = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Folder entity.
+func (m *FolderMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Folder entity was cleared.
+func (m *FolderMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Folder entity by IDs.
+func (m *FolderMutation) RemoveChildIDs(ids ...uuid.UUID) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Folder entity.
+func (m *FolderMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *FolderMutation) ChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *FolderMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddHistoryIDs adds the "histories" edge to the History entity by ids.
+func (m *FolderMutation) AddHistoryIDs(ids ...uuid.UUID) {
+	if m.histories == nil {
+		m.histories = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.histories[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHistories clears the "histories" edge to the History entity.
+func (m *FolderMutation) ClearHistories() {
+	m.clearedhistories = true
 }
 
 // HistoriesCleared reports if the "histories" edge to the History entity was cleared.
-func (m *UserMutation) HistoriesCleared() bool {
+func (m *FolderMutation) HistoriesCleared() bool {
 	return m.clearedhistories
 }
 
 // RemoveHistoryIDs removes the "histories" edge to the History entity by IDs.
-func (m *UserMutation) RemoveHistoryIDs(ids ...uuid.UUID) {
+func (m *FolderMutation) RemoveHistoryIDs(ids ...uuid.UUID) {
 	if m.removedhistories == nil {
 		m.removedhistories = make(map[uuid.UUID]struct{})
 	}
@@ -1191,7 +1319,7 @@ func (m *UserMutation) RemoveHistoryIDs(ids ...uuid.UUID) {
 }
 
 // RemovedHistories returns the removed IDs of the "histories" edge to the History entity.
-func (m *UserMutation) RemovedHistoriesIDs() (ids []uuid.UUID) {
+func (m *FolderMutation) RemovedHistoriesIDs() (ids []uuid.UUID) {
 	for id := range m.removedhistories {
 		ids = append(ids, id)
 	}
@@ -1199,7 +1327,7 @@ func (m *UserMutation) RemovedHistoriesIDs() (ids []uuid.UUID) {
 }
 
 // HistoriesIDs returns the "histories" edge IDs in the mutation.
-func (m *UserMutation) HistoriesIDs() (ids []uuid.UUID) {
+func (m *FolderMutation) HistoriesIDs() (ids []uuid.UUID) {
 	for id := range m.histories {
 		ids = append(ids, id)
 	}
@@ -1207,21 +1335,21 @@ func (m *UserMutation) HistoriesIDs() (ids []uuid.UUID) {
 }
 
 // ResetHistories resets all changes to the "histories" edge.
-func (m *UserMutation) ResetHistories() {
+func (m *FolderMutation) ResetHistories() {
 	m.histories = nil
 	m.clearedhistories = false
 	m.removedhistories = nil
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the FolderMutation builder.
+func (m *FolderMutation) Where(ps ...predicate.Folder) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the FolderMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *FolderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Folder, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1229,39 +1357,36 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *FolderMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *FolderMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (Folder).
+func (m *FolderMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *FolderMutation) Fields() []string {
+	fields := make([]string, 0, 4)
 	if m.name != nil {
-		fields = append(fields, user.FieldName)
-	}
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+		fields = append(fields, folder.FieldName)
 	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
+	if m.parent != nil {
+		fields = append(fields, folder.FieldParentID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+		fields = append(fields, folder.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+		fields = append(fields, folder.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -1269,17 +1394,15 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *FolderMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldName:
+	case folder.FieldName:
 		return m.Name()
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldCreatedAt:
+	case folder.FieldParentID:
+		return m.ParentID()
+	case folder.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldUpdatedAt:
+	case folder.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -1288,56 +1411,47 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *FolderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldName:
+	case folder.FieldName:
 		return m.OldName(ctx)
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldCreatedAt:
+	case folder.FieldParentID:
+		return m.OldParentID(ctx)
+	case folder.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
+	case folder.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown Folder field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *FolderMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldName:
+	case folder.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case user.FieldEmail:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmail(v)
-		return nil
-	case user.FieldPassword:
-		v, ok := value.(string)
+	case folder.FieldParentID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
+		m.SetParentID(v)
 		return nil
-	case user.FieldCreatedAt:
+	case folder.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case user.FieldUpdatedAt:
+	case folder.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1345,87 +1459,116 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown Folder field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *FolderMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *FolderMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *FolderMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown Folder numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
-	return nil
+func (m *FolderMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(folder.FieldParentID) {
+		fields = append(fields, folder.FieldParentID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *FolderMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *FolderMutation) ClearField(name string) error {
+	switch name {
+	case folder.FieldParentID:
+		m.ClearParentID()
+		return nil
+	}
+	return fmt.Errorf("unknown Folder nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *FolderMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldName:
+	case folder.FieldName:
 		m.ResetName()
 		return nil
-	case user.FieldEmail:
-		m.ResetEmail()
-		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
+	case folder.FieldParentID:
+		m.ResetParentID()
 		return nil
-	case user.FieldCreatedAt:
+	case folder.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case user.FieldUpdatedAt:
+	case folder.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown Folder field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *FolderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.user != nil {
+		edges = append(edges, folder.EdgeUser)
+	}
+	if m.parent != nil {
+		edges = append(edges, folder.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, folder.EdgeChildren)
+	}
 	if m.histories != nil {
-		edges = append(edges, user.EdgeHistories)
+		edges = append(edges, folder.EdgeHistories)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *FolderMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeHistories:
+	case folder.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case folder.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case folder.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case folder.EdgeHistories:
 		ids := make([]ent.Value, 0, len(m.histories))
 		for id := range m.histories {
 			ids = append(ids, id)
@@ -1436,19 +1579,28 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *FolderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedchildren != nil {
+		edges = append(edges, folder.EdgeChildren)
+	}
 	if m.removedhistories != nil {
-		edges = append(edges, user.EdgeHistories)
+		edges = append(edges, folder.EdgeHistories)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+func (m *FolderMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeHistories:
+	case folder.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case folder.EdgeHistories:
 		ids := make([]ent.Value, 0, len(m.removedhistories))
 		for id := range m.removedhistories {
 			ids = append(ids, id)
@@ -1459,19 +1611,34 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *FolderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.cleareduser {
+		edges = append(edges, folder.EdgeUser)
+	}
+	if m.clearedparent {
+		edges = append(edges, folder.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, folder.EdgeChildren)
+	}
 	if m.clearedhistories {
-		edges = append(edges, user.EdgeHistories)
+		edges = append(edges, folder.EdgeHistories)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *FolderMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeHistories:
+	case folder.EdgeUser:
+		return m.cleareduser
+	case folder.EdgeParent:
+		return m.clearedparent
+	case folder.EdgeChildren:
+		return m.clearedchildren
+	case folder.EdgeHistories:
 		return m.clearedhistories
 	}
 	return false
@@ -1479,19 +1646,14285 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *FolderMutation) ClearEdge(name string) error {
 	switch name {
+	case folder.EdgeUser:
+		m.ClearUser()
+		return nil
+	case folder.EdgeParent:
+		m.ClearParent()
+		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown Folder unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *FolderMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeHistories:
+	case folder.EdgeUser:
+		m.ResetUser()
+		return nil
+	case folder.EdgeParent:
+		m.ResetParent()
+		return nil
+	case folder.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case folder.EdgeHistories:
 		m.ResetHistories()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown Folder edge %s", name)
+}
+
+// HistoryMutation represents an operation that mutates the History nodes in the graph.
+type HistoryMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	deleted_at             *time.Time
+	text                   *string
+	format                 *history.Format
+	plain_text             *string
+	char_count             *int
+	addchar_count          *int
+	language               *string
+	language_confidence    *float64
+	addlanguage_confidence *float64
+	voice                  *string
+	rate                   *float64
+	addrate                *float64
+	pitch                  *float64
+	addpitch               *float64
+	volume                 *float64
+	addvolume              *float64
+	is_favorite            *bool
+	pinned_order           *int
+	addpinned_order        *int
+	play_count             *int
+	addplay_count          *int
+	last_played_at         *time.Time
+	content_hash           *string
+	created_at             *time.Time
+	updated_at             *time.Time
+	clearedFields          map[string]struct{}
+	user                   *uuid.UUID
+	cleareduser            bool
+	revisions              map[uuid.UUID]struct{}
+	removedrevisions       map[uuid.UUID]struct{}
+	clearedrevisions       bool
+	tags                   map[uuid.UUID]struct{}
+	removedtags            map[uuid.UUID]struct{}
+	clearedtags            bool
+	folder                 *uuid.UUID
+	clearedfolder          bool
+	done                   bool
+	oldValue               func(context.Context) (*History, error)
+	predicates             []predicate.History
+}
+
+var _ ent.Mutation = (*HistoryMutation)(nil)
+
+// historyOption allows management of the mutation configuration using functional options.
+type historyOption func(*HistoryMutation)
+
+// newHistoryMutation creates new mutation for the History entity.
+func newHistoryMutation(c config, op Op, opts ...historyOption) *HistoryMutation {
+	m := &HistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHistoryID sets the ID field of the mutation.
+func withHistoryID(id uuid.UUID) historyOption {
+	return func(m *HistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *History
+		)
+		m.oldValue = func(ctx context.Context) (*History, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().History.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHistory sets the old History of the mutation.
+func withHistory(node *History) historyOption {
+	return func(m *HistoryMutation) {
+		m.oldValue = func(context.Context) (*History, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of History entities.
+func (m *HistoryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HistoryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HistoryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().History.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *HistoryMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *HistoryMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the History entity.
+// If the History object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *HistoryMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[history.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *HistoryMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[history.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *HistoryMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, history.FieldDeletedAt)
+}
+
+// SetText sets the "text" field.
+func (m *HistoryMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *HistoryMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the History entity.
+// If the History object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *HistoryMutation) ResetText() {
+	m.text = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *HistoryMutation) SetFormat(h history.Format) {
+	m.format = &h
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *HistoryMutation) Format() (r history.Format, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the History entity.
+// If the History object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryMutation) OldFormat(ctx context.Context) (v history.Format, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *HistoryMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetPlainText sets the "plain_text" field.
+func (m *HistoryMutation) SetPlainText(s string) {
+	m.plain_text = &s
+}
+
+// PlainText returns the value of the "plain_text" field in the mutation.
+func (m *HistoryMutation) PlainText() (r string, exists bool) {
+	v := m.plain_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlainText returns the old "plain_text" field's value of the History entity.
+// If the History object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryMutation) OldPlainText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlainText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlainText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlainText: %w", err)
+	}
+	return oldValue.PlainText, nil
+}
+
+// ClearPlainText clears the value of the "plain_text" field.
+func (m *HistoryMutation) ClearPlainText() {
+	m.plain_text = nil
+	m.clearedFields[history.FieldPlainText] = struct{}{}
+}
+
+// PlainTextCleared returns if the "plain_text" field was cleared in this mutation.
+func (m *HistoryMutation) PlainTextCleared() bool {
+	_, ok := m.clearedFields[history.FieldPlainText]
+	return ok
+}
+
+// ResetPlainText resets all changes to the "plain_text" field.
+func (m *HistoryMutation) ResetPlainText() {
+	m.plain_text = nil
+	delete(m.clearedFields, history.FieldPlainText)
+}
+
+// SetCharCount sets the "char_count" field.
+func (m *HistoryMutation) SetCharCount(i int) {
+	m.char_count = &i
+	m.addchar_count = nil
+}
+
+// CharCount returns the value of the "char_count" field in the mutation.
+func (m *HistoryMutation) CharCount() (r int, exists bool) {
+	v := m.char_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCharCount returns the old "char_count" field's value of the History entity.
+// If the History object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryMutation) OldCharCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCharCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCharCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCharCount: %w", err)
+	}
+	return oldValue.CharCount, nil
+}
+
+// AddCharCount adds i to the "char_count" field.
+func (m *HistoryMutation) AddCharCount(i int) {
+	if m.addchar_count != nil {
+		*m.addchar_count += i
+	} else {
+		m.addchar_count = &i
+	}
+}
+
+// AddedCharCount returns the value that was added to the "char_count" field in this mutation.
+func (m *HistoryMutation) AddedCharCount() (r int, exists bool) {
+	v := m.addchar_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCharCount resets all changes to the "char_count" field.
+func (m *HistoryMutation) ResetCharCount() {
+	m.char_count = nil
+	m.addchar_count = nil
+}
+
+// SetLanguage sets the "language" field.
+func (m *HistoryMutation) SetLanguage(s string) {
+	m.language = &s
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *HistoryMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the History entity.
+// If the History object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryMutation) OldLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (m *HistoryMutation) ClearLanguage() {
+	m.language = nil
+	m.clearedFields[history.FieldLanguage] = struct{}{}
+}
+
+// LanguageCleared returns if the "language" field was cleared in this mutation.
+func (m *HistoryMutation) LanguageCleared() bool {
+	_, ok := m.clearedFields[history.FieldLanguage]
+	return ok
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *HistoryMutation) ResetLanguage() {
+	m.language = nil
+	delete(m.clearedFields, history.FieldLanguage)
+}
+
+// SetLanguageConfidence sets the "language_confidence" field.
+func (m *HistoryMutation) SetLanguageConfidence(f float64) {
+	m.language_confidence = &f
+	m.addlanguage_confidence = nil
+}
+
+// LanguageConfidence returns the value of the "language_confidence" field in the mutation.
+func (m *HistoryMutation) LanguageConfidence() (r float64, exists bool) {
+	v := m.language_confidence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguageConfidence returns the old "language_confidence" field's value of the History entity.
+// If the History object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryMutation) OldLanguageConfidence(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguageConfidence is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguageConfidence requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguageConfidence: %w", err)
+	}
+	return oldValue.LanguageConfidence, nil
+}
+
+// AddLanguageConfidence adds f to the "language_confidence" field.
+func (m *HistoryMutation) AddLanguageConfidence(f float64) {
+	if m.addlanguage_confidence != nil {
+		*m.addlanguage_confidence += f
+	} else {
+		m.addlanguage_confidence = &f
+	}
+}
+
+// AddedLanguageConfidence returns the value that was added to the "language_confidence" field in this mutation.
+func (m *HistoryMutation) AddedLanguageConfidence() (r float64, exists bool) {
+	v := m.addlanguage_confidence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLanguageConfidence clears the value of the "language_confidence" field.
+func (m *HistoryMutation) ClearLanguageConfidence() {
+	m.language_confidence = nil
+	m.addlanguage_confidence = nil
+	m.clearedFields[history.FieldLanguageConfidence] = struct{}{}
+}
+
+// LanguageConfidenceCleared returns if the "language_confidence" field was cleared in this mutation.
+func (m *HistoryMutation) LanguageConfidenceCleared() bool {
+	_, ok := m.clearedFields[history.FieldLanguageConfidence]
+	return ok
+}
+
+// ResetLanguageConfidence resets all changes to the "language_confidence" field.
+func (m *HistoryMutation) ResetLanguageConfidence() {
+	m.language_confidence = nil
+	m.addlanguage_confidence = nil
+	delete(m.clearedFields, history.FieldLanguageConfidence)
+}
+
+// SetVoice sets the "voice" field.
+func (m *HistoryMutation) SetVoice(s string) {
+	m.voice = &s
+}
+
+// Voice returns the value of the "voice" field in the mutation.
+func (m *HistoryMutation) Voice() (r string, exists bool) {
+	v := m.voice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVoice returns the old "voice" field's value of the History entity.
+// If the History object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryMutation) OldVoice(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVoice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVoice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVoice: %w", err)
+	}
+	return oldValue.Voice, nil
+}
+
+// ResetVoice resets all changes to the "voice" field.
+func (m *HistoryMutation) ResetVoice() {
+	m.voice = nil
+}
+
+// SetRate sets the "rate" field.
+func (m *HistoryMutation) SetRate(f float64) {
+	m.rate = &f
+	m.addrate = nil
+}
+
+// Rate returns the value of the "rate" field in the mutation.
+func (m *HistoryMutation) Rate() (r float64, exists bool) {
+	v := m.rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRate returns the old "rate" field's value of the History entity.
+// If the History object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryMutation) OldRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRate: %w", err)
+	}
+	return oldValue.Rate, nil
+}
+
+// AddRate adds f to the "rate" field.
+func (m *HistoryMutation) AddRate(f float64) {
+	if m.addrate != nil {
+		*m.addrate += f
+	} else {
+		m.addrate = &f
+	}
+}
+
+// AddedRate returns the value that was added to the "rate" field in this mutation.
+func (m *HistoryMutation) AddedRate() (r float64, exists bool) {
+	v := m.addrate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRate resets all changes to the "rate" field.
+func (m *HistoryMutation) ResetRate() {
+	m.rate = nil
+	m.addrate = nil
+}
+
+// SetPitch sets the "pitch" field.
+func (m *HistoryMutation) SetPitch(f float64) {
+	m.pitch = &f
+	m.addpitch = nil
+}
+
+// Pitch returns the value of the "pitch" field in the mutation.
+func (m *HistoryMutation) Pitch() (r float64, exists bool) {
+	v := m.pitch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPitch returns the old "pitch" field's value of the History entity.
+// If the History object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryMutation) OldPitch(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPitch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPitch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPitch: %w", err)
+	}
+	return oldValue.Pitch, nil
+}
+
+// AddPitch adds f to the "pitch" field.
+func (m *HistoryMutation) AddPitch(f float64) {
+	if m.addpitch != nil {
+		*m.addpitch += f
+	} else {
+		m.addpitch = &f
+	}
+}
+
+// AddedPitch returns the value that was added to the "pitch" field in this mutation.
+func (m *HistoryMutation) AddedPitch() (r float64, exists bool) {
+	v := m.addpitch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPitch resets all changes to the "pitch" field.
+func (m *HistoryMutation) ResetPitch() {
+	m.pitch = nil
+	m.addpitch = nil
+}
+
+// SetVolume sets the "volume" field.
+func (m *HistoryMutation) SetVolume(f float64) {
+	m.volume = &f
+	m.addvolume = nil
+}
+
+// Volume returns the value of the "volume" field in the mutation.
+func (m *HistoryMutation) Volume() (r float64, exists bool) {
+	v := m.volume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVolume returns the old "volume" field's value of the History entity.
+// If the History object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryMutation) OldVolume(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVolume is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVolume requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVolume: %w", err)
+	}
+	return oldValue.Volume, nil
+}
+
+// AddVolume adds f to the "volume" field.
+func (m *HistoryMutation) AddVolume(f float64) {
+	if m.addvolume != nil {
+		*m.addvolume += f
+	} else {
+		m.addvolume = &f
+	}
+}
+
+// AddedVolume returns the value that was added to the "volume" field in this mutation.
+func (m *HistoryMutation) AddedVolume() (r float64, exists bool) {
+	v := m.addvolume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVolume resets all changes to the "volume" field.
+func (m *HistoryMutation) ResetVolume() {
+	m.volume = nil
+	m.addvolume = nil
+}
+
+// SetIsFavorite sets the "is_favorite" field.
+func (m *HistoryMutation) SetIsFavorite(b bool) {
+	m.is_favorite = &b
+}
+
+// IsFavorite returns the value of the "is_favorite" field in the mutation.
+func (m *HistoryMutation) IsFavorite() (r bool, exists bool) {
+	v := m.is_favorite
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsFavorite returns the old "is_favorite" field's value of the History entity.
+// If the History object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryMutation) OldIsFavorite(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsFavorite is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsFavorite requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsFavorite: %w", err)
+	}
+	return oldValue.IsFavorite, nil
+}
+
+// ResetIsFavorite resets all changes to the "is_favorite" field.
+func (m *HistoryMutation) ResetIsFavorite() {
+	m.is_favorite = nil
+}
+
+// SetPinnedOrder sets the "pinned_order" field.
+func (m *HistoryMutation) SetPinnedOrder(i int) {
+	m.pinned_order = &i
+	m.addpinned_order = nil
+}
+
+// PinnedOrder returns the value of the "pinned_order" field in the mutation.
+func (m *HistoryMutation) PinnedOrder() (r int, exists bool) {
+	v := m.pinned_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPinnedOrder returns the old "pinned_order" field's value of the History entity.
+// If the History object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryMutation) OldPinnedOrder(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPinnedOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPinnedOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPinnedOrder: %w", err)
+	}
+	return oldValue.PinnedOrder, nil
+}
+
+// AddPinnedOrder adds i to the "pinned_order" field.
+func (m *HistoryMutation) AddPinnedOrder(i int) {
+	if m.addpinned_order != nil {
+		*m.addpinned_order += i
+	} else {
+		m.addpinned_order = &i
+	}
+}
+
+// AddedPinnedOrder returns the value that was added to the "pinned_order" field in this mutation.
+func (m *HistoryMutation) AddedPinnedOrder() (r int, exists bool) {
+	v := m.addpinned_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPinnedOrder clears the value of the "pinned_order" field.
+func (m *HistoryMutation) ClearPinnedOrder() {
+	m.pinned_order = nil
+	m.addpinned_order = nil
+	m.clearedFields[history.FieldPinnedOrder] = struct{}{}
+}
+
+// PinnedOrderCleared returns if the "pinned_order" field was cleared in this mutation.
+func (m *HistoryMutation) PinnedOrderCleared() bool {
+	_, ok := m.clearedFields[history.FieldPinnedOrder]
+	return ok
+}
+
+// ResetPinnedOrder resets all changes to the "pinned_order" field.
+func (m *HistoryMutation) ResetPinnedOrder() {
+	m.pinned_order = nil
+	m.addpinned_order = nil
+	delete(m.clearedFields, history.FieldPinnedOrder)
+}
+
+// SetPlayCount sets the "play_count" field.
+func (m *HistoryMutation) SetPlayCount(i int) {
+	m.play_count = &i
+	m.addplay_count = nil
+}
+
+// PlayCount returns the value of the "play_count" field in the mutation.
+func (m *HistoryMutation) PlayCount() (r int, exists bool) {
+	v := m.play_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlayCount returns the old "play_count" field's value of the History entity.
+// If the History object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryMutation) OldPlayCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlayCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlayCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlayCount: %w", err)
+	}
+	return oldValue.PlayCount, nil
+}
+
+// AddPlayCount adds i to the "play_count" field.
+func (m *HistoryMutation) AddPlayCount(i int) {
+	if m.addplay_count != nil {
+		*m.addplay_count += i
+	} else {
+		m.addplay_count = &i
+	}
+}
+
+// AddedPlayCount returns the value that was added to the "play_count" field in this mutation.
+func (m *HistoryMutation) AddedPlayCount() (r int, exists bool) {
+	v := m.addplay_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPlayCount resets all changes to the "play_count" field.
+func (m *HistoryMutation) ResetPlayCount() {
+	m.play_count = nil
+	m.addplay_count = nil
+}
+
+// SetLastPlayedAt sets the "last_played_at" field.
+func (m *HistoryMutation) SetLastPlayedAt(t time.Time) {
+	m.last_played_at = &t
+}
+
+// LastPlayedAt returns the value of the "last_played_at" field in the mutation.
+func (m *HistoryMutation) LastPlayedAt() (r time.Time, exists bool) {
+	v := m.last_played_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastPlayedAt returns the old "last_played_at" field's value of the History entity.
+// If the History object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryMutation) OldLastPlayedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastPlayedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastPlayedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastPlayedAt: %w", err)
+	}
+	return oldValue.LastPlayedAt, nil
+}
+
+// ClearLastPlayedAt clears the value of the "last_played_at" field.
+func (m *HistoryMutation) ClearLastPlayedAt() {
+	m.last_played_at = nil
+	m.clearedFields[history.FieldLastPlayedAt] = struct{}{}
+}
+
+// LastPlayedAtCleared returns if the "last_played_at" field was cleared in this mutation.
+func (m *HistoryMutation) LastPlayedAtCleared() bool {
+	_, ok := m.clearedFields[history.FieldLastPlayedAt]
+	return ok
+}
+
+// ResetLastPlayedAt resets all changes to the "last_played_at" field.
+func (m *HistoryMutation) ResetLastPlayedAt() {
+	m.last_played_at = nil
+	delete(m.clearedFields, history.FieldLastPlayedAt)
+}
+
+// SetContentHash sets the "content_hash" field.
+func (m *HistoryMutation) SetContentHash(s string) {
+	m.content_hash = &s
+}
+
+// ContentHash returns the value of the "content_hash" field in the mutation.
+func (m *HistoryMutation) ContentHash() (r string, exists bool) {
+	v := m.content_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentHash returns the old "content_hash" field's value of the History entity.
+// If the History object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryMutation) OldContentHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
+	}
+	return oldValue.ContentHash, nil
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (m *HistoryMutation) ClearContentHash() {
+	m.content_hash = nil
+	m.clearedFields[history.FieldContentHash] = struct{}{}
+}
+
+// ContentHashCleared returns if the "content_hash" field was cleared in this mutation.
+func (m *HistoryMutation) ContentHashCleared() bool {
+	_, ok := m.clearedFields[history.FieldContentHash]
+	return ok
+}
+
+// ResetContentHash resets all changes to the "content_hash" field.
+func (m *HistoryMutation) ResetContentHash() {
+	m.content_hash = nil
+	delete(m.clearedFields, history.FieldContentHash)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *HistoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *HistoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the History entity.
+// If the History object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *HistoryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *HistoryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *HistoryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the History entity.
+// If the History object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *HistoryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *HistoryMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *HistoryMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *HistoryMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *HistoryMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *HistoryMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *HistoryMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddRevisionIDs adds the "revisions" edge to the HistoryRevision entity by ids.
+func (m *HistoryMutation) AddRevisionIDs(ids ...uuid.UUID) {
+	if m.revisions == nil {
+		m.revisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRevisions clears the "revisions" edge to the HistoryRevision entity.
+func (m *HistoryMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the HistoryRevision entity was cleared.
+func (m *HistoryMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the HistoryRevision entity by IDs.
+func (m *HistoryMutation) RemoveRevisionIDs(ids ...uuid.UUID) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the HistoryRevision entity.
+func (m *HistoryMutation) RemovedRevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *HistoryMutation) RevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *HistoryMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *HistoryMutation) AddTagIDs(ids ...uuid.UUID) {
+	if m.tags == nil {
+		m.tags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *HistoryMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *HistoryMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *HistoryMutation) RemoveTagIDs(ids ...uuid.UUID) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *HistoryMutation) RemovedTagsIDs() (ids []uuid.UUID) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *HistoryMutation) TagsIDs() (ids []uuid.UUID) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *HistoryMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// SetFolderID sets the "folder" edge to the Folder entity by id.
+func (m *HistoryMutation) SetFolderID(id uuid.UUID) {
+	m.folder = &id
+}
+
+// ClearFolder clears the "folder" edge to the Folder entity.
+func (m *HistoryMutation) ClearFolder() {
+	m.clearedfolder = true
+}
+
+// FolderCleared reports if the "folder" edge to the Folder entity was cleared.
+func (m *HistoryMutation) FolderCleared() bool {
+	return m.clearedfolder
+}
+
+// FolderID returns the "folder" edge ID in the mutation.
+func (m *HistoryMutation) FolderID() (id uuid.UUID, exists bool) {
+	if m.folder != nil {
+		return *m.folder, true
+	}
+	return
+}
+
+// FolderIDs returns the "folder" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FolderID instead. It exists only for internal usage by the builders.
+func (m *HistoryMutation) FolderIDs() (ids []uuid.UUID) {
+	if id := m.folder; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFolder resets all changes to the "folder" edge.
+func (m *HistoryMutation) ResetFolder() {
+	m.folder = nil
+	m.clearedfolder = false
+}
+
+// Where appends a list predicates to the HistoryMutation builder.
+func (m *HistoryMutation) Where(ps ...predicate.History) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.History, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (History).
+func (m *HistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HistoryMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.deleted_at != nil {
+		fields = append(fields, history.FieldDeletedAt)
+	}
+	if m.text != nil {
+		fields = append(fields, history.FieldText)
+	}
+	if m.format != nil {
+		fields = append(fields, history.FieldFormat)
+	}
+	if m.plain_text != nil {
+		fields = append(fields, history.FieldPlainText)
+	}
+	if m.char_count != nil {
+		fields = append(fields, history.FieldCharCount)
+	}
+	if m.language != nil {
+		fields = append(fields, history.FieldLanguage)
+	}
+	if m.language_confidence != nil {
+		fields = append(fields, history.FieldLanguageConfidence)
+	}
+	if m.voice != nil {
+		fields = append(fields, history.FieldVoice)
+	}
+	if m.rate != nil {
+		fields = append(fields, history.FieldRate)
+	}
+	if m.pitch != nil {
+		fields = append(fields, history.FieldPitch)
+	}
+	if m.volume != nil {
+		fields = append(fields, history.FieldVolume)
+	}
+	if m.is_favorite != nil {
+		fields = append(fields, history.FieldIsFavorite)
+	}
+	if m.pinned_order != nil {
+		fields = append(fields, history.FieldPinnedOrder)
+	}
+	if m.play_count != nil {
+		fields = append(fields, history.FieldPlayCount)
+	}
+	if m.last_played_at != nil {
+		fields = append(fields, history.FieldLastPlayedAt)
+	}
+	if m.content_hash != nil {
+		fields = append(fields, history.FieldContentHash)
+	}
+	if m.created_at != nil {
+		fields = append(fields, history.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, history.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case history.FieldDeletedAt:
+		return m.DeletedAt()
+	case history.FieldText:
+		return m.Text()
+	case history.FieldFormat:
+		return m.Format()
+	case history.FieldPlainText:
+		return m.PlainText()
+	case history.FieldCharCount:
+		return m.CharCount()
+	case history.FieldLanguage:
+		return m.Language()
+	case history.FieldLanguageConfidence:
+		return m.LanguageConfidence()
+	case history.FieldVoice:
+		return m.Voice()
+	case history.FieldRate:
+		return m.Rate()
+	case history.FieldPitch:
+		return m.Pitch()
+	case history.FieldVolume:
+		return m.Volume()
+	case history.FieldIsFavorite:
+		return m.IsFavorite()
+	case history.FieldPinnedOrder:
+		return m.PinnedOrder()
+	case history.FieldPlayCount:
+		return m.PlayCount()
+	case history.FieldLastPlayedAt:
+		return m.LastPlayedAt()
+	case history.FieldContentHash:
+		return m.ContentHash()
+	case history.FieldCreatedAt:
+		return m.CreatedAt()
+	case history.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case history.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case history.FieldText:
+		return m.OldText(ctx)
+	case history.FieldFormat:
+		return m.OldFormat(ctx)
+	case history.FieldPlainText:
+		return m.OldPlainText(ctx)
+	case history.FieldCharCount:
+		return m.OldCharCount(ctx)
+	case history.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case history.FieldLanguageConfidence:
+		return m.OldLanguageConfidence(ctx)
+	case history.FieldVoice:
+		return m.OldVoice(ctx)
+	case history.FieldRate:
+		return m.OldRate(ctx)
+	case history.FieldPitch:
+		return m.OldPitch(ctx)
+	case history.FieldVolume:
+		return m.OldVolume(ctx)
+	case history.FieldIsFavorite:
+		return m.OldIsFavorite(ctx)
+	case history.FieldPinnedOrder:
+		return m.OldPinnedOrder(ctx)
+	case history.FieldPlayCount:
+		return m.OldPlayCount(ctx)
+	case history.FieldLastPlayedAt:
+		return m.OldLastPlayedAt(ctx)
+	case history.FieldContentHash:
+		return m.OldContentHash(ctx)
+	case history.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case history.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown History field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case history.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case history.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case history.FieldFormat:
+		v, ok := value.(history.Format)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case history.FieldPlainText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlainText(v)
+		return nil
+	case history.FieldCharCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCharCount(v)
+		return nil
+	case history.FieldLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguage(v)
+		return nil
+	case history.FieldLanguageConfidence:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguageConfidence(v)
+		return nil
+	case history.FieldVoice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVoice(v)
+		return nil
+	case history.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRate(v)
+		return nil
+	case history.FieldPitch:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPitch(v)
+		return nil
+	case history.FieldVolume:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVolume(v)
+		return nil
+	case history.FieldIsFavorite:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsFavorite(v)
+		return nil
+	case history.FieldPinnedOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPinnedOrder(v)
+		return nil
+	case history.FieldPlayCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayCount(v)
+		return nil
+	case history.FieldLastPlayedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastPlayedAt(v)
+		return nil
+	case history.FieldContentHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentHash(v)
+		return nil
+	case history.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case history.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown History field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addchar_count != nil {
+		fields = append(fields, history.FieldCharCount)
+	}
+	if m.addlanguage_confidence != nil {
+		fields = append(fields, history.FieldLanguageConfidence)
+	}
+	if m.addrate != nil {
+		fields = append(fields, history.FieldRate)
+	}
+	if m.addpitch != nil {
+		fields = append(fields, history.FieldPitch)
+	}
+	if m.addvolume != nil {
+		fields = append(fields, history.FieldVolume)
+	}
+	if m.addpinned_order != nil {
+		fields = append(fields, history.FieldPinnedOrder)
+	}
+	if m.addplay_count != nil {
+		fields = append(fields, history.FieldPlayCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case history.FieldCharCount:
+		return m.AddedCharCount()
+	case history.FieldLanguageConfidence:
+		return m.AddedLanguageConfidence()
+	case history.FieldRate:
+		return m.AddedRate()
+	case history.FieldPitch:
+		return m.AddedPitch()
+	case history.FieldVolume:
+		return m.AddedVolume()
+	case history.FieldPinnedOrder:
+		return m.AddedPinnedOrder()
+	case history.FieldPlayCount:
+		return m.AddedPlayCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case history.FieldCharCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCharCount(v)
+		return nil
+	case history.FieldLanguageConfidence:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLanguageConfidence(v)
+		return nil
+	case history.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRate(v)
+		return nil
+	case history.FieldPitch:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPitch(v)
+		return nil
+	case history.FieldVolume:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVolume(v)
+		return nil
+	case history.FieldPinnedOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPinnedOrder(v)
+		return nil
+	case history.FieldPlayCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPlayCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown History numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(history.FieldDeletedAt) {
+		fields = append(fields, history.FieldDeletedAt)
+	}
+	if m.FieldCleared(history.FieldPlainText) {
+		fields = append(fields, history.FieldPlainText)
+	}
+	if m.FieldCleared(history.FieldLanguage) {
+		fields = append(fields, history.FieldLanguage)
+	}
+	if m.FieldCleared(history.FieldLanguageConfidence) {
+		fields = append(fields, history.FieldLanguageConfidence)
+	}
+	if m.FieldCleared(history.FieldPinnedOrder) {
+		fields = append(fields, history.FieldPinnedOrder)
+	}
+	if m.FieldCleared(history.FieldLastPlayedAt) {
+		fields = append(fields, history.FieldLastPlayedAt)
+	}
+	if m.FieldCleared(history.FieldContentHash) {
+		fields = append(fields, history.FieldContentHash)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HistoryMutation) ClearField(name string) error {
+	switch name {
+	case history.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case history.FieldPlainText:
+		m.ClearPlainText()
+		return nil
+	case history.FieldLanguage:
+		m.ClearLanguage()
+		return nil
+	case history.FieldLanguageConfidence:
+		m.ClearLanguageConfidence()
+		return nil
+	case history.FieldPinnedOrder:
+		m.ClearPinnedOrder()
+		return nil
+	case history.FieldLastPlayedAt:
+		m.ClearLastPlayedAt()
+		return nil
+	case history.FieldContentHash:
+		m.ClearContentHash()
+		return nil
+	}
+	return fmt.Errorf("unknown History nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HistoryMutation) ResetField(name string) error {
+	switch name {
+	case history.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case history.FieldText:
+		m.ResetText()
+		return nil
+	case history.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case history.FieldPlainText:
+		m.ResetPlainText()
+		return nil
+	case history.FieldCharCount:
+		m.ResetCharCount()
+		return nil
+	case history.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case history.FieldLanguageConfidence:
+		m.ResetLanguageConfidence()
+		return nil
+	case history.FieldVoice:
+		m.ResetVoice()
+		return nil
+	case history.FieldRate:
+		m.ResetRate()
+		return nil
+	case history.FieldPitch:
+		m.ResetPitch()
+		return nil
+	case history.FieldVolume:
+		m.ResetVolume()
+		return nil
+	case history.FieldIsFavorite:
+		m.ResetIsFavorite()
+		return nil
+	case history.FieldPinnedOrder:
+		m.ResetPinnedOrder()
+		return nil
+	case history.FieldPlayCount:
+		m.ResetPlayCount()
+		return nil
+	case history.FieldLastPlayedAt:
+		m.ResetLastPlayedAt()
+		return nil
+	case history.FieldContentHash:
+		m.ResetContentHash()
+		return nil
+	case history.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case history.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown History field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.user != nil {
+		edges = append(edges, history.EdgeUser)
+	}
+	if m.revisions != nil {
+		edges = append(edges, history.EdgeRevisions)
+	}
+	if m.tags != nil {
+		edges = append(edges, history.EdgeTags)
+	}
+	if m.folder != nil {
+		edges = append(edges, history.EdgeFolder)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HistoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case history.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case history.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case history.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case history.EdgeFolder:
+		if id := m.folder; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedrevisions != nil {
+		edges = append(edges, history.EdgeRevisions)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, history.EdgeTags)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HistoryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case history.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case history.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.cleareduser {
+		edges = append(edges, history.EdgeUser)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, history.EdgeRevisions)
+	}
+	if m.clearedtags {
+		edges = append(edges, history.EdgeTags)
+	}
+	if m.clearedfolder {
+		edges = append(edges, history.EdgeFolder)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HistoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case history.EdgeUser:
+		return m.cleareduser
+	case history.EdgeRevisions:
+		return m.clearedrevisions
+	case history.EdgeTags:
+		return m.clearedtags
+	case history.EdgeFolder:
+		return m.clearedfolder
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HistoryMutation) ClearEdge(name string) error {
+	switch name {
+	case history.EdgeUser:
+		m.ClearUser()
+		return nil
+	case history.EdgeFolder:
+		m.ClearFolder()
+		return nil
+	}
+	return fmt.Errorf("unknown History unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HistoryMutation) ResetEdge(name string) error {
+	switch name {
+	case history.EdgeUser:
+		m.ResetUser()
+		return nil
+	case history.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	case history.EdgeTags:
+		m.ResetTags()
+		return nil
+	case history.EdgeFolder:
+		m.ResetFolder()
+		return nil
+	}
+	return fmt.Errorf("unknown History edge %s", name)
+}
+
+// HistoryRevisionMutation represents an operation that mutates the HistoryRevision nodes in the graph.
+type HistoryRevisionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	version        *int
+	addversion     *int
+	text           *string
+	format         *historyrevision.Format
+	voice          *string
+	rate           *float64
+	addrate        *float64
+	pitch          *float64
+	addpitch       *float64
+	volume         *float64
+	addvolume      *float64
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	history        *uuid.UUID
+	clearedhistory bool
+	done           bool
+	oldValue       func(context.Context) (*HistoryRevision, error)
+	predicates     []predicate.HistoryRevision
+}
+
+var _ ent.Mutation = (*HistoryRevisionMutation)(nil)
+
+// historyrevisionOption allows management of the mutation configuration using functional options.
+type historyrevisionOption func(*HistoryRevisionMutation)
+
+// newHistoryRevisionMutation creates new mutation for the HistoryRevision entity.
+func newHistoryRevisionMutation(c config, op Op, opts ...historyrevisionOption) *HistoryRevisionMutation {
+	m := &HistoryRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHistoryRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHistoryRevisionID sets the ID field of the mutation.
+func withHistoryRevisionID(id uuid.UUID) historyrevisionOption {
+	return func(m *HistoryRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *HistoryRevision
+		)
+		m.oldValue = func(ctx context.Context) (*HistoryRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().HistoryRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHistoryRevision sets the old HistoryRevision of the mutation.
+func withHistoryRevision(node *HistoryRevision) historyrevisionOption {
+	return func(m *HistoryRevisionMutation) {
+		m.oldValue = func(context.Context) (*HistoryRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HistoryRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HistoryRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of HistoryRevision entities.
+func (m *HistoryRevisionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HistoryRevisionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HistoryRevisionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().HistoryRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVersion sets the "version" field.
+func (m *HistoryRevisionMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *HistoryRevisionMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the HistoryRevision entity.
+// If the HistoryRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryRevisionMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *HistoryRevisionMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *HistoryRevisionMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *HistoryRevisionMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetText sets the "text" field.
+func (m *HistoryRevisionMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *HistoryRevisionMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the HistoryRevision entity.
+// If the HistoryRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryRevisionMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *HistoryRevisionMutation) ResetText() {
+	m.text = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *HistoryRevisionMutation) SetFormat(h historyrevision.Format) {
+	m.format = &h
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *HistoryRevisionMutation) Format() (r historyrevision.Format, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the HistoryRevision entity.
+// If the HistoryRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryRevisionMutation) OldFormat(ctx context.Context) (v historyrevision.Format, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *HistoryRevisionMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetVoice sets the "voice" field.
+func (m *HistoryRevisionMutation) SetVoice(s string) {
+	m.voice = &s
+}
+
+// Voice returns the value of the "voice" field in the mutation.
+func (m *HistoryRevisionMutation) Voice() (r string, exists bool) {
+	v := m.voice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVoice returns the old "voice" field's value of the HistoryRevision entity.
+// If the HistoryRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryRevisionMutation) OldVoice(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVoice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVoice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVoice: %w", err)
+	}
+	return oldValue.Voice, nil
+}
+
+// ResetVoice resets all changes to the "voice" field.
+func (m *HistoryRevisionMutation) ResetVoice() {
+	m.voice = nil
+}
+
+// SetRate sets the "rate" field.
+func (m *HistoryRevisionMutation) SetRate(f float64) {
+	m.rate = &f
+	m.addrate = nil
+}
+
+// Rate returns the value of the "rate" field in the mutation.
+func (m *HistoryRevisionMutation) Rate() (r float64, exists bool) {
+	v := m.rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRate returns the old "rate" field's value of the HistoryRevision entity.
+// If the HistoryRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryRevisionMutation) OldRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRate: %w", err)
+	}
+	return oldValue.Rate, nil
+}
+
+// AddRate adds f to the "rate" field.
+func (m *HistoryRevisionMutation) AddRate(f float64) {
+	if m.addrate != nil {
+		*m.addrate += f
+	} else {
+		m.addrate = &f
+	}
+}
+
+// AddedRate returns the value that was added to the "rate" field in this mutation.
+func (m *HistoryRevisionMutation) AddedRate() (r float64, exists bool) {
+	v := m.addrate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRate resets all changes to the "rate" field.
+func (m *HistoryRevisionMutation) ResetRate() {
+	m.rate = nil
+	m.addrate = nil
+}
+
+// SetPitch sets the "pitch" field.
+func (m *HistoryRevisionMutation) SetPitch(f float64) {
+	m.pitch = &f
+	m.addpitch = nil
+}
+
+// Pitch returns the value of the "pitch" field in the mutation.
+func (m *HistoryRevisionMutation) Pitch() (r float64, exists bool) {
+	v := m.pitch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPitch returns the old "pitch" field's value of the HistoryRevision entity.
+// If the HistoryRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryRevisionMutation) OldPitch(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPitch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPitch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPitch: %w", err)
+	}
+	return oldValue.Pitch, nil
+}
+
+// AddPitch adds f to the "pitch" field.
+func (m *HistoryRevisionMutation) AddPitch(f float64) {
+	if m.addpitch != nil {
+		*m.addpitch += f
+	} else {
+		m.addpitch = &f
+	}
+}
+
+// AddedPitch returns the value that was added to the "pitch" field in this mutation.
+func (m *HistoryRevisionMutation) AddedPitch() (r float64, exists bool) {
+	v := m.addpitch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPitch resets all changes to the "pitch" field.
+func (m *HistoryRevisionMutation) ResetPitch() {
+	m.pitch = nil
+	m.addpitch = nil
+}
+
+// SetVolume sets the "volume" field.
+func (m *HistoryRevisionMutation) SetVolume(f float64) {
+	m.volume = &f
+	m.addvolume = nil
+}
+
+// Volume returns the value of the "volume" field in the mutation.
+func (m *HistoryRevisionMutation) Volume() (r float64, exists bool) {
+	v := m.volume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVolume returns the old "volume" field's value of the HistoryRevision entity.
+// If the HistoryRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryRevisionMutation) OldVolume(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVolume is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVolume requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVolume: %w", err)
+	}
+	return oldValue.Volume, nil
+}
+
+// AddVolume adds f to the "volume" field.
+func (m *HistoryRevisionMutation) AddVolume(f float64) {
+	if m.addvolume != nil {
+		*m.addvolume += f
+	} else {
+		m.addvolume = &f
+	}
+}
+
+// AddedVolume returns the value that was added to the "volume" field in this mutation.
+func (m *HistoryRevisionMutation) AddedVolume() (r float64, exists bool) {
+	v := m.addvolume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVolume resets all changes to the "volume" field.
+func (m *HistoryRevisionMutation) ResetVolume() {
+	m.volume = nil
+	m.addvolume = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *HistoryRevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *HistoryRevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the HistoryRevision entity.
+// If the HistoryRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoryRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *HistoryRevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetHistoryID sets the "history" edge to the History entity by id.
+func (m *HistoryRevisionMutation) SetHistoryID(id uuid.UUID) {
+	m.history = &id
+}
+
+// ClearHistory clears the "history" edge to the History entity.
+func (m *HistoryRevisionMutation) ClearHistory() {
+	m.clearedhistory = true
+}
+
+// HistoryCleared reports if the "history" edge to the History entity was cleared.
+func (m *HistoryRevisionMutation) HistoryCleared() bool {
+	return m.clearedhistory
+}
+
+// HistoryID returns the "history" edge ID in the mutation.
+func (m *HistoryRevisionMutation) HistoryID() (id uuid.UUID, exists bool) {
+	if m.history != nil {
+		return *m.history, true
+	}
+	return
+}
+
+// HistoryIDs returns the "history" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HistoryID instead. It exists only for internal usage by the builders.
+func (m *HistoryRevisionMutation) HistoryIDs() (ids []uuid.UUID) {
+	if id := m.history; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetHistory resets all changes to the "history" edge.
+func (m *HistoryRevisionMutation) ResetHistory() {
+	m.history = nil
+	m.clearedhistory = false
+}
+
+// Where appends a list predicates to the HistoryRevisionMutation builder.
+func (m *HistoryRevisionMutation) Where(ps ...predicate.HistoryRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HistoryRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HistoryRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HistoryRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HistoryRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HistoryRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (HistoryRevision).
+func (m *HistoryRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HistoryRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.version != nil {
+		fields = append(fields, historyrevision.FieldVersion)
+	}
+	if m.text != nil {
+		fields = append(fields, historyrevision.FieldText)
+	}
+	if m.format != nil {
+		fields = append(fields, historyrevision.FieldFormat)
+	}
+	if m.voice != nil {
+		fields = append(fields, historyrevision.FieldVoice)
+	}
+	if m.rate != nil {
+		fields = append(fields, historyrevision.FieldRate)
+	}
+	if m.pitch != nil {
+		fields = append(fields, historyrevision.FieldPitch)
+	}
+	if m.volume != nil {
+		fields = append(fields, historyrevision.FieldVolume)
+	}
+	if m.created_at != nil {
+		fields = append(fields, historyrevision.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HistoryRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case historyrevision.FieldVersion:
+		return m.Version()
+	case historyrevision.FieldText:
+		return m.Text()
+	case historyrevision.FieldFormat:
+		return m.Format()
+	case historyrevision.FieldVoice:
+		return m.Voice()
+	case historyrevision.FieldRate:
+		return m.Rate()
+	case historyrevision.FieldPitch:
+		return m.Pitch()
+	case historyrevision.FieldVolume:
+		return m.Volume()
+	case historyrevision.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HistoryRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case historyrevision.FieldVersion:
+		return m.OldVersion(ctx)
+	case historyrevision.FieldText:
+		return m.OldText(ctx)
+	case historyrevision.FieldFormat:
+		return m.OldFormat(ctx)
+	case historyrevision.FieldVoice:
+		return m.OldVoice(ctx)
+	case historyrevision.FieldRate:
+		return m.OldRate(ctx)
+	case historyrevision.FieldPitch:
+		return m.OldPitch(ctx)
+	case historyrevision.FieldVolume:
+		return m.OldVolume(ctx)
+	case historyrevision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown HistoryRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HistoryRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case historyrevision.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case historyrevision.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case historyrevision.FieldFormat:
+		v, ok := value.(historyrevision.Format)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case historyrevision.FieldVoice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVoice(v)
+		return nil
+	case historyrevision.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRate(v)
+		return nil
+	case historyrevision.FieldPitch:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPitch(v)
+		return nil
+	case historyrevision.FieldVolume:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVolume(v)
+		return nil
+	case historyrevision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HistoryRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HistoryRevisionMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, historyrevision.FieldVersion)
+	}
+	if m.addrate != nil {
+		fields = append(fields, historyrevision.FieldRate)
+	}
+	if m.addpitch != nil {
+		fields = append(fields, historyrevision.FieldPitch)
+	}
+	if m.addvolume != nil {
+		fields = append(fields, historyrevision.FieldVolume)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HistoryRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case historyrevision.FieldVersion:
+		return m.AddedVersion()
+	case historyrevision.FieldRate:
+		return m.AddedRate()
+	case historyrevision.FieldPitch:
+		return m.AddedPitch()
+	case historyrevision.FieldVolume:
+		return m.AddedVolume()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HistoryRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case historyrevision.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	case historyrevision.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRate(v)
+		return nil
+	case historyrevision.FieldPitch:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPitch(v)
+		return nil
+	case historyrevision.FieldVolume:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVolume(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HistoryRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HistoryRevisionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HistoryRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HistoryRevisionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown HistoryRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HistoryRevisionMutation) ResetField(name string) error {
+	switch name {
+	case historyrevision.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case historyrevision.FieldText:
+		m.ResetText()
+		return nil
+	case historyrevision.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case historyrevision.FieldVoice:
+		m.ResetVoice()
+		return nil
+	case historyrevision.FieldRate:
+		m.ResetRate()
+		return nil
+	case historyrevision.FieldPitch:
+		m.ResetPitch()
+		return nil
+	case historyrevision.FieldVolume:
+		m.ResetVolume()
+		return nil
+	case historyrevision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown HistoryRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HistoryRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.history != nil {
+		edges = append(edges, historyrevision.EdgeHistory)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HistoryRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case historyrevision.EdgeHistory:
+		if id := m.history; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HistoryRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HistoryRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HistoryRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedhistory {
+		edges = append(edges, historyrevision.EdgeHistory)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HistoryRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case historyrevision.EdgeHistory:
+		return m.clearedhistory
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HistoryRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case historyrevision.EdgeHistory:
+		m.ClearHistory()
+		return nil
+	}
+	return fmt.Errorf("unknown HistoryRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HistoryRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case historyrevision.EdgeHistory:
+		m.ResetHistory()
+		return nil
+	}
+	return fmt.Errorf("unknown HistoryRevision edge %s", name)
+}
+
+// IdempotencyKeyMutation represents an operation that mutates the IdempotencyKey nodes in the graph.
+type IdempotencyKeyMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	key                *string
+	request_hash       *string
+	response_status    *int
+	addresponse_status *int
+	response_body      *[]byte
+	expires_at         *time.Time
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	user               *uuid.UUID
+	cleareduser        bool
+	done               bool
+	oldValue           func(context.Context) (*IdempotencyKey, error)
+	predicates         []predicate.IdempotencyKey
+}
+
+var _ ent.Mutation = (*IdempotencyKeyMutation)(nil)
+
+// idempotencykeyOption allows management of the mutation configuration using functional options.
+type idempotencykeyOption func(*IdempotencyKeyMutation)
+
+// newIdempotencyKeyMutation creates new mutation for the IdempotencyKey entity.
+func newIdempotencyKeyMutation(c config, op Op, opts ...idempotencykeyOption) *IdempotencyKeyMutation {
+	m := &IdempotencyKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIdempotencyKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIdempotencyKeyID sets the ID field of the mutation.
+func withIdempotencyKeyID(id uuid.UUID) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IdempotencyKey
+		)
+		m.oldValue = func(ctx context.Context) (*IdempotencyKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IdempotencyKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIdempotencyKey sets the old IdempotencyKey of the mutation.
+func withIdempotencyKey(node *IdempotencyKey) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		m.oldValue = func(context.Context) (*IdempotencyKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IdempotencyKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IdempotencyKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IdempotencyKey entities.
+func (m *IdempotencyKeyMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IdempotencyKeyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IdempotencyKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IdempotencyKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *IdempotencyKeyMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *IdempotencyKeyMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *IdempotencyKeyMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetRequestHash sets the "request_hash" field.
+func (m *IdempotencyKeyMutation) SetRequestHash(s string) {
+	m.request_hash = &s
+}
+
+// RequestHash returns the value of the "request_hash" field in the mutation.
+func (m *IdempotencyKeyMutation) RequestHash() (r string, exists bool) {
+	v := m.request_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestHash returns the old "request_hash" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldRequestHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestHash: %w", err)
+	}
+	return oldValue.RequestHash, nil
+}
+
+// ResetRequestHash resets all changes to the "request_hash" field.
+func (m *IdempotencyKeyMutation) ResetRequestHash() {
+	m.request_hash = nil
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (m *IdempotencyKeyMutation) SetResponseStatus(i int) {
+	m.response_status = &i
+	m.addresponse_status = nil
+}
+
+// ResponseStatus returns the value of the "response_status" field in the mutation.
+func (m *IdempotencyKeyMutation) ResponseStatus() (r int, exists bool) {
+	v := m.response_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponseStatus returns the old "response_status" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldResponseStatus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseStatus: %w", err)
+	}
+	return oldValue.ResponseStatus, nil
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (m *IdempotencyKeyMutation) AddResponseStatus(i int) {
+	if m.addresponse_status != nil {
+		*m.addresponse_status += i
+	} else {
+		m.addresponse_status = &i
+	}
+}
+
+// AddedResponseStatus returns the value that was added to the "response_status" field in this mutation.
+func (m *IdempotencyKeyMutation) AddedResponseStatus() (r int, exists bool) {
+	v := m.addresponse_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetResponseStatus resets all changes to the "response_status" field.
+func (m *IdempotencyKeyMutation) ResetResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+}
+
+// SetResponseBody sets the "response_body" field.
+func (m *IdempotencyKeyMutation) SetResponseBody(b []byte) {
+	m.response_body = &b
+}
+
+// ResponseBody returns the value of the "response_body" field in the mutation.
+func (m *IdempotencyKeyMutation) ResponseBody() (r []byte, exists bool) {
+	v := m.response_body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponseBody returns the old "response_body" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldResponseBody(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseBody: %w", err)
+	}
+	return oldValue.ResponseBody, nil
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (m *IdempotencyKeyMutation) ClearResponseBody() {
+	m.response_body = nil
+	m.clearedFields[idempotencykey.FieldResponseBody] = struct{}{}
+}
+
+// ResponseBodyCleared returns if the "response_body" field was cleared in this mutation.
+func (m *IdempotencyKeyMutation) ResponseBodyCleared() bool {
+	_, ok := m.clearedFields[idempotencykey.FieldResponseBody]
+	return ok
+}
+
+// ResetResponseBody resets all changes to the "response_body" field.
+func (m *IdempotencyKeyMutation) ResetResponseBody() {
+	m.response_body = nil
+	delete(m.clearedFields, idempotencykey.FieldResponseBody)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *IdempotencyKeyMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *IdempotencyKeyMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *IdempotencyKeyMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IdempotencyKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IdempotencyKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IdempotencyKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *IdempotencyKeyMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *IdempotencyKeyMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *IdempotencyKeyMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *IdempotencyKeyMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *IdempotencyKeyMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *IdempotencyKeyMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the IdempotencyKeyMutation builder.
+func (m *IdempotencyKeyMutation) Where(ps ...predicate.IdempotencyKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IdempotencyKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IdempotencyKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IdempotencyKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IdempotencyKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IdempotencyKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IdempotencyKey).
+func (m *IdempotencyKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IdempotencyKeyMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.key != nil {
+		fields = append(fields, idempotencykey.FieldKey)
+	}
+	if m.request_hash != nil {
+		fields = append(fields, idempotencykey.FieldRequestHash)
+	}
+	if m.response_status != nil {
+		fields = append(fields, idempotencykey.FieldResponseStatus)
+	}
+	if m.response_body != nil {
+		fields = append(fields, idempotencykey.FieldResponseBody)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, idempotencykey.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, idempotencykey.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IdempotencyKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case idempotencykey.FieldKey:
+		return m.Key()
+	case idempotencykey.FieldRequestHash:
+		return m.RequestHash()
+	case idempotencykey.FieldResponseStatus:
+		return m.ResponseStatus()
+	case idempotencykey.FieldResponseBody:
+		return m.ResponseBody()
+	case idempotencykey.FieldExpiresAt:
+		return m.ExpiresAt()
+	case idempotencykey.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IdempotencyKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case idempotencykey.FieldKey:
+		return m.OldKey(ctx)
+	case idempotencykey.FieldRequestHash:
+		return m.OldRequestHash(ctx)
+	case idempotencykey.FieldResponseStatus:
+		return m.OldResponseStatus(ctx)
+	case idempotencykey.FieldResponseBody:
+		return m.OldResponseBody(ctx)
+	case idempotencykey.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case idempotencykey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case idempotencykey.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case idempotencykey.FieldRequestHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestHash(v)
+		return nil
+	case idempotencykey.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseStatus(v)
+		return nil
+	case idempotencykey.FieldResponseBody:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseBody(v)
+		return nil
+	case idempotencykey.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case idempotencykey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IdempotencyKeyMutation) AddedFields() []string {
+	var fields []string
+	if m.addresponse_status != nil {
+		fields = append(fields, idempotencykey.FieldResponseStatus)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IdempotencyKeyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case idempotencykey.FieldResponseStatus:
+		return m.AddedResponseStatus()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case idempotencykey.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IdempotencyKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(idempotencykey.FieldResponseBody) {
+		fields = append(fields, idempotencykey.FieldResponseBody)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IdempotencyKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearField(name string) error {
+	switch name {
+	case idempotencykey.FieldResponseBody:
+		m.ClearResponseBody()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetField(name string) error {
+	switch name {
+	case idempotencykey.FieldKey:
+		m.ResetKey()
+		return nil
+	case idempotencykey.FieldRequestHash:
+		m.ResetRequestHash()
+		return nil
+	case idempotencykey.FieldResponseStatus:
+		m.ResetResponseStatus()
+		return nil
+	case idempotencykey.FieldResponseBody:
+		m.ResetResponseBody()
+		return nil
+	case idempotencykey.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case idempotencykey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IdempotencyKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, idempotencykey.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IdempotencyKeyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case idempotencykey.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IdempotencyKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IdempotencyKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IdempotencyKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, idempotencykey.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IdempotencyKeyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case idempotencykey.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearEdge(name string) error {
+	switch name {
+	case idempotencykey.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetEdge(name string) error {
+	switch name {
+	case idempotencykey.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey edge %s", name)
+}
+
+// LexiconEntryMutation represents an operation that mutates the LexiconEntry nodes in the graph.
+type LexiconEntryMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	grapheme       *string
+	alias          *string
+	phoneme        *string
+	alphabet       *lexiconentry.Alphabet
+	case_sensitive *bool
+	whole_word     *bool
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	user           *uuid.UUID
+	cleareduser    bool
+	done           bool
+	oldValue       func(context.Context) (*LexiconEntry, error)
+	predicates     []predicate.LexiconEntry
+}
+
+var _ ent.Mutation = (*LexiconEntryMutation)(nil)
+
+// lexiconentryOption allows management of the mutation configuration using functional options.
+type lexiconentryOption func(*LexiconEntryMutation)
+
+// newLexiconEntryMutation creates new mutation for the LexiconEntry entity.
+func newLexiconEntryMutation(c config, op Op, opts ...lexiconentryOption) *LexiconEntryMutation {
+	m := &LexiconEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLexiconEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLexiconEntryID sets the ID field of the mutation.
+func withLexiconEntryID(id uuid.UUID) lexiconentryOption {
+	return func(m *LexiconEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LexiconEntry
+		)
+		m.oldValue = func(ctx context.Context) (*LexiconEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LexiconEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLexiconEntry sets the old LexiconEntry of the mutation.
+func withLexiconEntry(node *LexiconEntry) lexiconentryOption {
+	return func(m *LexiconEntryMutation) {
+		m.oldValue = func(context.Context) (*LexiconEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LexiconEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LexiconEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LexiconEntry entities.
+func (m *LexiconEntryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LexiconEntryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LexiconEntryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LexiconEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGrapheme sets the "grapheme" field.
+func (m *LexiconEntryMutation) SetGrapheme(s string) {
+	m.grapheme = &s
+}
+
+// Grapheme returns the value of the "grapheme" field in the mutation.
+func (m *LexiconEntryMutation) Grapheme() (r string, exists bool) {
+	v := m.grapheme
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrapheme returns the old "grapheme" field's value of the LexiconEntry entity.
+// If the LexiconEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LexiconEntryMutation) OldGrapheme(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrapheme is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrapheme requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrapheme: %w", err)
+	}
+	return oldValue.Grapheme, nil
+}
+
+// ResetGrapheme resets all changes to the "grapheme" field.
+func (m *LexiconEntryMutation) ResetGrapheme() {
+	m.grapheme = nil
+}
+
+// SetAlias sets the "alias" field.
+func (m *LexiconEntryMutation) SetAlias(s string) {
+	m.alias = &s
+}
+
+// Alias returns the value of the "alias" field in the mutation.
+func (m *LexiconEntryMutation) Alias() (r string, exists bool) {
+	v := m.alias
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlias returns the old "alias" field's value of the LexiconEntry entity.
+// If the LexiconEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LexiconEntryMutation) OldAlias(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlias is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlias requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlias: %w", err)
+	}
+	return oldValue.Alias, nil
+}
+
+// ClearAlias clears the value of the "alias" field.
+func (m *LexiconEntryMutation) ClearAlias() {
+	m.alias = nil
+	m.clearedFields[lexiconentry.FieldAlias] = struct{}{}
+}
+
+// AliasCleared returns if the "alias" field was cleared in this mutation.
+func (m *LexiconEntryMutation) AliasCleared() bool {
+	_, ok := m.clearedFields[lexiconentry.FieldAlias]
+	return ok
+}
+
+// ResetAlias resets all changes to the "alias" field.
+func (m *LexiconEntryMutation) ResetAlias() {
+	m.alias = nil
+	delete(m.clearedFields, lexiconentry.FieldAlias)
+}
+
+// SetPhoneme sets the "phoneme" field.
+func (m *LexiconEntryMutation) SetPhoneme(s string) {
+	m.phoneme = &s
+}
+
+// Phoneme returns the value of the "phoneme" field in the mutation.
+func (m *LexiconEntryMutation) Phoneme() (r string, exists bool) {
+	v := m.phoneme
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhoneme returns the old "phoneme" field's value of the LexiconEntry entity.
+// If the LexiconEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LexiconEntryMutation) OldPhoneme(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhoneme is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhoneme requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhoneme: %w", err)
+	}
+	return oldValue.Phoneme, nil
+}
+
+// ClearPhoneme clears the value of the "phoneme" field.
+func (m *LexiconEntryMutation) ClearPhoneme() {
+	m.phoneme = nil
+	m.clearedFields[lexiconentry.FieldPhoneme] = struct{}{}
+}
+
+// PhonemeCleared returns if the "phoneme" field was cleared in this mutation.
+func (m *LexiconEntryMutation) PhonemeCleared() bool {
+	_, ok := m.clearedFields[lexiconentry.FieldPhoneme]
+	return ok
+}
+
+// ResetPhoneme resets all changes to the "phoneme" field.
+func (m *LexiconEntryMutation) ResetPhoneme() {
+	m.phoneme = nil
+	delete(m.clearedFields, lexiconentry.FieldPhoneme)
+}
+
+// SetAlphabet sets the "alphabet" field.
+func (m *LexiconEntryMutation) SetAlphabet(l lexiconentry.Alphabet) {
+	m.alphabet = &l
+}
+
+// Alphabet returns the value of the "alphabet" field in the mutation.
+func (m *LexiconEntryMutation) Alphabet() (r lexiconentry.Alphabet, exists bool) {
+	v := m.alphabet
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlphabet returns the old "alphabet" field's value of the LexiconEntry entity.
+// If the LexiconEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LexiconEntryMutation) OldAlphabet(ctx context.Context) (v lexiconentry.Alphabet, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlphabet is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlphabet requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlphabet: %w", err)
+	}
+	return oldValue.Alphabet, nil
+}
+
+// ResetAlphabet resets all changes to the "alphabet" field.
+func (m *LexiconEntryMutation) ResetAlphabet() {
+	m.alphabet = nil
+}
+
+// SetCaseSensitive sets the "case_sensitive" field.
+func (m *LexiconEntryMutation) SetCaseSensitive(b bool) {
+	m.case_sensitive = &b
+}
+
+// CaseSensitive returns the value of the "case_sensitive" field in the mutation.
+func (m *LexiconEntryMutation) CaseSensitive() (r bool, exists bool) {
+	v := m.case_sensitive
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCaseSensitive returns the old "case_sensitive" field's value of the LexiconEntry entity.
+// If the LexiconEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LexiconEntryMutation) OldCaseSensitive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaseSensitive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaseSensitive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaseSensitive: %w", err)
+	}
+	return oldValue.CaseSensitive, nil
+}
+
+// ResetCaseSensitive resets all changes to the "case_sensitive" field.
+func (m *LexiconEntryMutation) ResetCaseSensitive() {
+	m.case_sensitive = nil
+}
+
+// SetWholeWord sets the "whole_word" field.
+func (m *LexiconEntryMutation) SetWholeWord(b bool) {
+	m.whole_word = &b
+}
+
+// WholeWord returns the value of the "whole_word" field in the mutation.
+func (m *LexiconEntryMutation) WholeWord() (r bool, exists bool) {
+	v := m.whole_word
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWholeWord returns the old "whole_word" field's value of the LexiconEntry entity.
+// If the LexiconEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LexiconEntryMutation) OldWholeWord(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWholeWord is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWholeWord requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWholeWord: %w", err)
+	}
+	return oldValue.WholeWord, nil
+}
+
+// ResetWholeWord resets all changes to the "whole_word" field.
+func (m *LexiconEntryMutation) ResetWholeWord() {
+	m.whole_word = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LexiconEntryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LexiconEntryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LexiconEntry entity.
+// If the LexiconEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LexiconEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LexiconEntryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LexiconEntryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LexiconEntryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the LexiconEntry entity.
+// If the LexiconEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LexiconEntryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LexiconEntryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *LexiconEntryMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *LexiconEntryMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *LexiconEntryMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *LexiconEntryMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *LexiconEntryMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *LexiconEntryMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the LexiconEntryMutation builder.
+func (m *LexiconEntryMutation) Where(ps ...predicate.LexiconEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LexiconEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LexiconEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LexiconEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LexiconEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LexiconEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LexiconEntry).
+func (m *LexiconEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LexiconEntryMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.grapheme != nil {
+		fields = append(fields, lexiconentry.FieldGrapheme)
+	}
+	if m.alias != nil {
+		fields = append(fields, lexiconentry.FieldAlias)
+	}
+	if m.phoneme != nil {
+		fields = append(fields, lexiconentry.FieldPhoneme)
+	}
+	if m.alphabet != nil {
+		fields = append(fields, lexiconentry.FieldAlphabet)
+	}
+	if m.case_sensitive != nil {
+		fields = append(fields, lexiconentry.FieldCaseSensitive)
+	}
+	if m.whole_word != nil {
+		fields = append(fields, lexiconentry.FieldWholeWord)
+	}
+	if m.created_at != nil {
+		fields = append(fields, lexiconentry.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, lexiconentry.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LexiconEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case lexiconentry.FieldGrapheme:
+		return m.Grapheme()
+	case lexiconentry.FieldAlias:
+		return m.Alias()
+	case lexiconentry.FieldPhoneme:
+		return m.Phoneme()
+	case lexiconentry.FieldAlphabet:
+		return m.Alphabet()
+	case lexiconentry.FieldCaseSensitive:
+		return m.CaseSensitive()
+	case lexiconentry.FieldWholeWord:
+		return m.WholeWord()
+	case lexiconentry.FieldCreatedAt:
+		return m.CreatedAt()
+	case lexiconentry.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LexiconEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case lexiconentry.FieldGrapheme:
+		return m.OldGrapheme(ctx)
+	case lexiconentry.FieldAlias:
+		return m.OldAlias(ctx)
+	case lexiconentry.FieldPhoneme:
+		return m.OldPhoneme(ctx)
+	case lexiconentry.FieldAlphabet:
+		return m.OldAlphabet(ctx)
+	case lexiconentry.FieldCaseSensitive:
+		return m.OldCaseSensitive(ctx)
+	case lexiconentry.FieldWholeWord:
+		return m.OldWholeWord(ctx)
+	case lexiconentry.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case lexiconentry.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LexiconEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LexiconEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case lexiconentry.FieldGrapheme:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrapheme(v)
+		return nil
+	case lexiconentry.FieldAlias:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlias(v)
+		return nil
+	case lexiconentry.FieldPhoneme:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhoneme(v)
+		return nil
+	case lexiconentry.FieldAlphabet:
+		v, ok := value.(lexiconentry.Alphabet)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlphabet(v)
+		return nil
+	case lexiconentry.FieldCaseSensitive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCaseSensitive(v)
+		return nil
+	case lexiconentry.FieldWholeWord:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWholeWord(v)
+		return nil
+	case lexiconentry.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case lexiconentry.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LexiconEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LexiconEntryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LexiconEntryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LexiconEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown LexiconEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LexiconEntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(lexiconentry.FieldAlias) {
+		fields = append(fields, lexiconentry.FieldAlias)
+	}
+	if m.FieldCleared(lexiconentry.FieldPhoneme) {
+		fields = append(fields, lexiconentry.FieldPhoneme)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LexiconEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LexiconEntryMutation) ClearField(name string) error {
+	switch name {
+	case lexiconentry.FieldAlias:
+		m.ClearAlias()
+		return nil
+	case lexiconentry.FieldPhoneme:
+		m.ClearPhoneme()
+		return nil
+	}
+	return fmt.Errorf("unknown LexiconEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LexiconEntryMutation) ResetField(name string) error {
+	switch name {
+	case lexiconentry.FieldGrapheme:
+		m.ResetGrapheme()
+		return nil
+	case lexiconentry.FieldAlias:
+		m.ResetAlias()
+		return nil
+	case lexiconentry.FieldPhoneme:
+		m.ResetPhoneme()
+		return nil
+	case lexiconentry.FieldAlphabet:
+		m.ResetAlphabet()
+		return nil
+	case lexiconentry.FieldCaseSensitive:
+		m.ResetCaseSensitive()
+		return nil
+	case lexiconentry.FieldWholeWord:
+		m.ResetWholeWord()
+		return nil
+	case lexiconentry.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case lexiconentry.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LexiconEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LexiconEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, lexiconentry.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LexiconEntryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case lexiconentry.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LexiconEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LexiconEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LexiconEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, lexiconentry.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LexiconEntryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case lexiconentry.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LexiconEntryMutation) ClearEdge(name string) error {
+	switch name {
+	case lexiconentry.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown LexiconEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LexiconEntryMutation) ResetEdge(name string) error {
+	switch name {
+	case lexiconentry.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown LexiconEntry edge %s", name)
+}
+
+// ModerationRuleMutation represents an operation that mutates the ModerationRule nodes in the graph.
+type ModerationRuleMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	kind          *moderationrule.Kind
+	pattern       *string
+	action        *moderationrule.Action
+	replacement   *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*ModerationRule, error)
+	predicates    []predicate.ModerationRule
+}
+
+var _ ent.Mutation = (*ModerationRuleMutation)(nil)
+
+// moderationruleOption allows management of the mutation configuration using functional options.
+type moderationruleOption func(*ModerationRuleMutation)
+
+// newModerationRuleMutation creates new mutation for the ModerationRule entity.
+func newModerationRuleMutation(c config, op Op, opts ...moderationruleOption) *ModerationRuleMutation {
+	m := &ModerationRuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModerationRule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModerationRuleID sets the ID field of the mutation.
+func withModerationRuleID(id uuid.UUID) moderationruleOption {
+	return func(m *ModerationRuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ModerationRule
+		)
+		m.oldValue = func(ctx context.Context) (*ModerationRule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ModerationRule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModerationRule sets the old ModerationRule of the mutation.
+func withModerationRule(node *ModerationRule) moderationruleOption {
+	return func(m *ModerationRuleMutation) {
+		m.oldValue = func(context.Context) (*ModerationRule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModerationRuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModerationRuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ModerationRule entities.
+func (m *ModerationRuleMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModerationRuleMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModerationRuleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ModerationRule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKind sets the "kind" field.
+func (m *ModerationRuleMutation) SetKind(value moderationrule.Kind) {
+	m.kind = &value
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *ModerationRuleMutation) Kind() (r moderationrule.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the ModerationRule entity.
+// If the ModerationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationRuleMutation) OldKind(ctx context.Context) (v moderationrule.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *ModerationRuleMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetPattern sets the "pattern" field.
+func (m *ModerationRuleMutation) SetPattern(s string) {
+	m.pattern = &s
+}
+
+// Pattern returns the value of the "pattern" field in the mutation.
+func (m *ModerationRuleMutation) Pattern() (r string, exists bool) {
+	v := m.pattern
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPattern returns the old "pattern" field's value of the ModerationRule entity.
+// If the ModerationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationRuleMutation) OldPattern(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPattern is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPattern requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPattern: %w", err)
+	}
+	return oldValue.Pattern, nil
+}
+
+// ResetPattern resets all changes to the "pattern" field.
+func (m *ModerationRuleMutation) ResetPattern() {
+	m.pattern = nil
+}
+
+// SetAction sets the "action" field.
+func (m *ModerationRuleMutation) SetAction(value moderationrule.Action) {
+	m.action = &value
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *ModerationRuleMutation) Action() (r moderationrule.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the ModerationRule entity.
+// If the ModerationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationRuleMutation) OldAction(ctx context.Context) (v *moderationrule.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ClearAction clears the value of the "action" field.
+func (m *ModerationRuleMutation) ClearAction() {
+	m.action = nil
+	m.clearedFields[moderationrule.FieldAction] = struct{}{}
+}
+
+// ActionCleared returns if the "action" field was cleared in this mutation.
+func (m *ModerationRuleMutation) ActionCleared() bool {
+	_, ok := m.clearedFields[moderationrule.FieldAction]
+	return ok
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *ModerationRuleMutation) ResetAction() {
+	m.action = nil
+	delete(m.clearedFields, moderationrule.FieldAction)
+}
+
+// SetReplacement sets the "replacement" field.
+func (m *ModerationRuleMutation) SetReplacement(s string) {
+	m.replacement = &s
+}
+
+// Replacement returns the value of the "replacement" field in the mutation.
+func (m *ModerationRuleMutation) Replacement() (r string, exists bool) {
+	v := m.replacement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplacement returns the old "replacement" field's value of the ModerationRule entity.
+// If the ModerationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationRuleMutation) OldReplacement(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplacement is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplacement requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplacement: %w", err)
+	}
+	return oldValue.Replacement, nil
+}
+
+// ClearReplacement clears the value of the "replacement" field.
+func (m *ModerationRuleMutation) ClearReplacement() {
+	m.replacement = nil
+	m.clearedFields[moderationrule.FieldReplacement] = struct{}{}
+}
+
+// ReplacementCleared returns if the "replacement" field was cleared in this mutation.
+func (m *ModerationRuleMutation) ReplacementCleared() bool {
+	_, ok := m.clearedFields[moderationrule.FieldReplacement]
+	return ok
+}
+
+// ResetReplacement resets all changes to the "replacement" field.
+func (m *ModerationRuleMutation) ResetReplacement() {
+	m.replacement = nil
+	delete(m.clearedFields, moderationrule.FieldReplacement)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ModerationRuleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ModerationRuleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ModerationRule entity.
+// If the ModerationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ModerationRuleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ModerationRuleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ModerationRuleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ModerationRule entity.
+// If the ModerationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationRuleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ModerationRuleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *ModerationRuleMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ModerationRuleMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ModerationRuleMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *ModerationRuleMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ModerationRuleMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ModerationRuleMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the ModerationRuleMutation builder.
+func (m *ModerationRuleMutation) Where(ps ...predicate.ModerationRule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModerationRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModerationRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ModerationRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModerationRuleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModerationRuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ModerationRule).
+func (m *ModerationRuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModerationRuleMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.kind != nil {
+		fields = append(fields, moderationrule.FieldKind)
+	}
+	if m.pattern != nil {
+		fields = append(fields, moderationrule.FieldPattern)
+	}
+	if m.action != nil {
+		fields = append(fields, moderationrule.FieldAction)
+	}
+	if m.replacement != nil {
+		fields = append(fields, moderationrule.FieldReplacement)
+	}
+	if m.created_at != nil {
+		fields = append(fields, moderationrule.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, moderationrule.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModerationRuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case moderationrule.FieldKind:
+		return m.Kind()
+	case moderationrule.FieldPattern:
+		return m.Pattern()
+	case moderationrule.FieldAction:
+		return m.Action()
+	case moderationrule.FieldReplacement:
+		return m.Replacement()
+	case moderationrule.FieldCreatedAt:
+		return m.CreatedAt()
+	case moderationrule.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModerationRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case moderationrule.FieldKind:
+		return m.OldKind(ctx)
+	case moderationrule.FieldPattern:
+		return m.OldPattern(ctx)
+	case moderationrule.FieldAction:
+		return m.OldAction(ctx)
+	case moderationrule.FieldReplacement:
+		return m.OldReplacement(ctx)
+	case moderationrule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case moderationrule.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ModerationRule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModerationRuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case moderationrule.FieldKind:
+		v, ok := value.(moderationrule.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case moderationrule.FieldPattern:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPattern(v)
+		return nil
+	case moderationrule.FieldAction:
+		v, ok := value.(moderationrule.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case moderationrule.FieldReplacement:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplacement(v)
+		return nil
+	case moderationrule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case moderationrule.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationRule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModerationRuleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModerationRuleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModerationRuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ModerationRule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModerationRuleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(moderationrule.FieldAction) {
+		fields = append(fields, moderationrule.FieldAction)
+	}
+	if m.FieldCleared(moderationrule.FieldReplacement) {
+		fields = append(fields, moderationrule.FieldReplacement)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModerationRuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModerationRuleMutation) ClearField(name string) error {
+	switch name {
+	case moderationrule.FieldAction:
+		m.ClearAction()
+		return nil
+	case moderationrule.FieldReplacement:
+		m.ClearReplacement()
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationRule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModerationRuleMutation) ResetField(name string) error {
+	switch name {
+	case moderationrule.FieldKind:
+		m.ResetKind()
+		return nil
+	case moderationrule.FieldPattern:
+		m.ResetPattern()
+		return nil
+	case moderationrule.FieldAction:
+		m.ResetAction()
+		return nil
+	case moderationrule.FieldReplacement:
+		m.ResetReplacement()
+		return nil
+	case moderationrule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case moderationrule.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationRule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModerationRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, moderationrule.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModerationRuleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case moderationrule.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModerationRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModerationRuleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModerationRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, moderationrule.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModerationRuleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case moderationrule.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModerationRuleMutation) ClearEdge(name string) error {
+	switch name {
+	case moderationrule.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationRule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModerationRuleMutation) ResetEdge(name string) error {
+	switch name {
+	case moderationrule.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationRule edge %s", name)
+}
+
+// ModerationSettingMutation represents an operation that mutates the ModerationSetting nodes in the graph.
+type ModerationSettingMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	enabled             *bool
+	builtin_lists       *[]string
+	appendbuiltin_lists []string
+	action              *moderationsetting.Action
+	replacement         *string
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	user                *uuid.UUID
+	cleareduser         bool
+	done                bool
+	oldValue            func(context.Context) (*ModerationSetting, error)
+	predicates          []predicate.ModerationSetting
+}
+
+var _ ent.Mutation = (*ModerationSettingMutation)(nil)
+
+// moderationsettingOption allows management of the mutation configuration using functional options.
+type moderationsettingOption func(*ModerationSettingMutation)
+
+// newModerationSettingMutation creates new mutation for the ModerationSetting entity.
+func newModerationSettingMutation(c config, op Op, opts ...moderationsettingOption) *ModerationSettingMutation {
+	m := &ModerationSettingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModerationSetting,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModerationSettingID sets the ID field of the mutation.
+func withModerationSettingID(id uuid.UUID) moderationsettingOption {
+	return func(m *ModerationSettingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ModerationSetting
+		)
+		m.oldValue = func(ctx context.Context) (*ModerationSetting, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ModerationSetting.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModerationSetting sets the old ModerationSetting of the mutation.
+func withModerationSetting(node *ModerationSetting) moderationsettingOption {
+	return func(m *ModerationSettingMutation) {
+		m.oldValue = func(context.Context) (*ModerationSetting, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModerationSettingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModerationSettingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ModerationSetting entities.
+func (m *ModerationSettingMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModerationSettingMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModerationSettingMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ModerationSetting.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *ModerationSettingMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *ModerationSettingMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the ModerationSetting entity.
+// If the ModerationSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationSettingMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *ModerationSettingMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetBuiltinLists sets the "builtin_lists" field.
+func (m *ModerationSettingMutation) SetBuiltinLists(s []string) {
+	m.builtin_lists = &s
+	m.appendbuiltin_lists = nil
+}
+
+// BuiltinLists returns the value of the "builtin_lists" field in the mutation.
+func (m *ModerationSettingMutation) BuiltinLists() (r []string, exists bool) {
+	v := m.builtin_lists
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuiltinLists returns the old "builtin_lists" field's value of the ModerationSetting entity.
+// If the ModerationSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationSettingMutation) OldBuiltinLists(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuiltinLists is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuiltinLists requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuiltinLists: %w", err)
+	}
+	return oldValue.BuiltinLists, nil
+}
+
+// AppendBuiltinLists adds s to the "builtin_lists" field.
+func (m *ModerationSettingMutation) AppendBuiltinLists(s []string) {
+	m.appendbuiltin_lists = append(m.appendbuiltin_lists, s...)
+}
+
+// AppendedBuiltinLists returns the list of values that were appended to the "builtin_lists" field in this mutation.
+func (m *ModerationSettingMutation) AppendedBuiltinLists() ([]string, bool) {
+	if len(m.appendbuiltin_lists) == 0 {
+		return nil, false
+	}
+	return m.appendbuiltin_lists, true
+}
+
+// ResetBuiltinLists resets all changes to the "builtin_lists" field.
+func (m *ModerationSettingMutation) ResetBuiltinLists() {
+	m.builtin_lists = nil
+	m.appendbuiltin_lists = nil
+}
+
+// SetAction sets the "action" field.
+func (m *ModerationSettingMutation) SetAction(value moderationsetting.Action) {
+	m.action = &value
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *ModerationSettingMutation) Action() (r moderationsetting.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the ModerationSetting entity.
+// If the ModerationSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationSettingMutation) OldAction(ctx context.Context) (v moderationsetting.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *ModerationSettingMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetReplacement sets the "replacement" field.
+func (m *ModerationSettingMutation) SetReplacement(s string) {
+	m.replacement = &s
+}
+
+// Replacement returns the value of the "replacement" field in the mutation.
+func (m *ModerationSettingMutation) Replacement() (r string, exists bool) {
+	v := m.replacement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplacement returns the old "replacement" field's value of the ModerationSetting entity.
+// If the ModerationSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationSettingMutation) OldReplacement(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplacement is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplacement requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplacement: %w", err)
+	}
+	return oldValue.Replacement, nil
+}
+
+// ResetReplacement resets all changes to the "replacement" field.
+func (m *ModerationSettingMutation) ResetReplacement() {
+	m.replacement = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ModerationSettingMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ModerationSettingMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ModerationSetting entity.
+// If the ModerationSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationSettingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ModerationSettingMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ModerationSettingMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ModerationSettingMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ModerationSetting entity.
+// If the ModerationSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationSettingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ModerationSettingMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *ModerationSettingMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ModerationSettingMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ModerationSettingMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *ModerationSettingMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ModerationSettingMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ModerationSettingMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the ModerationSettingMutation builder.
+func (m *ModerationSettingMutation) Where(ps ...predicate.ModerationSetting) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModerationSettingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModerationSettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ModerationSetting, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModerationSettingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModerationSettingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ModerationSetting).
+func (m *ModerationSettingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModerationSettingMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.enabled != nil {
+		fields = append(fields, moderationsetting.FieldEnabled)
+	}
+	if m.builtin_lists != nil {
+		fields = append(fields, moderationsetting.FieldBuiltinLists)
+	}
+	if m.action != nil {
+		fields = append(fields, moderationsetting.FieldAction)
+	}
+	if m.replacement != nil {
+		fields = append(fields, moderationsetting.FieldReplacement)
+	}
+	if m.created_at != nil {
+		fields = append(fields, moderationsetting.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, moderationsetting.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModerationSettingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case moderationsetting.FieldEnabled:
+		return m.Enabled()
+	case moderationsetting.FieldBuiltinLists:
+		return m.BuiltinLists()
+	case moderationsetting.FieldAction:
+		return m.Action()
+	case moderationsetting.FieldReplacement:
+		return m.Replacement()
+	case moderationsetting.FieldCreatedAt:
+		return m.CreatedAt()
+	case moderationsetting.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModerationSettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case moderationsetting.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case moderationsetting.FieldBuiltinLists:
+		return m.OldBuiltinLists(ctx)
+	case moderationsetting.FieldAction:
+		return m.OldAction(ctx)
+	case moderationsetting.FieldReplacement:
+		return m.OldReplacement(ctx)
+	case moderationsetting.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case moderationsetting.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ModerationSetting field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModerationSettingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case moderationsetting.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case moderationsetting.FieldBuiltinLists:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuiltinLists(v)
+		return nil
+	case moderationsetting.FieldAction:
+		v, ok := value.(moderationsetting.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case moderationsetting.FieldReplacement:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplacement(v)
+		return nil
+	case moderationsetting.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case moderationsetting.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationSetting field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModerationSettingMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModerationSettingMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModerationSettingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ModerationSetting numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModerationSettingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModerationSettingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModerationSettingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ModerationSetting nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModerationSettingMutation) ResetField(name string) error {
+	switch name {
+	case moderationsetting.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case moderationsetting.FieldBuiltinLists:
+		m.ResetBuiltinLists()
+		return nil
+	case moderationsetting.FieldAction:
+		m.ResetAction()
+		return nil
+	case moderationsetting.FieldReplacement:
+		m.ResetReplacement()
+		return nil
+	case moderationsetting.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case moderationsetting.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationSetting field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModerationSettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, moderationsetting.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModerationSettingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case moderationsetting.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModerationSettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModerationSettingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModerationSettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, moderationsetting.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModerationSettingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case moderationsetting.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModerationSettingMutation) ClearEdge(name string) error {
+	switch name {
+	case moderationsetting.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationSetting unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModerationSettingMutation) ResetEdge(name string) error {
+	switch name {
+	case moderationsetting.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationSetting edge %s", name)
+}
+
+// PlanMutation represents an operation that mutates the Plan nodes in the graph.
+type PlanMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *string
+	name                  *string
+	monthly_characters    *int
+	addmonthly_characters *int
+	monthly_entries       *int
+	addmonthly_entries    *int
+	created_at            *time.Time
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	users                 map[uuid.UUID]struct{}
+	removedusers          map[uuid.UUID]struct{}
+	clearedusers          bool
+	done                  bool
+	oldValue              func(context.Context) (*Plan, error)
+	predicates            []predicate.Plan
+}
+
+var _ ent.Mutation = (*PlanMutation)(nil)
+
+// planOption allows management of the mutation configuration using functional options.
+type planOption func(*PlanMutation)
+
+// newPlanMutation creates new mutation for the Plan entity.
+func newPlanMutation(c config, op Op, opts ...planOption) *PlanMutation {
+	m := &PlanMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlan,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlanID sets the ID field of the mutation.
+func withPlanID(id string) planOption {
+	return func(m *PlanMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Plan
+		)
+		m.oldValue = func(ctx context.Context) (*Plan, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Plan.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlan sets the old Plan of the mutation.
+func withPlan(node *Plan) planOption {
+	return func(m *PlanMutation) {
+		m.oldValue = func(context.Context) (*Plan, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlanMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlanMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Plan entities.
+func (m *PlanMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PlanMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PlanMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Plan.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *PlanMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PlanMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PlanMutation) ResetName() {
+	m.name = nil
+}
+
+// SetMonthlyCharacters sets the "monthly_characters" field.
+func (m *PlanMutation) SetMonthlyCharacters(i int) {
+	m.monthly_characters = &i
+	m.addmonthly_characters = nil
+}
+
+// MonthlyCharacters returns the value of the "monthly_characters" field in the mutation.
+func (m *PlanMutation) MonthlyCharacters() (r int, exists bool) {
+	v := m.monthly_characters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMonthlyCharacters returns the old "monthly_characters" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldMonthlyCharacters(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonthlyCharacters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonthlyCharacters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonthlyCharacters: %w", err)
+	}
+	return oldValue.MonthlyCharacters, nil
+}
+
+// AddMonthlyCharacters adds i to the "monthly_characters" field.
+func (m *PlanMutation) AddMonthlyCharacters(i int) {
+	if m.addmonthly_characters != nil {
+		*m.addmonthly_characters += i
+	} else {
+		m.addmonthly_characters = &i
+	}
+}
+
+// AddedMonthlyCharacters returns the value that was added to the "monthly_characters" field in this mutation.
+func (m *PlanMutation) AddedMonthlyCharacters() (r int, exists bool) {
+	v := m.addmonthly_characters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMonthlyCharacters resets all changes to the "monthly_characters" field.
+func (m *PlanMutation) ResetMonthlyCharacters() {
+	m.monthly_characters = nil
+	m.addmonthly_characters = nil
+}
+
+// SetMonthlyEntries sets the "monthly_entries" field.
+func (m *PlanMutation) SetMonthlyEntries(i int) {
+	m.monthly_entries = &i
+	m.addmonthly_entries = nil
+}
+
+// MonthlyEntries returns the value of the "monthly_entries" field in the mutation.
+func (m *PlanMutation) MonthlyEntries() (r int, exists bool) {
+	v := m.monthly_entries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMonthlyEntries returns the old "monthly_entries" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldMonthlyEntries(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonthlyEntries is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonthlyEntries requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonthlyEntries: %w", err)
+	}
+	return oldValue.MonthlyEntries, nil
+}
+
+// AddMonthlyEntries adds i to the "monthly_entries" field.
+func (m *PlanMutation) AddMonthlyEntries(i int) {
+	if m.addmonthly_entries != nil {
+		*m.addmonthly_entries += i
+	} else {
+		m.addmonthly_entries = &i
+	}
+}
+
+// AddedMonthlyEntries returns the value that was added to the "monthly_entries" field in this mutation.
+func (m *PlanMutation) AddedMonthlyEntries() (r int, exists bool) {
+	v := m.addmonthly_entries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMonthlyEntries resets all changes to the "monthly_entries" field.
+func (m *PlanMutation) ResetMonthlyEntries() {
+	m.monthly_entries = nil
+	m.addmonthly_entries = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PlanMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PlanMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PlanMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PlanMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PlanMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PlanMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *PlanMutation) AddUserIDs(ids ...uuid.UUID) {
+	if m.users == nil {
+		m.users = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *PlanMutation) ClearUsers() {
+	m.clearedusers = true
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *PlanMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *PlanMutation) RemoveUserIDs(ids ...uuid.UUID) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *PlanMutation) RemovedUsersIDs() (ids []uuid.UUID) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *PlanMutation) UsersIDs() (ids []uuid.UUID) {
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *PlanMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
+}
+
+// Where appends a list predicates to the PlanMutation builder.
+func (m *PlanMutation) Where(ps ...predicate.Plan) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PlanMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PlanMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Plan, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PlanMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PlanMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Plan).
+func (m *PlanMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlanMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, plan.FieldName)
+	}
+	if m.monthly_characters != nil {
+		fields = append(fields, plan.FieldMonthlyCharacters)
+	}
+	if m.monthly_entries != nil {
+		fields = append(fields, plan.FieldMonthlyEntries)
+	}
+	if m.created_at != nil {
+		fields = append(fields, plan.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, plan.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlanMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case plan.FieldName:
+		return m.Name()
+	case plan.FieldMonthlyCharacters:
+		return m.MonthlyCharacters()
+	case plan.FieldMonthlyEntries:
+		return m.MonthlyEntries()
+	case plan.FieldCreatedAt:
+		return m.CreatedAt()
+	case plan.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case plan.FieldName:
+		return m.OldName(ctx)
+	case plan.FieldMonthlyCharacters:
+		return m.OldMonthlyCharacters(ctx)
+	case plan.FieldMonthlyEntries:
+		return m.OldMonthlyEntries(ctx)
+	case plan.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case plan.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Plan field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlanMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case plan.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case plan.FieldMonthlyCharacters:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonthlyCharacters(v)
+		return nil
+	case plan.FieldMonthlyEntries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonthlyEntries(v)
+		return nil
+	case plan.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case plan.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Plan field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlanMutation) AddedFields() []string {
+	var fields []string
+	if m.addmonthly_characters != nil {
+		fields = append(fields, plan.FieldMonthlyCharacters)
+	}
+	if m.addmonthly_entries != nil {
+		fields = append(fields, plan.FieldMonthlyEntries)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlanMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case plan.FieldMonthlyCharacters:
+		return m.AddedMonthlyCharacters()
+	case plan.FieldMonthlyEntries:
+		return m.AddedMonthlyEntries()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlanMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case plan.FieldMonthlyCharacters:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMonthlyCharacters(v)
+		return nil
+	case plan.FieldMonthlyEntries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMonthlyEntries(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Plan numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlanMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlanMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlanMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Plan nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlanMutation) ResetField(name string) error {
+	switch name {
+	case plan.FieldName:
+		m.ResetName()
+		return nil
+	case plan.FieldMonthlyCharacters:
+		m.ResetMonthlyCharacters()
+		return nil
+	case plan.FieldMonthlyEntries:
+		m.ResetMonthlyEntries()
+		return nil
+	case plan.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case plan.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Plan field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlanMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.users != nil {
+		edges = append(edges, plan.EdgeUsers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlanMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case plan.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlanMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedusers != nil {
+		edges = append(edges, plan.EdgeUsers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlanMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case plan.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlanMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedusers {
+		edges = append(edges, plan.EdgeUsers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlanMutation) EdgeCleared(name string) bool {
+	switch name {
+	case plan.EdgeUsers:
+		return m.clearedusers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlanMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Plan unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlanMutation) ResetEdge(name string) error {
+	switch name {
+	case plan.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	}
+	return fmt.Errorf("unknown Plan edge %s", name)
+}
+
+// PlayEventMutation represents an operation that mutates the PlayEvent nodes in the graph.
+type PlayEventMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	voice         *string
+	history_id    *uuid.UUID
+	played_at     *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*PlayEvent, error)
+	predicates    []predicate.PlayEvent
+}
+
+var _ ent.Mutation = (*PlayEventMutation)(nil)
+
+// playeventOption allows management of the mutation configuration using functional options.
+type playeventOption func(*PlayEventMutation)
+
+// newPlayEventMutation creates new mutation for the PlayEvent entity.
+func newPlayEventMutation(c config, op Op, opts ...playeventOption) *PlayEventMutation {
+	m := &PlayEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlayEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlayEventID sets the ID field of the mutation.
+func withPlayEventID(id uuid.UUID) playeventOption {
+	return func(m *PlayEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PlayEvent
+		)
+		m.oldValue = func(ctx context.Context) (*PlayEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PlayEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlayEvent sets the old PlayEvent of the mutation.
+func withPlayEvent(node *PlayEvent) playeventOption {
+	return func(m *PlayEventMutation) {
+		m.oldValue = func(context.Context) (*PlayEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlayEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlayEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PlayEvent entities.
+func (m *PlayEventMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PlayEventMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PlayEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PlayEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVoice sets the "voice" field.
+func (m *PlayEventMutation) SetVoice(s string) {
+	m.voice = &s
+}
+
+// Voice returns the value of the "voice" field in the mutation.
+func (m *PlayEventMutation) Voice() (r string, exists bool) {
+	v := m.voice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVoice returns the old "voice" field's value of the PlayEvent entity.
+// If the PlayEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayEventMutation) OldVoice(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVoice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVoice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVoice: %w", err)
+	}
+	return oldValue.Voice, nil
+}
+
+// ResetVoice resets all changes to the "voice" field.
+func (m *PlayEventMutation) ResetVoice() {
+	m.voice = nil
+}
+
+// SetHistoryID sets the "history_id" field.
+func (m *PlayEventMutation) SetHistoryID(u uuid.UUID) {
+	m.history_id = &u
+}
+
+// HistoryID returns the value of the "history_id" field in the mutation.
+func (m *PlayEventMutation) HistoryID() (r uuid.UUID, exists bool) {
+	v := m.history_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHistoryID returns the old "history_id" field's value of the PlayEvent entity.
+// If the PlayEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayEventMutation) OldHistoryID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHistoryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHistoryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHistoryID: %w", err)
+	}
+	return oldValue.HistoryID, nil
+}
+
+// ResetHistoryID resets all changes to the "history_id" field.
+func (m *PlayEventMutation) ResetHistoryID() {
+	m.history_id = nil
+}
+
+// SetPlayedAt sets the "played_at" field.
+func (m *PlayEventMutation) SetPlayedAt(t time.Time) {
+	m.played_at = &t
+}
+
+// PlayedAt returns the value of the "played_at" field in the mutation.
+func (m *PlayEventMutation) PlayedAt() (r time.Time, exists bool) {
+	v := m.played_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlayedAt returns the old "played_at" field's value of the PlayEvent entity.
+// If the PlayEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayEventMutation) OldPlayedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlayedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlayedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlayedAt: %w", err)
+	}
+	return oldValue.PlayedAt, nil
+}
+
+// ResetPlayedAt resets all changes to the "played_at" field.
+func (m *PlayEventMutation) ResetPlayedAt() {
+	m.played_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *PlayEventMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PlayEventMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PlayEventMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *PlayEventMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PlayEventMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PlayEventMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the PlayEventMutation builder.
+func (m *PlayEventMutation) Where(ps ...predicate.PlayEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PlayEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PlayEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PlayEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PlayEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PlayEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PlayEvent).
+func (m *PlayEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlayEventMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.voice != nil {
+		fields = append(fields, playevent.FieldVoice)
+	}
+	if m.history_id != nil {
+		fields = append(fields, playevent.FieldHistoryID)
+	}
+	if m.played_at != nil {
+		fields = append(fields, playevent.FieldPlayedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlayEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case playevent.FieldVoice:
+		return m.Voice()
+	case playevent.FieldHistoryID:
+		return m.HistoryID()
+	case playevent.FieldPlayedAt:
+		return m.PlayedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlayEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case playevent.FieldVoice:
+		return m.OldVoice(ctx)
+	case playevent.FieldHistoryID:
+		return m.OldHistoryID(ctx)
+	case playevent.FieldPlayedAt:
+		return m.OldPlayedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PlayEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlayEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case playevent.FieldVoice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVoice(v)
+		return nil
+	case playevent.FieldHistoryID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHistoryID(v)
+		return nil
+	case playevent.FieldPlayedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PlayEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlayEventMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlayEventMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlayEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PlayEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlayEventMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlayEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlayEventMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PlayEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlayEventMutation) ResetField(name string) error {
+	switch name {
+	case playevent.FieldVoice:
+		m.ResetVoice()
+		return nil
+	case playevent.FieldHistoryID:
+		m.ResetHistoryID()
+		return nil
+	case playevent.FieldPlayedAt:
+		m.ResetPlayedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PlayEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlayEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, playevent.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlayEventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case playevent.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlayEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlayEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlayEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, playevent.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlayEventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case playevent.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlayEventMutation) ClearEdge(name string) error {
+	switch name {
+	case playevent.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PlayEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlayEventMutation) ResetEdge(name string) error {
+	switch name {
+	case playevent.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PlayEvent edge %s", name)
+}
+
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	name             *string
+	color            *string
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	user             *uuid.UUID
+	cleareduser      bool
+	histories        map[uuid.UUID]struct{}
+	removedhistories map[uuid.UUID]struct{}
+	clearedhistories bool
+	done             bool
+	oldValue         func(context.Context) (*Tag, error)
+	predicates       []predicate.Tag
+}
+
+var _ ent.Mutation = (*TagMutation)(nil)
+
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
+
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTagID sets the ID field of the mutation.
+func withTagID(id uuid.UUID) tagOption {
+	return func(m *TagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tag
+		)
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tag entities.
+func (m *TagMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TagMutation) ResetName() {
+	m.name = nil
+}
+
+// SetColor sets the "color" field.
+func (m *TagMutation) SetColor(s string) {
+	m.color = &s
+}
+
+// Color returns the value of the "color" field in the mutation.
+func (m *TagMutation) Color() (r string, exists bool) {
+	v := m.color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColor returns the old "color" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+	}
+	return oldValue.Color, nil
+}
+
+// ClearColor clears the value of the "color" field.
+func (m *TagMutation) ClearColor() {
+	m.color = nil
+	m.clearedFields[tag.FieldColor] = struct{}{}
+}
+
+// ColorCleared returns if the "color" field was cleared in this mutation.
+func (m *TagMutation) ColorCleared() bool {
+	_, ok := m.clearedFields[tag.FieldColor]
+	return ok
+}
+
+// ResetColor resets all changes to the "color" field.
+func (m *TagMutation) ResetColor() {
+	m.color = nil
+	delete(m.clearedFields, tag.FieldColor)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TagMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TagMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TagMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TagMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *TagMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *TagMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *TagMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *TagMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *TagMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *TagMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddHistoryIDs adds the "histories" edge to the History entity by ids.
+func (m *TagMutation) AddHistoryIDs(ids ...uuid.UUID) {
+	if m.histories == nil {
+		m.histories = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.histories[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHistories clears the "histories" edge to the History entity.
+func (m *TagMutation) ClearHistories() {
+	m.clearedhistories = true
+}
+
+// HistoriesCleared reports if the "histories" edge to the History entity was cleared.
+func (m *TagMutation) HistoriesCleared() bool {
+	return m.clearedhistories
+}
+
+// RemoveHistoryIDs removes the "histories" edge to the History entity by IDs.
+func (m *TagMutation) RemoveHistoryIDs(ids ...uuid.UUID) {
+	if m.removedhistories == nil {
+		m.removedhistories = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.histories, ids[i])
+		m.removedhistories[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHistories returns the removed IDs of the "histories" edge to the History entity.
+func (m *TagMutation) RemovedHistoriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedhistories {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HistoriesIDs returns the "histories" edge IDs in the mutation.
+func (m *TagMutation) HistoriesIDs() (ids []uuid.UUID) {
+	for id := range m.histories {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHistories resets all changes to the "histories" edge.
+func (m *TagMutation) ResetHistories() {
+	m.histories = nil
+	m.clearedhistories = false
+	m.removedhistories = nil
+}
+
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, tag.FieldName)
+	}
+	if m.color != nil {
+		fields = append(fields, tag.FieldColor)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tag.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, tag.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tag.FieldName:
+		return m.Name()
+	case tag.FieldColor:
+		return m.Color()
+	case tag.FieldCreatedAt:
+		return m.CreatedAt()
+	case tag.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tag.FieldName:
+		return m.OldName(ctx)
+	case tag.FieldColor:
+		return m.OldColor(ctx)
+	case tag.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case tag.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tag.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tag.FieldColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColor(v)
+		return nil
+	case tag.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case tag.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TagMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TagMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tag.FieldColor) {
+		fields = append(fields, tag.FieldColor)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TagMutation) ClearField(name string) error {
+	switch name {
+	case tag.FieldColor:
+		m.ClearColor()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TagMutation) ResetField(name string) error {
+	switch name {
+	case tag.FieldName:
+		m.ResetName()
+		return nil
+	case tag.FieldColor:
+		m.ResetColor()
+		return nil
+	case tag.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case tag.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, tag.EdgeUser)
+	}
+	if m.histories != nil {
+		edges = append(edges, tag.EdgeHistories)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case tag.EdgeHistories:
+		ids := make([]ent.Value, 0, len(m.histories))
+		for id := range m.histories {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedhistories != nil {
+		edges = append(edges, tag.EdgeHistories)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeHistories:
+		ids := make([]ent.Value, 0, len(m.removedhistories))
+		for id := range m.removedhistories {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, tag.EdgeUser)
+	}
+	if m.clearedhistories {
+		edges = append(edges, tag.EdgeHistories)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tag.EdgeUser:
+		return m.cleareduser
+	case tag.EdgeHistories:
+		return m.clearedhistories
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TagMutation) ClearEdge(name string) error {
+	switch name {
+	case tag.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TagMutation) ResetEdge(name string) error {
+	switch name {
+	case tag.EdgeUser:
+		m.ResetUser()
+		return nil
+	case tag.EdgeHistories:
+		m.ResetHistories()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag edge %s", name)
+}
+
+// TemplateMutation represents an operation that mutates the Template nodes in the graph.
+type TemplateMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	text          *string
+	format        *template.Format
+	language      *string
+	currency      *string
+	preset_id     *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*Template, error)
+	predicates    []predicate.Template
+}
+
+var _ ent.Mutation = (*TemplateMutation)(nil)
+
+// templateOption allows management of the mutation configuration using functional options.
+type templateOption func(*TemplateMutation)
+
+// newTemplateMutation creates new mutation for the Template entity.
+func newTemplateMutation(c config, op Op, opts ...templateOption) *TemplateMutation {
+	m := &TemplateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTemplate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTemplateID sets the ID field of the mutation.
+func withTemplateID(id uuid.UUID) templateOption {
+	return func(m *TemplateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Template
+		)
+		m.oldValue = func(ctx context.Context) (*Template, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Template.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTemplate sets the old Template of the mutation.
+func withTemplate(node *Template) templateOption {
+	return func(m *TemplateMutation) {
+		m.oldValue = func(context.Context) (*Template, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TemplateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TemplateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Template entities.
+func (m *TemplateMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TemplateMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TemplateMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Template.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TemplateMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TemplateMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TemplateMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TemplateMutation) ResetName() {
+	m.name = nil
+}
+
+// SetText sets the "text" field.
+func (m *TemplateMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *TemplateMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TemplateMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *TemplateMutation) ResetText() {
+	m.text = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *TemplateMutation) SetFormat(t template.Format) {
+	m.format = &t
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *TemplateMutation) Format() (r template.Format, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TemplateMutation) OldFormat(ctx context.Context) (v template.Format, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *TemplateMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetLanguage sets the "language" field.
+func (m *TemplateMutation) SetLanguage(s string) {
+	m.language = &s
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *TemplateMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TemplateMutation) OldLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *TemplateMutation) ResetLanguage() {
+	m.language = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *TemplateMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *TemplateMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TemplateMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *TemplateMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetPresetID sets the "preset_id" field.
+func (m *TemplateMutation) SetPresetID(u uuid.UUID) {
+	m.preset_id = &u
+}
+
+// PresetID returns the value of the "preset_id" field in the mutation.
+func (m *TemplateMutation) PresetID() (r uuid.UUID, exists bool) {
+	v := m.preset_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPresetID returns the old "preset_id" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TemplateMutation) OldPresetID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPresetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPresetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPresetID: %w", err)
+	}
+	return oldValue.PresetID, nil
+}
+
+// ClearPresetID clears the value of the "preset_id" field.
+func (m *TemplateMutation) ClearPresetID() {
+	m.preset_id = nil
+	m.clearedFields[template.FieldPresetID] = struct{}{}
+}
+
+// PresetIDCleared returns if the "preset_id" field was cleared in this mutation.
+func (m *TemplateMutation) PresetIDCleared() bool {
+	_, ok := m.clearedFields[template.FieldPresetID]
+	return ok
+}
+
+// ResetPresetID resets all changes to the "preset_id" field.
+func (m *TemplateMutation) ResetPresetID() {
+	m.preset_id = nil
+	delete(m.clearedFields, template.FieldPresetID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TemplateMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TemplateMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TemplateMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TemplateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TemplateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TemplateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TemplateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *TemplateMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *TemplateMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *TemplateMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *TemplateMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *TemplateMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *TemplateMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the TemplateMutation builder.
+func (m *TemplateMutation) Where(ps ...predicate.Template) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TemplateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TemplateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Template, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TemplateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TemplateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Template).
+func (m *TemplateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TemplateMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, template.FieldName)
+	}
+	if m.text != nil {
+		fields = append(fields, template.FieldText)
+	}
+	if m.format != nil {
+		fields = append(fields, template.FieldFormat)
+	}
+	if m.language != nil {
+		fields = append(fields, template.FieldLanguage)
+	}
+	if m.currency != nil {
+		fields = append(fields, template.FieldCurrency)
+	}
+	if m.preset_id != nil {
+		fields = append(fields, template.FieldPresetID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, template.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, template.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TemplateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case template.FieldName:
+		return m.Name()
+	case template.FieldText:
+		return m.Text()
+	case template.FieldFormat:
+		return m.Format()
+	case template.FieldLanguage:
+		return m.Language()
+	case template.FieldCurrency:
+		return m.Currency()
+	case template.FieldPresetID:
+		return m.PresetID()
+	case template.FieldCreatedAt:
+		return m.CreatedAt()
+	case template.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case template.FieldName:
+		return m.OldName(ctx)
+	case template.FieldText:
+		return m.OldText(ctx)
+	case template.FieldFormat:
+		return m.OldFormat(ctx)
+	case template.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case template.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case template.FieldPresetID:
+		return m.OldPresetID(ctx)
+	case template.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case template.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Template field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TemplateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case template.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case template.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case template.FieldFormat:
+		v, ok := value.(template.Format)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case template.FieldLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguage(v)
+		return nil
+	case template.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case template.FieldPresetID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPresetID(v)
+		return nil
+	case template.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case template.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Template field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TemplateMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TemplateMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TemplateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Template numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TemplateMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(template.FieldPresetID) {
+		fields = append(fields, template.FieldPresetID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TemplateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TemplateMutation) ClearField(name string) error {
+	switch name {
+	case template.FieldPresetID:
+		m.ClearPresetID()
+		return nil
+	}
+	return fmt.Errorf("unknown Template nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TemplateMutation) ResetField(name string) error {
+	switch name {
+	case template.FieldName:
+		m.ResetName()
+		return nil
+	case template.FieldText:
+		m.ResetText()
+		return nil
+	case template.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case template.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case template.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case template.FieldPresetID:
+		m.ResetPresetID()
+		return nil
+	case template.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case template.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Template field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TemplateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, template.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TemplateMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case template.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TemplateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TemplateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TemplateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, template.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TemplateMutation) EdgeCleared(name string) bool {
+	switch name {
+	case template.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TemplateMutation) ClearEdge(name string) error {
+	switch name {
+	case template.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Template unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TemplateMutation) ResetEdge(name string) error {
+	switch name {
+	case template.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Template edge %s", name)
+}
+
+// UsageEntryMutation represents an operation that mutates the UsageEntry nodes in the graph.
+type UsageEntryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	kind          *usageentry.Kind
+	characters    *int
+	addcharacters *int
+	entries       *int
+	addentries    *int
+	history_id    *uuid.UUID
+	period_start  *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*UsageEntry, error)
+	predicates    []predicate.UsageEntry
+}
+
+var _ ent.Mutation = (*UsageEntryMutation)(nil)
+
+// usageentryOption allows management of the mutation configuration using functional options.
+type usageentryOption func(*UsageEntryMutation)
+
+// newUsageEntryMutation creates new mutation for the UsageEntry entity.
+func newUsageEntryMutation(c config, op Op, opts ...usageentryOption) *UsageEntryMutation {
+	m := &UsageEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUsageEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUsageEntryID sets the ID field of the mutation.
+func withUsageEntryID(id uuid.UUID) usageentryOption {
+	return func(m *UsageEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UsageEntry
+		)
+		m.oldValue = func(ctx context.Context) (*UsageEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UsageEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUsageEntry sets the old UsageEntry of the mutation.
+func withUsageEntry(node *UsageEntry) usageentryOption {
+	return func(m *UsageEntryMutation) {
+		m.oldValue = func(context.Context) (*UsageEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UsageEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UsageEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of UsageEntry entities.
+func (m *UsageEntryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UsageEntryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UsageEntryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UsageEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKind sets the "kind" field.
+func (m *UsageEntryMutation) SetKind(u usageentry.Kind) {
+	m.kind = &u
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *UsageEntryMutation) Kind() (r usageentry.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the UsageEntry entity.
+// If the UsageEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageEntryMutation) OldKind(ctx context.Context) (v usageentry.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *UsageEntryMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetCharacters sets the "characters" field.
+func (m *UsageEntryMutation) SetCharacters(i int) {
+	m.characters = &i
+	m.addcharacters = nil
+}
+
+// Characters returns the value of the "characters" field in the mutation.
+func (m *UsageEntryMutation) Characters() (r int, exists bool) {
+	v := m.characters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCharacters returns the old "characters" field's value of the UsageEntry entity.
+// If the UsageEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageEntryMutation) OldCharacters(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCharacters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCharacters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCharacters: %w", err)
+	}
+	return oldValue.Characters, nil
+}
+
+// AddCharacters adds i to the "characters" field.
+func (m *UsageEntryMutation) AddCharacters(i int) {
+	if m.addcharacters != nil {
+		*m.addcharacters += i
+	} else {
+		m.addcharacters = &i
+	}
+}
+
+// AddedCharacters returns the value that was added to the "characters" field in this mutation.
+func (m *UsageEntryMutation) AddedCharacters() (r int, exists bool) {
+	v := m.addcharacters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCharacters resets all changes to the "characters" field.
+func (m *UsageEntryMutation) ResetCharacters() {
+	m.characters = nil
+	m.addcharacters = nil
+}
+
+// SetEntries sets the "entries" field.
+func (m *UsageEntryMutation) SetEntries(i int) {
+	m.entries = &i
+	m.addentries = nil
+}
+
+// Entries returns the value of the "entries" field in the mutation.
+func (m *UsageEntryMutation) Entries() (r int, exists bool) {
+	v := m.entries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntries returns the old "entries" field's value of the UsageEntry entity.
+// If the UsageEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageEntryMutation) OldEntries(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntries is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntries requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntries: %w", err)
+	}
+	return oldValue.Entries, nil
+}
+
+// AddEntries adds i to the "entries" field.
+func (m *UsageEntryMutation) AddEntries(i int) {
+	if m.addentries != nil {
+		*m.addentries += i
+	} else {
+		m.addentries = &i
+	}
+}
+
+// AddedEntries returns the value that was added to the "entries" field in this mutation.
+func (m *UsageEntryMutation) AddedEntries() (r int, exists bool) {
+	v := m.addentries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEntries resets all changes to the "entries" field.
+func (m *UsageEntryMutation) ResetEntries() {
+	m.entries = nil
+	m.addentries = nil
+}
+
+// SetHistoryID sets the "history_id" field.
+func (m *UsageEntryMutation) SetHistoryID(u uuid.UUID) {
+	m.history_id = &u
+}
+
+// HistoryID returns the value of the "history_id" field in the mutation.
+func (m *UsageEntryMutation) HistoryID() (r uuid.UUID, exists bool) {
+	v := m.history_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHistoryID returns the old "history_id" field's value of the UsageEntry entity.
+// If the UsageEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageEntryMutation) OldHistoryID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHistoryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHistoryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHistoryID: %w", err)
+	}
+	return oldValue.HistoryID, nil
+}
+
+// ClearHistoryID clears the value of the "history_id" field.
+func (m *UsageEntryMutation) ClearHistoryID() {
+	m.history_id = nil
+	m.clearedFields[usageentry.FieldHistoryID] = struct{}{}
+}
+
+// HistoryIDCleared returns if the "history_id" field was cleared in this mutation.
+func (m *UsageEntryMutation) HistoryIDCleared() bool {
+	_, ok := m.clearedFields[usageentry.FieldHistoryID]
+	return ok
+}
+
+// ResetHistoryID resets all changes to the "history_id" field.
+func (m *UsageEntryMutation) ResetHistoryID() {
+	m.history_id = nil
+	delete(m.clearedFields, usageentry.FieldHistoryID)
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (m *UsageEntryMutation) SetPeriodStart(t time.Time) {
+	m.period_start = &t
+}
+
+// PeriodStart returns the value of the "period_start" field in the mutation.
+func (m *UsageEntryMutation) PeriodStart() (r time.Time, exists bool) {
+	v := m.period_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeriodStart returns the old "period_start" field's value of the UsageEntry entity.
+// If the UsageEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageEntryMutation) OldPeriodStart(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeriodStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeriodStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeriodStart: %w", err)
+	}
+	return oldValue.PeriodStart, nil
+}
+
+// ResetPeriodStart resets all changes to the "period_start" field.
+func (m *UsageEntryMutation) ResetPeriodStart() {
+	m.period_start = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UsageEntryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UsageEntryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the UsageEntry entity.
+// If the UsageEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UsageEntryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *UsageEntryMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *UsageEntryMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UsageEntryMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *UsageEntryMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UsageEntryMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *UsageEntryMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the UsageEntryMutation builder.
+func (m *UsageEntryMutation) Where(ps ...predicate.UsageEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UsageEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UsageEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UsageEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UsageEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UsageEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (UsageEntry).
+func (m *UsageEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UsageEntryMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.kind != nil {
+		fields = append(fields, usageentry.FieldKind)
+	}
+	if m.characters != nil {
+		fields = append(fields, usageentry.FieldCharacters)
+	}
+	if m.entries != nil {
+		fields = append(fields, usageentry.FieldEntries)
+	}
+	if m.history_id != nil {
+		fields = append(fields, usageentry.FieldHistoryID)
+	}
+	if m.period_start != nil {
+		fields = append(fields, usageentry.FieldPeriodStart)
+	}
+	if m.created_at != nil {
+		fields = append(fields, usageentry.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UsageEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case usageentry.FieldKind:
+		return m.Kind()
+	case usageentry.FieldCharacters:
+		return m.Characters()
+	case usageentry.FieldEntries:
+		return m.Entries()
+	case usageentry.FieldHistoryID:
+		return m.HistoryID()
+	case usageentry.FieldPeriodStart:
+		return m.PeriodStart()
+	case usageentry.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UsageEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case usageentry.FieldKind:
+		return m.OldKind(ctx)
+	case usageentry.FieldCharacters:
+		return m.OldCharacters(ctx)
+	case usageentry.FieldEntries:
+		return m.OldEntries(ctx)
+	case usageentry.FieldHistoryID:
+		return m.OldHistoryID(ctx)
+	case usageentry.FieldPeriodStart:
+		return m.OldPeriodStart(ctx)
+	case usageentry.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown UsageEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UsageEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case usageentry.FieldKind:
+		v, ok := value.(usageentry.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case usageentry.FieldCharacters:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCharacters(v)
+		return nil
+	case usageentry.FieldEntries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntries(v)
+		return nil
+	case usageentry.FieldHistoryID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHistoryID(v)
+		return nil
+	case usageentry.FieldPeriodStart:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeriodStart(v)
+		return nil
+	case usageentry.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UsageEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UsageEntryMutation) AddedFields() []string {
+	var fields []string
+	if m.addcharacters != nil {
+		fields = append(fields, usageentry.FieldCharacters)
+	}
+	if m.addentries != nil {
+		fields = append(fields, usageentry.FieldEntries)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UsageEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case usageentry.FieldCharacters:
+		return m.AddedCharacters()
+	case usageentry.FieldEntries:
+		return m.AddedEntries()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UsageEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case usageentry.FieldCharacters:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCharacters(v)
+		return nil
+	case usageentry.FieldEntries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEntries(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UsageEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UsageEntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(usageentry.FieldHistoryID) {
+		fields = append(fields, usageentry.FieldHistoryID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UsageEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UsageEntryMutation) ClearField(name string) error {
+	switch name {
+	case usageentry.FieldHistoryID:
+		m.ClearHistoryID()
+		return nil
+	}
+	return fmt.Errorf("unknown UsageEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UsageEntryMutation) ResetField(name string) error {
+	switch name {
+	case usageentry.FieldKind:
+		m.ResetKind()
+		return nil
+	case usageentry.FieldCharacters:
+		m.ResetCharacters()
+		return nil
+	case usageentry.FieldEntries:
+		m.ResetEntries()
+		return nil
+	case usageentry.FieldHistoryID:
+		m.ResetHistoryID()
+		return nil
+	case usageentry.FieldPeriodStart:
+		m.ResetPeriodStart()
+		return nil
+	case usageentry.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown UsageEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UsageEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, usageentry.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UsageEntryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case usageentry.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UsageEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UsageEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UsageEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, usageentry.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UsageEntryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case usageentry.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UsageEntryMutation) ClearEdge(name string) error {
+	switch name {
+	case usageentry.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown UsageEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UsageEntryMutation) ResetEdge(name string) error {
+	switch name {
+	case usageentry.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown UsageEntry edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	name                      *string
+	email                     *string
+	password                  *string
+	created_at                *time.Time
+	updated_at                *time.Time
+	clearedFields             map[string]struct{}
+	histories                 map[uuid.UUID]struct{}
+	removedhistories          map[uuid.UUID]struct{}
+	clearedhistories          bool
+	idempotency_keys          map[uuid.UUID]struct{}
+	removedidempotency_keys   map[uuid.UUID]struct{}
+	clearedidempotency_keys   bool
+	tags                      map[uuid.UUID]struct{}
+	removedtags               map[uuid.UUID]struct{}
+	clearedtags               bool
+	folders                   map[uuid.UUID]struct{}
+	removedfolders            map[uuid.UUID]struct{}
+	clearedfolders            bool
+	voice_presets             map[uuid.UUID]struct{}
+	removedvoice_presets      map[uuid.UUID]struct{}
+	clearedvoice_presets      bool
+	preference                *uuid.UUID
+	clearedpreference         bool
+	usage                     *uuid.UUID
+	clearedusage              bool
+	usage_entries             map[uuid.UUID]struct{}
+	removedusage_entries      map[uuid.UUID]struct{}
+	clearedusage_entries      bool
+	play_events               map[uuid.UUID]struct{}
+	removedplay_events        map[uuid.UUID]struct{}
+	clearedplay_events        bool
+	lexicon_entries           map[uuid.UUID]struct{}
+	removedlexicon_entries    map[uuid.UUID]struct{}
+	clearedlexicon_entries    bool
+	templates                 map[uuid.UUID]struct{}
+	removedtemplates          map[uuid.UUID]struct{}
+	clearedtemplates          bool
+	moderation_rules          map[uuid.UUID]struct{}
+	removedmoderation_rules   map[uuid.UUID]struct{}
+	clearedmoderation_rules   bool
+	moderation_setting        *uuid.UUID
+	clearedmoderation_setting bool
+	plan                      *string
+	clearedplan               bool
+	done                      bool
+	oldValue                  func(context.Context) (*User, error)
+	predicates                []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddHistoryIDs adds the "histories" edge to the History entity by ids.
+func (m *UserMutation) AddHistoryIDs(ids ...uuid.UUID) {
+	if m.histories == nil {
+		m.histories = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.histories[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHistories clears the "histories" edge to the History entity.
+func (m *UserMutation) ClearHistories() {
+	m.clearedhistories = true
+}
+
+// HistoriesCleared reports if the "histories" edge to the History entity was cleared.
+func (m *UserMutation) HistoriesCleared() bool {
+	return m.clearedhistories
+}
+
+// RemoveHistoryIDs removes the "histories" edge to the History entity by IDs.
+func (m *UserMutation) RemoveHistoryIDs(ids ...uuid.UUID) {
+	if m.removedhistories == nil {
+		m.removedhistories = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.histories, ids[i])
+		m.removedhistories[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHistories returns the removed IDs of the "histories" edge to the History entity.
+func (m *UserMutation) RemovedHistoriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedhistories {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HistoriesIDs returns the "histories" edge IDs in the mutation.
+func (m *UserMutation) HistoriesIDs() (ids []uuid.UUID) {
+	for id := range m.histories {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHistories resets all changes to the "histories" edge.
+func (m *UserMutation) ResetHistories() {
+	m.histories = nil
+	m.clearedhistories = false
+	m.removedhistories = nil
+}
+
+// AddIdempotencyKeyIDs adds the "idempotency_keys" edge to the IdempotencyKey entity by ids.
+func (m *UserMutation) AddIdempotencyKeyIDs(ids ...uuid.UUID) {
+	if m.idempotency_keys == nil {
+		m.idempotency_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.idempotency_keys[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIdempotencyKeys clears the "idempotency_keys" edge to the IdempotencyKey entity.
+func (m *UserMutation) ClearIdempotencyKeys() {
+	m.clearedidempotency_keys = true
+}
+
+// IdempotencyKeysCleared reports if the "idempotency_keys" edge to the IdempotencyKey entity was cleared.
+func (m *UserMutation) IdempotencyKeysCleared() bool {
+	return m.clearedidempotency_keys
+}
+
+// RemoveIdempotencyKeyIDs removes the "idempotency_keys" edge to the IdempotencyKey entity by IDs.
+func (m *UserMutation) RemoveIdempotencyKeyIDs(ids ...uuid.UUID) {
+	if m.removedidempotency_keys == nil {
+		m.removedidempotency_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.idempotency_keys, ids[i])
+		m.removedidempotency_keys[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIdempotencyKeys returns the removed IDs of the "idempotency_keys" edge to the IdempotencyKey entity.
+func (m *UserMutation) RemovedIdempotencyKeysIDs() (ids []uuid.UUID) {
+	for id := range m.removedidempotency_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IdempotencyKeysIDs returns the "idempotency_keys" edge IDs in the mutation.
+func (m *UserMutation) IdempotencyKeysIDs() (ids []uuid.UUID) {
+	for id := range m.idempotency_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIdempotencyKeys resets all changes to the "idempotency_keys" edge.
+func (m *UserMutation) ResetIdempotencyKeys() {
+	m.idempotency_keys = nil
+	m.clearedidempotency_keys = false
+	m.removedidempotency_keys = nil
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *UserMutation) AddTagIDs(ids ...uuid.UUID) {
+	if m.tags == nil {
+		m.tags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *UserMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *UserMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *UserMutation) RemoveTagIDs(ids ...uuid.UUID) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *UserMutation) RemovedTagsIDs() (ids []uuid.UUID) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *UserMutation) TagsIDs() (ids []uuid.UUID) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *UserMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddFolderIDs adds the "folders" edge to the Folder entity by ids.
+func (m *UserMutation) AddFolderIDs(ids ...uuid.UUID) {
+	if m.folders == nil {
+		m.folders = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.folders[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFolders clears the "folders" edge to the Folder entity.
+func (m *UserMutation) ClearFolders() {
+	m.clearedfolders = true
+}
+
+// FoldersCleared reports if the "folders" edge to the Folder entity was cleared.
+func (m *UserMutation) FoldersCleared() bool {
+	return m.clearedfolders
+}
+
+// RemoveFolderIDs removes the "folders" edge to the Folder entity by IDs.
+func (m *UserMutation) RemoveFolderIDs(ids ...uuid.UUID) {
+	if m.removedfolders == nil {
+		m.removedfolders = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.folders, ids[i])
+		m.removedfolders[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFolders returns the removed IDs of the "folders" edge to the Folder entity.
+func (m *UserMutation) RemovedFoldersIDs() (ids []uuid.UUID) {
+	for id := range m.removedfolders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FoldersIDs returns the "folders" edge IDs in the mutation.
+func (m *UserMutation) FoldersIDs() (ids []uuid.UUID) {
+	for id := range m.folders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFolders resets all changes to the "folders" edge.
+func (m *UserMutation) ResetFolders() {
+	m.folders = nil
+	m.clearedfolders = false
+	m.removedfolders = nil
+}
+
+// AddVoicePresetIDs adds the "voice_presets" edge to the VoicePreset entity by ids.
+func (m *UserMutation) AddVoicePresetIDs(ids ...uuid.UUID) {
+	if m.voice_presets == nil {
+		m.voice_presets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.voice_presets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVoicePresets clears the "voice_presets" edge to the VoicePreset entity.
+func (m *UserMutation) ClearVoicePresets() {
+	m.clearedvoice_presets = true
+}
+
+// VoicePresetsCleared reports if the "voice_presets" edge to the VoicePreset entity was cleared.
+func (m *UserMutation) VoicePresetsCleared() bool {
+	return m.clearedvoice_presets
+}
+
+// RemoveVoicePresetIDs removes the "voice_presets" edge to the VoicePreset entity by IDs.
+func (m *UserMutation) RemoveVoicePresetIDs(ids ...uuid.UUID) {
+	if m.removedvoice_presets == nil {
+		m.removedvoice_presets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.voice_presets, ids[i])
+		m.removedvoice_presets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVoicePresets returns the removed IDs of the "voice_presets" edge to the VoicePreset entity.
+func (m *UserMutation) RemovedVoicePresetsIDs() (ids []uuid.UUID) {
+	for id := range m.removedvoice_presets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VoicePresetsIDs returns the "voice_presets" edge IDs in the mutation.
+func (m *UserMutation) VoicePresetsIDs() (ids []uuid.UUID) {
+	for id := range m.voice_presets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVoicePresets resets all changes to the "voice_presets" edge.
+func (m *UserMutation) ResetVoicePresets() {
+	m.voice_presets = nil
+	m.clearedvoice_presets = false
+	m.removedvoice_presets = nil
+}
+
+// SetPreferenceID sets the "preference" edge to the UserPreference entity by id.
+func (m *UserMutation) SetPreferenceID(id uuid.UUID) {
+	m.preference = &id
+}
+
+// ClearPreference clears the "preference" edge to the UserPreference entity.
+func (m *UserMutation) ClearPreference() {
+	m.clearedpreference = true
+}
+
+// PreferenceCleared reports if the "preference" edge to the UserPreference entity was cleared.
+func (m *UserMutation) PreferenceCleared() bool {
+	return m.clearedpreference
+}
+
+// PreferenceID returns the "preference" edge ID in the mutation.
+func (m *UserMutation) PreferenceID() (id uuid.UUID, exists bool) {
+	if m.preference != nil {
+		return *m.preference, true
+	}
+	return
+}
+
+// PreferenceIDs returns the "preference" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PreferenceID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) PreferenceIDs() (ids []uuid.UUID) {
+	if id := m.preference; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPreference resets all changes to the "preference" edge.
+func (m *UserMutation) ResetPreference() {
+	m.preference = nil
+	m.clearedpreference = false
+}
+
+// SetUsageID sets the "usage" edge to the UserUsage entity by id.
+func (m *UserMutation) SetUsageID(id uuid.UUID) {
+	m.usage = &id
+}
+
+// ClearUsage clears the "usage" edge to the UserUsage entity.
+func (m *UserMutation) ClearUsage() {
+	m.clearedusage = true
+}
+
+// UsageCleared reports if the "usage" edge to the UserUsage entity was cleared.
+func (m *UserMutation) UsageCleared() bool {
+	return m.clearedusage
+}
+
+// UsageID returns the "usage" edge ID in the mutation.
+func (m *UserMutation) UsageID() (id uuid.UUID, exists bool) {
+	if m.usage != nil {
+		return *m.usage, true
+	}
+	return
+}
+
+// UsageIDs returns the "usage" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UsageID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) UsageIDs() (ids []uuid.UUID) {
+	if id := m.usage; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUsage resets all changes to the "usage" edge.
+func (m *UserMutation) ResetUsage() {
+	m.usage = nil
+	m.clearedusage = false
+}
+
+// AddUsageEntryIDs adds the "usage_entries" edge to the UsageEntry entity by ids.
+func (m *UserMutation) AddUsageEntryIDs(ids ...uuid.UUID) {
+	if m.usage_entries == nil {
+		m.usage_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.usage_entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsageEntries clears the "usage_entries" edge to the UsageEntry entity.
+func (m *UserMutation) ClearUsageEntries() {
+	m.clearedusage_entries = true
+}
+
+// UsageEntriesCleared reports if the "usage_entries" edge to the UsageEntry entity was cleared.
+func (m *UserMutation) UsageEntriesCleared() bool {
+	return m.clearedusage_entries
+}
+
+// RemoveUsageEntryIDs removes the "usage_entries" edge to the UsageEntry entity by IDs.
+func (m *UserMutation) RemoveUsageEntryIDs(ids ...uuid.UUID) {
+	if m.removedusage_entries == nil {
+		m.removedusage_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.usage_entries, ids[i])
+		m.removedusage_entries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsageEntries returns the removed IDs of the "usage_entries" edge to the UsageEntry entity.
+func (m *UserMutation) RemovedUsageEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedusage_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsageEntriesIDs returns the "usage_entries" edge IDs in the mutation.
+func (m *UserMutation) UsageEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.usage_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsageEntries resets all changes to the "usage_entries" edge.
+func (m *UserMutation) ResetUsageEntries() {
+	m.usage_entries = nil
+	m.clearedusage_entries = false
+	m.removedusage_entries = nil
+}
+
+// AddPlayEventIDs adds the "play_events" edge to the PlayEvent entity by ids.
+func (m *UserMutation) AddPlayEventIDs(ids ...uuid.UUID) {
+	if m.play_events == nil {
+		m.play_events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.play_events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPlayEvents clears the "play_events" edge to the PlayEvent entity.
+func (m *UserMutation) ClearPlayEvents() {
+	m.clearedplay_events = true
+}
+
+// PlayEventsCleared reports if the "play_events" edge to the PlayEvent entity was cleared.
+func (m *UserMutation) PlayEventsCleared() bool {
+	return m.clearedplay_events
+}
+
+// RemovePlayEventIDs removes the "play_events" edge to the PlayEvent entity by IDs.
+func (m *UserMutation) RemovePlayEventIDs(ids ...uuid.UUID) {
+	if m.removedplay_events == nil {
+		m.removedplay_events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.play_events, ids[i])
+		m.removedplay_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPlayEvents returns the removed IDs of the "play_events" edge to the PlayEvent entity.
+func (m *UserMutation) RemovedPlayEventsIDs() (ids []uuid.UUID) {
+	for id := range m.removedplay_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PlayEventsIDs returns the "play_events" edge IDs in the mutation.
+func (m *UserMutation) PlayEventsIDs() (ids []uuid.UUID) {
+	for id := range m.play_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPlayEvents resets all changes to the "play_events" edge.
+func (m *UserMutation) ResetPlayEvents() {
+	m.play_events = nil
+	m.clearedplay_events = false
+	m.removedplay_events = nil
+}
+
+// AddLexiconEntryIDs adds the "lexicon_entries" edge to the LexiconEntry entity by ids.
+func (m *UserMutation) AddLexiconEntryIDs(ids ...uuid.UUID) {
+	if m.lexicon_entries == nil {
+		m.lexicon_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.lexicon_entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLexiconEntries clears the "lexicon_entries" edge to the LexiconEntry entity.
+func (m *UserMutation) ClearLexiconEntries() {
+	m.clearedlexicon_entries = true
+}
+
+// LexiconEntriesCleared reports if the "lexicon_entries" edge to the LexiconEntry entity was cleared.
+func (m *UserMutation) LexiconEntriesCleared() bool {
+	return m.clearedlexicon_entries
+}
+
+// RemoveLexiconEntryIDs removes the "lexicon_entries" edge to the LexiconEntry entity by IDs.
+func (m *UserMutation) RemoveLexiconEntryIDs(ids ...uuid.UUID) {
+	if m.removedlexicon_entries == nil {
+		m.removedlexicon_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.lexicon_entries, ids[i])
+		m.removedlexicon_entries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLexiconEntries returns the removed IDs of the "lexicon_entries" edge to the LexiconEntry entity.
+func (m *UserMutation) RemovedLexiconEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedlexicon_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LexiconEntriesIDs returns the "lexicon_entries" edge IDs in the mutation.
+func (m *UserMutation) LexiconEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.lexicon_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLexiconEntries resets all changes to the "lexicon_entries" edge.
+func (m *UserMutation) ResetLexiconEntries() {
+	m.lexicon_entries = nil
+	m.clearedlexicon_entries = false
+	m.removedlexicon_entries = nil
+}
+
+// AddTemplateIDs adds the "templates" edge to the Template entity by ids.
+func (m *UserMutation) AddTemplateIDs(ids ...uuid.UUID) {
+	if m.templates == nil {
+		m.templates = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.templates[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTemplates clears the "templates" edge to the Template entity.
+func (m *UserMutation) ClearTemplates() {
+	m.clearedtemplates = true
+}
+
+// TemplatesCleared reports if the "templates" edge to the Template entity was cleared.
+func (m *UserMutation) TemplatesCleared() bool {
+	return m.clearedtemplates
+}
+
+// RemoveTemplateIDs removes the "templates" edge to the Template entity by IDs.
+func (m *UserMutation) RemoveTemplateIDs(ids ...uuid.UUID) {
+	if m.removedtemplates == nil {
+		m.removedtemplates = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.templates, ids[i])
+		m.removedtemplates[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTemplates returns the removed IDs of the "templates" edge to the Template entity.
+func (m *UserMutation) RemovedTemplatesIDs() (ids []uuid.UUID) {
+	for id := range m.removedtemplates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TemplatesIDs returns the "templates" edge IDs in the mutation.
+func (m *UserMutation) TemplatesIDs() (ids []uuid.UUID) {
+	for id := range m.templates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTemplates resets all changes to the "templates" edge.
+func (m *UserMutation) ResetTemplates() {
+	m.templates = nil
+	m.clearedtemplates = false
+	m.removedtemplates = nil
+}
+
+// AddModerationRuleIDs adds the "moderation_rules" edge to the ModerationRule entity by ids.
+func (m *UserMutation) AddModerationRuleIDs(ids ...uuid.UUID) {
+	if m.moderation_rules == nil {
+		m.moderation_rules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.moderation_rules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearModerationRules clears the "moderation_rules" edge to the ModerationRule entity.
+func (m *UserMutation) ClearModerationRules() {
+	m.clearedmoderation_rules = true
+}
+
+// ModerationRulesCleared reports if the "moderation_rules" edge to the ModerationRule entity was cleared.
+func (m *UserMutation) ModerationRulesCleared() bool {
+	return m.clearedmoderation_rules
+}
+
+// RemoveModerationRuleIDs removes the "moderation_rules" edge to the ModerationRule entity by IDs.
+func (m *UserMutation) RemoveModerationRuleIDs(ids ...uuid.UUID) {
+	if m.removedmoderation_rules == nil {
+		m.removedmoderation_rules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.moderation_rules, ids[i])
+		m.removedmoderation_rules[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedModerationRules returns the removed IDs of the "moderation_rules" edge to the ModerationRule entity.
+func (m *UserMutation) RemovedModerationRulesIDs() (ids []uuid.UUID) {
+	for id := range m.removedmoderation_rules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ModerationRulesIDs returns the "moderation_rules" edge IDs in the mutation.
+func (m *UserMutation) ModerationRulesIDs() (ids []uuid.UUID) {
+	for id := range m.moderation_rules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetModerationRules resets all changes to the "moderation_rules" edge.
+func (m *UserMutation) ResetModerationRules() {
+	m.moderation_rules = nil
+	m.clearedmoderation_rules = false
+	m.removedmoderation_rules = nil
+}
+
+// SetModerationSettingID sets the "moderation_setting" edge to the ModerationSetting entity by id.
+func (m *UserMutation) SetModerationSettingID(id uuid.UUID) {
+	m.moderation_setting = &id
+}
+
+// ClearModerationSetting clears the "moderation_setting" edge to the ModerationSetting entity.
+func (m *UserMutation) ClearModerationSetting() {
+	m.clearedmoderation_setting = true
+}
+
+// ModerationSettingCleared reports if the "moderation_setting" edge to the ModerationSetting entity was cleared.
+func (m *UserMutation) ModerationSettingCleared() bool {
+	return m.clearedmoderation_setting
+}
+
+// ModerationSettingID returns the "moderation_setting" edge ID in the mutation.
+func (m *UserMutation) ModerationSettingID() (id uuid.UUID, exists bool) {
+	if m.moderation_setting != nil {
+		return *m.moderation_setting, true
+	}
+	return
+}
+
+// ModerationSettingIDs returns the "moderation_setting" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ModerationSettingID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) ModerationSettingIDs() (ids []uuid.UUID) {
+	if id := m.moderation_setting; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetModerationSetting resets all changes to the "moderation_setting" edge.
+func (m *UserMutation) ResetModerationSetting() {
+	m.moderation_setting = nil
+	m.clearedmoderation_setting = false
+}
+
+// SetPlanID sets the "plan" edge to the Plan entity by id.
+func (m *UserMutation) SetPlanID(id string) {
+	m.plan = &id
+}
+
+// ClearPlan clears the "plan" edge to the Plan entity.
+func (m *UserMutation) ClearPlan() {
+	m.clearedplan = true
+}
+
+// PlanCleared reports if the "plan" edge to the Plan entity was cleared.
+func (m *UserMutation) PlanCleared() bool {
+	return m.clearedplan
+}
+
+// PlanID returns the "plan" edge ID in the mutation.
+func (m *UserMutation) PlanID() (id string, exists bool) {
+	if m.plan != nil {
+		return *m.plan, true
+	}
+	return
+}
+
+// PlanIDs returns the "plan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlanID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) PlanIDs() (ids []string) {
+	if id := m.plan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPlan resets all changes to the "plan" edge.
+func (m *UserMutation) ResetPlan() {
+	m.plan = nil
+	m.clearedplan = false
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, user.FieldName)
+	}
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldName:
+		return m.Name()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldName:
+		return m.OldName(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case user.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldName:
+		m.ResetName()
+		return nil
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case user.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 14)
+	if m.histories != nil {
+		edges = append(edges, user.EdgeHistories)
+	}
+	if m.idempotency_keys != nil {
+		edges = append(edges, user.EdgeIdempotencyKeys)
+	}
+	if m.tags != nil {
+		edges = append(edges, user.EdgeTags)
+	}
+	if m.folders != nil {
+		edges = append(edges, user.EdgeFolders)
+	}
+	if m.voice_presets != nil {
+		edges = append(edges, user.EdgeVoicePresets)
+	}
+	if m.preference != nil {
+		edges = append(edges, user.EdgePreference)
+	}
+	if m.usage != nil {
+		edges = append(edges, user.EdgeUsage)
+	}
+	if m.usage_entries != nil {
+		edges = append(edges, user.EdgeUsageEntries)
+	}
+	if m.play_events != nil {
+		edges = append(edges, user.EdgePlayEvents)
+	}
+	if m.lexicon_entries != nil {
+		edges = append(edges, user.EdgeLexiconEntries)
+	}
+	if m.templates != nil {
+		edges = append(edges, user.EdgeTemplates)
+	}
+	if m.moderation_rules != nil {
+		edges = append(edges, user.EdgeModerationRules)
+	}
+	if m.moderation_setting != nil {
+		edges = append(edges, user.EdgeModerationSetting)
+	}
+	if m.plan != nil {
+		edges = append(edges, user.EdgePlan)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeHistories:
+		ids := make([]ent.Value, 0, len(m.histories))
+		for id := range m.histories {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeIdempotencyKeys:
+		ids := make([]ent.Value, 0, len(m.idempotency_keys))
+		for id := range m.idempotency_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFolders:
+		ids := make([]ent.Value, 0, len(m.folders))
+		for id := range m.folders {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeVoicePresets:
+		ids := make([]ent.Value, 0, len(m.voice_presets))
+		for id := range m.voice_presets {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePreference:
+		if id := m.preference; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgeUsage:
+		if id := m.usage; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgeUsageEntries:
+		ids := make([]ent.Value, 0, len(m.usage_entries))
+		for id := range m.usage_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePlayEvents:
+		ids := make([]ent.Value, 0, len(m.play_events))
+		for id := range m.play_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLexiconEntries:
+		ids := make([]ent.Value, 0, len(m.lexicon_entries))
+		for id := range m.lexicon_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTemplates:
+		ids := make([]ent.Value, 0, len(m.templates))
+		for id := range m.templates {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeModerationRules:
+		ids := make([]ent.Value, 0, len(m.moderation_rules))
+		for id := range m.moderation_rules {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeModerationSetting:
+		if id := m.moderation_setting; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgePlan:
+		if id := m.plan; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 14)
+	if m.removedhistories != nil {
+		edges = append(edges, user.EdgeHistories)
+	}
+	if m.removedidempotency_keys != nil {
+		edges = append(edges, user.EdgeIdempotencyKeys)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, user.EdgeTags)
+	}
+	if m.removedfolders != nil {
+		edges = append(edges, user.EdgeFolders)
+	}
+	if m.removedvoice_presets != nil {
+		edges = append(edges, user.EdgeVoicePresets)
+	}
+	if m.removedusage_entries != nil {
+		edges = append(edges, user.EdgeUsageEntries)
+	}
+	if m.removedplay_events != nil {
+		edges = append(edges, user.EdgePlayEvents)
+	}
+	if m.removedlexicon_entries != nil {
+		edges = append(edges, user.EdgeLexiconEntries)
+	}
+	if m.removedtemplates != nil {
+		edges = append(edges, user.EdgeTemplates)
+	}
+	if m.removedmoderation_rules != nil {
+		edges = append(edges, user.EdgeModerationRules)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeHistories:
+		ids := make([]ent.Value, 0, len(m.removedhistories))
+		for id := range m.removedhistories {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeIdempotencyKeys:
+		ids := make([]ent.Value, 0, len(m.removedidempotency_keys))
+		for id := range m.removedidempotency_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFolders:
+		ids := make([]ent.Value, 0, len(m.removedfolders))
+		for id := range m.removedfolders {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeVoicePresets:
+		ids := make([]ent.Value, 0, len(m.removedvoice_presets))
+		for id := range m.removedvoice_presets {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUsageEntries:
+		ids := make([]ent.Value, 0, len(m.removedusage_entries))
+		for id := range m.removedusage_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePlayEvents:
+		ids := make([]ent.Value, 0, len(m.removedplay_events))
+		for id := range m.removedplay_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLexiconEntries:
+		ids := make([]ent.Value, 0, len(m.removedlexicon_entries))
+		for id := range m.removedlexicon_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTemplates:
+		ids := make([]ent.Value, 0, len(m.removedtemplates))
+		for id := range m.removedtemplates {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeModerationRules:
+		ids := make([]ent.Value, 0, len(m.removedmoderation_rules))
+		for id := range m.removedmoderation_rules {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 14)
+	if m.clearedhistories {
+		edges = append(edges, user.EdgeHistories)
+	}
+	if m.clearedidempotency_keys {
+		edges = append(edges, user.EdgeIdempotencyKeys)
+	}
+	if m.clearedtags {
+		edges = append(edges, user.EdgeTags)
+	}
+	if m.clearedfolders {
+		edges = append(edges, user.EdgeFolders)
+	}
+	if m.clearedvoice_presets {
+		edges = append(edges, user.EdgeVoicePresets)
+	}
+	if m.clearedpreference {
+		edges = append(edges, user.EdgePreference)
+	}
+	if m.clearedusage {
+		edges = append(edges, user.EdgeUsage)
+	}
+	if m.clearedusage_entries {
+		edges = append(edges, user.EdgeUsageEntries)
+	}
+	if m.clearedplay_events {
+		edges = append(edges, user.EdgePlayEvents)
+	}
+	if m.clearedlexicon_entries {
+		edges = append(edges, user.EdgeLexiconEntries)
+	}
+	if m.clearedtemplates {
+		edges = append(edges, user.EdgeTemplates)
+	}
+	if m.clearedmoderation_rules {
+		edges = append(edges, user.EdgeModerationRules)
+	}
+	if m.clearedmoderation_setting {
+		edges = append(edges, user.EdgeModerationSetting)
+	}
+	if m.clearedplan {
+		edges = append(edges, user.EdgePlan)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeHistories:
+		return m.clearedhistories
+	case user.EdgeIdempotencyKeys:
+		return m.clearedidempotency_keys
+	case user.EdgeTags:
+		return m.clearedtags
+	case user.EdgeFolders:
+		return m.clearedfolders
+	case user.EdgeVoicePresets:
+		return m.clearedvoice_presets
+	case user.EdgePreference:
+		return m.clearedpreference
+	case user.EdgeUsage:
+		return m.clearedusage
+	case user.EdgeUsageEntries:
+		return m.clearedusage_entries
+	case user.EdgePlayEvents:
+		return m.clearedplay_events
+	case user.EdgeLexiconEntries:
+		return m.clearedlexicon_entries
+	case user.EdgeTemplates:
+		return m.clearedtemplates
+	case user.EdgeModerationRules:
+		return m.clearedmoderation_rules
+	case user.EdgeModerationSetting:
+		return m.clearedmoderation_setting
+	case user.EdgePlan:
+		return m.clearedplan
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	case user.EdgePreference:
+		m.ClearPreference()
+		return nil
+	case user.EdgeUsage:
+		m.ClearUsage()
+		return nil
+	case user.EdgeModerationSetting:
+		m.ClearModerationSetting()
+		return nil
+	case user.EdgePlan:
+		m.ClearPlan()
+		return nil
+	}
+	return fmt.Errorf("unknown User unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeHistories:
+		m.ResetHistories()
+		return nil
+	case user.EdgeIdempotencyKeys:
+		m.ResetIdempotencyKeys()
+		return nil
+	case user.EdgeTags:
+		m.ResetTags()
+		return nil
+	case user.EdgeFolders:
+		m.ResetFolders()
+		return nil
+	case user.EdgeVoicePresets:
+		m.ResetVoicePresets()
+		return nil
+	case user.EdgePreference:
+		m.ResetPreference()
+		return nil
+	case user.EdgeUsage:
+		m.ResetUsage()
+		return nil
+	case user.EdgeUsageEntries:
+		m.ResetUsageEntries()
+		return nil
+	case user.EdgePlayEvents:
+		m.ResetPlayEvents()
+		return nil
+	case user.EdgeLexiconEntries:
+		m.ResetLexiconEntries()
+		return nil
+	case user.EdgeTemplates:
+		m.ResetTemplates()
+		return nil
+	case user.EdgeModerationRules:
+		m.ResetModerationRules()
+		return nil
+	case user.EdgeModerationSetting:
+		m.ResetModerationSetting()
+		return nil
+	case user.EdgePlan:
+		m.ResetPlan()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
+}
+
+// UserPreferenceMutation represents an operation that mutates the UserPreference nodes in the graph.
+type UserPreferenceMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	voice              *string
+	rate               *float64
+	addrate            *float64
+	pitch              *float64
+	addpitch           *float64
+	volume             *float64
+	addvolume          *float64
+	language           *string
+	max_text_length    *int
+	addmax_text_length *int
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	user               *uuid.UUID
+	cleareduser        bool
+	done               bool
+	oldValue           func(context.Context) (*UserPreference, error)
+	predicates         []predicate.UserPreference
+}
+
+var _ ent.Mutation = (*UserPreferenceMutation)(nil)
+
+// userpreferenceOption allows management of the mutation configuration using functional options.
+type userpreferenceOption func(*UserPreferenceMutation)
+
+// newUserPreferenceMutation creates new mutation for the UserPreference entity.
+func newUserPreferenceMutation(c config, op Op, opts ...userpreferenceOption) *UserPreferenceMutation {
+	m := &UserPreferenceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserPreference,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserPreferenceID sets the ID field of the mutation.
+func withUserPreferenceID(id uuid.UUID) userpreferenceOption {
+	return func(m *UserPreferenceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserPreference
+		)
+		m.oldValue = func(ctx context.Context) (*UserPreference, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserPreference.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUserPreference sets the old UserPreference of the mutation.
+func withUserPreference(node *UserPreference) userpreferenceOption {
+	return func(m *UserPreferenceMutation) {
+		m.oldValue = func(context.Context) (*UserPreference, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserPreferenceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserPreferenceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of UserPreference entities.
+func (m *UserPreferenceMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserPreferenceMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserPreferenceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserPreference.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVoice sets the "voice" field.
+func (m *UserPreferenceMutation) SetVoice(s string) {
+	m.voice = &s
+}
+
+// Voice returns the value of the "voice" field in the mutation.
+func (m *UserPreferenceMutation) Voice() (r string, exists bool) {
+	v := m.voice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVoice returns the old "voice" field's value of the UserPreference entity.
+// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPreferenceMutation) OldVoice(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVoice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVoice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVoice: %w", err)
+	}
+	return oldValue.Voice, nil
+}
+
+// ClearVoice clears the value of the "voice" field.
+func (m *UserPreferenceMutation) ClearVoice() {
+	m.voice = nil
+	m.clearedFields[userpreference.FieldVoice] = struct{}{}
+}
+
+// VoiceCleared returns if the "voice" field was cleared in this mutation.
+func (m *UserPreferenceMutation) VoiceCleared() bool {
+	_, ok := m.clearedFields[userpreference.FieldVoice]
+	return ok
+}
+
+// ResetVoice resets all changes to the "voice" field.
+func (m *UserPreferenceMutation) ResetVoice() {
+	m.voice = nil
+	delete(m.clearedFields, userpreference.FieldVoice)
+}
+
+// SetRate sets the "rate" field.
+func (m *UserPreferenceMutation) SetRate(f float64) {
+	m.rate = &f
+	m.addrate = nil
+}
+
+// Rate returns the value of the "rate" field in the mutation.
+func (m *UserPreferenceMutation) Rate() (r float64, exists bool) {
+	v := m.rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRate returns the old "rate" field's value of the UserPreference entity.
+// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPreferenceMutation) OldRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRate: %w", err)
+	}
+	return oldValue.Rate, nil
+}
+
+// AddRate adds f to the "rate" field.
+func (m *UserPreferenceMutation) AddRate(f float64) {
+	if m.addrate != nil {
+		*m.addrate += f
+	} else {
+		m.addrate = &f
+	}
+}
+
+// AddedRate returns the value that was added to the "rate" field in this mutation.
+func (m *UserPreferenceMutation) AddedRate() (r float64, exists bool) {
+	v := m.addrate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRate resets all changes to the "rate" field.
+func (m *UserPreferenceMutation) ResetRate() {
+	m.rate = nil
+	m.addrate = nil
+}
+
+// SetPitch sets the "pitch" field.
+func (m *UserPreferenceMutation) SetPitch(f float64) {
+	m.pitch = &f
+	m.addpitch = nil
+}
+
+// Pitch returns the value of the "pitch" field in the mutation.
+func (m *UserPreferenceMutation) Pitch() (r float64, exists bool) {
+	v := m.pitch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPitch returns the old "pitch" field's value of the UserPreference entity.
+// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPreferenceMutation) OldPitch(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPitch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPitch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPitch: %w", err)
+	}
+	return oldValue.Pitch, nil
+}
+
+// AddPitch adds f to the "pitch" field.
+func (m *UserPreferenceMutation) AddPitch(f float64) {
+	if m.addpitch != nil {
+		*m.addpitch += f
+	} else {
+		m.addpitch = &f
+	}
+}
+
+// AddedPitch returns the value that was added to the "pitch" field in this mutation.
+func (m *UserPreferenceMutation) AddedPitch() (r float64, exists bool) {
+	v := m.addpitch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPitch resets all changes to the "pitch" field.
+func (m *UserPreferenceMutation) ResetPitch() {
+	m.pitch = nil
+	m.addpitch = nil
+}
+
+// SetVolume sets the "volume" field.
+func (m *UserPreferenceMutation) SetVolume(f float64) {
+	m.volume = &f
+	m.addvolume = nil
+}
+
+// Volume returns the value of the "volume" field in the mutation.
+func (m *UserPreferenceMutation) Volume() (r float64, exists bool) {
+	v := m.volume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVolume returns the old "volume" field's value of the UserPreference entity.
+// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPreferenceMutation) OldVolume(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVolume is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVolume requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVolume: %w", err)
+	}
+	return oldValue.Volume, nil
+}
+
+// AddVolume adds f to the "volume" field.
+func (m *UserPreferenceMutation) AddVolume(f float64) {
+	if m.addvolume != nil {
+		*m.addvolume += f
+	} else {
+		m.addvolume = &f
+	}
+}
+
+// AddedVolume returns the value that was added to the "volume" field in this mutation.
+func (m *UserPreferenceMutation) AddedVolume() (r float64, exists bool) {
+	v := m.addvolume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVolume resets all changes to the "volume" field.
+func (m *UserPreferenceMutation) ResetVolume() {
+	m.volume = nil
+	m.addvolume = nil
+}
+
+// SetLanguage sets the "language" field.
+func (m *UserPreferenceMutation) SetLanguage(s string) {
+	m.language = &s
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *UserPreferenceMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the UserPreference entity.
+// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPreferenceMutation) OldLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (m *UserPreferenceMutation) ClearLanguage() {
+	m.language = nil
+	m.clearedFields[userpreference.FieldLanguage] = struct{}{}
+}
+
+// LanguageCleared returns if the "language" field was cleared in this mutation.
+func (m *UserPreferenceMutation) LanguageCleared() bool {
+	_, ok := m.clearedFields[userpreference.FieldLanguage]
+	return ok
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *UserPreferenceMutation) ResetLanguage() {
+	m.language = nil
+	delete(m.clearedFields, userpreference.FieldLanguage)
+}
+
+// SetMaxTextLength sets the "max_text_length" field.
+func (m *UserPreferenceMutation) SetMaxTextLength(i int) {
+	m.max_text_length = &i
+	m.addmax_text_length = nil
+}
+
+// MaxTextLength returns the value of the "max_text_length" field in the mutation.
+func (m *UserPreferenceMutation) MaxTextLength() (r int, exists bool) {
+	v := m.max_text_length
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxTextLength returns the old "max_text_length" field's value of the UserPreference entity.
+// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPreferenceMutation) OldMaxTextLength(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxTextLength is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxTextLength requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxTextLength: %w", err)
+	}
+	return oldValue.MaxTextLength, nil
+}
+
+// AddMaxTextLength adds i to the "max_text_length" field.
+func (m *UserPreferenceMutation) AddMaxTextLength(i int) {
+	if m.addmax_text_length != nil {
+		*m.addmax_text_length += i
+	} else {
+		m.addmax_text_length = &i
+	}
+}
+
+// AddedMaxTextLength returns the value that was added to the "max_text_length" field in this mutation.
+func (m *UserPreferenceMutation) AddedMaxTextLength() (r int, exists bool) {
+	v := m.addmax_text_length
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxTextLength resets all changes to the "max_text_length" field.
+func (m *UserPreferenceMutation) ResetMaxTextLength() {
+	m.max_text_length = nil
+	m.addmax_text_length = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserPreferenceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserPreferenceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the UserPreference entity.
+// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPreferenceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserPreferenceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserPreferenceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserPreferenceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the UserPreference entity.
+// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPreferenceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserPreferenceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *UserPreferenceMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *UserPreferenceMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UserPreferenceMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *UserPreferenceMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserPreferenceMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *UserPreferenceMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the UserPreferenceMutation builder.
+func (m *UserPreferenceMutation) Where(ps ...predicate.UserPreference) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserPreferenceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserPreferenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserPreference, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserPreferenceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserPreferenceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (UserPreference).
+func (m *UserPreferenceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserPreferenceMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.voice != nil {
+		fields = append(fields, userpreference.FieldVoice)
+	}
+	if m.rate != nil {
+		fields = append(fields, userpreference.FieldRate)
+	}
+	if m.pitch != nil {
+		fields = append(fields, userpreference.FieldPitch)
+	}
+	if m.volume != nil {
+		fields = append(fields, userpreference.FieldVolume)
+	}
+	if m.language != nil {
+		fields = append(fields, userpreference.FieldLanguage)
+	}
+	if m.max_text_length != nil {
+		fields = append(fields, userpreference.FieldMaxTextLength)
+	}
+	if m.created_at != nil {
+		fields = append(fields, userpreference.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, userpreference.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserPreferenceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case userpreference.FieldVoice:
+		return m.Voice()
+	case userpreference.FieldRate:
+		return m.Rate()
+	case userpreference.FieldPitch:
+		return m.Pitch()
+	case userpreference.FieldVolume:
+		return m.Volume()
+	case userpreference.FieldLanguage:
+		return m.Language()
+	case userpreference.FieldMaxTextLength:
+		return m.MaxTextLength()
+	case userpreference.FieldCreatedAt:
+		return m.CreatedAt()
+	case userpreference.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserPreferenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case userpreference.FieldVoice:
+		return m.OldVoice(ctx)
+	case userpreference.FieldRate:
+		return m.OldRate(ctx)
+	case userpreference.FieldPitch:
+		return m.OldPitch(ctx)
+	case userpreference.FieldVolume:
+		return m.OldVolume(ctx)
+	case userpreference.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case userpreference.FieldMaxTextLength:
+		return m.OldMaxTextLength(ctx)
+	case userpreference.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case userpreference.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown UserPreference field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserPreferenceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case userpreference.FieldVoice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVoice(v)
+		return nil
+	case userpreference.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRate(v)
+		return nil
+	case userpreference.FieldPitch:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPitch(v)
+		return nil
+	case userpreference.FieldVolume:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVolume(v)
+		return nil
+	case userpreference.FieldLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguage(v)
+		return nil
+	case userpreference.FieldMaxTextLength:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxTextLength(v)
+		return nil
+	case userpreference.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case userpreference.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UserPreference field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserPreferenceMutation) AddedFields() []string {
+	var fields []string
+	if m.addrate != nil {
+		fields = append(fields, userpreference.FieldRate)
+	}
+	if m.addpitch != nil {
+		fields = append(fields, userpreference.FieldPitch)
+	}
+	if m.addvolume != nil {
+		fields = append(fields, userpreference.FieldVolume)
+	}
+	if m.addmax_text_length != nil {
+		fields = append(fields, userpreference.FieldMaxTextLength)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserPreferenceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case userpreference.FieldRate:
+		return m.AddedRate()
+	case userpreference.FieldPitch:
+		return m.AddedPitch()
+	case userpreference.FieldVolume:
+		return m.AddedVolume()
+	case userpreference.FieldMaxTextLength:
+		return m.AddedMaxTextLength()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserPreferenceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case userpreference.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRate(v)
+		return nil
+	case userpreference.FieldPitch:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPitch(v)
+		return nil
+	case userpreference.FieldVolume:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVolume(v)
+		return nil
+	case userpreference.FieldMaxTextLength:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxTextLength(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UserPreference numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserPreferenceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(userpreference.FieldVoice) {
+		fields = append(fields, userpreference.FieldVoice)
+	}
+	if m.FieldCleared(userpreference.FieldLanguage) {
+		fields = append(fields, userpreference.FieldLanguage)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserPreferenceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserPreferenceMutation) ClearField(name string) error {
+	switch name {
+	case userpreference.FieldVoice:
+		m.ClearVoice()
+		return nil
+	case userpreference.FieldLanguage:
+		m.ClearLanguage()
+		return nil
+	}
+	return fmt.Errorf("unknown UserPreference nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserPreferenceMutation) ResetField(name string) error {
+	switch name {
+	case userpreference.FieldVoice:
+		m.ResetVoice()
+		return nil
+	case userpreference.FieldRate:
+		m.ResetRate()
+		return nil
+	case userpreference.FieldPitch:
+		m.ResetPitch()
+		return nil
+	case userpreference.FieldVolume:
+		m.ResetVolume()
+		return nil
+	case userpreference.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case userpreference.FieldMaxTextLength:
+		m.ResetMaxTextLength()
+		return nil
+	case userpreference.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case userpreference.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown UserPreference field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserPreferenceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, userpreference.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserPreferenceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case userpreference.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserPreferenceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserPreferenceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserPreferenceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, userpreference.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserPreferenceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case userpreference.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserPreferenceMutation) ClearEdge(name string) error {
+	switch name {
+	case userpreference.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown UserPreference unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserPreferenceMutation) ResetEdge(name string) error {
+	switch name {
+	case userpreference.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown UserPreference edge %s", name)
+}
+
+// UserUsageMutation represents an operation that mutates the UserUsage nodes in the graph.
+type UserUsageMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	period_start  *time.Time
+	characters    *int
+	addcharacters *int
+	entries       *int
+	addentries    *int
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*UserUsage, error)
+	predicates    []predicate.UserUsage
+}
+
+var _ ent.Mutation = (*UserUsageMutation)(nil)
+
+// userusageOption allows management of the mutation configuration using functional options.
+type userusageOption func(*UserUsageMutation)
+
+// newUserUsageMutation creates new mutation for the UserUsage entity.
+func newUserUsageMutation(c config, op Op, opts ...userusageOption) *UserUsageMutation {
+	m := &UserUsageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserUsage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserUsageID sets the ID field of the mutation.
+func withUserUsageID(id uuid.UUID) userusageOption {
+	return func(m *UserUsageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserUsage
+		)
+		m.oldValue = func(ctx context.Context) (*UserUsage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserUsage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUserUsage sets the old UserUsage of the mutation.
+func withUserUsage(node *UserUsage) userusageOption {
+	return func(m *UserUsageMutation) {
+		m.oldValue = func(context.Context) (*UserUsage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserUsageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserUsageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of UserUsage entities.
+func (m *UserUsageMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserUsageMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserUsageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserUsage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (m *UserUsageMutation) SetPeriodStart(t time.Time) {
+	m.period_start = &t
+}
+
+// PeriodStart returns the value of the "period_start" field in the mutation.
+func (m *UserUsageMutation) PeriodStart() (r time.Time, exists bool) {
+	v := m.period_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeriodStart returns the old "period_start" field's value of the UserUsage entity.
+// If the UserUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserUsageMutation) OldPeriodStart(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeriodStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeriodStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeriodStart: %w", err)
+	}
+	return oldValue.PeriodStart, nil
+}
+
+// ResetPeriodStart resets all changes to the "period_start" field.
+func (m *UserUsageMutation) ResetPeriodStart() {
+	m.period_start = nil
+}
+
+// SetCharacters sets the "characters" field.
+func (m *UserUsageMutation) SetCharacters(i int) {
+	m.characters = &i
+	m.addcharacters = nil
+}
+
+// Characters returns the value of the "characters" field in the mutation.
+func (m *UserUsageMutation) Characters() (r int, exists bool) {
+	v := m.characters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCharacters returns the old "characters" field's value of the UserUsage entity.
+// If the UserUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserUsageMutation) OldCharacters(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCharacters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCharacters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCharacters: %w", err)
+	}
+	return oldValue.Characters, nil
+}
+
+// AddCharacters adds i to the "characters" field.
+func (m *UserUsageMutation) AddCharacters(i int) {
+	if m.addcharacters != nil {
+		*m.addcharacters += i
+	} else {
+		m.addcharacters = &i
+	}
+}
+
+// AddedCharacters returns the value that was added to the "characters" field in this mutation.
+func (m *UserUsageMutation) AddedCharacters() (r int, exists bool) {
+	v := m.addcharacters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCharacters resets all changes to the "characters" field.
+func (m *UserUsageMutation) ResetCharacters() {
+	m.characters = nil
+	m.addcharacters = nil
+}
+
+// SetEntries sets the "entries" field.
+func (m *UserUsageMutation) SetEntries(i int) {
+	m.entries = &i
+	m.addentries = nil
+}
+
+// Entries returns the value of the "entries" field in the mutation.
+func (m *UserUsageMutation) Entries() (r int, exists bool) {
+	v := m.entries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntries returns the old "entries" field's value of the UserUsage entity.
+// If the UserUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserUsageMutation) OldEntries(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntries is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntries requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntries: %w", err)
+	}
+	return oldValue.Entries, nil
+}
+
+// AddEntries adds i to the "entries" field.
+func (m *UserUsageMutation) AddEntries(i int) {
+	if m.addentries != nil {
+		*m.addentries += i
+	} else {
+		m.addentries = &i
+	}
+}
+
+// AddedEntries returns the value that was added to the "entries" field in this mutation.
+func (m *UserUsageMutation) AddedEntries() (r int, exists bool) {
+	v := m.addentries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEntries resets all changes to the "entries" field.
+func (m *UserUsageMutation) ResetEntries() {
+	m.entries = nil
+	m.addentries = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserUsageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserUsageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the UserUsage entity.
+// If the UserUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserUsageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserUsageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *UserUsageMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *UserUsageMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UserUsageMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *UserUsageMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserUsageMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *UserUsageMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the UserUsageMutation builder.
+func (m *UserUsageMutation) Where(ps ...predicate.UserUsage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserUsageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserUsageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserUsage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserUsageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserUsageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (UserUsage).
+func (m *UserUsageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserUsageMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.period_start != nil {
+		fields = append(fields, userusage.FieldPeriodStart)
+	}
+	if m.characters != nil {
+		fields = append(fields, userusage.FieldCharacters)
+	}
+	if m.entries != nil {
+		fields = append(fields, userusage.FieldEntries)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, userusage.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserUsageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case userusage.FieldPeriodStart:
+		return m.PeriodStart()
+	case userusage.FieldCharacters:
+		return m.Characters()
+	case userusage.FieldEntries:
+		return m.Entries()
+	case userusage.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserUsageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case userusage.FieldPeriodStart:
+		return m.OldPeriodStart(ctx)
+	case userusage.FieldCharacters:
+		return m.OldCharacters(ctx)
+	case userusage.FieldEntries:
+		return m.OldEntries(ctx)
+	case userusage.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown UserUsage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserUsageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case userusage.FieldPeriodStart:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeriodStart(v)
+		return nil
+	case userusage.FieldCharacters:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCharacters(v)
+		return nil
+	case userusage.FieldEntries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntries(v)
+		return nil
+	case userusage.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UserUsage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserUsageMutation) AddedFields() []string {
+	var fields []string
+	if m.addcharacters != nil {
+		fields = append(fields, userusage.FieldCharacters)
+	}
+	if m.addentries != nil {
+		fields = append(fields, userusage.FieldEntries)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserUsageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case userusage.FieldCharacters:
+		return m.AddedCharacters()
+	case userusage.FieldEntries:
+		return m.AddedEntries()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserUsageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case userusage.FieldCharacters:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCharacters(v)
+		return nil
+	case userusage.FieldEntries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEntries(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UserUsage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserUsageMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserUsageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserUsageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserUsage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserUsageMutation) ResetField(name string) error {
+	switch name {
+	case userusage.FieldPeriodStart:
+		m.ResetPeriodStart()
+		return nil
+	case userusage.FieldCharacters:
+		m.ResetCharacters()
+		return nil
+	case userusage.FieldEntries:
+		m.ResetEntries()
+		return nil
+	case userusage.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown UserUsage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserUsageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, userusage.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserUsageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case userusage.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserUsageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserUsageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserUsageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, userusage.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserUsageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case userusage.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserUsageMutation) ClearEdge(name string) error {
+	switch name {
+	case userusage.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown UserUsage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserUsageMutation) ResetEdge(name string) error {
+	switch name {
+	case userusage.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown UserUsage edge %s", name)
+}
+
+// VoiceMutation represents an operation that mutates the Voice nodes in the graph.
+type VoiceMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	provider      *string
+	language      *string
+	gender        *voice.Gender
+	display_name  *string
+	min_rate      *float64
+	addmin_rate   *float64
+	max_rate      *float64
+	addmax_rate   *float64
+	min_pitch     *float64
+	addmin_pitch  *float64
+	max_pitch     *float64
+	addmax_pitch  *float64
+	enabled       *bool
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Voice, error)
+	predicates    []predicate.Voice
+}
+
+var _ ent.Mutation = (*VoiceMutation)(nil)
+
+// voiceOption allows management of the mutation configuration using functional options.
+type voiceOption func(*VoiceMutation)
+
+// newVoiceMutation creates new mutation for the Voice entity.
+func newVoiceMutation(c config, op Op, opts ...voiceOption) *VoiceMutation {
+	m := &VoiceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVoice,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVoiceID sets the ID field of the mutation.
+func withVoiceID(id string) voiceOption {
+	return func(m *VoiceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Voice
+		)
+		m.oldValue = func(ctx context.Context) (*Voice, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Voice.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withVoice sets the old Voice of the mutation.
+func withVoice(node *Voice) voiceOption {
+	return func(m *VoiceMutation) {
+		m.oldValue = func(context.Context) (*Voice, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VoiceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VoiceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Voice entities.
+func (m *VoiceMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VoiceMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VoiceMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Voice.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProvider sets the "provider" field.
+func (m *VoiceMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *VoiceMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the Voice entity.
+// If the Voice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoiceMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *VoiceMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetLanguage sets the "language" field.
+func (m *VoiceMutation) SetLanguage(s string) {
+	m.language = &s
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *VoiceMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the Voice entity.
+// If the Voice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoiceMutation) OldLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *VoiceMutation) ResetLanguage() {
+	m.language = nil
+}
+
+// SetGender sets the "gender" field.
+func (m *VoiceMutation) SetGender(v voice.Gender) {
+	m.gender = &v
+}
+
+// Gender returns the value of the "gender" field in the mutation.
+func (m *VoiceMutation) Gender() (r voice.Gender, exists bool) {
+	v := m.gender
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGender returns the old "gender" field's value of the Voice entity.
+// If the Voice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoiceMutation) OldGender(ctx context.Context) (v voice.Gender, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGender is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGender requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGender: %w", err)
+	}
+	return oldValue.Gender, nil
+}
+
+// ResetGender resets all changes to the "gender" field.
+func (m *VoiceMutation) ResetGender() {
+	m.gender = nil
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *VoiceMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *VoiceMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the Voice entity.
+// If the Voice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoiceMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *VoiceMutation) ResetDisplayName() {
+	m.display_name = nil
+}
+
+// SetMinRate sets the "min_rate" field.
+func (m *VoiceMutation) SetMinRate(f float64) {
+	m.min_rate = &f
+	m.addmin_rate = nil
+}
+
+// MinRate returns the value of the "min_rate" field in the mutation.
+func (m *VoiceMutation) MinRate() (r float64, exists bool) {
+	v := m.min_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinRate returns the old "min_rate" field's value of the Voice entity.
+// If the Voice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoiceMutation) OldMinRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinRate: %w", err)
+	}
+	return oldValue.MinRate, nil
+}
+
+// AddMinRate adds f to the "min_rate" field.
+func (m *VoiceMutation) AddMinRate(f float64) {
+	if m.addmin_rate != nil {
+		*m.addmin_rate += f
+	} else {
+		m.addmin_rate = &f
+	}
+}
+
+// AddedMinRate returns the value that was added to the "min_rate" field in this mutation.
+func (m *VoiceMutation) AddedMinRate() (r float64, exists bool) {
+	v := m.addmin_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMinRate resets all changes to the "min_rate" field.
+func (m *VoiceMutation) ResetMinRate() {
+	m.min_rate = nil
+	m.addmin_rate = nil
+}
+
+// SetMaxRate sets the "max_rate" field.
+func (m *VoiceMutation) SetMaxRate(f float64) {
+	m.max_rate = &f
+	m.addmax_rate = nil
+}
+
+// MaxRate returns the value of the "max_rate" field in the mutation.
+func (m *VoiceMutation) MaxRate() (r float64, exists bool) {
+	v := m.max_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxRate returns the old "max_rate" field's value of the Voice entity.
+// If the Voice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoiceMutation) OldMaxRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxRate: %w", err)
+	}
+	return oldValue.MaxRate, nil
+}
+
+// AddMaxRate adds f to the "max_rate" field.
+func (m *VoiceMutation) AddMaxRate(f float64) {
+	if m.addmax_rate != nil {
+		*m.addmax_rate += f
+	} else {
+		m.addmax_rate = &f
+	}
+}
+
+// AddedMaxRate returns the value that was added to the "max_rate" field in this mutation.
+func (m *VoiceMutation) AddedMaxRate() (r float64, exists bool) {
+	v := m.addmax_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxRate resets all changes to the "max_rate" field.
+func (m *VoiceMutation) ResetMaxRate() {
+	m.max_rate = nil
+	m.addmax_rate = nil
+}
+
+// SetMinPitch sets the "min_pitch" field.
+func (m *VoiceMutation) SetMinPitch(f float64) {
+	m.min_pitch = &f
+	m.addmin_pitch = nil
+}
+
+// MinPitch returns the value of the "min_pitch" field in the mutation.
+func (m *VoiceMutation) MinPitch() (r float64, exists bool) {
+	v := m.min_pitch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinPitch returns the old "min_pitch" field's value of the Voice entity.
+// If the Voice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoiceMutation) OldMinPitch(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinPitch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinPitch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinPitch: %w", err)
+	}
+	return oldValue.MinPitch, nil
+}
+
+// AddMinPitch adds f to the "min_pitch" field.
+func (m *VoiceMutation) AddMinPitch(f float64) {
+	if m.addmin_pitch != nil {
+		*m.addmin_pitch += f
+	} else {
+		m.addmin_pitch = &f
+	}
+}
+
+// AddedMinPitch returns the value that was added to the "min_pitch" field in this mutation.
+func (m *VoiceMutation) AddedMinPitch() (r float64, exists bool) {
+	v := m.addmin_pitch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMinPitch resets all changes to the "min_pitch" field.
+func (m *VoiceMutation) ResetMinPitch() {
+	m.min_pitch = nil
+	m.addmin_pitch = nil
+}
+
+// SetMaxPitch sets the "max_pitch" field.
+func (m *VoiceMutation) SetMaxPitch(f float64) {
+	m.max_pitch = &f
+	m.addmax_pitch = nil
+}
+
+// MaxPitch returns the value of the "max_pitch" field in the mutation.
+func (m *VoiceMutation) MaxPitch() (r float64, exists bool) {
+	v := m.max_pitch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxPitch returns the old "max_pitch" field's value of the Voice entity.
+// If the Voice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoiceMutation) OldMaxPitch(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxPitch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxPitch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxPitch: %w", err)
+	}
+	return oldValue.MaxPitch, nil
+}
+
+// AddMaxPitch adds f to the "max_pitch" field.
+func (m *VoiceMutation) AddMaxPitch(f float64) {
+	if m.addmax_pitch != nil {
+		*m.addmax_pitch += f
+	} else {
+		m.addmax_pitch = &f
+	}
+}
+
+// AddedMaxPitch returns the value that was added to the "max_pitch" field in this mutation.
+func (m *VoiceMutation) AddedMaxPitch() (r float64, exists bool) {
+	v := m.addmax_pitch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxPitch resets all changes to the "max_pitch" field.
+func (m *VoiceMutation) ResetMaxPitch() {
+	m.max_pitch = nil
+	m.addmax_pitch = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *VoiceMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *VoiceMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Voice entity.
+// If the Voice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoiceMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *VoiceMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *VoiceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *VoiceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Voice entity.
+// If the Voice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoiceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *VoiceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *VoiceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *VoiceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Voice entity.
+// If the Voice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoiceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *VoiceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the VoiceMutation builder.
+func (m *VoiceMutation) Where(ps ...predicate.Voice) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the VoiceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *VoiceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Voice, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *VoiceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *VoiceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Voice).
+func (m *VoiceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *VoiceMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.provider != nil {
+		fields = append(fields, voice.FieldProvider)
+	}
+	if m.language != nil {
+		fields = append(fields, voice.FieldLanguage)
+	}
+	if m.gender != nil {
+		fields = append(fields, voice.FieldGender)
+	}
+	if m.display_name != nil {
+		fields = append(fields, voice.FieldDisplayName)
+	}
+	if m.min_rate != nil {
+		fields = append(fields, voice.FieldMinRate)
+	}
+	if m.max_rate != nil {
+		fields = append(fields, voice.FieldMaxRate)
+	}
+	if m.min_pitch != nil {
+		fields = append(fields, voice.FieldMinPitch)
+	}
+	if m.max_pitch != nil {
+		fields = append(fields, voice.FieldMaxPitch)
+	}
+	if m.enabled != nil {
+		fields = append(fields, voice.FieldEnabled)
+	}
+	if m.created_at != nil {
+		fields = append(fields, voice.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, voice.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *VoiceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case voice.FieldProvider:
+		return m.Provider()
+	case voice.FieldLanguage:
+		return m.Language()
+	case voice.FieldGender:
+		return m.Gender()
+	case voice.FieldDisplayName:
+		return m.DisplayName()
+	case voice.FieldMinRate:
+		return m.MinRate()
+	case voice.FieldMaxRate:
+		return m.MaxRate()
+	case voice.FieldMinPitch:
+		return m.MinPitch()
+	case voice.FieldMaxPitch:
+		return m.MaxPitch()
+	case voice.FieldEnabled:
+		return m.Enabled()
+	case voice.FieldCreatedAt:
+		return m.CreatedAt()
+	case voice.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *VoiceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case voice.FieldProvider:
+		return m.OldProvider(ctx)
+	case voice.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case voice.FieldGender:
+		return m.OldGender(ctx)
+	case voice.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case voice.FieldMinRate:
+		return m.OldMinRate(ctx)
+	case voice.FieldMaxRate:
+		return m.OldMaxRate(ctx)
+	case voice.FieldMinPitch:
+		return m.OldMinPitch(ctx)
+	case voice.FieldMaxPitch:
+		return m.OldMaxPitch(ctx)
+	case voice.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case voice.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case voice.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Voice field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VoiceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case voice.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case voice.FieldLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguage(v)
+		return nil
+	case voice.FieldGender:
+		v, ok := value.(voice.Gender)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGender(v)
+		return nil
+	case voice.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case voice.FieldMinRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinRate(v)
+		return nil
+	case voice.FieldMaxRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxRate(v)
+		return nil
+	case voice.FieldMinPitch:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinPitch(v)
+		return nil
+	case voice.FieldMaxPitch:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxPitch(v)
+		return nil
+	case voice.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case voice.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case voice.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Voice field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *VoiceMutation) AddedFields() []string {
+	var fields []string
+	if m.addmin_rate != nil {
+		fields = append(fields, voice.FieldMinRate)
+	}
+	if m.addmax_rate != nil {
+		fields = append(fields, voice.FieldMaxRate)
+	}
+	if m.addmin_pitch != nil {
+		fields = append(fields, voice.FieldMinPitch)
+	}
+	if m.addmax_pitch != nil {
+		fields = append(fields, voice.FieldMaxPitch)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *VoiceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case voice.FieldMinRate:
+		return m.AddedMinRate()
+	case voice.FieldMaxRate:
+		return m.AddedMaxRate()
+	case voice.FieldMinPitch:
+		return m.AddedMinPitch()
+	case voice.FieldMaxPitch:
+		return m.AddedMaxPitch()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VoiceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case voice.FieldMinRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinRate(v)
+		return nil
+	case voice.FieldMaxRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxRate(v)
+		return nil
+	case voice.FieldMinPitch:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinPitch(v)
+		return nil
+	case voice.FieldMaxPitch:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxPitch(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Voice numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *VoiceMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *VoiceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *VoiceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Voice nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *VoiceMutation) ResetField(name string) error {
+	switch name {
+	case voice.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case voice.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case voice.FieldGender:
+		m.ResetGender()
+		return nil
+	case voice.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case voice.FieldMinRate:
+		m.ResetMinRate()
+		return nil
+	case voice.FieldMaxRate:
+		m.ResetMaxRate()
+		return nil
+	case voice.FieldMinPitch:
+		m.ResetMinPitch()
+		return nil
+	case voice.FieldMaxPitch:
+		m.ResetMaxPitch()
+		return nil
+	case voice.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case voice.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case voice.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Voice field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *VoiceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *VoiceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *VoiceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *VoiceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *VoiceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *VoiceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *VoiceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Voice unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *VoiceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Voice edge %s", name)
+}
+
+// VoicePresetMutation represents an operation that mutates the VoicePreset nodes in the graph.
+type VoicePresetMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	voice         *string
+	rate          *float64
+	addrate       *float64
+	pitch         *float64
+	addpitch      *float64
+	volume        *float64
+	addvolume     *float64
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*VoicePreset, error)
+	predicates    []predicate.VoicePreset
+}
+
+var _ ent.Mutation = (*VoicePresetMutation)(nil)
+
+// voicepresetOption allows management of the mutation configuration using functional options.
+type voicepresetOption func(*VoicePresetMutation)
+
+// newVoicePresetMutation creates new mutation for the VoicePreset entity.
+func newVoicePresetMutation(c config, op Op, opts ...voicepresetOption) *VoicePresetMutation {
+	m := &VoicePresetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVoicePreset,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVoicePresetID sets the ID field of the mutation.
+func withVoicePresetID(id uuid.UUID) voicepresetOption {
+	return func(m *VoicePresetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *VoicePreset
+		)
+		m.oldValue = func(ctx context.Context) (*VoicePreset, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().VoicePreset.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withVoicePreset sets the old VoicePreset of the mutation.
+func withVoicePreset(node *VoicePreset) voicepresetOption {
+	return func(m *VoicePresetMutation) {
+		m.oldValue = func(context.Context) (*VoicePreset, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VoicePresetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VoicePresetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of VoicePreset entities.
+func (m *VoicePresetMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VoicePresetMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VoicePresetMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().VoicePreset.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *VoicePresetMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *VoicePresetMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the VoicePreset entity.
+// If the VoicePreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoicePresetMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *VoicePresetMutation) ResetName() {
+	m.name = nil
+}
+
+// SetVoice sets the "voice" field.
+func (m *VoicePresetMutation) SetVoice(s string) {
+	m.voice = &s
+}
+
+// Voice returns the value of the "voice" field in the mutation.
+func (m *VoicePresetMutation) Voice() (r string, exists bool) {
+	v := m.voice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVoice returns the old "voice" field's value of the VoicePreset entity.
+// If the VoicePreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoicePresetMutation) OldVoice(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVoice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVoice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVoice: %w", err)
+	}
+	return oldValue.Voice, nil
+}
+
+// ResetVoice resets all changes to the "voice" field.
+func (m *VoicePresetMutation) ResetVoice() {
+	m.voice = nil
+}
+
+// SetRate sets the "rate" field.
+func (m *VoicePresetMutation) SetRate(f float64) {
+	m.rate = &f
+	m.addrate = nil
+}
+
+// Rate returns the value of the "rate" field in the mutation.
+func (m *VoicePresetMutation) Rate() (r float64, exists bool) {
+	v := m.rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRate returns the old "rate" field's value of the VoicePreset entity.
+// If the VoicePreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoicePresetMutation) OldRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRate: %w", err)
+	}
+	return oldValue.Rate, nil
+}
+
+// AddRate adds f to the "rate" field.
+func (m *VoicePresetMutation) AddRate(f float64) {
+	if m.addrate != nil {
+		*m.addrate += f
+	} else {
+		m.addrate = &f
+	}
+}
+
+// AddedRate returns the value that was added to the "rate" field in this mutation.
+func (m *VoicePresetMutation) AddedRate() (r float64, exists bool) {
+	v := m.addrate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRate resets all changes to the "rate" field.
+func (m *VoicePresetMutation) ResetRate() {
+	m.rate = nil
+	m.addrate = nil
+}
+
+// SetPitch sets the "pitch" field.
+func (m *VoicePresetMutation) SetPitch(f float64) {
+	m.pitch = &f
+	m.addpitch = nil
+}
+
+// Pitch returns the value of the "pitch" field in the mutation.
+func (m *VoicePresetMutation) Pitch() (r float64, exists bool) {
+	v := m.pitch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPitch returns the old "pitch" field's value of the VoicePreset entity.
+// If the VoicePreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoicePresetMutation) OldPitch(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPitch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPitch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPitch: %w", err)
+	}
+	return oldValue.Pitch, nil
+}
+
+// AddPitch adds f to the "pitch" field.
+func (m *VoicePresetMutation) AddPitch(f float64) {
+	if m.addpitch != nil {
+		*m.addpitch += f
+	} else {
+		m.addpitch = &f
+	}
+}
+
+// AddedPitch returns the value that was added to the "pitch" field in this mutation.
+func (m *VoicePresetMutation) AddedPitch() (r float64, exists bool) {
+	v := m.addpitch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPitch resets all changes to the "pitch" field.
+func (m *VoicePresetMutation) ResetPitch() {
+	m.pitch = nil
+	m.addpitch = nil
+}
+
+// SetVolume sets the "volume" field.
+func (m *VoicePresetMutation) SetVolume(f float64) {
+	m.volume = &f
+	m.addvolume = nil
+}
+
+// Volume returns the value of the "volume" field in the mutation.
+func (m *VoicePresetMutation) Volume() (r float64, exists bool) {
+	v := m.volume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVolume returns the old "volume" field's value of the VoicePreset entity.
+// If the VoicePreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoicePresetMutation) OldVolume(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVolume is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVolume requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVolume: %w", err)
+	}
+	return oldValue.Volume, nil
+}
+
+// AddVolume adds f to the "volume" field.
+func (m *VoicePresetMutation) AddVolume(f float64) {
+	if m.addvolume != nil {
+		*m.addvolume += f
+	} else {
+		m.addvolume = &f
+	}
+}
+
+// AddedVolume returns the value that was added to the "volume" field in this mutation.
+func (m *VoicePresetMutation) AddedVolume() (r float64, exists bool) {
+	v := m.addvolume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVolume resets all changes to the "volume" field.
+func (m *VoicePresetMutation) ResetVolume() {
+	m.volume = nil
+	m.addvolume = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *VoicePresetMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *VoicePresetMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the VoicePreset entity.
+// If the VoicePreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoicePresetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *VoicePresetMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *VoicePresetMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *VoicePresetMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the VoicePreset entity.
+// If the VoicePreset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VoicePresetMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *VoicePresetMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *VoicePresetMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *VoicePresetMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *VoicePresetMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *VoicePresetMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *VoicePresetMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *VoicePresetMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the VoicePresetMutation builder.
+func (m *VoicePresetMutation) Where(ps ...predicate.VoicePreset) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the VoicePresetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *VoicePresetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VoicePreset, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *VoicePresetMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *VoicePresetMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (VoicePreset).
+func (m *VoicePresetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *VoicePresetMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, voicepreset.FieldName)
+	}
+	if m.voice != nil {
+		fields = append(fields, voicepreset.FieldVoice)
+	}
+	if m.rate != nil {
+		fields = append(fields, voicepreset.FieldRate)
+	}
+	if m.pitch != nil {
+		fields = append(fields, voicepreset.FieldPitch)
+	}
+	if m.volume != nil {
+		fields = append(fields, voicepreset.FieldVolume)
+	}
+	if m.created_at != nil {
+		fields = append(fields, voicepreset.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, voicepreset.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *VoicePresetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case voicepreset.FieldName:
+		return m.Name()
+	case voicepreset.FieldVoice:
+		return m.Voice()
+	case voicepreset.FieldRate:
+		return m.Rate()
+	case voicepreset.FieldPitch:
+		return m.Pitch()
+	case voicepreset.FieldVolume:
+		return m.Volume()
+	case voicepreset.FieldCreatedAt:
+		return m.CreatedAt()
+	case voicepreset.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *VoicePresetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case voicepreset.FieldName:
+		return m.OldName(ctx)
+	case voicepreset.FieldVoice:
+		return m.OldVoice(ctx)
+	case voicepreset.FieldRate:
+		return m.OldRate(ctx)
+	case voicepreset.FieldPitch:
+		return m.OldPitch(ctx)
+	case voicepreset.FieldVolume:
+		return m.OldVolume(ctx)
+	case voicepreset.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case voicepreset.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown VoicePreset field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VoicePresetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case voicepreset.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case voicepreset.FieldVoice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVoice(v)
+		return nil
+	case voicepreset.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRate(v)
+		return nil
+	case voicepreset.FieldPitch:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPitch(v)
+		return nil
+	case voicepreset.FieldVolume:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVolume(v)
+		return nil
+	case voicepreset.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case voicepreset.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown VoicePreset field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *VoicePresetMutation) AddedFields() []string {
+	var fields []string
+	if m.addrate != nil {
+		fields = append(fields, voicepreset.FieldRate)
+	}
+	if m.addpitch != nil {
+		fields = append(fields, voicepreset.FieldPitch)
+	}
+	if m.addvolume != nil {
+		fields = append(fields, voicepreset.FieldVolume)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *VoicePresetMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case voicepreset.FieldRate:
+		return m.AddedRate()
+	case voicepreset.FieldPitch:
+		return m.AddedPitch()
+	case voicepreset.FieldVolume:
+		return m.AddedVolume()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VoicePresetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case voicepreset.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRate(v)
+		return nil
+	case voicepreset.FieldPitch:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPitch(v)
+		return nil
+	case voicepreset.FieldVolume:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVolume(v)
+		return nil
+	}
+	return fmt.Errorf("unknown VoicePreset numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *VoicePresetMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *VoicePresetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *VoicePresetMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown VoicePreset nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *VoicePresetMutation) ResetField(name string) error {
+	switch name {
+	case voicepreset.FieldName:
+		m.ResetName()
+		return nil
+	case voicepreset.FieldVoice:
+		m.ResetVoice()
+		return nil
+	case voicepreset.FieldRate:
+		m.ResetRate()
+		return nil
+	case voicepreset.FieldPitch:
+		m.ResetPitch()
+		return nil
+	case voicepreset.FieldVolume:
+		m.ResetVolume()
+		return nil
+	case voicepreset.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case voicepreset.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown VoicePreset field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *VoicePresetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, voicepreset.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *VoicePresetMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case voicepreset.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *VoicePresetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *VoicePresetMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *VoicePresetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, voicepreset.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *VoicePresetMutation) EdgeCleared(name string) bool {
+	switch name {
+	case voicepreset.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *VoicePresetMutation) ClearEdge(name string) error {
+	switch name {
+	case voicepreset.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown VoicePreset unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *VoicePresetMutation) ResetEdge(name string) error {
+	switch name {
+	case voicepreset.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown VoicePreset edge %s", name)
 }
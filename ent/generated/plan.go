@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/plan"
+)
+
+// Plan is the model entity for the Plan schema.
+type Plan struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// MonthlyCharacters holds the value of the "monthly_characters" field.
+	MonthlyCharacters int `json:"monthlyCharacters"`
+	// MonthlyEntries holds the value of the "monthly_entries" field.
+	MonthlyEntries int `json:"monthlyEntries"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PlanQuery when eager-loading is set.
+	Edges        PlanEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PlanEdges holds the relations/edges for other nodes in the graph.
+type PlanEdges struct {
+	// Users holds the value of the users edge.
+	Users []*User `json:"users,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UsersOrErr returns the Users value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlanEdges) UsersOrErr() ([]*User, error) {
+	if e.loadedTypes[0] {
+		return e.Users, nil
+	}
+	return nil, &NotLoadedError{edge: "users"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Plan) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case plan.FieldMonthlyCharacters, plan.FieldMonthlyEntries:
+			values[i] = new(sql.NullInt64)
+		case plan.FieldID, plan.FieldName:
+			values[i] = new(sql.NullString)
+		case plan.FieldCreatedAt, plan.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Plan fields.
+func (_m *Plan) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case plan.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case plan.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case plan.FieldMonthlyCharacters:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field monthly_characters", values[i])
+			} else if value.Valid {
+				_m.MonthlyCharacters = int(value.Int64)
+			}
+		case plan.FieldMonthlyEntries:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field monthly_entries", values[i])
+			} else if value.Valid {
+				_m.MonthlyEntries = int(value.Int64)
+			}
+		case plan.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case plan.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Plan.
+// This includes values selected through modifiers, order, etc.
+func (_m *Plan) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUsers queries the "users" edge of the Plan entity.
+func (_m *Plan) QueryUsers() *UserQuery {
+	return NewPlanClient(_m.config).QueryUsers(_m)
+}
+
+// Update returns a builder for updating this Plan.
+// Note that you need to call Plan.Unwrap() before calling this method if this Plan
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Plan) Update() *PlanUpdateOne {
+	return NewPlanClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Plan entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Plan) Unwrap() *Plan {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: Plan is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Plan) String() string {
+	var builder strings.Builder
+	builder.WriteString("Plan(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("monthly_characters=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MonthlyCharacters))
+	builder.WriteString(", ")
+	builder.WriteString("monthly_entries=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MonthlyEntries))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Plans is a parsable slice of Plan.
+type Plans []*Plan
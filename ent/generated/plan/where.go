@@ -0,0 +1,354 @@
+// Code generated by ent, DO NOT EDIT.
+
+package plan
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Plan {
+	return predicate.Plan(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Plan {
+	return predicate.Plan(sql.FieldContainsFold(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldName, v))
+}
+
+// MonthlyCharacters applies equality check predicate on the "monthly_characters" field. It's identical to MonthlyCharactersEQ.
+func MonthlyCharacters(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMonthlyCharacters, v))
+}
+
+// MonthlyEntries applies equality check predicate on the "monthly_entries" field. It's identical to MonthlyEntriesEQ.
+func MonthlyEntries(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMonthlyEntries, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContainsFold(FieldName, v))
+}
+
+// MonthlyCharactersEQ applies the EQ predicate on the "monthly_characters" field.
+func MonthlyCharactersEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMonthlyCharacters, v))
+}
+
+// MonthlyCharactersNEQ applies the NEQ predicate on the "monthly_characters" field.
+func MonthlyCharactersNEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldMonthlyCharacters, v))
+}
+
+// MonthlyCharactersIn applies the In predicate on the "monthly_characters" field.
+func MonthlyCharactersIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldMonthlyCharacters, vs...))
+}
+
+// MonthlyCharactersNotIn applies the NotIn predicate on the "monthly_characters" field.
+func MonthlyCharactersNotIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldMonthlyCharacters, vs...))
+}
+
+// MonthlyCharactersGT applies the GT predicate on the "monthly_characters" field.
+func MonthlyCharactersGT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldMonthlyCharacters, v))
+}
+
+// MonthlyCharactersGTE applies the GTE predicate on the "monthly_characters" field.
+func MonthlyCharactersGTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldMonthlyCharacters, v))
+}
+
+// MonthlyCharactersLT applies the LT predicate on the "monthly_characters" field.
+func MonthlyCharactersLT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldMonthlyCharacters, v))
+}
+
+// MonthlyCharactersLTE applies the LTE predicate on the "monthly_characters" field.
+func MonthlyCharactersLTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldMonthlyCharacters, v))
+}
+
+// MonthlyEntriesEQ applies the EQ predicate on the "monthly_entries" field.
+func MonthlyEntriesEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMonthlyEntries, v))
+}
+
+// MonthlyEntriesNEQ applies the NEQ predicate on the "monthly_entries" field.
+func MonthlyEntriesNEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldMonthlyEntries, v))
+}
+
+// MonthlyEntriesIn applies the In predicate on the "monthly_entries" field.
+func MonthlyEntriesIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldMonthlyEntries, vs...))
+}
+
+// MonthlyEntriesNotIn applies the NotIn predicate on the "monthly_entries" field.
+func MonthlyEntriesNotIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldMonthlyEntries, vs...))
+}
+
+// MonthlyEntriesGT applies the GT predicate on the "monthly_entries" field.
+func MonthlyEntriesGT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldMonthlyEntries, v))
+}
+
+// MonthlyEntriesGTE applies the GTE predicate on the "monthly_entries" field.
+func MonthlyEntriesGTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldMonthlyEntries, v))
+}
+
+// MonthlyEntriesLT applies the LT predicate on the "monthly_entries" field.
+func MonthlyEntriesLT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldMonthlyEntries, v))
+}
+
+// MonthlyEntriesLTE applies the LTE predicate on the "monthly_entries" field.
+func MonthlyEntriesLTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldMonthlyEntries, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUsers applies the HasEdge predicate on the "users" edge.
+func HasUsers() predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UsersTable, UsersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUsersWith applies the HasEdge predicate on the "users" edge with a given conditions (other predicates).
+func HasUsersWith(preds ...predicate.User) predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		step := newUsersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Plan) predicate.Plan {
+	return predicate.Plan(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Plan) predicate.Plan {
+	return predicate.Plan(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Plan) predicate.Plan {
+	return predicate.Plan(sql.NotPredicates(p))
+}
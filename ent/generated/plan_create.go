@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/plan"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+)
+
+// PlanCreate is the builder for creating a Plan entity.
+type PlanCreate struct {
+	config
+	mutation *PlanMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *PlanCreate) SetName(v string) *PlanCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetMonthlyCharacters sets the "monthly_characters" field.
+func (_c *PlanCreate) SetMonthlyCharacters(v int) *PlanCreate {
+	_c.mutation.SetMonthlyCharacters(v)
+	return _c
+}
+
+// SetMonthlyEntries sets the "monthly_entries" field.
+func (_c *PlanCreate) SetMonthlyEntries(v int) *PlanCreate {
+	_c.mutation.SetMonthlyEntries(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PlanCreate) SetCreatedAt(v time.Time) *PlanCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PlanCreate) SetNillableCreatedAt(v *time.Time) *PlanCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PlanCreate) SetUpdatedAt(v time.Time) *PlanCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PlanCreate) SetNillableUpdatedAt(v *time.Time) *PlanCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PlanCreate) SetID(v string) *PlanCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddUserIDs adds the "users" edge to the User entity by IDs.
+func (_c *PlanCreate) AddUserIDs(ids ...uuid.UUID) *PlanCreate {
+	_c.mutation.AddUserIDs(ids...)
+	return _c
+}
+
+// AddUsers adds the "users" edges to the User entity.
+func (_c *PlanCreate) AddUsers(v ...*User) *PlanCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUserIDs(ids...)
+}
+
+// Mutation returns the PlanMutation object of the builder.
+func (_c *PlanCreate) Mutation() *PlanMutation {
+	return _c.mutation
+}
+
+// Save creates the Plan in the database.
+func (_c *PlanCreate) Save(ctx context.Context) (*Plan, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PlanCreate) SaveX(ctx context.Context) *Plan {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlanCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlanCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PlanCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := plan.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := plan.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PlanCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`generated: missing required field "Plan.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := plan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "Plan.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MonthlyCharacters(); !ok {
+		return &ValidationError{Name: "monthly_characters", err: errors.New(`generated: missing required field "Plan.monthly_characters"`)}
+	}
+	if v, ok := _c.mutation.MonthlyCharacters(); ok {
+		if err := plan.MonthlyCharactersValidator(v); err != nil {
+			return &ValidationError{Name: "monthly_characters", err: fmt.Errorf(`generated: validator failed for field "Plan.monthly_characters": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MonthlyEntries(); !ok {
+		return &ValidationError{Name: "monthly_entries", err: errors.New(`generated: missing required field "Plan.monthly_entries"`)}
+	}
+	if v, ok := _c.mutation.MonthlyEntries(); ok {
+		if err := plan.MonthlyEntriesValidator(v); err != nil {
+			return &ValidationError{Name: "monthly_entries", err: fmt.Errorf(`generated: validator failed for field "Plan.monthly_entries": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Plan.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "Plan.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := plan.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "Plan.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *PlanCreate) sqlSave(ctx context.Context) (*Plan, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Plan.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PlanCreate) createSpec() (*Plan, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Plan{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(plan.Table, sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(plan.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.MonthlyCharacters(); ok {
+		_spec.SetField(plan.FieldMonthlyCharacters, field.TypeInt, value)
+		_node.MonthlyCharacters = value
+	}
+	if value, ok := _c.mutation.MonthlyEntries(); ok {
+		_spec.SetField(plan.FieldMonthlyEntries, field.TypeInt, value)
+		_node.MonthlyEntries = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(plan.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(plan.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.UsersTable,
+			Columns: []string{plan.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PlanCreateBulk is the builder for creating many Plan entities in bulk.
+type PlanCreateBulk struct {
+	config
+	err      error
+	builders []*PlanCreate
+}
+
+// Save creates the Plan entities in the database.
+func (_c *PlanCreateBulk) Save(ctx context.Context) ([]*Plan, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Plan, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlanMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PlanCreateBulk) SaveX(ctx context.Context) []*Plan {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlanCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlanCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
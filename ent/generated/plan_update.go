@@ -0,0 +1,598 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/plan"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+)
+
+// PlanUpdate is the builder for updating Plan entities.
+type PlanUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlanMutation
+}
+
+// Where appends a list predicates to the PlanUpdate builder.
+func (_u *PlanUpdate) Where(ps ...predicate.Plan) *PlanUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PlanUpdate) SetName(v string) *PlanUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PlanUpdate) SetNillableName(v *string) *PlanUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetMonthlyCharacters sets the "monthly_characters" field.
+func (_u *PlanUpdate) SetMonthlyCharacters(v int) *PlanUpdate {
+	_u.mutation.ResetMonthlyCharacters()
+	_u.mutation.SetMonthlyCharacters(v)
+	return _u
+}
+
+// SetNillableMonthlyCharacters sets the "monthly_characters" field if the given value is not nil.
+func (_u *PlanUpdate) SetNillableMonthlyCharacters(v *int) *PlanUpdate {
+	if v != nil {
+		_u.SetMonthlyCharacters(*v)
+	}
+	return _u
+}
+
+// AddMonthlyCharacters adds value to the "monthly_characters" field.
+func (_u *PlanUpdate) AddMonthlyCharacters(v int) *PlanUpdate {
+	_u.mutation.AddMonthlyCharacters(v)
+	return _u
+}
+
+// SetMonthlyEntries sets the "monthly_entries" field.
+func (_u *PlanUpdate) SetMonthlyEntries(v int) *PlanUpdate {
+	_u.mutation.ResetMonthlyEntries()
+	_u.mutation.SetMonthlyEntries(v)
+	return _u
+}
+
+// SetNillableMonthlyEntries sets the "monthly_entries" field if the given value is not nil.
+func (_u *PlanUpdate) SetNillableMonthlyEntries(v *int) *PlanUpdate {
+	if v != nil {
+		_u.SetMonthlyEntries(*v)
+	}
+	return _u
+}
+
+// AddMonthlyEntries adds value to the "monthly_entries" field.
+func (_u *PlanUpdate) AddMonthlyEntries(v int) *PlanUpdate {
+	_u.mutation.AddMonthlyEntries(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PlanUpdate) SetCreatedAt(v time.Time) *PlanUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PlanUpdate) SetNillableCreatedAt(v *time.Time) *PlanUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PlanUpdate) SetUpdatedAt(v time.Time) *PlanUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUserIDs adds the "users" edge to the User entity by IDs.
+func (_u *PlanUpdate) AddUserIDs(ids ...uuid.UUID) *PlanUpdate {
+	_u.mutation.AddUserIDs(ids...)
+	return _u
+}
+
+// AddUsers adds the "users" edges to the User entity.
+func (_u *PlanUpdate) AddUsers(v ...*User) *PlanUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUserIDs(ids...)
+}
+
+// Mutation returns the PlanMutation object of the builder.
+func (_u *PlanUpdate) Mutation() *PlanMutation {
+	return _u.mutation
+}
+
+// ClearUsers clears all "users" edges to the User entity.
+func (_u *PlanUpdate) ClearUsers() *PlanUpdate {
+	_u.mutation.ClearUsers()
+	return _u
+}
+
+// RemoveUserIDs removes the "users" edge to User entities by IDs.
+func (_u *PlanUpdate) RemoveUserIDs(ids ...uuid.UUID) *PlanUpdate {
+	_u.mutation.RemoveUserIDs(ids...)
+	return _u
+}
+
+// RemoveUsers removes "users" edges to User entities.
+func (_u *PlanUpdate) RemoveUsers(v ...*User) *PlanUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUserIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PlanUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlanUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PlanUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlanUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PlanUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := plan.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlanUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := plan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "Plan.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MonthlyCharacters(); ok {
+		if err := plan.MonthlyCharactersValidator(v); err != nil {
+			return &ValidationError{Name: "monthly_characters", err: fmt.Errorf(`generated: validator failed for field "Plan.monthly_characters": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MonthlyEntries(); ok {
+		if err := plan.MonthlyEntriesValidator(v); err != nil {
+			return &ValidationError{Name: "monthly_entries", err: fmt.Errorf(`generated: validator failed for field "Plan.monthly_entries": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PlanUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(plan.Table, plan.Columns, sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(plan.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MonthlyCharacters(); ok {
+		_spec.SetField(plan.FieldMonthlyCharacters, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyCharacters(); ok {
+		_spec.AddField(plan.FieldMonthlyCharacters, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MonthlyEntries(); ok {
+		_spec.SetField(plan.FieldMonthlyEntries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyEntries(); ok {
+		_spec.AddField(plan.FieldMonthlyEntries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(plan.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(plan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.UsersTable,
+			Columns: []string{plan.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUsersIDs(); len(nodes) > 0 && !_u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.UsersTable,
+			Columns: []string{plan.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.UsersTable,
+			Columns: []string{plan.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{plan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PlanUpdateOne is the builder for updating a single Plan entity.
+type PlanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlanMutation
+}
+
+// SetName sets the "name" field.
+func (_u *PlanUpdateOne) SetName(v string) *PlanUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PlanUpdateOne) SetNillableName(v *string) *PlanUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetMonthlyCharacters sets the "monthly_characters" field.
+func (_u *PlanUpdateOne) SetMonthlyCharacters(v int) *PlanUpdateOne {
+	_u.mutation.ResetMonthlyCharacters()
+	_u.mutation.SetMonthlyCharacters(v)
+	return _u
+}
+
+// SetNillableMonthlyCharacters sets the "monthly_characters" field if the given value is not nil.
+func (_u *PlanUpdateOne) SetNillableMonthlyCharacters(v *int) *PlanUpdateOne {
+	if v != nil {
+		_u.SetMonthlyCharacters(*v)
+	}
+	return _u
+}
+
+// AddMonthlyCharacters adds value to the "monthly_characters" field.
+func (_u *PlanUpdateOne) AddMonthlyCharacters(v int) *PlanUpdateOne {
+	_u.mutation.AddMonthlyCharacters(v)
+	return _u
+}
+
+// SetMonthlyEntries sets the "monthly_entries" field.
+func (_u *PlanUpdateOne) SetMonthlyEntries(v int) *PlanUpdateOne {
+	_u.mutation.ResetMonthlyEntries()
+	_u.mutation.SetMonthlyEntries(v)
+	return _u
+}
+
+// SetNillableMonthlyEntries sets the "monthly_entries" field if the given value is not nil.
+func (_u *PlanUpdateOne) SetNillableMonthlyEntries(v *int) *PlanUpdateOne {
+	if v != nil {
+		_u.SetMonthlyEntries(*v)
+	}
+	return _u
+}
+
+// AddMonthlyEntries adds value to the "monthly_entries" field.
+func (_u *PlanUpdateOne) AddMonthlyEntries(v int) *PlanUpdateOne {
+	_u.mutation.AddMonthlyEntries(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PlanUpdateOne) SetCreatedAt(v time.Time) *PlanUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PlanUpdateOne) SetNillableCreatedAt(v *time.Time) *PlanUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PlanUpdateOne) SetUpdatedAt(v time.Time) *PlanUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUserIDs adds the "users" edge to the User entity by IDs.
+func (_u *PlanUpdateOne) AddUserIDs(ids ...uuid.UUID) *PlanUpdateOne {
+	_u.mutation.AddUserIDs(ids...)
+	return _u
+}
+
+// AddUsers adds the "users" edges to the User entity.
+func (_u *PlanUpdateOne) AddUsers(v ...*User) *PlanUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUserIDs(ids...)
+}
+
+// Mutation returns the PlanMutation object of the builder.
+func (_u *PlanUpdateOne) Mutation() *PlanMutation {
+	return _u.mutation
+}
+
+// ClearUsers clears all "users" edges to the User entity.
+func (_u *PlanUpdateOne) ClearUsers() *PlanUpdateOne {
+	_u.mutation.ClearUsers()
+	return _u
+}
+
+// RemoveUserIDs removes the "users" edge to User entities by IDs.
+func (_u *PlanUpdateOne) RemoveUserIDs(ids ...uuid.UUID) *PlanUpdateOne {
+	_u.mutation.RemoveUserIDs(ids...)
+	return _u
+}
+
+// RemoveUsers removes "users" edges to User entities.
+func (_u *PlanUpdateOne) RemoveUsers(v ...*User) *PlanUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUserIDs(ids...)
+}
+
+// Where appends a list predicates to the PlanUpdate builder.
+func (_u *PlanUpdateOne) Where(ps ...predicate.Plan) *PlanUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PlanUpdateOne) Select(field string, fields ...string) *PlanUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Plan entity.
+func (_u *PlanUpdateOne) Save(ctx context.Context) (*Plan, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlanUpdateOne) SaveX(ctx context.Context) *Plan {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PlanUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlanUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PlanUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := plan.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlanUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := plan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "Plan.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MonthlyCharacters(); ok {
+		if err := plan.MonthlyCharactersValidator(v); err != nil {
+			return &ValidationError{Name: "monthly_characters", err: fmt.Errorf(`generated: validator failed for field "Plan.monthly_characters": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MonthlyEntries(); ok {
+		if err := plan.MonthlyEntriesValidator(v); err != nil {
+			return &ValidationError{Name: "monthly_entries", err: fmt.Errorf(`generated: validator failed for field "Plan.monthly_entries": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PlanUpdateOne) sqlSave(ctx context.Context) (_node *Plan, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(plan.Table, plan.Columns, sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "Plan.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, plan.FieldID)
+		for _, f := range fields {
+			if !plan.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != plan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(plan.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MonthlyCharacters(); ok {
+		_spec.SetField(plan.FieldMonthlyCharacters, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyCharacters(); ok {
+		_spec.AddField(plan.FieldMonthlyCharacters, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MonthlyEntries(); ok {
+		_spec.SetField(plan.FieldMonthlyEntries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyEntries(); ok {
+		_spec.AddField(plan.FieldMonthlyEntries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(plan.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(plan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.UsersTable,
+			Columns: []string{plan.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUsersIDs(); len(nodes) > 0 && !_u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.UsersTable,
+			Columns: []string{plan.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.UsersTable,
+			Columns: []string{plan.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Plan{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{plan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
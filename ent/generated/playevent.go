@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/playevent"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+)
+
+// PlayEvent is the model entity for the PlayEvent schema.
+type PlayEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Voice holds the value of the "voice" field.
+	Voice string `json:"voice,omitempty"`
+	// HistoryID holds the value of the "history_id" field.
+	HistoryID uuid.UUID `json:"historyId"`
+	// PlayedAt holds the value of the "played_at" field.
+	PlayedAt time.Time `json:"playedAt"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PlayEventQuery when eager-loading is set.
+	Edges            PlayEventEdges `json:"edges"`
+	user_play_events *uuid.UUID
+	selectValues     sql.SelectValues
+}
+
+// PlayEventEdges holds the relations/edges for other nodes in the graph.
+type PlayEventEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PlayEventEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PlayEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case playevent.FieldVoice:
+			values[i] = new(sql.NullString)
+		case playevent.FieldPlayedAt:
+			values[i] = new(sql.NullTime)
+		case playevent.FieldID, playevent.FieldHistoryID:
+			values[i] = new(uuid.UUID)
+		case playevent.ForeignKeys[0]: // user_play_events
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PlayEvent fields.
+func (_m *PlayEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case playevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case playevent.FieldVoice:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field voice", values[i])
+			} else if value.Valid {
+				_m.Voice = value.String
+			}
+		case playevent.FieldHistoryID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field history_id", values[i])
+			} else if value != nil {
+				_m.HistoryID = *value
+			}
+		case playevent.FieldPlayedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field played_at", values[i])
+			} else if value.Valid {
+				_m.PlayedAt = value.Time
+			}
+		case playevent.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_play_events", values[i])
+			} else if value.Valid {
+				_m.user_play_events = new(uuid.UUID)
+				*_m.user_play_events = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PlayEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *PlayEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the PlayEvent entity.
+func (_m *PlayEvent) QueryUser() *UserQuery {
+	return NewPlayEventClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this PlayEvent.
+// Note that you need to call PlayEvent.Unwrap() before calling this method if this PlayEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PlayEvent) Update() *PlayEventUpdateOne {
+	return NewPlayEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PlayEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PlayEvent) Unwrap() *PlayEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: PlayEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PlayEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("PlayEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("voice=")
+	builder.WriteString(_m.Voice)
+	builder.WriteString(", ")
+	builder.WriteString("history_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HistoryID))
+	builder.WriteString(", ")
+	builder.WriteString("played_at=")
+	builder.WriteString(_m.PlayedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PlayEvents is a parsable slice of PlayEvent.
+type PlayEvents []*PlayEvent
@@ -0,0 +1,108 @@
+// Code generated by ent, DO NOT EDIT.
+
+package playevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the playevent type in the database.
+	Label = "play_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVoice holds the string denoting the voice field in the database.
+	FieldVoice = "voice"
+	// FieldHistoryID holds the string denoting the history_id field in the database.
+	FieldHistoryID = "history_id"
+	// FieldPlayedAt holds the string denoting the played_at field in the database.
+	FieldPlayedAt = "played_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the playevent in the database.
+	Table = "play_events"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "play_events"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_play_events"
+)
+
+// Columns holds all SQL columns for playevent fields.
+var Columns = []string{
+	FieldID,
+	FieldVoice,
+	FieldHistoryID,
+	FieldPlayedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "play_events"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_play_events",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPlayedAt holds the default value on creation for the "played_at" field.
+	DefaultPlayedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the PlayEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVoice orders the results by the voice field.
+func ByVoice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVoice, opts...).ToFunc()
+}
+
+// ByHistoryID orders the results by the history_id field.
+func ByHistoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHistoryID, opts...).ToFunc()
+}
+
+// ByPlayedAt orders the results by the played_at field.
+func ByPlayedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlayedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package playevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldLTE(FieldID, id))
+}
+
+// Voice applies equality check predicate on the "voice" field. It's identical to VoiceEQ.
+func Voice(v string) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldEQ(FieldVoice, v))
+}
+
+// HistoryID applies equality check predicate on the "history_id" field. It's identical to HistoryIDEQ.
+func HistoryID(v uuid.UUID) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldEQ(FieldHistoryID, v))
+}
+
+// PlayedAt applies equality check predicate on the "played_at" field. It's identical to PlayedAtEQ.
+func PlayedAt(v time.Time) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldEQ(FieldPlayedAt, v))
+}
+
+// VoiceEQ applies the EQ predicate on the "voice" field.
+func VoiceEQ(v string) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldEQ(FieldVoice, v))
+}
+
+// VoiceNEQ applies the NEQ predicate on the "voice" field.
+func VoiceNEQ(v string) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldNEQ(FieldVoice, v))
+}
+
+// VoiceIn applies the In predicate on the "voice" field.
+func VoiceIn(vs ...string) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldIn(FieldVoice, vs...))
+}
+
+// VoiceNotIn applies the NotIn predicate on the "voice" field.
+func VoiceNotIn(vs ...string) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldNotIn(FieldVoice, vs...))
+}
+
+// VoiceGT applies the GT predicate on the "voice" field.
+func VoiceGT(v string) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldGT(FieldVoice, v))
+}
+
+// VoiceGTE applies the GTE predicate on the "voice" field.
+func VoiceGTE(v string) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldGTE(FieldVoice, v))
+}
+
+// VoiceLT applies the LT predicate on the "voice" field.
+func VoiceLT(v string) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldLT(FieldVoice, v))
+}
+
+// VoiceLTE applies the LTE predicate on the "voice" field.
+func VoiceLTE(v string) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldLTE(FieldVoice, v))
+}
+
+// VoiceContains applies the Contains predicate on the "voice" field.
+func VoiceContains(v string) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldContains(FieldVoice, v))
+}
+
+// VoiceHasPrefix applies the HasPrefix predicate on the "voice" field.
+func VoiceHasPrefix(v string) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldHasPrefix(FieldVoice, v))
+}
+
+// VoiceHasSuffix applies the HasSuffix predicate on the "voice" field.
+func VoiceHasSuffix(v string) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldHasSuffix(FieldVoice, v))
+}
+
+// VoiceEqualFold applies the EqualFold predicate on the "voice" field.
+func VoiceEqualFold(v string) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldEqualFold(FieldVoice, v))
+}
+
+// VoiceContainsFold applies the ContainsFold predicate on the "voice" field.
+func VoiceContainsFold(v string) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldContainsFold(FieldVoice, v))
+}
+
+// HistoryIDEQ applies the EQ predicate on the "history_id" field.
+func HistoryIDEQ(v uuid.UUID) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldEQ(FieldHistoryID, v))
+}
+
+// HistoryIDNEQ applies the NEQ predicate on the "history_id" field.
+func HistoryIDNEQ(v uuid.UUID) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldNEQ(FieldHistoryID, v))
+}
+
+// HistoryIDIn applies the In predicate on the "history_id" field.
+func HistoryIDIn(vs ...uuid.UUID) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldIn(FieldHistoryID, vs...))
+}
+
+// HistoryIDNotIn applies the NotIn predicate on the "history_id" field.
+func HistoryIDNotIn(vs ...uuid.UUID) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldNotIn(FieldHistoryID, vs...))
+}
+
+// HistoryIDGT applies the GT predicate on the "history_id" field.
+func HistoryIDGT(v uuid.UUID) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldGT(FieldHistoryID, v))
+}
+
+// HistoryIDGTE applies the GTE predicate on the "history_id" field.
+func HistoryIDGTE(v uuid.UUID) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldGTE(FieldHistoryID, v))
+}
+
+// HistoryIDLT applies the LT predicate on the "history_id" field.
+func HistoryIDLT(v uuid.UUID) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldLT(FieldHistoryID, v))
+}
+
+// HistoryIDLTE applies the LTE predicate on the "history_id" field.
+func HistoryIDLTE(v uuid.UUID) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldLTE(FieldHistoryID, v))
+}
+
+// PlayedAtEQ applies the EQ predicate on the "played_at" field.
+func PlayedAtEQ(v time.Time) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldEQ(FieldPlayedAt, v))
+}
+
+// PlayedAtNEQ applies the NEQ predicate on the "played_at" field.
+func PlayedAtNEQ(v time.Time) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldNEQ(FieldPlayedAt, v))
+}
+
+// PlayedAtIn applies the In predicate on the "played_at" field.
+func PlayedAtIn(vs ...time.Time) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldIn(FieldPlayedAt, vs...))
+}
+
+// PlayedAtNotIn applies the NotIn predicate on the "played_at" field.
+func PlayedAtNotIn(vs ...time.Time) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldNotIn(FieldPlayedAt, vs...))
+}
+
+// PlayedAtGT applies the GT predicate on the "played_at" field.
+func PlayedAtGT(v time.Time) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldGT(FieldPlayedAt, v))
+}
+
+// PlayedAtGTE applies the GTE predicate on the "played_at" field.
+func PlayedAtGTE(v time.Time) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldGTE(FieldPlayedAt, v))
+}
+
+// PlayedAtLT applies the LT predicate on the "played_at" field.
+func PlayedAtLT(v time.Time) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldLT(FieldPlayedAt, v))
+}
+
+// PlayedAtLTE applies the LTE predicate on the "played_at" field.
+func PlayedAtLTE(v time.Time) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.FieldLTE(FieldPlayedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.PlayEvent {
+	return predicate.PlayEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.PlayEvent {
+	return predicate.PlayEvent(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PlayEvent) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PlayEvent) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PlayEvent) predicate.PlayEvent {
+	return predicate.PlayEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/playevent"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+)
+
+// PlayEventCreate is the builder for creating a PlayEvent entity.
+type PlayEventCreate struct {
+	config
+	mutation *PlayEventMutation
+	hooks    []Hook
+}
+
+// SetVoice sets the "voice" field.
+func (_c *PlayEventCreate) SetVoice(v string) *PlayEventCreate {
+	_c.mutation.SetVoice(v)
+	return _c
+}
+
+// SetHistoryID sets the "history_id" field.
+func (_c *PlayEventCreate) SetHistoryID(v uuid.UUID) *PlayEventCreate {
+	_c.mutation.SetHistoryID(v)
+	return _c
+}
+
+// SetPlayedAt sets the "played_at" field.
+func (_c *PlayEventCreate) SetPlayedAt(v time.Time) *PlayEventCreate {
+	_c.mutation.SetPlayedAt(v)
+	return _c
+}
+
+// SetNillablePlayedAt sets the "played_at" field if the given value is not nil.
+func (_c *PlayEventCreate) SetNillablePlayedAt(v *time.Time) *PlayEventCreate {
+	if v != nil {
+		_c.SetPlayedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PlayEventCreate) SetID(v uuid.UUID) *PlayEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PlayEventCreate) SetNillableID(v *uuid.UUID) *PlayEventCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *PlayEventCreate) SetUserID(id uuid.UUID) *PlayEventCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *PlayEventCreate) SetUser(v *User) *PlayEventCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the PlayEventMutation object of the builder.
+func (_c *PlayEventCreate) Mutation() *PlayEventMutation {
+	return _c.mutation
+}
+
+// Save creates the PlayEvent in the database.
+func (_c *PlayEventCreate) Save(ctx context.Context) (*PlayEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PlayEventCreate) SaveX(ctx context.Context) *PlayEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlayEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlayEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PlayEventCreate) defaults() {
+	if _, ok := _c.mutation.PlayedAt(); !ok {
+		v := playevent.DefaultPlayedAt()
+		_c.mutation.SetPlayedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := playevent.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PlayEventCreate) check() error {
+	if _, ok := _c.mutation.Voice(); !ok {
+		return &ValidationError{Name: "voice", err: errors.New(`generated: missing required field "PlayEvent.voice"`)}
+	}
+	if _, ok := _c.mutation.HistoryID(); !ok {
+		return &ValidationError{Name: "history_id", err: errors.New(`generated: missing required field "PlayEvent.history_id"`)}
+	}
+	if _, ok := _c.mutation.PlayedAt(); !ok {
+		return &ValidationError{Name: "played_at", err: errors.New(`generated: missing required field "PlayEvent.played_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`generated: missing required edge "PlayEvent.user"`)}
+	}
+	return nil
+}
+
+func (_c *PlayEventCreate) sqlSave(ctx context.Context) (*PlayEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PlayEventCreate) createSpec() (*PlayEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PlayEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(playevent.Table, sqlgraph.NewFieldSpec(playevent.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Voice(); ok {
+		_spec.SetField(playevent.FieldVoice, field.TypeString, value)
+		_node.Voice = value
+	}
+	if value, ok := _c.mutation.HistoryID(); ok {
+		_spec.SetField(playevent.FieldHistoryID, field.TypeUUID, value)
+		_node.HistoryID = value
+	}
+	if value, ok := _c.mutation.PlayedAt(); ok {
+		_spec.SetField(playevent.FieldPlayedAt, field.TypeTime, value)
+		_node.PlayedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playevent.UserTable,
+			Columns: []string{playevent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_play_events = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PlayEventCreateBulk is the builder for creating many PlayEvent entities in bulk.
+type PlayEventCreateBulk struct {
+	config
+	err      error
+	builders []*PlayEventCreate
+}
+
+// Save creates the PlayEvent entities in the database.
+func (_c *PlayEventCreateBulk) Save(ctx context.Context) ([]*PlayEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PlayEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlayEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PlayEventCreateBulk) SaveX(ctx context.Context) []*PlayEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlayEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlayEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
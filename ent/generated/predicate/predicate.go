@@ -6,8 +6,56 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AudioRender is the predicate function for audiorender builders.
+type AudioRender func(*sql.Selector)
+
+// Folder is the predicate function for folder builders.
+type Folder func(*sql.Selector)
+
 // History is the predicate function for history builders.
 type History func(*sql.Selector)
 
+// HistoryRevision is the predicate function for historyrevision builders.
+type HistoryRevision func(*sql.Selector)
+
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
+// LexiconEntry is the predicate function for lexiconentry builders.
+type LexiconEntry func(*sql.Selector)
+
+// ModerationRule is the predicate function for moderationrule builders.
+type ModerationRule func(*sql.Selector)
+
+// ModerationSetting is the predicate function for moderationsetting builders.
+type ModerationSetting func(*sql.Selector)
+
+// Plan is the predicate function for plan builders.
+type Plan func(*sql.Selector)
+
+// PlayEvent is the predicate function for playevent builders.
+type PlayEvent func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
+// Template is the predicate function for template builders.
+type Template func(*sql.Selector)
+
+// UsageEntry is the predicate function for usageentry builders.
+type UsageEntry func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// UserPreference is the predicate function for userpreference builders.
+type UserPreference func(*sql.Selector)
+
+// UserUsage is the predicate function for userusage builders.
+type UserUsage func(*sql.Selector)
+
+// Voice is the predicate function for voice builders.
+type Voice func(*sql.Selector)
+
+// VoicePreset is the predicate function for voicepreset builders.
+type VoicePreset func(*sql.Selector)
@@ -2,7 +2,856 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/kiminodare/HOVARLAY-BE/ent/generated/runtime.go
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/audiorender"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/folder"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/historyrevision"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/idempotencykey"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/lexiconentry"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationrule"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationsetting"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/plan"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/playevent"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/tag"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/template"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/usageentry"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userpreference"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userusage"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/voice"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/voicepreset"
+	"github.com/kiminodare/HOVARLAY-BE/ent/schema"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	audiorenderFields := schema.AudioRender{}.Fields()
+	_ = audiorenderFields
+	// audiorenderDescCacheKey is the schema descriptor for cache_key field.
+	audiorenderDescCacheKey := audiorenderFields[1].Descriptor()
+	// audiorender.CacheKeyValidator is a validator for the "cache_key" field. It is called by the builders before save.
+	audiorender.CacheKeyValidator = audiorenderDescCacheKey.Validators[0].(func(string) error)
+	// audiorenderDescBlobKey is the schema descriptor for blob_key field.
+	audiorenderDescBlobKey := audiorenderFields[2].Descriptor()
+	// audiorender.BlobKeyValidator is a validator for the "blob_key" field. It is called by the builders before save.
+	audiorender.BlobKeyValidator = audiorenderDescBlobKey.Validators[0].(func(string) error)
+	// audiorenderDescContentType is the schema descriptor for content_type field.
+	audiorenderDescContentType := audiorenderFields[3].Descriptor()
+	// audiorender.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	audiorender.ContentTypeValidator = audiorenderDescContentType.Validators[0].(func(string) error)
+	// audiorenderDescSize is the schema descriptor for size field.
+	audiorenderDescSize := audiorenderFields[4].Descriptor()
+	// audiorender.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	audiorender.SizeValidator = audiorenderDescSize.Validators[0].(func(int64) error)
+	// audiorenderDescDurationMs is the schema descriptor for duration_ms field.
+	audiorenderDescDurationMs := audiorenderFields[5].Descriptor()
+	// audiorender.DurationMsValidator is a validator for the "duration_ms" field. It is called by the builders before save.
+	audiorender.DurationMsValidator = audiorenderDescDurationMs.Validators[0].(func(int64) error)
+	// audiorenderDescCreatedAt is the schema descriptor for created_at field.
+	audiorenderDescCreatedAt := audiorenderFields[7].Descriptor()
+	// audiorender.DefaultCreatedAt holds the default value on creation for the created_at field.
+	audiorender.DefaultCreatedAt = audiorenderDescCreatedAt.Default.(func() time.Time)
+	// audiorenderDescUpdatedAt is the schema descriptor for updated_at field.
+	audiorenderDescUpdatedAt := audiorenderFields[8].Descriptor()
+	// audiorender.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	audiorender.DefaultUpdatedAt = audiorenderDescUpdatedAt.Default.(func() time.Time)
+	// audiorender.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	audiorender.UpdateDefaultUpdatedAt = audiorenderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// audiorenderDescID is the schema descriptor for id field.
+	audiorenderDescID := audiorenderFields[0].Descriptor()
+	// audiorender.DefaultID holds the default value on creation for the id field.
+	audiorender.DefaultID = audiorenderDescID.Default.(func() uuid.UUID)
+	folderFields := schema.Folder{}.Fields()
+	_ = folderFields
+	// folderDescName is the schema descriptor for name field.
+	folderDescName := folderFields[1].Descriptor()
+	// folder.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	folder.NameValidator = func() func(string) error {
+		validators := folderDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// folderDescCreatedAt is the schema descriptor for created_at field.
+	folderDescCreatedAt := folderFields[3].Descriptor()
+	// folder.DefaultCreatedAt holds the default value on creation for the created_at field.
+	folder.DefaultCreatedAt = folderDescCreatedAt.Default.(func() time.Time)
+	// folderDescUpdatedAt is the schema descriptor for updated_at field.
+	folderDescUpdatedAt := folderFields[4].Descriptor()
+	// folder.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	folder.DefaultUpdatedAt = folderDescUpdatedAt.Default.(func() time.Time)
+	// folder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	folder.UpdateDefaultUpdatedAt = folderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// folderDescID is the schema descriptor for id field.
+	folderDescID := folderFields[0].Descriptor()
+	// folder.DefaultID holds the default value on creation for the id field.
+	folder.DefaultID = folderDescID.Default.(func() uuid.UUID)
+	historyMixin := schema.History{}.Mixin()
+	historyMixinHooks0 := historyMixin[0].Hooks()
+	historyHooks := schema.History{}.Hooks()
+	history.Hooks[0] = historyMixinHooks0[0]
+	history.Hooks[1] = historyHooks[0]
+	history.Hooks[2] = historyHooks[1]
+	history.Hooks[3] = historyHooks[2]
+	historyMixinInters0 := historyMixin[0].Interceptors()
+	history.Interceptors[0] = historyMixinInters0[0]
+	historyFields := schema.History{}.Fields()
+	_ = historyFields
+	// historyDescText is the schema descriptor for text field.
+	historyDescText := historyFields[1].Descriptor()
+	// history.TextValidator is a validator for the "text" field. It is called by the builders before save.
+	history.TextValidator = historyDescText.Validators[0].(func(string) error)
+	// historyDescCharCount is the schema descriptor for char_count field.
+	historyDescCharCount := historyFields[4].Descriptor()
+	// history.DefaultCharCount holds the default value on creation for the char_count field.
+	history.DefaultCharCount = historyDescCharCount.Default.(int)
+	// history.CharCountValidator is a validator for the "char_count" field. It is called by the builders before save.
+	history.CharCountValidator = historyDescCharCount.Validators[0].(func(int) error)
+	// historyDescLanguageConfidence is the schema descriptor for language_confidence field.
+	historyDescLanguageConfidence := historyFields[6].Descriptor()
+	// history.LanguageConfidenceValidator is a validator for the "language_confidence" field. It is called by the builders before save.
+	history.LanguageConfidenceValidator = func() func(float64) error {
+		validators := historyDescLanguageConfidence.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(language_confidence float64) error {
+			for _, fn := range fns {
+				if err := fn(language_confidence); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// historyDescVoice is the schema descriptor for voice field.
+	historyDescVoice := historyFields[7].Descriptor()
+	// history.VoiceValidator is a validator for the "voice" field. It is called by the builders before save.
+	history.VoiceValidator = historyDescVoice.Validators[0].(func(string) error)
+	// historyDescRate is the schema descriptor for rate field.
+	historyDescRate := historyFields[8].Descriptor()
+	// history.DefaultRate holds the default value on creation for the rate field.
+	history.DefaultRate = historyDescRate.Default.(float64)
+	// history.RateValidator is a validator for the "rate" field. It is called by the builders before save.
+	history.RateValidator = func() func(float64) error {
+		validators := historyDescRate.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(rate float64) error {
+			for _, fn := range fns {
+				if err := fn(rate); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// historyDescPitch is the schema descriptor for pitch field.
+	historyDescPitch := historyFields[9].Descriptor()
+	// history.DefaultPitch holds the default value on creation for the pitch field.
+	history.DefaultPitch = historyDescPitch.Default.(float64)
+	// history.PitchValidator is a validator for the "pitch" field. It is called by the builders before save.
+	history.PitchValidator = func() func(float64) error {
+		validators := historyDescPitch.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(pitch float64) error {
+			for _, fn := range fns {
+				if err := fn(pitch); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// historyDescVolume is the schema descriptor for volume field.
+	historyDescVolume := historyFields[10].Descriptor()
+	// history.DefaultVolume holds the default value on creation for the volume field.
+	history.DefaultVolume = historyDescVolume.Default.(float64)
+	// history.VolumeValidator is a validator for the "volume" field. It is called by the builders before save.
+	history.VolumeValidator = func() func(float64) error {
+		validators := historyDescVolume.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(volume float64) error {
+			for _, fn := range fns {
+				if err := fn(volume); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// historyDescIsFavorite is the schema descriptor for is_favorite field.
+	historyDescIsFavorite := historyFields[11].Descriptor()
+	// history.DefaultIsFavorite holds the default value on creation for the is_favorite field.
+	history.DefaultIsFavorite = historyDescIsFavorite.Default.(bool)
+	// historyDescPinnedOrder is the schema descriptor for pinned_order field.
+	historyDescPinnedOrder := historyFields[12].Descriptor()
+	// history.PinnedOrderValidator is a validator for the "pinned_order" field. It is called by the builders before save.
+	history.PinnedOrderValidator = historyDescPinnedOrder.Validators[0].(func(int) error)
+	// historyDescPlayCount is the schema descriptor for play_count field.
+	historyDescPlayCount := historyFields[13].Descriptor()
+	// history.DefaultPlayCount holds the default value on creation for the play_count field.
+	history.DefaultPlayCount = historyDescPlayCount.Default.(int)
+	// history.PlayCountValidator is a validator for the "play_count" field. It is called by the builders before save.
+	history.PlayCountValidator = historyDescPlayCount.Validators[0].(func(int) error)
+	// historyDescCreatedAt is the schema descriptor for created_at field.
+	historyDescCreatedAt := historyFields[16].Descriptor()
+	// history.DefaultCreatedAt holds the default value on creation for the created_at field.
+	history.DefaultCreatedAt = historyDescCreatedAt.Default.(func() time.Time)
+	// historyDescUpdatedAt is the schema descriptor for updated_at field.
+	historyDescUpdatedAt := historyFields[17].Descriptor()
+	// history.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	history.DefaultUpdatedAt = historyDescUpdatedAt.Default.(func() time.Time)
+	// history.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	history.UpdateDefaultUpdatedAt = historyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// historyDescID is the schema descriptor for id field.
+	historyDescID := historyFields[0].Descriptor()
+	// history.DefaultID holds the default value on creation for the id field.
+	history.DefaultID = historyDescID.Default.(func() uuid.UUID)
+	historyrevisionFields := schema.HistoryRevision{}.Fields()
+	_ = historyrevisionFields
+	// historyrevisionDescVersion is the schema descriptor for version field.
+	historyrevisionDescVersion := historyrevisionFields[1].Descriptor()
+	// historyrevision.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	historyrevision.VersionValidator = historyrevisionDescVersion.Validators[0].(func(int) error)
+	// historyrevisionDescText is the schema descriptor for text field.
+	historyrevisionDescText := historyrevisionFields[2].Descriptor()
+	// historyrevision.TextValidator is a validator for the "text" field. It is called by the builders before save.
+	historyrevision.TextValidator = historyrevisionDescText.Validators[0].(func(string) error)
+	// historyrevisionDescVoice is the schema descriptor for voice field.
+	historyrevisionDescVoice := historyrevisionFields[4].Descriptor()
+	// historyrevision.VoiceValidator is a validator for the "voice" field. It is called by the builders before save.
+	historyrevision.VoiceValidator = historyrevisionDescVoice.Validators[0].(func(string) error)
+	// historyrevisionDescCreatedAt is the schema descriptor for created_at field.
+	historyrevisionDescCreatedAt := historyrevisionFields[8].Descriptor()
+	// historyrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	historyrevision.DefaultCreatedAt = historyrevisionDescCreatedAt.Default.(func() time.Time)
+	// historyrevisionDescID is the schema descriptor for id field.
+	historyrevisionDescID := historyrevisionFields[0].Descriptor()
+	// historyrevision.DefaultID holds the default value on creation for the id field.
+	historyrevision.DefaultID = historyrevisionDescID.Default.(func() uuid.UUID)
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescKey is the schema descriptor for key field.
+	idempotencykeyDescKey := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencykey.KeyValidator = func() func(string) error {
+		validators := idempotencykeyDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// idempotencykeyDescRequestHash is the schema descriptor for request_hash field.
+	idempotencykeyDescRequestHash := idempotencykeyFields[2].Descriptor()
+	// idempotencykey.RequestHashValidator is a validator for the "request_hash" field. It is called by the builders before save.
+	idempotencykey.RequestHashValidator = idempotencykeyDescRequestHash.Validators[0].(func(string) error)
+	// idempotencykeyDescResponseStatus is the schema descriptor for response_status field.
+	idempotencykeyDescResponseStatus := idempotencykeyFields[3].Descriptor()
+	// idempotencykey.DefaultResponseStatus holds the default value on creation for the response_status field.
+	idempotencykey.DefaultResponseStatus = idempotencykeyDescResponseStatus.Default.(int)
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[6].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
+	// idempotencykeyDescID is the schema descriptor for id field.
+	idempotencykeyDescID := idempotencykeyFields[0].Descriptor()
+	// idempotencykey.DefaultID holds the default value on creation for the id field.
+	idempotencykey.DefaultID = idempotencykeyDescID.Default.(func() uuid.UUID)
+	lexiconentryFields := schema.LexiconEntry{}.Fields()
+	_ = lexiconentryFields
+	// lexiconentryDescGrapheme is the schema descriptor for grapheme field.
+	lexiconentryDescGrapheme := lexiconentryFields[1].Descriptor()
+	// lexiconentry.GraphemeValidator is a validator for the "grapheme" field. It is called by the builders before save.
+	lexiconentry.GraphemeValidator = func() func(string) error {
+		validators := lexiconentryDescGrapheme.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(grapheme string) error {
+			for _, fn := range fns {
+				if err := fn(grapheme); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// lexiconentryDescAlias is the schema descriptor for alias field.
+	lexiconentryDescAlias := lexiconentryFields[2].Descriptor()
+	// lexiconentry.AliasValidator is a validator for the "alias" field. It is called by the builders before save.
+	lexiconentry.AliasValidator = lexiconentryDescAlias.Validators[0].(func(string) error)
+	// lexiconentryDescPhoneme is the schema descriptor for phoneme field.
+	lexiconentryDescPhoneme := lexiconentryFields[3].Descriptor()
+	// lexiconentry.PhonemeValidator is a validator for the "phoneme" field. It is called by the builders before save.
+	lexiconentry.PhonemeValidator = lexiconentryDescPhoneme.Validators[0].(func(string) error)
+	// lexiconentryDescCaseSensitive is the schema descriptor for case_sensitive field.
+	lexiconentryDescCaseSensitive := lexiconentryFields[5].Descriptor()
+	// lexiconentry.DefaultCaseSensitive holds the default value on creation for the case_sensitive field.
+	lexiconentry.DefaultCaseSensitive = lexiconentryDescCaseSensitive.Default.(bool)
+	// lexiconentryDescWholeWord is the schema descriptor for whole_word field.
+	lexiconentryDescWholeWord := lexiconentryFields[6].Descriptor()
+	// lexiconentry.DefaultWholeWord holds the default value on creation for the whole_word field.
+	lexiconentry.DefaultWholeWord = lexiconentryDescWholeWord.Default.(bool)
+	// lexiconentryDescCreatedAt is the schema descriptor for created_at field.
+	lexiconentryDescCreatedAt := lexiconentryFields[7].Descriptor()
+	// lexiconentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	lexiconentry.DefaultCreatedAt = lexiconentryDescCreatedAt.Default.(func() time.Time)
+	// lexiconentryDescUpdatedAt is the schema descriptor for updated_at field.
+	lexiconentryDescUpdatedAt := lexiconentryFields[8].Descriptor()
+	// lexiconentry.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	lexiconentry.DefaultUpdatedAt = lexiconentryDescUpdatedAt.Default.(func() time.Time)
+	// lexiconentry.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	lexiconentry.UpdateDefaultUpdatedAt = lexiconentryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// lexiconentryDescID is the schema descriptor for id field.
+	lexiconentryDescID := lexiconentryFields[0].Descriptor()
+	// lexiconentry.DefaultID holds the default value on creation for the id field.
+	lexiconentry.DefaultID = lexiconentryDescID.Default.(func() uuid.UUID)
+	moderationruleFields := schema.ModerationRule{}.Fields()
+	_ = moderationruleFields
+	// moderationruleDescPattern is the schema descriptor for pattern field.
+	moderationruleDescPattern := moderationruleFields[2].Descriptor()
+	// moderationrule.PatternValidator is a validator for the "pattern" field. It is called by the builders before save.
+	moderationrule.PatternValidator = func() func(string) error {
+		validators := moderationruleDescPattern.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(pattern string) error {
+			for _, fn := range fns {
+				if err := fn(pattern); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// moderationruleDescReplacement is the schema descriptor for replacement field.
+	moderationruleDescReplacement := moderationruleFields[4].Descriptor()
+	// moderationrule.ReplacementValidator is a validator for the "replacement" field. It is called by the builders before save.
+	moderationrule.ReplacementValidator = moderationruleDescReplacement.Validators[0].(func(string) error)
+	// moderationruleDescCreatedAt is the schema descriptor for created_at field.
+	moderationruleDescCreatedAt := moderationruleFields[5].Descriptor()
+	// moderationrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	moderationrule.DefaultCreatedAt = moderationruleDescCreatedAt.Default.(func() time.Time)
+	// moderationruleDescUpdatedAt is the schema descriptor for updated_at field.
+	moderationruleDescUpdatedAt := moderationruleFields[6].Descriptor()
+	// moderationrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	moderationrule.DefaultUpdatedAt = moderationruleDescUpdatedAt.Default.(func() time.Time)
+	// moderationrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	moderationrule.UpdateDefaultUpdatedAt = moderationruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// moderationruleDescID is the schema descriptor for id field.
+	moderationruleDescID := moderationruleFields[0].Descriptor()
+	// moderationrule.DefaultID holds the default value on creation for the id field.
+	moderationrule.DefaultID = moderationruleDescID.Default.(func() uuid.UUID)
+	moderationsettingFields := schema.ModerationSetting{}.Fields()
+	_ = moderationsettingFields
+	// moderationsettingDescEnabled is the schema descriptor for enabled field.
+	moderationsettingDescEnabled := moderationsettingFields[1].Descriptor()
+	// moderationsetting.DefaultEnabled holds the default value on creation for the enabled field.
+	moderationsetting.DefaultEnabled = moderationsettingDescEnabled.Default.(bool)
+	// moderationsettingDescBuiltinLists is the schema descriptor for builtin_lists field.
+	moderationsettingDescBuiltinLists := moderationsettingFields[2].Descriptor()
+	// moderationsetting.DefaultBuiltinLists holds the default value on creation for the builtin_lists field.
+	moderationsetting.DefaultBuiltinLists = moderationsettingDescBuiltinLists.Default.([]string)
+	// moderationsettingDescReplacement is the schema descriptor for replacement field.
+	moderationsettingDescReplacement := moderationsettingFields[4].Descriptor()
+	// moderationsetting.DefaultReplacement holds the default value on creation for the replacement field.
+	moderationsetting.DefaultReplacement = moderationsettingDescReplacement.Default.(string)
+	// moderationsetting.ReplacementValidator is a validator for the "replacement" field. It is called by the builders before save.
+	moderationsetting.ReplacementValidator = moderationsettingDescReplacement.Validators[0].(func(string) error)
+	// moderationsettingDescCreatedAt is the schema descriptor for created_at field.
+	moderationsettingDescCreatedAt := moderationsettingFields[5].Descriptor()
+	// moderationsetting.DefaultCreatedAt holds the default value on creation for the created_at field.
+	moderationsetting.DefaultCreatedAt = moderationsettingDescCreatedAt.Default.(func() time.Time)
+	// moderationsettingDescUpdatedAt is the schema descriptor for updated_at field.
+	moderationsettingDescUpdatedAt := moderationsettingFields[6].Descriptor()
+	// moderationsetting.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	moderationsetting.DefaultUpdatedAt = moderationsettingDescUpdatedAt.Default.(func() time.Time)
+	// moderationsetting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	moderationsetting.UpdateDefaultUpdatedAt = moderationsettingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// moderationsettingDescID is the schema descriptor for id field.
+	moderationsettingDescID := moderationsettingFields[0].Descriptor()
+	// moderationsetting.DefaultID holds the default value on creation for the id field.
+	moderationsetting.DefaultID = moderationsettingDescID.Default.(func() uuid.UUID)
+	planFields := schema.Plan{}.Fields()
+	_ = planFields
+	// planDescName is the schema descriptor for name field.
+	planDescName := planFields[1].Descriptor()
+	// plan.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	plan.NameValidator = planDescName.Validators[0].(func(string) error)
+	// planDescMonthlyCharacters is the schema descriptor for monthly_characters field.
+	planDescMonthlyCharacters := planFields[2].Descriptor()
+	// plan.MonthlyCharactersValidator is a validator for the "monthly_characters" field. It is called by the builders before save.
+	plan.MonthlyCharactersValidator = planDescMonthlyCharacters.Validators[0].(func(int) error)
+	// planDescMonthlyEntries is the schema descriptor for monthly_entries field.
+	planDescMonthlyEntries := planFields[3].Descriptor()
+	// plan.MonthlyEntriesValidator is a validator for the "monthly_entries" field. It is called by the builders before save.
+	plan.MonthlyEntriesValidator = planDescMonthlyEntries.Validators[0].(func(int) error)
+	// planDescCreatedAt is the schema descriptor for created_at field.
+	planDescCreatedAt := planFields[4].Descriptor()
+	// plan.DefaultCreatedAt holds the default value on creation for the created_at field.
+	plan.DefaultCreatedAt = planDescCreatedAt.Default.(func() time.Time)
+	// planDescUpdatedAt is the schema descriptor for updated_at field.
+	planDescUpdatedAt := planFields[5].Descriptor()
+	// plan.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	plan.DefaultUpdatedAt = planDescUpdatedAt.Default.(func() time.Time)
+	// plan.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	plan.UpdateDefaultUpdatedAt = planDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// planDescID is the schema descriptor for id field.
+	planDescID := planFields[0].Descriptor()
+	// plan.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	plan.IDValidator = func() func(string) error {
+		validators := planDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	playeventFields := schema.PlayEvent{}.Fields()
+	_ = playeventFields
+	// playeventDescPlayedAt is the schema descriptor for played_at field.
+	playeventDescPlayedAt := playeventFields[3].Descriptor()
+	// playevent.DefaultPlayedAt holds the default value on creation for the played_at field.
+	playevent.DefaultPlayedAt = playeventDescPlayedAt.Default.(func() time.Time)
+	// playeventDescID is the schema descriptor for id field.
+	playeventDescID := playeventFields[0].Descriptor()
+	// playevent.DefaultID holds the default value on creation for the id field.
+	playevent.DefaultID = playeventDescID.Default.(func() uuid.UUID)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[1].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = func() func(string) error {
+		validators := tagDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// tagDescColor is the schema descriptor for color field.
+	tagDescColor := tagFields[2].Descriptor()
+	// tag.ColorValidator is a validator for the "color" field. It is called by the builders before save.
+	tag.ColorValidator = tagDescColor.Validators[0].(func(string) error)
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagFields[3].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
+	// tagDescUpdatedAt is the schema descriptor for updated_at field.
+	tagDescUpdatedAt := tagFields[4].Descriptor()
+	// tag.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tag.DefaultUpdatedAt = tagDescUpdatedAt.Default.(func() time.Time)
+	// tag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tag.UpdateDefaultUpdatedAt = tagDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tagDescID is the schema descriptor for id field.
+	tagDescID := tagFields[0].Descriptor()
+	// tag.DefaultID holds the default value on creation for the id field.
+	tag.DefaultID = tagDescID.Default.(func() uuid.UUID)
+	templateFields := schema.Template{}.Fields()
+	_ = templateFields
+	// templateDescName is the schema descriptor for name field.
+	templateDescName := templateFields[1].Descriptor()
+	// template.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	template.NameValidator = func() func(string) error {
+		validators := templateDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// templateDescText is the schema descriptor for text field.
+	templateDescText := templateFields[2].Descriptor()
+	// template.TextValidator is a validator for the "text" field. It is called by the builders before save.
+	template.TextValidator = templateDescText.Validators[0].(func(string) error)
+	// templateDescLanguage is the schema descriptor for language field.
+	templateDescLanguage := templateFields[4].Descriptor()
+	// template.DefaultLanguage holds the default value on creation for the language field.
+	template.DefaultLanguage = templateDescLanguage.Default.(string)
+	// templateDescCurrency is the schema descriptor for currency field.
+	templateDescCurrency := templateFields[5].Descriptor()
+	// template.DefaultCurrency holds the default value on creation for the currency field.
+	template.DefaultCurrency = templateDescCurrency.Default.(string)
+	// template.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	template.CurrencyValidator = templateDescCurrency.Validators[0].(func(string) error)
+	// templateDescCreatedAt is the schema descriptor for created_at field.
+	templateDescCreatedAt := templateFields[7].Descriptor()
+	// template.DefaultCreatedAt holds the default value on creation for the created_at field.
+	template.DefaultCreatedAt = templateDescCreatedAt.Default.(func() time.Time)
+	// templateDescUpdatedAt is the schema descriptor for updated_at field.
+	templateDescUpdatedAt := templateFields[8].Descriptor()
+	// template.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	template.DefaultUpdatedAt = templateDescUpdatedAt.Default.(func() time.Time)
+	// template.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	template.UpdateDefaultUpdatedAt = templateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// templateDescID is the schema descriptor for id field.
+	templateDescID := templateFields[0].Descriptor()
+	// template.DefaultID holds the default value on creation for the id field.
+	template.DefaultID = templateDescID.Default.(func() uuid.UUID)
+	usageentryFields := schema.UsageEntry{}.Fields()
+	_ = usageentryFields
+	// usageentryDescCreatedAt is the schema descriptor for created_at field.
+	usageentryDescCreatedAt := usageentryFields[6].Descriptor()
+	// usageentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usageentry.DefaultCreatedAt = usageentryDescCreatedAt.Default.(func() time.Time)
+	// usageentryDescID is the schema descriptor for id field.
+	usageentryDescID := usageentryFields[0].Descriptor()
+	// usageentry.DefaultID holds the default value on creation for the id field.
+	usageentry.DefaultID = usageentryDescID.Default.(func() uuid.UUID)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescName is the schema descriptor for name field.
+	userDescName := userFields[1].Descriptor()
+	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	user.NameValidator = userDescName.Validators[0].(func(string) error)
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[2].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescPassword is the schema descriptor for password field.
+	userDescPassword := userFields[3].Descriptor()
+	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
+	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[4].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[5].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userFields[0].Descriptor()
+	// user.DefaultID holds the default value on creation for the id field.
+	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	userpreferenceFields := schema.UserPreference{}.Fields()
+	_ = userpreferenceFields
+	// userpreferenceDescRate is the schema descriptor for rate field.
+	userpreferenceDescRate := userpreferenceFields[2].Descriptor()
+	// userpreference.DefaultRate holds the default value on creation for the rate field.
+	userpreference.DefaultRate = userpreferenceDescRate.Default.(float64)
+	// userpreference.RateValidator is a validator for the "rate" field. It is called by the builders before save.
+	userpreference.RateValidator = func() func(float64) error {
+		validators := userpreferenceDescRate.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(rate float64) error {
+			for _, fn := range fns {
+				if err := fn(rate); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userpreferenceDescPitch is the schema descriptor for pitch field.
+	userpreferenceDescPitch := userpreferenceFields[3].Descriptor()
+	// userpreference.DefaultPitch holds the default value on creation for the pitch field.
+	userpreference.DefaultPitch = userpreferenceDescPitch.Default.(float64)
+	// userpreference.PitchValidator is a validator for the "pitch" field. It is called by the builders before save.
+	userpreference.PitchValidator = func() func(float64) error {
+		validators := userpreferenceDescPitch.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(pitch float64) error {
+			for _, fn := range fns {
+				if err := fn(pitch); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userpreferenceDescVolume is the schema descriptor for volume field.
+	userpreferenceDescVolume := userpreferenceFields[4].Descriptor()
+	// userpreference.DefaultVolume holds the default value on creation for the volume field.
+	userpreference.DefaultVolume = userpreferenceDescVolume.Default.(float64)
+	// userpreference.VolumeValidator is a validator for the "volume" field. It is called by the builders before save.
+	userpreference.VolumeValidator = func() func(float64) error {
+		validators := userpreferenceDescVolume.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(volume float64) error {
+			for _, fn := range fns {
+				if err := fn(volume); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userpreferenceDescMaxTextLength is the schema descriptor for max_text_length field.
+	userpreferenceDescMaxTextLength := userpreferenceFields[6].Descriptor()
+	// userpreference.DefaultMaxTextLength holds the default value on creation for the max_text_length field.
+	userpreference.DefaultMaxTextLength = userpreferenceDescMaxTextLength.Default.(int)
+	// userpreference.MaxTextLengthValidator is a validator for the "max_text_length" field. It is called by the builders before save.
+	userpreference.MaxTextLengthValidator = userpreferenceDescMaxTextLength.Validators[0].(func(int) error)
+	// userpreferenceDescCreatedAt is the schema descriptor for created_at field.
+	userpreferenceDescCreatedAt := userpreferenceFields[7].Descriptor()
+	// userpreference.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userpreference.DefaultCreatedAt = userpreferenceDescCreatedAt.Default.(func() time.Time)
+	// userpreferenceDescUpdatedAt is the schema descriptor for updated_at field.
+	userpreferenceDescUpdatedAt := userpreferenceFields[8].Descriptor()
+	// userpreference.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	userpreference.DefaultUpdatedAt = userpreferenceDescUpdatedAt.Default.(func() time.Time)
+	// userpreference.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	userpreference.UpdateDefaultUpdatedAt = userpreferenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userpreferenceDescID is the schema descriptor for id field.
+	userpreferenceDescID := userpreferenceFields[0].Descriptor()
+	// userpreference.DefaultID holds the default value on creation for the id field.
+	userpreference.DefaultID = userpreferenceDescID.Default.(func() uuid.UUID)
+	userusageFields := schema.UserUsage{}.Fields()
+	_ = userusageFields
+	// userusageDescCharacters is the schema descriptor for characters field.
+	userusageDescCharacters := userusageFields[2].Descriptor()
+	// userusage.DefaultCharacters holds the default value on creation for the characters field.
+	userusage.DefaultCharacters = userusageDescCharacters.Default.(int)
+	// userusage.CharactersValidator is a validator for the "characters" field. It is called by the builders before save.
+	userusage.CharactersValidator = userusageDescCharacters.Validators[0].(func(int) error)
+	// userusageDescEntries is the schema descriptor for entries field.
+	userusageDescEntries := userusageFields[3].Descriptor()
+	// userusage.DefaultEntries holds the default value on creation for the entries field.
+	userusage.DefaultEntries = userusageDescEntries.Default.(int)
+	// userusage.EntriesValidator is a validator for the "entries" field. It is called by the builders before save.
+	userusage.EntriesValidator = userusageDescEntries.Validators[0].(func(int) error)
+	// userusageDescUpdatedAt is the schema descriptor for updated_at field.
+	userusageDescUpdatedAt := userusageFields[4].Descriptor()
+	// userusage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	userusage.DefaultUpdatedAt = userusageDescUpdatedAt.Default.(func() time.Time)
+	// userusage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	userusage.UpdateDefaultUpdatedAt = userusageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userusageDescID is the schema descriptor for id field.
+	userusageDescID := userusageFields[0].Descriptor()
+	// userusage.DefaultID holds the default value on creation for the id field.
+	userusage.DefaultID = userusageDescID.Default.(func() uuid.UUID)
+	voiceFields := schema.Voice{}.Fields()
+	_ = voiceFields
+	// voiceDescProvider is the schema descriptor for provider field.
+	voiceDescProvider := voiceFields[1].Descriptor()
+	// voice.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	voice.ProviderValidator = voiceDescProvider.Validators[0].(func(string) error)
+	// voiceDescLanguage is the schema descriptor for language field.
+	voiceDescLanguage := voiceFields[2].Descriptor()
+	// voice.LanguageValidator is a validator for the "language" field. It is called by the builders before save.
+	voice.LanguageValidator = voiceDescLanguage.Validators[0].(func(string) error)
+	// voiceDescDisplayName is the schema descriptor for display_name field.
+	voiceDescDisplayName := voiceFields[4].Descriptor()
+	// voice.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	voice.DisplayNameValidator = voiceDescDisplayName.Validators[0].(func(string) error)
+	// voiceDescMinRate is the schema descriptor for min_rate field.
+	voiceDescMinRate := voiceFields[5].Descriptor()
+	// voice.DefaultMinRate holds the default value on creation for the min_rate field.
+	voice.DefaultMinRate = voiceDescMinRate.Default.(float64)
+	// voiceDescMaxRate is the schema descriptor for max_rate field.
+	voiceDescMaxRate := voiceFields[6].Descriptor()
+	// voice.DefaultMaxRate holds the default value on creation for the max_rate field.
+	voice.DefaultMaxRate = voiceDescMaxRate.Default.(float64)
+	// voiceDescMinPitch is the schema descriptor for min_pitch field.
+	voiceDescMinPitch := voiceFields[7].Descriptor()
+	// voice.DefaultMinPitch holds the default value on creation for the min_pitch field.
+	voice.DefaultMinPitch = voiceDescMinPitch.Default.(float64)
+	// voiceDescMaxPitch is the schema descriptor for max_pitch field.
+	voiceDescMaxPitch := voiceFields[8].Descriptor()
+	// voice.DefaultMaxPitch holds the default value on creation for the max_pitch field.
+	voice.DefaultMaxPitch = voiceDescMaxPitch.Default.(float64)
+	// voiceDescEnabled is the schema descriptor for enabled field.
+	voiceDescEnabled := voiceFields[9].Descriptor()
+	// voice.DefaultEnabled holds the default value on creation for the enabled field.
+	voice.DefaultEnabled = voiceDescEnabled.Default.(bool)
+	// voiceDescCreatedAt is the schema descriptor for created_at field.
+	voiceDescCreatedAt := voiceFields[10].Descriptor()
+	// voice.DefaultCreatedAt holds the default value on creation for the created_at field.
+	voice.DefaultCreatedAt = voiceDescCreatedAt.Default.(func() time.Time)
+	// voiceDescUpdatedAt is the schema descriptor for updated_at field.
+	voiceDescUpdatedAt := voiceFields[11].Descriptor()
+	// voice.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	voice.DefaultUpdatedAt = voiceDescUpdatedAt.Default.(func() time.Time)
+	// voice.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	voice.UpdateDefaultUpdatedAt = voiceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// voiceDescID is the schema descriptor for id field.
+	voiceDescID := voiceFields[0].Descriptor()
+	// voice.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	voice.IDValidator = func() func(string) error {
+		validators := voiceDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	voicepresetFields := schema.VoicePreset{}.Fields()
+	_ = voicepresetFields
+	// voicepresetDescName is the schema descriptor for name field.
+	voicepresetDescName := voicepresetFields[1].Descriptor()
+	// voicepreset.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	voicepreset.NameValidator = func() func(string) error {
+		validators := voicepresetDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// voicepresetDescVoice is the schema descriptor for voice field.
+	voicepresetDescVoice := voicepresetFields[2].Descriptor()
+	// voicepreset.VoiceValidator is a validator for the "voice" field. It is called by the builders before save.
+	voicepreset.VoiceValidator = voicepresetDescVoice.Validators[0].(func(string) error)
+	// voicepresetDescRate is the schema descriptor for rate field.
+	voicepresetDescRate := voicepresetFields[3].Descriptor()
+	// voicepreset.DefaultRate holds the default value on creation for the rate field.
+	voicepreset.DefaultRate = voicepresetDescRate.Default.(float64)
+	// voicepreset.RateValidator is a validator for the "rate" field. It is called by the builders before save.
+	voicepreset.RateValidator = func() func(float64) error {
+		validators := voicepresetDescRate.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(rate float64) error {
+			for _, fn := range fns {
+				if err := fn(rate); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// voicepresetDescPitch is the schema descriptor for pitch field.
+	voicepresetDescPitch := voicepresetFields[4].Descriptor()
+	// voicepreset.DefaultPitch holds the default value on creation for the pitch field.
+	voicepreset.DefaultPitch = voicepresetDescPitch.Default.(float64)
+	// voicepreset.PitchValidator is a validator for the "pitch" field. It is called by the builders before save.
+	voicepreset.PitchValidator = func() func(float64) error {
+		validators := voicepresetDescPitch.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(pitch float64) error {
+			for _, fn := range fns {
+				if err := fn(pitch); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// voicepresetDescVolume is the schema descriptor for volume field.
+	voicepresetDescVolume := voicepresetFields[5].Descriptor()
+	// voicepreset.DefaultVolume holds the default value on creation for the volume field.
+	voicepreset.DefaultVolume = voicepresetDescVolume.Default.(float64)
+	// voicepreset.VolumeValidator is a validator for the "volume" field. It is called by the builders before save.
+	voicepreset.VolumeValidator = func() func(float64) error {
+		validators := voicepresetDescVolume.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(volume float64) error {
+			for _, fn := range fns {
+				if err := fn(volume); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// voicepresetDescCreatedAt is the schema descriptor for created_at field.
+	voicepresetDescCreatedAt := voicepresetFields[6].Descriptor()
+	// voicepreset.DefaultCreatedAt holds the default value on creation for the created_at field.
+	voicepreset.DefaultCreatedAt = voicepresetDescCreatedAt.Default.(func() time.Time)
+	// voicepresetDescUpdatedAt is the schema descriptor for updated_at field.
+	voicepresetDescUpdatedAt := voicepresetFields[7].Descriptor()
+	// voicepreset.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	voicepreset.DefaultUpdatedAt = voicepresetDescUpdatedAt.Default.(func() time.Time)
+	// voicepreset.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	voicepreset.UpdateDefaultUpdatedAt = voicepresetDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// voicepresetDescID is the schema descriptor for id field.
+	voicepresetDescID := voicepresetFields[0].Descriptor()
+	// voicepreset.DefaultID holds the default value on creation for the id field.
+	voicepreset.DefaultID = voicepresetDescID.Default.(func() uuid.UUID)
+}
 
 const (
 	Version = "v0.14.5"                                         // Version of ent codegen.
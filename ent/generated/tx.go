@@ -12,10 +12,42 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AudioRender is the client for interacting with the AudioRender builders.
+	AudioRender *AudioRenderClient
+	// Folder is the client for interacting with the Folder builders.
+	Folder *FolderClient
 	// History is the client for interacting with the History builders.
 	History *HistoryClient
+	// HistoryRevision is the client for interacting with the HistoryRevision builders.
+	HistoryRevision *HistoryRevisionClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// LexiconEntry is the client for interacting with the LexiconEntry builders.
+	LexiconEntry *LexiconEntryClient
+	// ModerationRule is the client for interacting with the ModerationRule builders.
+	ModerationRule *ModerationRuleClient
+	// ModerationSetting is the client for interacting with the ModerationSetting builders.
+	ModerationSetting *ModerationSettingClient
+	// Plan is the client for interacting with the Plan builders.
+	Plan *PlanClient
+	// PlayEvent is the client for interacting with the PlayEvent builders.
+	PlayEvent *PlayEventClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
+	// Template is the client for interacting with the Template builders.
+	Template *TemplateClient
+	// UsageEntry is the client for interacting with the UsageEntry builders.
+	UsageEntry *UsageEntryClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserPreference is the client for interacting with the UserPreference builders.
+	UserPreference *UserPreferenceClient
+	// UserUsage is the client for interacting with the UserUsage builders.
+	UserUsage *UserUsageClient
+	// Voice is the client for interacting with the Voice builders.
+	Voice *VoiceClient
+	// VoicePreset is the client for interacting with the VoicePreset builders.
+	VoicePreset *VoicePresetClient
 
 	// lazily loaded.
 	client     *Client
@@ -147,8 +179,24 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AudioRender = NewAudioRenderClient(tx.config)
+	tx.Folder = NewFolderClient(tx.config)
 	tx.History = NewHistoryClient(tx.config)
+	tx.HistoryRevision = NewHistoryRevisionClient(tx.config)
+	tx.IdempotencyKey = NewIdempotencyKeyClient(tx.config)
+	tx.LexiconEntry = NewLexiconEntryClient(tx.config)
+	tx.ModerationRule = NewModerationRuleClient(tx.config)
+	tx.ModerationSetting = NewModerationSettingClient(tx.config)
+	tx.Plan = NewPlanClient(tx.config)
+	tx.PlayEvent = NewPlayEventClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
+	tx.Template = NewTemplateClient(tx.config)
+	tx.UsageEntry = NewUsageEntryClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserPreference = NewUserPreferenceClient(tx.config)
+	tx.UserUsage = NewUserUsageClient(tx.config)
+	tx.Voice = NewVoiceClient(tx.config)
+	tx.VoicePreset = NewVoicePresetClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -158,7 +206,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: History.QueryXXX(), the query will be executed
+// applies a query, for example: AudioRender.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/usageentry"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+)
+
+// UsageEntry is the model entity for the UsageEntry schema.
+type UsageEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind usageentry.Kind `json:"kind,omitempty"`
+	// Characters holds the value of the "characters" field.
+	Characters int `json:"characters,omitempty"`
+	// Entries holds the value of the "entries" field.
+	Entries int `json:"entries,omitempty"`
+	// HistoryID holds the value of the "history_id" field.
+	HistoryID *uuid.UUID `json:"historyId,omitempty"`
+	// PeriodStart holds the value of the "period_start" field.
+	PeriodStart time.Time `json:"periodStart"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UsageEntryQuery when eager-loading is set.
+	Edges              UsageEntryEdges `json:"edges"`
+	user_usage_entries *uuid.UUID
+	selectValues       sql.SelectValues
+}
+
+// UsageEntryEdges holds the relations/edges for other nodes in the graph.
+type UsageEntryEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UsageEntryEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UsageEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usageentry.FieldHistoryID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case usageentry.FieldCharacters, usageentry.FieldEntries:
+			values[i] = new(sql.NullInt64)
+		case usageentry.FieldKind:
+			values[i] = new(sql.NullString)
+		case usageentry.FieldPeriodStart, usageentry.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case usageentry.FieldID:
+			values[i] = new(uuid.UUID)
+		case usageentry.ForeignKeys[0]: // user_usage_entries
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UsageEntry fields.
+func (_m *UsageEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usageentry.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case usageentry.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = usageentry.Kind(value.String)
+			}
+		case usageentry.FieldCharacters:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field characters", values[i])
+			} else if value.Valid {
+				_m.Characters = int(value.Int64)
+			}
+		case usageentry.FieldEntries:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entries", values[i])
+			} else if value.Valid {
+				_m.Entries = int(value.Int64)
+			}
+		case usageentry.FieldHistoryID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field history_id", values[i])
+			} else if value.Valid {
+				_m.HistoryID = new(uuid.UUID)
+				*_m.HistoryID = *value.S.(*uuid.UUID)
+			}
+		case usageentry.FieldPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[i])
+			} else if value.Valid {
+				_m.PeriodStart = value.Time
+			}
+		case usageentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case usageentry.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_usage_entries", values[i])
+			} else if value.Valid {
+				_m.user_usage_entries = new(uuid.UUID)
+				*_m.user_usage_entries = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UsageEntry.
+// This includes values selected through modifiers, order, etc.
+func (_m *UsageEntry) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the UsageEntry entity.
+func (_m *UsageEntry) QueryUser() *UserQuery {
+	return NewUsageEntryClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this UsageEntry.
+// Note that you need to call UsageEntry.Unwrap() before calling this method if this UsageEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *UsageEntry) Update() *UsageEntryUpdateOne {
+	return NewUsageEntryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the UsageEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *UsageEntry) Unwrap() *UsageEntry {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: UsageEntry is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *UsageEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("UsageEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("characters=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Characters))
+	builder.WriteString(", ")
+	builder.WriteString("entries=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Entries))
+	builder.WriteString(", ")
+	if v := _m.HistoryID; v != nil {
+		builder.WriteString("history_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("period_start=")
+	builder.WriteString(_m.PeriodStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UsageEntries is a parsable slice of UsageEntry.
+type UsageEntries []*UsageEntry
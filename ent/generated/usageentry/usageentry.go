@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usageentry
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the usageentry type in the database.
+	Label = "usage_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldCharacters holds the string denoting the characters field in the database.
+	FieldCharacters = "characters"
+	// FieldEntries holds the string denoting the entries field in the database.
+	FieldEntries = "entries"
+	// FieldHistoryID holds the string denoting the history_id field in the database.
+	FieldHistoryID = "history_id"
+	// FieldPeriodStart holds the string denoting the period_start field in the database.
+	FieldPeriodStart = "period_start"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the usageentry in the database.
+	Table = "usage_entries"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "usage_entries"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_usage_entries"
+)
+
+// Columns holds all SQL columns for usageentry fields.
+var Columns = []string{
+	FieldID,
+	FieldKind,
+	FieldCharacters,
+	FieldEntries,
+	FieldHistoryID,
+	FieldPeriodStart,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "usage_entries"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_usage_entries",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindCreate Kind = "create"
+	KindUpdate Kind = "update"
+	KindImport Kind = "import"
+	KindRefund Kind = "refund"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindCreate, KindUpdate, KindImport, KindRefund:
+		return nil
+	default:
+		return fmt.Errorf("usageentry: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the UsageEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByCharacters orders the results by the characters field.
+func ByCharacters(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCharacters, opts...).ToFunc()
+}
+
+// ByEntries orders the results by the entries field.
+func ByEntries(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntries, opts...).ToFunc()
+}
+
+// ByHistoryID orders the results by the history_id field.
+func ByHistoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHistoryID, opts...).ToFunc()
+}
+
+// ByPeriodStart orders the results by the period_start field.
+func ByPeriodStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodStart, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usageentry
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldLTE(FieldID, id))
+}
+
+// Characters applies equality check predicate on the "characters" field. It's identical to CharactersEQ.
+func Characters(v int) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldEQ(FieldCharacters, v))
+}
+
+// Entries applies equality check predicate on the "entries" field. It's identical to EntriesEQ.
+func Entries(v int) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldEQ(FieldEntries, v))
+}
+
+// HistoryID applies equality check predicate on the "history_id" field. It's identical to HistoryIDEQ.
+func HistoryID(v uuid.UUID) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldEQ(FieldHistoryID, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// CharactersEQ applies the EQ predicate on the "characters" field.
+func CharactersEQ(v int) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldEQ(FieldCharacters, v))
+}
+
+// CharactersNEQ applies the NEQ predicate on the "characters" field.
+func CharactersNEQ(v int) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldNEQ(FieldCharacters, v))
+}
+
+// CharactersIn applies the In predicate on the "characters" field.
+func CharactersIn(vs ...int) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldIn(FieldCharacters, vs...))
+}
+
+// CharactersNotIn applies the NotIn predicate on the "characters" field.
+func CharactersNotIn(vs ...int) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldNotIn(FieldCharacters, vs...))
+}
+
+// CharactersGT applies the GT predicate on the "characters" field.
+func CharactersGT(v int) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldGT(FieldCharacters, v))
+}
+
+// CharactersGTE applies the GTE predicate on the "characters" field.
+func CharactersGTE(v int) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldGTE(FieldCharacters, v))
+}
+
+// CharactersLT applies the LT predicate on the "characters" field.
+func CharactersLT(v int) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldLT(FieldCharacters, v))
+}
+
+// CharactersLTE applies the LTE predicate on the "characters" field.
+func CharactersLTE(v int) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldLTE(FieldCharacters, v))
+}
+
+// EntriesEQ applies the EQ predicate on the "entries" field.
+func EntriesEQ(v int) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldEQ(FieldEntries, v))
+}
+
+// EntriesNEQ applies the NEQ predicate on the "entries" field.
+func EntriesNEQ(v int) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldNEQ(FieldEntries, v))
+}
+
+// EntriesIn applies the In predicate on the "entries" field.
+func EntriesIn(vs ...int) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldIn(FieldEntries, vs...))
+}
+
+// EntriesNotIn applies the NotIn predicate on the "entries" field.
+func EntriesNotIn(vs ...int) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldNotIn(FieldEntries, vs...))
+}
+
+// EntriesGT applies the GT predicate on the "entries" field.
+func EntriesGT(v int) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldGT(FieldEntries, v))
+}
+
+// EntriesGTE applies the GTE predicate on the "entries" field.
+func EntriesGTE(v int) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldGTE(FieldEntries, v))
+}
+
+// EntriesLT applies the LT predicate on the "entries" field.
+func EntriesLT(v int) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldLT(FieldEntries, v))
+}
+
+// EntriesLTE applies the LTE predicate on the "entries" field.
+func EntriesLTE(v int) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldLTE(FieldEntries, v))
+}
+
+// HistoryIDEQ applies the EQ predicate on the "history_id" field.
+func HistoryIDEQ(v uuid.UUID) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldEQ(FieldHistoryID, v))
+}
+
+// HistoryIDNEQ applies the NEQ predicate on the "history_id" field.
+func HistoryIDNEQ(v uuid.UUID) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldNEQ(FieldHistoryID, v))
+}
+
+// HistoryIDIn applies the In predicate on the "history_id" field.
+func HistoryIDIn(vs ...uuid.UUID) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldIn(FieldHistoryID, vs...))
+}
+
+// HistoryIDNotIn applies the NotIn predicate on the "history_id" field.
+func HistoryIDNotIn(vs ...uuid.UUID) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldNotIn(FieldHistoryID, vs...))
+}
+
+// HistoryIDGT applies the GT predicate on the "history_id" field.
+func HistoryIDGT(v uuid.UUID) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldGT(FieldHistoryID, v))
+}
+
+// HistoryIDGTE applies the GTE predicate on the "history_id" field.
+func HistoryIDGTE(v uuid.UUID) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldGTE(FieldHistoryID, v))
+}
+
+// HistoryIDLT applies the LT predicate on the "history_id" field.
+func HistoryIDLT(v uuid.UUID) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldLT(FieldHistoryID, v))
+}
+
+// HistoryIDLTE applies the LTE predicate on the "history_id" field.
+func HistoryIDLTE(v uuid.UUID) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldLTE(FieldHistoryID, v))
+}
+
+// HistoryIDIsNil applies the IsNil predicate on the "history_id" field.
+func HistoryIDIsNil() predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldIsNull(FieldHistoryID))
+}
+
+// HistoryIDNotNil applies the NotNil predicate on the "history_id" field.
+func HistoryIDNotNil() predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldNotNull(FieldHistoryID))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.UsageEntry {
+	return predicate.UsageEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.UsageEntry {
+	return predicate.UsageEntry(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UsageEntry) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UsageEntry) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UsageEntry) predicate.UsageEntry {
+	return predicate.UsageEntry(sql.NotPredicates(p))
+}
@@ -0,0 +1,333 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/usageentry"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+)
+
+// UsageEntryCreate is the builder for creating a UsageEntry entity.
+type UsageEntryCreate struct {
+	config
+	mutation *UsageEntryMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (_c *UsageEntryCreate) SetKind(v usageentry.Kind) *UsageEntryCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetCharacters sets the "characters" field.
+func (_c *UsageEntryCreate) SetCharacters(v int) *UsageEntryCreate {
+	_c.mutation.SetCharacters(v)
+	return _c
+}
+
+// SetEntries sets the "entries" field.
+func (_c *UsageEntryCreate) SetEntries(v int) *UsageEntryCreate {
+	_c.mutation.SetEntries(v)
+	return _c
+}
+
+// SetHistoryID sets the "history_id" field.
+func (_c *UsageEntryCreate) SetHistoryID(v uuid.UUID) *UsageEntryCreate {
+	_c.mutation.SetHistoryID(v)
+	return _c
+}
+
+// SetNillableHistoryID sets the "history_id" field if the given value is not nil.
+func (_c *UsageEntryCreate) SetNillableHistoryID(v *uuid.UUID) *UsageEntryCreate {
+	if v != nil {
+		_c.SetHistoryID(*v)
+	}
+	return _c
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_c *UsageEntryCreate) SetPeriodStart(v time.Time) *UsageEntryCreate {
+	_c.mutation.SetPeriodStart(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UsageEntryCreate) SetCreatedAt(v time.Time) *UsageEntryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UsageEntryCreate) SetNillableCreatedAt(v *time.Time) *UsageEntryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UsageEntryCreate) SetID(v uuid.UUID) *UsageEntryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *UsageEntryCreate) SetNillableID(v *uuid.UUID) *UsageEntryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *UsageEntryCreate) SetUserID(id uuid.UUID) *UsageEntryCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *UsageEntryCreate) SetUser(v *User) *UsageEntryCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the UsageEntryMutation object of the builder.
+func (_c *UsageEntryCreate) Mutation() *UsageEntryMutation {
+	return _c.mutation
+}
+
+// Save creates the UsageEntry in the database.
+func (_c *UsageEntryCreate) Save(ctx context.Context) (*UsageEntry, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UsageEntryCreate) SaveX(ctx context.Context) *UsageEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UsageEntryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UsageEntryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UsageEntryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := usageentry.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := usageentry.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UsageEntryCreate) check() error {
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`generated: missing required field "UsageEntry.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := usageentry.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`generated: validator failed for field "UsageEntry.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Characters(); !ok {
+		return &ValidationError{Name: "characters", err: errors.New(`generated: missing required field "UsageEntry.characters"`)}
+	}
+	if _, ok := _c.mutation.Entries(); !ok {
+		return &ValidationError{Name: "entries", err: errors.New(`generated: missing required field "UsageEntry.entries"`)}
+	}
+	if _, ok := _c.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`generated: missing required field "UsageEntry.period_start"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "UsageEntry.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`generated: missing required edge "UsageEntry.user"`)}
+	}
+	return nil
+}
+
+func (_c *UsageEntryCreate) sqlSave(ctx context.Context) (*UsageEntry, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UsageEntryCreate) createSpec() (*UsageEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UsageEntry{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(usageentry.Table, sqlgraph.NewFieldSpec(usageentry.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(usageentry.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Characters(); ok {
+		_spec.SetField(usageentry.FieldCharacters, field.TypeInt, value)
+		_node.Characters = value
+	}
+	if value, ok := _c.mutation.Entries(); ok {
+		_spec.SetField(usageentry.FieldEntries, field.TypeInt, value)
+		_node.Entries = value
+	}
+	if value, ok := _c.mutation.HistoryID(); ok {
+		_spec.SetField(usageentry.FieldHistoryID, field.TypeUUID, value)
+		_node.HistoryID = &value
+	}
+	if value, ok := _c.mutation.PeriodStart(); ok {
+		_spec.SetField(usageentry.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(usageentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usageentry.UserTable,
+			Columns: []string{usageentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_usage_entries = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UsageEntryCreateBulk is the builder for creating many UsageEntry entities in bulk.
+type UsageEntryCreateBulk struct {
+	config
+	err      error
+	builders []*UsageEntryCreate
+}
+
+// Save creates the UsageEntry entities in the database.
+func (_c *UsageEntryCreateBulk) Save(ctx context.Context) ([]*UsageEntry, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UsageEntry, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsageEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UsageEntryCreateBulk) SaveX(ctx context.Context) []*UsageEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UsageEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UsageEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/usageentry"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+)
+
+// UsageEntryUpdate is the builder for updating UsageEntry entities.
+type UsageEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UsageEntryMutation
+}
+
+// Where appends a list predicates to the UsageEntryUpdate builder.
+func (_u *UsageEntryUpdate) Where(ps ...predicate.UsageEntry) *UsageEntryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetHistoryID sets the "history_id" field.
+func (_u *UsageEntryUpdate) SetHistoryID(v uuid.UUID) *UsageEntryUpdate {
+	_u.mutation.SetHistoryID(v)
+	return _u
+}
+
+// SetNillableHistoryID sets the "history_id" field if the given value is not nil.
+func (_u *UsageEntryUpdate) SetNillableHistoryID(v *uuid.UUID) *UsageEntryUpdate {
+	if v != nil {
+		_u.SetHistoryID(*v)
+	}
+	return _u
+}
+
+// ClearHistoryID clears the value of the "history_id" field.
+func (_u *UsageEntryUpdate) ClearHistoryID() *UsageEntryUpdate {
+	_u.mutation.ClearHistoryID()
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *UsageEntryUpdate) SetUserID(id uuid.UUID) *UsageEntryUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *UsageEntryUpdate) SetUser(v *User) *UsageEntryUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the UsageEntryMutation object of the builder.
+func (_u *UsageEntryUpdate) Mutation() *UsageEntryMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *UsageEntryUpdate) ClearUser() *UsageEntryUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UsageEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UsageEntryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageEntryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UsageEntryUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "UsageEntry.user"`)
+	}
+	return nil
+}
+
+func (_u *UsageEntryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usageentry.Table, usageentry.Columns, sqlgraph.NewFieldSpec(usageentry.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.HistoryID(); ok {
+		_spec.SetField(usageentry.FieldHistoryID, field.TypeUUID, value)
+	}
+	if _u.mutation.HistoryIDCleared() {
+		_spec.ClearField(usageentry.FieldHistoryID, field.TypeUUID)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usageentry.UserTable,
+			Columns: []string{usageentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usageentry.UserTable,
+			Columns: []string{usageentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usageentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UsageEntryUpdateOne is the builder for updating a single UsageEntry entity.
+type UsageEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UsageEntryMutation
+}
+
+// SetHistoryID sets the "history_id" field.
+func (_u *UsageEntryUpdateOne) SetHistoryID(v uuid.UUID) *UsageEntryUpdateOne {
+	_u.mutation.SetHistoryID(v)
+	return _u
+}
+
+// SetNillableHistoryID sets the "history_id" field if the given value is not nil.
+func (_u *UsageEntryUpdateOne) SetNillableHistoryID(v *uuid.UUID) *UsageEntryUpdateOne {
+	if v != nil {
+		_u.SetHistoryID(*v)
+	}
+	return _u
+}
+
+// ClearHistoryID clears the value of the "history_id" field.
+func (_u *UsageEntryUpdateOne) ClearHistoryID() *UsageEntryUpdateOne {
+	_u.mutation.ClearHistoryID()
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *UsageEntryUpdateOne) SetUserID(id uuid.UUID) *UsageEntryUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *UsageEntryUpdateOne) SetUser(v *User) *UsageEntryUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the UsageEntryMutation object of the builder.
+func (_u *UsageEntryUpdateOne) Mutation() *UsageEntryMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *UsageEntryUpdateOne) ClearUser() *UsageEntryUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the UsageEntryUpdate builder.
+func (_u *UsageEntryUpdateOne) Where(ps ...predicate.UsageEntry) *UsageEntryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UsageEntryUpdateOne) Select(field string, fields ...string) *UsageEntryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UsageEntry entity.
+func (_u *UsageEntryUpdateOne) Save(ctx context.Context) (*UsageEntry, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageEntryUpdateOne) SaveX(ctx context.Context) *UsageEntry {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UsageEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UsageEntryUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "UsageEntry.user"`)
+	}
+	return nil
+}
+
+func (_u *UsageEntryUpdateOne) sqlSave(ctx context.Context) (_node *UsageEntry, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usageentry.Table, usageentry.Columns, sqlgraph.NewFieldSpec(usageentry.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "UsageEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usageentry.FieldID)
+		for _, f := range fields {
+			if !usageentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != usageentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.HistoryID(); ok {
+		_spec.SetField(usageentry.FieldHistoryID, field.TypeUUID, value)
+	}
+	if _u.mutation.HistoryIDCleared() {
+		_spec.ClearField(usageentry.FieldHistoryID, field.TypeUUID)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usageentry.UserTable,
+			Columns: []string{usageentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   usageentry.UserTable,
+			Columns: []string{usageentry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &UsageEntry{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usageentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
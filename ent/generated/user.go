@@ -10,7 +10,11 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationsetting"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/plan"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userpreference"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userusage"
 )
 
 // User is the model entity for the User schema.
@@ -31,6 +35,7 @@ type User struct {
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
+	plan_users   *string
 	selectValues sql.SelectValues
 }
 
@@ -38,9 +43,35 @@ type User struct {
 type UserEdges struct {
 	// Histories holds the value of the histories edge.
 	Histories []*History `json:"histories,omitempty"`
+	// IdempotencyKeys holds the value of the idempotency_keys edge.
+	IdempotencyKeys []*IdempotencyKey `json:"idempotency_keys,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Folders holds the value of the folders edge.
+	Folders []*Folder `json:"folders,omitempty"`
+	// VoicePresets holds the value of the voice_presets edge.
+	VoicePresets []*VoicePreset `json:"voice_presets,omitempty"`
+	// Preference holds the value of the preference edge.
+	Preference *UserPreference `json:"preference,omitempty"`
+	// Usage holds the value of the usage edge.
+	Usage *UserUsage `json:"usage,omitempty"`
+	// UsageEntries holds the value of the usage_entries edge.
+	UsageEntries []*UsageEntry `json:"usage_entries,omitempty"`
+	// PlayEvents holds the value of the play_events edge.
+	PlayEvents []*PlayEvent `json:"play_events,omitempty"`
+	// LexiconEntries holds the value of the lexicon_entries edge.
+	LexiconEntries []*LexiconEntry `json:"lexicon_entries,omitempty"`
+	// Templates holds the value of the templates edge.
+	Templates []*Template `json:"templates,omitempty"`
+	// ModerationRules holds the value of the moderation_rules edge.
+	ModerationRules []*ModerationRule `json:"moderation_rules,omitempty"`
+	// ModerationSetting holds the value of the moderation_setting edge.
+	ModerationSetting *ModerationSetting `json:"moderation_setting,omitempty"`
+	// Plan holds the value of the plan edge.
+	Plan *Plan `json:"plan,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [14]bool
 }
 
 // HistoriesOrErr returns the Histories value or an error if the edge
@@ -52,6 +83,131 @@ func (e UserEdges) HistoriesOrErr() ([]*History, error) {
 	return nil, &NotLoadedError{edge: "histories"}
 }
 
+// IdempotencyKeysOrErr returns the IdempotencyKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdempotencyKeysOrErr() ([]*IdempotencyKey, error) {
+	if e.loadedTypes[1] {
+		return e.IdempotencyKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "idempotency_keys"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[2] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// FoldersOrErr returns the Folders value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FoldersOrErr() ([]*Folder, error) {
+	if e.loadedTypes[3] {
+		return e.Folders, nil
+	}
+	return nil, &NotLoadedError{edge: "folders"}
+}
+
+// VoicePresetsOrErr returns the VoicePresets value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) VoicePresetsOrErr() ([]*VoicePreset, error) {
+	if e.loadedTypes[4] {
+		return e.VoicePresets, nil
+	}
+	return nil, &NotLoadedError{edge: "voice_presets"}
+}
+
+// PreferenceOrErr returns the Preference value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) PreferenceOrErr() (*UserPreference, error) {
+	if e.Preference != nil {
+		return e.Preference, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: userpreference.Label}
+	}
+	return nil, &NotLoadedError{edge: "preference"}
+}
+
+// UsageOrErr returns the Usage value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) UsageOrErr() (*UserUsage, error) {
+	if e.Usage != nil {
+		return e.Usage, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: userusage.Label}
+	}
+	return nil, &NotLoadedError{edge: "usage"}
+}
+
+// UsageEntriesOrErr returns the UsageEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) UsageEntriesOrErr() ([]*UsageEntry, error) {
+	if e.loadedTypes[7] {
+		return e.UsageEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "usage_entries"}
+}
+
+// PlayEventsOrErr returns the PlayEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PlayEventsOrErr() ([]*PlayEvent, error) {
+	if e.loadedTypes[8] {
+		return e.PlayEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "play_events"}
+}
+
+// LexiconEntriesOrErr returns the LexiconEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LexiconEntriesOrErr() ([]*LexiconEntry, error) {
+	if e.loadedTypes[9] {
+		return e.LexiconEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "lexicon_entries"}
+}
+
+// TemplatesOrErr returns the Templates value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TemplatesOrErr() ([]*Template, error) {
+	if e.loadedTypes[10] {
+		return e.Templates, nil
+	}
+	return nil, &NotLoadedError{edge: "templates"}
+}
+
+// ModerationRulesOrErr returns the ModerationRules value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ModerationRulesOrErr() ([]*ModerationRule, error) {
+	if e.loadedTypes[11] {
+		return e.ModerationRules, nil
+	}
+	return nil, &NotLoadedError{edge: "moderation_rules"}
+}
+
+// ModerationSettingOrErr returns the ModerationSetting value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) ModerationSettingOrErr() (*ModerationSetting, error) {
+	if e.ModerationSetting != nil {
+		return e.ModerationSetting, nil
+	} else if e.loadedTypes[12] {
+		return nil, &NotFoundError{label: moderationsetting.Label}
+	}
+	return nil, &NotLoadedError{edge: "moderation_setting"}
+}
+
+// PlanOrErr returns the Plan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) PlanOrErr() (*Plan, error) {
+	if e.Plan != nil {
+		return e.Plan, nil
+	} else if e.loadedTypes[13] {
+		return nil, &NotFoundError{label: plan.Label}
+	}
+	return nil, &NotLoadedError{edge: "plan"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -63,6 +219,8 @@ func (*User) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullTime)
 		case user.FieldID:
 			values[i] = new(uuid.UUID)
+		case user.ForeignKeys[0]: // plan_users
+			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -114,6 +272,13 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case user.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_users", values[i])
+			} else if value.Valid {
+				_m.plan_users = new(string)
+				*_m.plan_users = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -132,6 +297,71 @@ func (_m *User) QueryHistories() *HistoryQuery {
 	return NewUserClient(_m.config).QueryHistories(_m)
 }
 
+// QueryIdempotencyKeys queries the "idempotency_keys" edge of the User entity.
+func (_m *User) QueryIdempotencyKeys() *IdempotencyKeyQuery {
+	return NewUserClient(_m.config).QueryIdempotencyKeys(_m)
+}
+
+// QueryTags queries the "tags" edge of the User entity.
+func (_m *User) QueryTags() *TagQuery {
+	return NewUserClient(_m.config).QueryTags(_m)
+}
+
+// QueryFolders queries the "folders" edge of the User entity.
+func (_m *User) QueryFolders() *FolderQuery {
+	return NewUserClient(_m.config).QueryFolders(_m)
+}
+
+// QueryVoicePresets queries the "voice_presets" edge of the User entity.
+func (_m *User) QueryVoicePresets() *VoicePresetQuery {
+	return NewUserClient(_m.config).QueryVoicePresets(_m)
+}
+
+// QueryPreference queries the "preference" edge of the User entity.
+func (_m *User) QueryPreference() *UserPreferenceQuery {
+	return NewUserClient(_m.config).QueryPreference(_m)
+}
+
+// QueryUsage queries the "usage" edge of the User entity.
+func (_m *User) QueryUsage() *UserUsageQuery {
+	return NewUserClient(_m.config).QueryUsage(_m)
+}
+
+// QueryUsageEntries queries the "usage_entries" edge of the User entity.
+func (_m *User) QueryUsageEntries() *UsageEntryQuery {
+	return NewUserClient(_m.config).QueryUsageEntries(_m)
+}
+
+// QueryPlayEvents queries the "play_events" edge of the User entity.
+func (_m *User) QueryPlayEvents() *PlayEventQuery {
+	return NewUserClient(_m.config).QueryPlayEvents(_m)
+}
+
+// QueryLexiconEntries queries the "lexicon_entries" edge of the User entity.
+func (_m *User) QueryLexiconEntries() *LexiconEntryQuery {
+	return NewUserClient(_m.config).QueryLexiconEntries(_m)
+}
+
+// QueryTemplates queries the "templates" edge of the User entity.
+func (_m *User) QueryTemplates() *TemplateQuery {
+	return NewUserClient(_m.config).QueryTemplates(_m)
+}
+
+// QueryModerationRules queries the "moderation_rules" edge of the User entity.
+func (_m *User) QueryModerationRules() *ModerationRuleQuery {
+	return NewUserClient(_m.config).QueryModerationRules(_m)
+}
+
+// QueryModerationSetting queries the "moderation_setting" edge of the User entity.
+func (_m *User) QueryModerationSetting() *ModerationSettingQuery {
+	return NewUserClient(_m.config).QueryModerationSetting(_m)
+}
+
+// QueryPlan queries the "plan" edge of the User entity.
+func (_m *User) QueryPlan() *PlanQuery {
+	return NewUserClient(_m.config).QueryPlan(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -27,6 +27,32 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// EdgeHistories holds the string denoting the histories edge name in mutations.
 	EdgeHistories = "histories"
+	// EdgeIdempotencyKeys holds the string denoting the idempotency_keys edge name in mutations.
+	EdgeIdempotencyKeys = "idempotency_keys"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgeFolders holds the string denoting the folders edge name in mutations.
+	EdgeFolders = "folders"
+	// EdgeVoicePresets holds the string denoting the voice_presets edge name in mutations.
+	EdgeVoicePresets = "voice_presets"
+	// EdgePreference holds the string denoting the preference edge name in mutations.
+	EdgePreference = "preference"
+	// EdgeUsage holds the string denoting the usage edge name in mutations.
+	EdgeUsage = "usage"
+	// EdgeUsageEntries holds the string denoting the usage_entries edge name in mutations.
+	EdgeUsageEntries = "usage_entries"
+	// EdgePlayEvents holds the string denoting the play_events edge name in mutations.
+	EdgePlayEvents = "play_events"
+	// EdgeLexiconEntries holds the string denoting the lexicon_entries edge name in mutations.
+	EdgeLexiconEntries = "lexicon_entries"
+	// EdgeTemplates holds the string denoting the templates edge name in mutations.
+	EdgeTemplates = "templates"
+	// EdgeModerationRules holds the string denoting the moderation_rules edge name in mutations.
+	EdgeModerationRules = "moderation_rules"
+	// EdgeModerationSetting holds the string denoting the moderation_setting edge name in mutations.
+	EdgeModerationSetting = "moderation_setting"
+	// EdgePlan holds the string denoting the plan edge name in mutations.
+	EdgePlan = "plan"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// HistoriesTable is the table that holds the histories relation/edge.
@@ -36,6 +62,97 @@ const (
 	HistoriesInverseTable = "histories"
 	// HistoriesColumn is the table column denoting the histories relation/edge.
 	HistoriesColumn = "user_histories"
+	// IdempotencyKeysTable is the table that holds the idempotency_keys relation/edge.
+	IdempotencyKeysTable = "idempotency_keys"
+	// IdempotencyKeysInverseTable is the table name for the IdempotencyKey entity.
+	// It exists in this package in order to avoid circular dependency with the "idempotencykey" package.
+	IdempotencyKeysInverseTable = "idempotency_keys"
+	// IdempotencyKeysColumn is the table column denoting the idempotency_keys relation/edge.
+	IdempotencyKeysColumn = "user_idempotency_keys"
+	// TagsTable is the table that holds the tags relation/edge.
+	TagsTable = "tags"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
+	// TagsColumn is the table column denoting the tags relation/edge.
+	TagsColumn = "user_tags"
+	// FoldersTable is the table that holds the folders relation/edge.
+	FoldersTable = "folders"
+	// FoldersInverseTable is the table name for the Folder entity.
+	// It exists in this package in order to avoid circular dependency with the "folder" package.
+	FoldersInverseTable = "folders"
+	// FoldersColumn is the table column denoting the folders relation/edge.
+	FoldersColumn = "user_folders"
+	// VoicePresetsTable is the table that holds the voice_presets relation/edge.
+	VoicePresetsTable = "voice_presets"
+	// VoicePresetsInverseTable is the table name for the VoicePreset entity.
+	// It exists in this package in order to avoid circular dependency with the "voicepreset" package.
+	VoicePresetsInverseTable = "voice_presets"
+	// VoicePresetsColumn is the table column denoting the voice_presets relation/edge.
+	VoicePresetsColumn = "user_voice_presets"
+	// PreferenceTable is the table that holds the preference relation/edge.
+	PreferenceTable = "user_preferences"
+	// PreferenceInverseTable is the table name for the UserPreference entity.
+	// It exists in this package in order to avoid circular dependency with the "userpreference" package.
+	PreferenceInverseTable = "user_preferences"
+	// PreferenceColumn is the table column denoting the preference relation/edge.
+	PreferenceColumn = "user_preference"
+	// UsageTable is the table that holds the usage relation/edge.
+	UsageTable = "user_usages"
+	// UsageInverseTable is the table name for the UserUsage entity.
+	// It exists in this package in order to avoid circular dependency with the "userusage" package.
+	UsageInverseTable = "user_usages"
+	// UsageColumn is the table column denoting the usage relation/edge.
+	UsageColumn = "user_usage"
+	// UsageEntriesTable is the table that holds the usage_entries relation/edge.
+	UsageEntriesTable = "usage_entries"
+	// UsageEntriesInverseTable is the table name for the UsageEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "usageentry" package.
+	UsageEntriesInverseTable = "usage_entries"
+	// UsageEntriesColumn is the table column denoting the usage_entries relation/edge.
+	UsageEntriesColumn = "user_usage_entries"
+	// PlayEventsTable is the table that holds the play_events relation/edge.
+	PlayEventsTable = "play_events"
+	// PlayEventsInverseTable is the table name for the PlayEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "playevent" package.
+	PlayEventsInverseTable = "play_events"
+	// PlayEventsColumn is the table column denoting the play_events relation/edge.
+	PlayEventsColumn = "user_play_events"
+	// LexiconEntriesTable is the table that holds the lexicon_entries relation/edge.
+	LexiconEntriesTable = "lexicon_entries"
+	// LexiconEntriesInverseTable is the table name for the LexiconEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "lexiconentry" package.
+	LexiconEntriesInverseTable = "lexicon_entries"
+	// LexiconEntriesColumn is the table column denoting the lexicon_entries relation/edge.
+	LexiconEntriesColumn = "user_lexicon_entries"
+	// TemplatesTable is the table that holds the templates relation/edge.
+	TemplatesTable = "templates"
+	// TemplatesInverseTable is the table name for the Template entity.
+	// It exists in this package in order to avoid circular dependency with the "template" package.
+	TemplatesInverseTable = "templates"
+	// TemplatesColumn is the table column denoting the templates relation/edge.
+	TemplatesColumn = "user_templates"
+	// ModerationRulesTable is the table that holds the moderation_rules relation/edge.
+	ModerationRulesTable = "moderation_rules"
+	// ModerationRulesInverseTable is the table name for the ModerationRule entity.
+	// It exists in this package in order to avoid circular dependency with the "moderationrule" package.
+	ModerationRulesInverseTable = "moderation_rules"
+	// ModerationRulesColumn is the table column denoting the moderation_rules relation/edge.
+	ModerationRulesColumn = "user_moderation_rules"
+	// ModerationSettingTable is the table that holds the moderation_setting relation/edge.
+	ModerationSettingTable = "moderation_settings"
+	// ModerationSettingInverseTable is the table name for the ModerationSetting entity.
+	// It exists in this package in order to avoid circular dependency with the "moderationsetting" package.
+	ModerationSettingInverseTable = "moderation_settings"
+	// ModerationSettingColumn is the table column denoting the moderation_setting relation/edge.
+	ModerationSettingColumn = "user_moderation_setting"
+	// PlanTable is the table that holds the plan relation/edge.
+	PlanTable = "users"
+	// PlanInverseTable is the table name for the Plan entity.
+	// It exists in this package in order to avoid circular dependency with the "plan" package.
+	PlanInverseTable = "plans"
+	// PlanColumn is the table column denoting the plan relation/edge.
+	PlanColumn = "plan_users"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -48,6 +165,12 @@ var Columns = []string{
 	FieldUpdatedAt,
 }
 
+// ForeignKeys holds the SQL foreign-keys that are owned by the "users"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"plan_users",
+}
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -55,6 +178,11 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
 	return false
 }
 
@@ -121,6 +249,160 @@ func ByHistories(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newHistoriesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByIdempotencyKeysCount orders the results by idempotency_keys count.
+func ByIdempotencyKeysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIdempotencyKeysStep(), opts...)
+	}
+}
+
+// ByIdempotencyKeys orders the results by idempotency_keys terms.
+func ByIdempotencyKeys(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIdempotencyKeysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFoldersCount orders the results by folders count.
+func ByFoldersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFoldersStep(), opts...)
+	}
+}
+
+// ByFolders orders the results by folders terms.
+func ByFolders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFoldersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByVoicePresetsCount orders the results by voice_presets count.
+func ByVoicePresetsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVoicePresetsStep(), opts...)
+	}
+}
+
+// ByVoicePresets orders the results by voice_presets terms.
+func ByVoicePresets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVoicePresetsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPreferenceField orders the results by preference field.
+func ByPreferenceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPreferenceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByUsageField orders the results by usage field.
+func ByUsageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUsageStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByUsageEntriesCount orders the results by usage_entries count.
+func ByUsageEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUsageEntriesStep(), opts...)
+	}
+}
+
+// ByUsageEntries orders the results by usage_entries terms.
+func ByUsageEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUsageEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPlayEventsCount orders the results by play_events count.
+func ByPlayEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPlayEventsStep(), opts...)
+	}
+}
+
+// ByPlayEvents orders the results by play_events terms.
+func ByPlayEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlayEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLexiconEntriesCount orders the results by lexicon_entries count.
+func ByLexiconEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLexiconEntriesStep(), opts...)
+	}
+}
+
+// ByLexiconEntries orders the results by lexicon_entries terms.
+func ByLexiconEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLexiconEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTemplatesCount orders the results by templates count.
+func ByTemplatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTemplatesStep(), opts...)
+	}
+}
+
+// ByTemplates orders the results by templates terms.
+func ByTemplates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTemplatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByModerationRulesCount orders the results by moderation_rules count.
+func ByModerationRulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newModerationRulesStep(), opts...)
+	}
+}
+
+// ByModerationRules orders the results by moderation_rules terms.
+func ByModerationRules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newModerationRulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByModerationSettingField orders the results by moderation_setting field.
+func ByModerationSettingField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newModerationSettingStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPlanField orders the results by plan field.
+func ByPlanField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlanStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newHistoriesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -128,3 +410,94 @@ func newHistoriesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, HistoriesTable, HistoriesColumn),
 	)
 }
+func newIdempotencyKeysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IdempotencyKeysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IdempotencyKeysTable, IdempotencyKeysColumn),
+	)
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TagsTable, TagsColumn),
+	)
+}
+func newFoldersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FoldersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FoldersTable, FoldersColumn),
+	)
+}
+func newVoicePresetsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VoicePresetsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VoicePresetsTable, VoicePresetsColumn),
+	)
+}
+func newPreferenceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PreferenceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, PreferenceTable, PreferenceColumn),
+	)
+}
+func newUsageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UsageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, UsageTable, UsageColumn),
+	)
+}
+func newUsageEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UsageEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UsageEntriesTable, UsageEntriesColumn),
+	)
+}
+func newPlayEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PlayEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PlayEventsTable, PlayEventsColumn),
+	)
+}
+func newLexiconEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LexiconEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LexiconEntriesTable, LexiconEntriesColumn),
+	)
+}
+func newTemplatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TemplatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TemplatesTable, TemplatesColumn),
+	)
+}
+func newModerationRulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ModerationRulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ModerationRulesTable, ModerationRulesColumn),
+	)
+}
+func newModerationSettingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ModerationSettingInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, ModerationSettingTable, ModerationSettingColumn),
+	)
+}
+func newPlanStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PlanInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PlanTable, PlanColumn),
+	)
+}
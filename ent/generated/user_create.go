@@ -11,8 +11,21 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/folder"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/idempotencykey"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/lexiconentry"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationrule"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationsetting"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/plan"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/playevent"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/tag"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/template"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/usageentry"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userpreference"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userusage"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/voicepreset"
 )
 
 // UserCreate is the builder for creating a User entity.
@@ -97,6 +110,217 @@ func (_c *UserCreate) AddHistories(v ...*History) *UserCreate {
 	return _c.AddHistoryIDs(ids...)
 }
 
+// AddIdempotencyKeyIDs adds the "idempotency_keys" edge to the IdempotencyKey entity by IDs.
+func (_c *UserCreate) AddIdempotencyKeyIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddIdempotencyKeyIDs(ids...)
+	return _c
+}
+
+// AddIdempotencyKeys adds the "idempotency_keys" edges to the IdempotencyKey entity.
+func (_c *UserCreate) AddIdempotencyKeys(v ...*IdempotencyKey) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddIdempotencyKeyIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_c *UserCreate) AddTagIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddTagIDs(ids...)
+	return _c
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_c *UserCreate) AddTags(v ...*Tag) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTagIDs(ids...)
+}
+
+// AddFolderIDs adds the "folders" edge to the Folder entity by IDs.
+func (_c *UserCreate) AddFolderIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddFolderIDs(ids...)
+	return _c
+}
+
+// AddFolders adds the "folders" edges to the Folder entity.
+func (_c *UserCreate) AddFolders(v ...*Folder) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFolderIDs(ids...)
+}
+
+// AddVoicePresetIDs adds the "voice_presets" edge to the VoicePreset entity by IDs.
+func (_c *UserCreate) AddVoicePresetIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddVoicePresetIDs(ids...)
+	return _c
+}
+
+// AddVoicePresets adds the "voice_presets" edges to the VoicePreset entity.
+func (_c *UserCreate) AddVoicePresets(v ...*VoicePreset) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVoicePresetIDs(ids...)
+}
+
+// SetPreferenceID sets the "preference" edge to the UserPreference entity by ID.
+func (_c *UserCreate) SetPreferenceID(id uuid.UUID) *UserCreate {
+	_c.mutation.SetPreferenceID(id)
+	return _c
+}
+
+// SetNillablePreferenceID sets the "preference" edge to the UserPreference entity by ID if the given value is not nil.
+func (_c *UserCreate) SetNillablePreferenceID(id *uuid.UUID) *UserCreate {
+	if id != nil {
+		_c = _c.SetPreferenceID(*id)
+	}
+	return _c
+}
+
+// SetPreference sets the "preference" edge to the UserPreference entity.
+func (_c *UserCreate) SetPreference(v *UserPreference) *UserCreate {
+	return _c.SetPreferenceID(v.ID)
+}
+
+// SetUsageID sets the "usage" edge to the UserUsage entity by ID.
+func (_c *UserCreate) SetUsageID(id uuid.UUID) *UserCreate {
+	_c.mutation.SetUsageID(id)
+	return _c
+}
+
+// SetNillableUsageID sets the "usage" edge to the UserUsage entity by ID if the given value is not nil.
+func (_c *UserCreate) SetNillableUsageID(id *uuid.UUID) *UserCreate {
+	if id != nil {
+		_c = _c.SetUsageID(*id)
+	}
+	return _c
+}
+
+// SetUsage sets the "usage" edge to the UserUsage entity.
+func (_c *UserCreate) SetUsage(v *UserUsage) *UserCreate {
+	return _c.SetUsageID(v.ID)
+}
+
+// AddUsageEntryIDs adds the "usage_entries" edge to the UsageEntry entity by IDs.
+func (_c *UserCreate) AddUsageEntryIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddUsageEntryIDs(ids...)
+	return _c
+}
+
+// AddUsageEntries adds the "usage_entries" edges to the UsageEntry entity.
+func (_c *UserCreate) AddUsageEntries(v ...*UsageEntry) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUsageEntryIDs(ids...)
+}
+
+// AddPlayEventIDs adds the "play_events" edge to the PlayEvent entity by IDs.
+func (_c *UserCreate) AddPlayEventIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddPlayEventIDs(ids...)
+	return _c
+}
+
+// AddPlayEvents adds the "play_events" edges to the PlayEvent entity.
+func (_c *UserCreate) AddPlayEvents(v ...*PlayEvent) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPlayEventIDs(ids...)
+}
+
+// AddLexiconEntryIDs adds the "lexicon_entries" edge to the LexiconEntry entity by IDs.
+func (_c *UserCreate) AddLexiconEntryIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddLexiconEntryIDs(ids...)
+	return _c
+}
+
+// AddLexiconEntries adds the "lexicon_entries" edges to the LexiconEntry entity.
+func (_c *UserCreate) AddLexiconEntries(v ...*LexiconEntry) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLexiconEntryIDs(ids...)
+}
+
+// AddTemplateIDs adds the "templates" edge to the Template entity by IDs.
+func (_c *UserCreate) AddTemplateIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddTemplateIDs(ids...)
+	return _c
+}
+
+// AddTemplates adds the "templates" edges to the Template entity.
+func (_c *UserCreate) AddTemplates(v ...*Template) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTemplateIDs(ids...)
+}
+
+// AddModerationRuleIDs adds the "moderation_rules" edge to the ModerationRule entity by IDs.
+func (_c *UserCreate) AddModerationRuleIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddModerationRuleIDs(ids...)
+	return _c
+}
+
+// AddModerationRules adds the "moderation_rules" edges to the ModerationRule entity.
+func (_c *UserCreate) AddModerationRules(v ...*ModerationRule) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddModerationRuleIDs(ids...)
+}
+
+// SetModerationSettingID sets the "moderation_setting" edge to the ModerationSetting entity by ID.
+func (_c *UserCreate) SetModerationSettingID(id uuid.UUID) *UserCreate {
+	_c.mutation.SetModerationSettingID(id)
+	return _c
+}
+
+// SetNillableModerationSettingID sets the "moderation_setting" edge to the ModerationSetting entity by ID if the given value is not nil.
+func (_c *UserCreate) SetNillableModerationSettingID(id *uuid.UUID) *UserCreate {
+	if id != nil {
+		_c = _c.SetModerationSettingID(*id)
+	}
+	return _c
+}
+
+// SetModerationSetting sets the "moderation_setting" edge to the ModerationSetting entity.
+func (_c *UserCreate) SetModerationSetting(v *ModerationSetting) *UserCreate {
+	return _c.SetModerationSettingID(v.ID)
+}
+
+// SetPlanID sets the "plan" edge to the Plan entity by ID.
+func (_c *UserCreate) SetPlanID(id string) *UserCreate {
+	_c.mutation.SetPlanID(id)
+	return _c
+}
+
+// SetNillablePlanID sets the "plan" edge to the Plan entity by ID if the given value is not nil.
+func (_c *UserCreate) SetNillablePlanID(id *string) *UserCreate {
+	if id != nil {
+		_c = _c.SetPlanID(*id)
+	}
+	return _c
+}
+
+// SetPlan sets the "plan" edge to the Plan entity.
+func (_c *UserCreate) SetPlan(v *Plan) *UserCreate {
+	return _c.SetPlanID(v.ID)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -249,6 +473,215 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.IdempotencyKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdempotencyKeysTable,
+			Columns: []string{user.IdempotencyKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FoldersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FoldersTable,
+			Columns: []string{user.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VoicePresetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.VoicePresetsTable,
+			Columns: []string{user.VoicePresetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(voicepreset.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PreferenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.PreferenceTable,
+			Columns: []string{user.PreferenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userpreference.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UsageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.UsageTable,
+			Columns: []string{user.UsageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userusage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UsageEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UsageEntriesTable,
+			Columns: []string{user.UsageEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usageentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PlayEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PlayEventsTable,
+			Columns: []string{user.PlayEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LexiconEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LexiconEntriesTable,
+			Columns: []string{user.LexiconEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lexiconentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TemplatesTable,
+			Columns: []string{user.TemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ModerationRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ModerationRulesTable,
+			Columns: []string{user.ModerationRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationrule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ModerationSettingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.ModerationSettingTable,
+			Columns: []string{user.ModerationSettingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationsetting.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.PlanTable,
+			Columns: []string{user.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.plan_users = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userpreference"
+)
+
+// UserPreference is the model entity for the UserPreference schema.
+type UserPreference struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Voice holds the value of the "voice" field.
+	Voice string `json:"voice,omitempty"`
+	// Rate holds the value of the "rate" field.
+	Rate float64 `json:"rate,omitempty"`
+	// Pitch holds the value of the "pitch" field.
+	Pitch float64 `json:"pitch,omitempty"`
+	// Volume holds the value of the "volume" field.
+	Volume float64 `json:"volume,omitempty"`
+	// Language holds the value of the "language" field.
+	Language string `json:"language,omitempty"`
+	// MaxTextLength holds the value of the "max_text_length" field.
+	MaxTextLength int `json:"maxTextLength"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserPreferenceQuery when eager-loading is set.
+	Edges           UserPreferenceEdges `json:"edges"`
+	user_preference *uuid.UUID
+	selectValues    sql.SelectValues
+}
+
+// UserPreferenceEdges holds the relations/edges for other nodes in the graph.
+type UserPreferenceEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserPreferenceEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserPreference) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userpreference.FieldRate, userpreference.FieldPitch, userpreference.FieldVolume:
+			values[i] = new(sql.NullFloat64)
+		case userpreference.FieldMaxTextLength:
+			values[i] = new(sql.NullInt64)
+		case userpreference.FieldVoice, userpreference.FieldLanguage:
+			values[i] = new(sql.NullString)
+		case userpreference.FieldCreatedAt, userpreference.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case userpreference.FieldID:
+			values[i] = new(uuid.UUID)
+		case userpreference.ForeignKeys[0]: // user_preference
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserPreference fields.
+func (_m *UserPreference) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userpreference.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case userpreference.FieldVoice:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field voice", values[i])
+			} else if value.Valid {
+				_m.Voice = value.String
+			}
+		case userpreference.FieldRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value.Valid {
+				_m.Rate = value.Float64
+			}
+		case userpreference.FieldPitch:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field pitch", values[i])
+			} else if value.Valid {
+				_m.Pitch = value.Float64
+			}
+		case userpreference.FieldVolume:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field volume", values[i])
+			} else if value.Valid {
+				_m.Volume = value.Float64
+			}
+		case userpreference.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				_m.Language = value.String
+			}
+		case userpreference.FieldMaxTextLength:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_text_length", values[i])
+			} else if value.Valid {
+				_m.MaxTextLength = int(value.Int64)
+			}
+		case userpreference.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case userpreference.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case userpreference.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_preference", values[i])
+			} else if value.Valid {
+				_m.user_preference = new(uuid.UUID)
+				*_m.user_preference = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserPreference.
+// This includes values selected through modifiers, order, etc.
+func (_m *UserPreference) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the UserPreference entity.
+func (_m *UserPreference) QueryUser() *UserQuery {
+	return NewUserPreferenceClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this UserPreference.
+// Note that you need to call UserPreference.Unwrap() before calling this method if this UserPreference
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *UserPreference) Update() *UserPreferenceUpdateOne {
+	return NewUserPreferenceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the UserPreference entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *UserPreference) Unwrap() *UserPreference {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: UserPreference is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *UserPreference) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserPreference(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("voice=")
+	builder.WriteString(_m.Voice)
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rate))
+	builder.WriteString(", ")
+	builder.WriteString("pitch=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Pitch))
+	builder.WriteString(", ")
+	builder.WriteString("volume=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Volume))
+	builder.WriteString(", ")
+	builder.WriteString("language=")
+	builder.WriteString(_m.Language)
+	builder.WriteString(", ")
+	builder.WriteString("max_text_length=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxTextLength))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserPreferences is a parsable slice of UserPreference.
+type UserPreferences []*UserPreference
@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userpreference
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the userpreference type in the database.
+	Label = "user_preference"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVoice holds the string denoting the voice field in the database.
+	FieldVoice = "voice"
+	// FieldRate holds the string denoting the rate field in the database.
+	FieldRate = "rate"
+	// FieldPitch holds the string denoting the pitch field in the database.
+	FieldPitch = "pitch"
+	// FieldVolume holds the string denoting the volume field in the database.
+	FieldVolume = "volume"
+	// FieldLanguage holds the string denoting the language field in the database.
+	FieldLanguage = "language"
+	// FieldMaxTextLength holds the string denoting the max_text_length field in the database.
+	FieldMaxTextLength = "max_text_length"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the userpreference in the database.
+	Table = "user_preferences"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "user_preferences"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_preference"
+)
+
+// Columns holds all SQL columns for userpreference fields.
+var Columns = []string{
+	FieldID,
+	FieldVoice,
+	FieldRate,
+	FieldPitch,
+	FieldVolume,
+	FieldLanguage,
+	FieldMaxTextLength,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "user_preferences"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_preference",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultRate holds the default value on creation for the "rate" field.
+	DefaultRate float64
+	// RateValidator is a validator for the "rate" field. It is called by the builders before save.
+	RateValidator func(float64) error
+	// DefaultPitch holds the default value on creation for the "pitch" field.
+	DefaultPitch float64
+	// PitchValidator is a validator for the "pitch" field. It is called by the builders before save.
+	PitchValidator func(float64) error
+	// DefaultVolume holds the default value on creation for the "volume" field.
+	DefaultVolume float64
+	// VolumeValidator is a validator for the "volume" field. It is called by the builders before save.
+	VolumeValidator func(float64) error
+	// DefaultMaxTextLength holds the default value on creation for the "max_text_length" field.
+	DefaultMaxTextLength int
+	// MaxTextLengthValidator is a validator for the "max_text_length" field. It is called by the builders before save.
+	MaxTextLengthValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the UserPreference queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVoice orders the results by the voice field.
+func ByVoice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVoice, opts...).ToFunc()
+}
+
+// ByRate orders the results by the rate field.
+func ByRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRate, opts...).ToFunc()
+}
+
+// ByPitch orders the results by the pitch field.
+func ByPitch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPitch, opts...).ToFunc()
+}
+
+// ByVolume orders the results by the volume field.
+func ByVolume(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVolume, opts...).ToFunc()
+}
+
+// ByLanguage orders the results by the language field.
+func ByLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLanguage, opts...).ToFunc()
+}
+
+// ByMaxTextLength orders the results by the max_text_length field.
+func ByMaxTextLength(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxTextLength, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+	)
+}
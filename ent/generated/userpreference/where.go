@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userpreference
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldLTE(FieldID, id))
+}
+
+// Voice applies equality check predicate on the "voice" field. It's identical to VoiceEQ.
+func Voice(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEQ(FieldVoice, v))
+}
+
+// Rate applies equality check predicate on the "rate" field. It's identical to RateEQ.
+func Rate(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEQ(FieldRate, v))
+}
+
+// Pitch applies equality check predicate on the "pitch" field. It's identical to PitchEQ.
+func Pitch(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEQ(FieldPitch, v))
+}
+
+// Volume applies equality check predicate on the "volume" field. It's identical to VolumeEQ.
+func Volume(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEQ(FieldVolume, v))
+}
+
+// Language applies equality check predicate on the "language" field. It's identical to LanguageEQ.
+func Language(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEQ(FieldLanguage, v))
+}
+
+// MaxTextLength applies equality check predicate on the "max_text_length" field. It's identical to MaxTextLengthEQ.
+func MaxTextLength(v int) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEQ(FieldMaxTextLength, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// VoiceEQ applies the EQ predicate on the "voice" field.
+func VoiceEQ(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEQ(FieldVoice, v))
+}
+
+// VoiceNEQ applies the NEQ predicate on the "voice" field.
+func VoiceNEQ(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNEQ(FieldVoice, v))
+}
+
+// VoiceIn applies the In predicate on the "voice" field.
+func VoiceIn(vs ...string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldIn(FieldVoice, vs...))
+}
+
+// VoiceNotIn applies the NotIn predicate on the "voice" field.
+func VoiceNotIn(vs ...string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNotIn(FieldVoice, vs...))
+}
+
+// VoiceGT applies the GT predicate on the "voice" field.
+func VoiceGT(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldGT(FieldVoice, v))
+}
+
+// VoiceGTE applies the GTE predicate on the "voice" field.
+func VoiceGTE(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldGTE(FieldVoice, v))
+}
+
+// VoiceLT applies the LT predicate on the "voice" field.
+func VoiceLT(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldLT(FieldVoice, v))
+}
+
+// VoiceLTE applies the LTE predicate on the "voice" field.
+func VoiceLTE(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldLTE(FieldVoice, v))
+}
+
+// VoiceContains applies the Contains predicate on the "voice" field.
+func VoiceContains(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldContains(FieldVoice, v))
+}
+
+// VoiceHasPrefix applies the HasPrefix predicate on the "voice" field.
+func VoiceHasPrefix(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldHasPrefix(FieldVoice, v))
+}
+
+// VoiceHasSuffix applies the HasSuffix predicate on the "voice" field.
+func VoiceHasSuffix(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldHasSuffix(FieldVoice, v))
+}
+
+// VoiceIsNil applies the IsNil predicate on the "voice" field.
+func VoiceIsNil() predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldIsNull(FieldVoice))
+}
+
+// VoiceNotNil applies the NotNil predicate on the "voice" field.
+func VoiceNotNil() predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNotNull(FieldVoice))
+}
+
+// VoiceEqualFold applies the EqualFold predicate on the "voice" field.
+func VoiceEqualFold(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEqualFold(FieldVoice, v))
+}
+
+// VoiceContainsFold applies the ContainsFold predicate on the "voice" field.
+func VoiceContainsFold(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldContainsFold(FieldVoice, v))
+}
+
+// RateEQ applies the EQ predicate on the "rate" field.
+func RateEQ(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEQ(FieldRate, v))
+}
+
+// RateNEQ applies the NEQ predicate on the "rate" field.
+func RateNEQ(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNEQ(FieldRate, v))
+}
+
+// RateIn applies the In predicate on the "rate" field.
+func RateIn(vs ...float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldIn(FieldRate, vs...))
+}
+
+// RateNotIn applies the NotIn predicate on the "rate" field.
+func RateNotIn(vs ...float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNotIn(FieldRate, vs...))
+}
+
+// RateGT applies the GT predicate on the "rate" field.
+func RateGT(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldGT(FieldRate, v))
+}
+
+// RateGTE applies the GTE predicate on the "rate" field.
+func RateGTE(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldGTE(FieldRate, v))
+}
+
+// RateLT applies the LT predicate on the "rate" field.
+func RateLT(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldLT(FieldRate, v))
+}
+
+// RateLTE applies the LTE predicate on the "rate" field.
+func RateLTE(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldLTE(FieldRate, v))
+}
+
+// PitchEQ applies the EQ predicate on the "pitch" field.
+func PitchEQ(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEQ(FieldPitch, v))
+}
+
+// PitchNEQ applies the NEQ predicate on the "pitch" field.
+func PitchNEQ(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNEQ(FieldPitch, v))
+}
+
+// PitchIn applies the In predicate on the "pitch" field.
+func PitchIn(vs ...float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldIn(FieldPitch, vs...))
+}
+
+// PitchNotIn applies the NotIn predicate on the "pitch" field.
+func PitchNotIn(vs ...float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNotIn(FieldPitch, vs...))
+}
+
+// PitchGT applies the GT predicate on the "pitch" field.
+func PitchGT(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldGT(FieldPitch, v))
+}
+
+// PitchGTE applies the GTE predicate on the "pitch" field.
+func PitchGTE(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldGTE(FieldPitch, v))
+}
+
+// PitchLT applies the LT predicate on the "pitch" field.
+func PitchLT(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldLT(FieldPitch, v))
+}
+
+// PitchLTE applies the LTE predicate on the "pitch" field.
+func PitchLTE(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldLTE(FieldPitch, v))
+}
+
+// VolumeEQ applies the EQ predicate on the "volume" field.
+func VolumeEQ(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEQ(FieldVolume, v))
+}
+
+// VolumeNEQ applies the NEQ predicate on the "volume" field.
+func VolumeNEQ(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNEQ(FieldVolume, v))
+}
+
+// VolumeIn applies the In predicate on the "volume" field.
+func VolumeIn(vs ...float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldIn(FieldVolume, vs...))
+}
+
+// VolumeNotIn applies the NotIn predicate on the "volume" field.
+func VolumeNotIn(vs ...float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNotIn(FieldVolume, vs...))
+}
+
+// VolumeGT applies the GT predicate on the "volume" field.
+func VolumeGT(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldGT(FieldVolume, v))
+}
+
+// VolumeGTE applies the GTE predicate on the "volume" field.
+func VolumeGTE(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldGTE(FieldVolume, v))
+}
+
+// VolumeLT applies the LT predicate on the "volume" field.
+func VolumeLT(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldLT(FieldVolume, v))
+}
+
+// VolumeLTE applies the LTE predicate on the "volume" field.
+func VolumeLTE(v float64) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldLTE(FieldVolume, v))
+}
+
+// LanguageEQ applies the EQ predicate on the "language" field.
+func LanguageEQ(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEQ(FieldLanguage, v))
+}
+
+// LanguageNEQ applies the NEQ predicate on the "language" field.
+func LanguageNEQ(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNEQ(FieldLanguage, v))
+}
+
+// LanguageIn applies the In predicate on the "language" field.
+func LanguageIn(vs ...string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldIn(FieldLanguage, vs...))
+}
+
+// LanguageNotIn applies the NotIn predicate on the "language" field.
+func LanguageNotIn(vs ...string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNotIn(FieldLanguage, vs...))
+}
+
+// LanguageGT applies the GT predicate on the "language" field.
+func LanguageGT(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldGT(FieldLanguage, v))
+}
+
+// LanguageGTE applies the GTE predicate on the "language" field.
+func LanguageGTE(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldGTE(FieldLanguage, v))
+}
+
+// LanguageLT applies the LT predicate on the "language" field.
+func LanguageLT(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldLT(FieldLanguage, v))
+}
+
+// LanguageLTE applies the LTE predicate on the "language" field.
+func LanguageLTE(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldLTE(FieldLanguage, v))
+}
+
+// LanguageContains applies the Contains predicate on the "language" field.
+func LanguageContains(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldContains(FieldLanguage, v))
+}
+
+// LanguageHasPrefix applies the HasPrefix predicate on the "language" field.
+func LanguageHasPrefix(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldHasPrefix(FieldLanguage, v))
+}
+
+// LanguageHasSuffix applies the HasSuffix predicate on the "language" field.
+func LanguageHasSuffix(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldHasSuffix(FieldLanguage, v))
+}
+
+// LanguageIsNil applies the IsNil predicate on the "language" field.
+func LanguageIsNil() predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldIsNull(FieldLanguage))
+}
+
+// LanguageNotNil applies the NotNil predicate on the "language" field.
+func LanguageNotNil() predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNotNull(FieldLanguage))
+}
+
+// LanguageEqualFold applies the EqualFold predicate on the "language" field.
+func LanguageEqualFold(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEqualFold(FieldLanguage, v))
+}
+
+// LanguageContainsFold applies the ContainsFold predicate on the "language" field.
+func LanguageContainsFold(v string) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldContainsFold(FieldLanguage, v))
+}
+
+// MaxTextLengthEQ applies the EQ predicate on the "max_text_length" field.
+func MaxTextLengthEQ(v int) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEQ(FieldMaxTextLength, v))
+}
+
+// MaxTextLengthNEQ applies the NEQ predicate on the "max_text_length" field.
+func MaxTextLengthNEQ(v int) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNEQ(FieldMaxTextLength, v))
+}
+
+// MaxTextLengthIn applies the In predicate on the "max_text_length" field.
+func MaxTextLengthIn(vs ...int) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldIn(FieldMaxTextLength, vs...))
+}
+
+// MaxTextLengthNotIn applies the NotIn predicate on the "max_text_length" field.
+func MaxTextLengthNotIn(vs ...int) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNotIn(FieldMaxTextLength, vs...))
+}
+
+// MaxTextLengthGT applies the GT predicate on the "max_text_length" field.
+func MaxTextLengthGT(v int) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldGT(FieldMaxTextLength, v))
+}
+
+// MaxTextLengthGTE applies the GTE predicate on the "max_text_length" field.
+func MaxTextLengthGTE(v int) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldGTE(FieldMaxTextLength, v))
+}
+
+// MaxTextLengthLT applies the LT predicate on the "max_text_length" field.
+func MaxTextLengthLT(v int) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldLT(FieldMaxTextLength, v))
+}
+
+// MaxTextLengthLTE applies the LTE predicate on the "max_text_length" field.
+func MaxTextLengthLTE(v int) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldLTE(FieldMaxTextLength, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UserPreference {
+	return predicate.UserPreference(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.UserPreference {
+	return predicate.UserPreference(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.UserPreference {
+	return predicate.UserPreference(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserPreference) predicate.UserPreference {
+	return predicate.UserPreference(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserPreference) predicate.UserPreference {
+	return predicate.UserPreference(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserPreference) predicate.UserPreference {
+	return predicate.UserPreference(sql.NotPredicates(p))
+}
@@ -0,0 +1,439 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userpreference"
+)
+
+// UserPreferenceCreate is the builder for creating a UserPreference entity.
+type UserPreferenceCreate struct {
+	config
+	mutation *UserPreferenceMutation
+	hooks    []Hook
+}
+
+// SetVoice sets the "voice" field.
+func (_c *UserPreferenceCreate) SetVoice(v string) *UserPreferenceCreate {
+	_c.mutation.SetVoice(v)
+	return _c
+}
+
+// SetNillableVoice sets the "voice" field if the given value is not nil.
+func (_c *UserPreferenceCreate) SetNillableVoice(v *string) *UserPreferenceCreate {
+	if v != nil {
+		_c.SetVoice(*v)
+	}
+	return _c
+}
+
+// SetRate sets the "rate" field.
+func (_c *UserPreferenceCreate) SetRate(v float64) *UserPreferenceCreate {
+	_c.mutation.SetRate(v)
+	return _c
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_c *UserPreferenceCreate) SetNillableRate(v *float64) *UserPreferenceCreate {
+	if v != nil {
+		_c.SetRate(*v)
+	}
+	return _c
+}
+
+// SetPitch sets the "pitch" field.
+func (_c *UserPreferenceCreate) SetPitch(v float64) *UserPreferenceCreate {
+	_c.mutation.SetPitch(v)
+	return _c
+}
+
+// SetNillablePitch sets the "pitch" field if the given value is not nil.
+func (_c *UserPreferenceCreate) SetNillablePitch(v *float64) *UserPreferenceCreate {
+	if v != nil {
+		_c.SetPitch(*v)
+	}
+	return _c
+}
+
+// SetVolume sets the "volume" field.
+func (_c *UserPreferenceCreate) SetVolume(v float64) *UserPreferenceCreate {
+	_c.mutation.SetVolume(v)
+	return _c
+}
+
+// SetNillableVolume sets the "volume" field if the given value is not nil.
+func (_c *UserPreferenceCreate) SetNillableVolume(v *float64) *UserPreferenceCreate {
+	if v != nil {
+		_c.SetVolume(*v)
+	}
+	return _c
+}
+
+// SetLanguage sets the "language" field.
+func (_c *UserPreferenceCreate) SetLanguage(v string) *UserPreferenceCreate {
+	_c.mutation.SetLanguage(v)
+	return _c
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_c *UserPreferenceCreate) SetNillableLanguage(v *string) *UserPreferenceCreate {
+	if v != nil {
+		_c.SetLanguage(*v)
+	}
+	return _c
+}
+
+// SetMaxTextLength sets the "max_text_length" field.
+func (_c *UserPreferenceCreate) SetMaxTextLength(v int) *UserPreferenceCreate {
+	_c.mutation.SetMaxTextLength(v)
+	return _c
+}
+
+// SetNillableMaxTextLength sets the "max_text_length" field if the given value is not nil.
+func (_c *UserPreferenceCreate) SetNillableMaxTextLength(v *int) *UserPreferenceCreate {
+	if v != nil {
+		_c.SetMaxTextLength(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UserPreferenceCreate) SetCreatedAt(v time.Time) *UserPreferenceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UserPreferenceCreate) SetNillableCreatedAt(v *time.Time) *UserPreferenceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UserPreferenceCreate) SetUpdatedAt(v time.Time) *UserPreferenceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *UserPreferenceCreate) SetNillableUpdatedAt(v *time.Time) *UserPreferenceCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UserPreferenceCreate) SetID(v uuid.UUID) *UserPreferenceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *UserPreferenceCreate) SetNillableID(v *uuid.UUID) *UserPreferenceCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *UserPreferenceCreate) SetUserID(id uuid.UUID) *UserPreferenceCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *UserPreferenceCreate) SetUser(v *User) *UserPreferenceCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the UserPreferenceMutation object of the builder.
+func (_c *UserPreferenceCreate) Mutation() *UserPreferenceMutation {
+	return _c.mutation
+}
+
+// Save creates the UserPreference in the database.
+func (_c *UserPreferenceCreate) Save(ctx context.Context) (*UserPreference, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserPreferenceCreate) SaveX(ctx context.Context) *UserPreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserPreferenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserPreferenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserPreferenceCreate) defaults() {
+	if _, ok := _c.mutation.Rate(); !ok {
+		v := userpreference.DefaultRate
+		_c.mutation.SetRate(v)
+	}
+	if _, ok := _c.mutation.Pitch(); !ok {
+		v := userpreference.DefaultPitch
+		_c.mutation.SetPitch(v)
+	}
+	if _, ok := _c.mutation.Volume(); !ok {
+		v := userpreference.DefaultVolume
+		_c.mutation.SetVolume(v)
+	}
+	if _, ok := _c.mutation.MaxTextLength(); !ok {
+		v := userpreference.DefaultMaxTextLength
+		_c.mutation.SetMaxTextLength(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := userpreference.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := userpreference.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := userpreference.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserPreferenceCreate) check() error {
+	if _, ok := _c.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`generated: missing required field "UserPreference.rate"`)}
+	}
+	if v, ok := _c.mutation.Rate(); ok {
+		if err := userpreference.RateValidator(v); err != nil {
+			return &ValidationError{Name: "rate", err: fmt.Errorf(`generated: validator failed for field "UserPreference.rate": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Pitch(); !ok {
+		return &ValidationError{Name: "pitch", err: errors.New(`generated: missing required field "UserPreference.pitch"`)}
+	}
+	if v, ok := _c.mutation.Pitch(); ok {
+		if err := userpreference.PitchValidator(v); err != nil {
+			return &ValidationError{Name: "pitch", err: fmt.Errorf(`generated: validator failed for field "UserPreference.pitch": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Volume(); !ok {
+		return &ValidationError{Name: "volume", err: errors.New(`generated: missing required field "UserPreference.volume"`)}
+	}
+	if v, ok := _c.mutation.Volume(); ok {
+		if err := userpreference.VolumeValidator(v); err != nil {
+			return &ValidationError{Name: "volume", err: fmt.Errorf(`generated: validator failed for field "UserPreference.volume": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxTextLength(); !ok {
+		return &ValidationError{Name: "max_text_length", err: errors.New(`generated: missing required field "UserPreference.max_text_length"`)}
+	}
+	if v, ok := _c.mutation.MaxTextLength(); ok {
+		if err := userpreference.MaxTextLengthValidator(v); err != nil {
+			return &ValidationError{Name: "max_text_length", err: fmt.Errorf(`generated: validator failed for field "UserPreference.max_text_length": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "UserPreference.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "UserPreference.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`generated: missing required edge "UserPreference.user"`)}
+	}
+	return nil
+}
+
+func (_c *UserPreferenceCreate) sqlSave(ctx context.Context) (*UserPreference, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserPreferenceCreate) createSpec() (*UserPreference, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserPreference{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(userpreference.Table, sqlgraph.NewFieldSpec(userpreference.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Voice(); ok {
+		_spec.SetField(userpreference.FieldVoice, field.TypeString, value)
+		_node.Voice = value
+	}
+	if value, ok := _c.mutation.Rate(); ok {
+		_spec.SetField(userpreference.FieldRate, field.TypeFloat64, value)
+		_node.Rate = value
+	}
+	if value, ok := _c.mutation.Pitch(); ok {
+		_spec.SetField(userpreference.FieldPitch, field.TypeFloat64, value)
+		_node.Pitch = value
+	}
+	if value, ok := _c.mutation.Volume(); ok {
+		_spec.SetField(userpreference.FieldVolume, field.TypeFloat64, value)
+		_node.Volume = value
+	}
+	if value, ok := _c.mutation.Language(); ok {
+		_spec.SetField(userpreference.FieldLanguage, field.TypeString, value)
+		_node.Language = value
+	}
+	if value, ok := _c.mutation.MaxTextLength(); ok {
+		_spec.SetField(userpreference.FieldMaxTextLength, field.TypeInt, value)
+		_node.MaxTextLength = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(userpreference.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(userpreference.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   userpreference.UserTable,
+			Columns: []string{userpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_preference = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UserPreferenceCreateBulk is the builder for creating many UserPreference entities in bulk.
+type UserPreferenceCreateBulk struct {
+	config
+	err      error
+	builders []*UserPreferenceCreate
+}
+
+// Save creates the UserPreference entities in the database.
+func (_c *UserPreferenceCreateBulk) Save(ctx context.Context) ([]*UserPreference, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserPreference, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserPreferenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserPreferenceCreateBulk) SaveX(ctx context.Context) []*UserPreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserPreferenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserPreferenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,722 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userpreference"
+)
+
+// UserPreferenceUpdate is the builder for updating UserPreference entities.
+type UserPreferenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserPreferenceMutation
+}
+
+// Where appends a list predicates to the UserPreferenceUpdate builder.
+func (_u *UserPreferenceUpdate) Where(ps ...predicate.UserPreference) *UserPreferenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVoice sets the "voice" field.
+func (_u *UserPreferenceUpdate) SetVoice(v string) *UserPreferenceUpdate {
+	_u.mutation.SetVoice(v)
+	return _u
+}
+
+// SetNillableVoice sets the "voice" field if the given value is not nil.
+func (_u *UserPreferenceUpdate) SetNillableVoice(v *string) *UserPreferenceUpdate {
+	if v != nil {
+		_u.SetVoice(*v)
+	}
+	return _u
+}
+
+// ClearVoice clears the value of the "voice" field.
+func (_u *UserPreferenceUpdate) ClearVoice() *UserPreferenceUpdate {
+	_u.mutation.ClearVoice()
+	return _u
+}
+
+// SetRate sets the "rate" field.
+func (_u *UserPreferenceUpdate) SetRate(v float64) *UserPreferenceUpdate {
+	_u.mutation.ResetRate()
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *UserPreferenceUpdate) SetNillableRate(v *float64) *UserPreferenceUpdate {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// AddRate adds value to the "rate" field.
+func (_u *UserPreferenceUpdate) AddRate(v float64) *UserPreferenceUpdate {
+	_u.mutation.AddRate(v)
+	return _u
+}
+
+// SetPitch sets the "pitch" field.
+func (_u *UserPreferenceUpdate) SetPitch(v float64) *UserPreferenceUpdate {
+	_u.mutation.ResetPitch()
+	_u.mutation.SetPitch(v)
+	return _u
+}
+
+// SetNillablePitch sets the "pitch" field if the given value is not nil.
+func (_u *UserPreferenceUpdate) SetNillablePitch(v *float64) *UserPreferenceUpdate {
+	if v != nil {
+		_u.SetPitch(*v)
+	}
+	return _u
+}
+
+// AddPitch adds value to the "pitch" field.
+func (_u *UserPreferenceUpdate) AddPitch(v float64) *UserPreferenceUpdate {
+	_u.mutation.AddPitch(v)
+	return _u
+}
+
+// SetVolume sets the "volume" field.
+func (_u *UserPreferenceUpdate) SetVolume(v float64) *UserPreferenceUpdate {
+	_u.mutation.ResetVolume()
+	_u.mutation.SetVolume(v)
+	return _u
+}
+
+// SetNillableVolume sets the "volume" field if the given value is not nil.
+func (_u *UserPreferenceUpdate) SetNillableVolume(v *float64) *UserPreferenceUpdate {
+	if v != nil {
+		_u.SetVolume(*v)
+	}
+	return _u
+}
+
+// AddVolume adds value to the "volume" field.
+func (_u *UserPreferenceUpdate) AddVolume(v float64) *UserPreferenceUpdate {
+	_u.mutation.AddVolume(v)
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *UserPreferenceUpdate) SetLanguage(v string) *UserPreferenceUpdate {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *UserPreferenceUpdate) SetNillableLanguage(v *string) *UserPreferenceUpdate {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (_u *UserPreferenceUpdate) ClearLanguage() *UserPreferenceUpdate {
+	_u.mutation.ClearLanguage()
+	return _u
+}
+
+// SetMaxTextLength sets the "max_text_length" field.
+func (_u *UserPreferenceUpdate) SetMaxTextLength(v int) *UserPreferenceUpdate {
+	_u.mutation.ResetMaxTextLength()
+	_u.mutation.SetMaxTextLength(v)
+	return _u
+}
+
+// SetNillableMaxTextLength sets the "max_text_length" field if the given value is not nil.
+func (_u *UserPreferenceUpdate) SetNillableMaxTextLength(v *int) *UserPreferenceUpdate {
+	if v != nil {
+		_u.SetMaxTextLength(*v)
+	}
+	return _u
+}
+
+// AddMaxTextLength adds value to the "max_text_length" field.
+func (_u *UserPreferenceUpdate) AddMaxTextLength(v int) *UserPreferenceUpdate {
+	_u.mutation.AddMaxTextLength(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *UserPreferenceUpdate) SetCreatedAt(v time.Time) *UserPreferenceUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *UserPreferenceUpdate) SetNillableCreatedAt(v *time.Time) *UserPreferenceUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserPreferenceUpdate) SetUpdatedAt(v time.Time) *UserPreferenceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *UserPreferenceUpdate) SetUserID(id uuid.UUID) *UserPreferenceUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *UserPreferenceUpdate) SetUser(v *User) *UserPreferenceUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the UserPreferenceMutation object of the builder.
+func (_u *UserPreferenceUpdate) Mutation() *UserPreferenceMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *UserPreferenceUpdate) ClearUser() *UserPreferenceUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserPreferenceUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserPreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserPreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserPreferenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserPreferenceUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := userpreference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserPreferenceUpdate) check() error {
+	if v, ok := _u.mutation.Rate(); ok {
+		if err := userpreference.RateValidator(v); err != nil {
+			return &ValidationError{Name: "rate", err: fmt.Errorf(`generated: validator failed for field "UserPreference.rate": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Pitch(); ok {
+		if err := userpreference.PitchValidator(v); err != nil {
+			return &ValidationError{Name: "pitch", err: fmt.Errorf(`generated: validator failed for field "UserPreference.pitch": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Volume(); ok {
+		if err := userpreference.VolumeValidator(v); err != nil {
+			return &ValidationError{Name: "volume", err: fmt.Errorf(`generated: validator failed for field "UserPreference.volume": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxTextLength(); ok {
+		if err := userpreference.MaxTextLengthValidator(v); err != nil {
+			return &ValidationError{Name: "max_text_length", err: fmt.Errorf(`generated: validator failed for field "UserPreference.max_text_length": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "UserPreference.user"`)
+	}
+	return nil
+}
+
+func (_u *UserPreferenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userpreference.Table, userpreference.Columns, sqlgraph.NewFieldSpec(userpreference.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Voice(); ok {
+		_spec.SetField(userpreference.FieldVoice, field.TypeString, value)
+	}
+	if _u.mutation.VoiceCleared() {
+		_spec.ClearField(userpreference.FieldVoice, field.TypeString)
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(userpreference.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRate(); ok {
+		_spec.AddField(userpreference.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Pitch(); ok {
+		_spec.SetField(userpreference.FieldPitch, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPitch(); ok {
+		_spec.AddField(userpreference.FieldPitch, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Volume(); ok {
+		_spec.SetField(userpreference.FieldVolume, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVolume(); ok {
+		_spec.AddField(userpreference.FieldVolume, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(userpreference.FieldLanguage, field.TypeString, value)
+	}
+	if _u.mutation.LanguageCleared() {
+		_spec.ClearField(userpreference.FieldLanguage, field.TypeString)
+	}
+	if value, ok := _u.mutation.MaxTextLength(); ok {
+		_spec.SetField(userpreference.FieldMaxTextLength, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxTextLength(); ok {
+		_spec.AddField(userpreference.FieldMaxTextLength, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(userpreference.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(userpreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   userpreference.UserTable,
+			Columns: []string{userpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   userpreference.UserTable,
+			Columns: []string{userpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserPreferenceUpdateOne is the builder for updating a single UserPreference entity.
+type UserPreferenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserPreferenceMutation
+}
+
+// SetVoice sets the "voice" field.
+func (_u *UserPreferenceUpdateOne) SetVoice(v string) *UserPreferenceUpdateOne {
+	_u.mutation.SetVoice(v)
+	return _u
+}
+
+// SetNillableVoice sets the "voice" field if the given value is not nil.
+func (_u *UserPreferenceUpdateOne) SetNillableVoice(v *string) *UserPreferenceUpdateOne {
+	if v != nil {
+		_u.SetVoice(*v)
+	}
+	return _u
+}
+
+// ClearVoice clears the value of the "voice" field.
+func (_u *UserPreferenceUpdateOne) ClearVoice() *UserPreferenceUpdateOne {
+	_u.mutation.ClearVoice()
+	return _u
+}
+
+// SetRate sets the "rate" field.
+func (_u *UserPreferenceUpdateOne) SetRate(v float64) *UserPreferenceUpdateOne {
+	_u.mutation.ResetRate()
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *UserPreferenceUpdateOne) SetNillableRate(v *float64) *UserPreferenceUpdateOne {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// AddRate adds value to the "rate" field.
+func (_u *UserPreferenceUpdateOne) AddRate(v float64) *UserPreferenceUpdateOne {
+	_u.mutation.AddRate(v)
+	return _u
+}
+
+// SetPitch sets the "pitch" field.
+func (_u *UserPreferenceUpdateOne) SetPitch(v float64) *UserPreferenceUpdateOne {
+	_u.mutation.ResetPitch()
+	_u.mutation.SetPitch(v)
+	return _u
+}
+
+// SetNillablePitch sets the "pitch" field if the given value is not nil.
+func (_u *UserPreferenceUpdateOne) SetNillablePitch(v *float64) *UserPreferenceUpdateOne {
+	if v != nil {
+		_u.SetPitch(*v)
+	}
+	return _u
+}
+
+// AddPitch adds value to the "pitch" field.
+func (_u *UserPreferenceUpdateOne) AddPitch(v float64) *UserPreferenceUpdateOne {
+	_u.mutation.AddPitch(v)
+	return _u
+}
+
+// SetVolume sets the "volume" field.
+func (_u *UserPreferenceUpdateOne) SetVolume(v float64) *UserPreferenceUpdateOne {
+	_u.mutation.ResetVolume()
+	_u.mutation.SetVolume(v)
+	return _u
+}
+
+// SetNillableVolume sets the "volume" field if the given value is not nil.
+func (_u *UserPreferenceUpdateOne) SetNillableVolume(v *float64) *UserPreferenceUpdateOne {
+	if v != nil {
+		_u.SetVolume(*v)
+	}
+	return _u
+}
+
+// AddVolume adds value to the "volume" field.
+func (_u *UserPreferenceUpdateOne) AddVolume(v float64) *UserPreferenceUpdateOne {
+	_u.mutation.AddVolume(v)
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *UserPreferenceUpdateOne) SetLanguage(v string) *UserPreferenceUpdateOne {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *UserPreferenceUpdateOne) SetNillableLanguage(v *string) *UserPreferenceUpdateOne {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (_u *UserPreferenceUpdateOne) ClearLanguage() *UserPreferenceUpdateOne {
+	_u.mutation.ClearLanguage()
+	return _u
+}
+
+// SetMaxTextLength sets the "max_text_length" field.
+func (_u *UserPreferenceUpdateOne) SetMaxTextLength(v int) *UserPreferenceUpdateOne {
+	_u.mutation.ResetMaxTextLength()
+	_u.mutation.SetMaxTextLength(v)
+	return _u
+}
+
+// SetNillableMaxTextLength sets the "max_text_length" field if the given value is not nil.
+func (_u *UserPreferenceUpdateOne) SetNillableMaxTextLength(v *int) *UserPreferenceUpdateOne {
+	if v != nil {
+		_u.SetMaxTextLength(*v)
+	}
+	return _u
+}
+
+// AddMaxTextLength adds value to the "max_text_length" field.
+func (_u *UserPreferenceUpdateOne) AddMaxTextLength(v int) *UserPreferenceUpdateOne {
+	_u.mutation.AddMaxTextLength(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *UserPreferenceUpdateOne) SetCreatedAt(v time.Time) *UserPreferenceUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *UserPreferenceUpdateOne) SetNillableCreatedAt(v *time.Time) *UserPreferenceUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserPreferenceUpdateOne) SetUpdatedAt(v time.Time) *UserPreferenceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *UserPreferenceUpdateOne) SetUserID(id uuid.UUID) *UserPreferenceUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *UserPreferenceUpdateOne) SetUser(v *User) *UserPreferenceUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the UserPreferenceMutation object of the builder.
+func (_u *UserPreferenceUpdateOne) Mutation() *UserPreferenceMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *UserPreferenceUpdateOne) ClearUser() *UserPreferenceUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the UserPreferenceUpdate builder.
+func (_u *UserPreferenceUpdateOne) Where(ps ...predicate.UserPreference) *UserPreferenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserPreferenceUpdateOne) Select(field string, fields ...string) *UserPreferenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserPreference entity.
+func (_u *UserPreferenceUpdateOne) Save(ctx context.Context) (*UserPreference, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserPreferenceUpdateOne) SaveX(ctx context.Context) *UserPreference {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserPreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserPreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserPreferenceUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := userpreference.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserPreferenceUpdateOne) check() error {
+	if v, ok := _u.mutation.Rate(); ok {
+		if err := userpreference.RateValidator(v); err != nil {
+			return &ValidationError{Name: "rate", err: fmt.Errorf(`generated: validator failed for field "UserPreference.rate": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Pitch(); ok {
+		if err := userpreference.PitchValidator(v); err != nil {
+			return &ValidationError{Name: "pitch", err: fmt.Errorf(`generated: validator failed for field "UserPreference.pitch": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Volume(); ok {
+		if err := userpreference.VolumeValidator(v); err != nil {
+			return &ValidationError{Name: "volume", err: fmt.Errorf(`generated: validator failed for field "UserPreference.volume": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxTextLength(); ok {
+		if err := userpreference.MaxTextLengthValidator(v); err != nil {
+			return &ValidationError{Name: "max_text_length", err: fmt.Errorf(`generated: validator failed for field "UserPreference.max_text_length": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "UserPreference.user"`)
+	}
+	return nil
+}
+
+func (_u *UserPreferenceUpdateOne) sqlSave(ctx context.Context) (_node *UserPreference, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userpreference.Table, userpreference.Columns, sqlgraph.NewFieldSpec(userpreference.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "UserPreference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userpreference.FieldID)
+		for _, f := range fields {
+			if !userpreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != userpreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Voice(); ok {
+		_spec.SetField(userpreference.FieldVoice, field.TypeString, value)
+	}
+	if _u.mutation.VoiceCleared() {
+		_spec.ClearField(userpreference.FieldVoice, field.TypeString)
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(userpreference.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRate(); ok {
+		_spec.AddField(userpreference.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Pitch(); ok {
+		_spec.SetField(userpreference.FieldPitch, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPitch(); ok {
+		_spec.AddField(userpreference.FieldPitch, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Volume(); ok {
+		_spec.SetField(userpreference.FieldVolume, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVolume(); ok {
+		_spec.AddField(userpreference.FieldVolume, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(userpreference.FieldLanguage, field.TypeString, value)
+	}
+	if _u.mutation.LanguageCleared() {
+		_spec.ClearField(userpreference.FieldLanguage, field.TypeString)
+	}
+	if value, ok := _u.mutation.MaxTextLength(); ok {
+		_spec.SetField(userpreference.FieldMaxTextLength, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxTextLength(); ok {
+		_spec.AddField(userpreference.FieldMaxTextLength, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(userpreference.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(userpreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   userpreference.UserTable,
+			Columns: []string{userpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   userpreference.UserTable,
+			Columns: []string{userpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &UserPreference{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userusage"
+)
+
+// UserUsage is the model entity for the UserUsage schema.
+type UserUsage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// PeriodStart holds the value of the "period_start" field.
+	PeriodStart time.Time `json:"periodStart"`
+	// Characters holds the value of the "characters" field.
+	Characters int `json:"characters,omitempty"`
+	// Entries holds the value of the "entries" field.
+	Entries int `json:"entries,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserUsageQuery when eager-loading is set.
+	Edges        UserUsageEdges `json:"edges"`
+	user_usage   *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// UserUsageEdges holds the relations/edges for other nodes in the graph.
+type UserUsageEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserUsageEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserUsage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userusage.FieldCharacters, userusage.FieldEntries:
+			values[i] = new(sql.NullInt64)
+		case userusage.FieldPeriodStart, userusage.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case userusage.FieldID:
+			values[i] = new(uuid.UUID)
+		case userusage.ForeignKeys[0]: // user_usage
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserUsage fields.
+func (_m *UserUsage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userusage.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case userusage.FieldPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[i])
+			} else if value.Valid {
+				_m.PeriodStart = value.Time
+			}
+		case userusage.FieldCharacters:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field characters", values[i])
+			} else if value.Valid {
+				_m.Characters = int(value.Int64)
+			}
+		case userusage.FieldEntries:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entries", values[i])
+			} else if value.Valid {
+				_m.Entries = int(value.Int64)
+			}
+		case userusage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case userusage.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_usage", values[i])
+			} else if value.Valid {
+				_m.user_usage = new(uuid.UUID)
+				*_m.user_usage = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserUsage.
+// This includes values selected through modifiers, order, etc.
+func (_m *UserUsage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the UserUsage entity.
+func (_m *UserUsage) QueryUser() *UserQuery {
+	return NewUserUsageClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this UserUsage.
+// Note that you need to call UserUsage.Unwrap() before calling this method if this UserUsage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *UserUsage) Update() *UserUsageUpdateOne {
+	return NewUserUsageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the UserUsage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *UserUsage) Unwrap() *UserUsage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: UserUsage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *UserUsage) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserUsage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("period_start=")
+	builder.WriteString(_m.PeriodStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("characters=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Characters))
+	builder.WriteString(", ")
+	builder.WriteString("entries=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Entries))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserUsages is a parsable slice of UserUsage.
+type UserUsages []*UserUsage
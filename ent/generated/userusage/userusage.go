@@ -0,0 +1,126 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userusage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the userusage type in the database.
+	Label = "user_usage"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPeriodStart holds the string denoting the period_start field in the database.
+	FieldPeriodStart = "period_start"
+	// FieldCharacters holds the string denoting the characters field in the database.
+	FieldCharacters = "characters"
+	// FieldEntries holds the string denoting the entries field in the database.
+	FieldEntries = "entries"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the userusage in the database.
+	Table = "user_usages"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "user_usages"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_usage"
+)
+
+// Columns holds all SQL columns for userusage fields.
+var Columns = []string{
+	FieldID,
+	FieldPeriodStart,
+	FieldCharacters,
+	FieldEntries,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "user_usages"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_usage",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCharacters holds the default value on creation for the "characters" field.
+	DefaultCharacters int
+	// CharactersValidator is a validator for the "characters" field. It is called by the builders before save.
+	CharactersValidator func(int) error
+	// DefaultEntries holds the default value on creation for the "entries" field.
+	DefaultEntries int
+	// EntriesValidator is a validator for the "entries" field. It is called by the builders before save.
+	EntriesValidator func(int) error
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the UserUsage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPeriodStart orders the results by the period_start field.
+func ByPeriodStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodStart, opts...).ToFunc()
+}
+
+// ByCharacters orders the results by the characters field.
+func ByCharacters(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCharacters, opts...).ToFunc()
+}
+
+// ByEntries orders the results by the entries field.
+func ByEntries(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntries, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+	)
+}
@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userusage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldLTE(FieldID, id))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// Characters applies equality check predicate on the "characters" field. It's identical to CharactersEQ.
+func Characters(v int) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldEQ(FieldCharacters, v))
+}
+
+// Entries applies equality check predicate on the "entries" field. It's identical to EntriesEQ.
+func Entries(v int) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldEQ(FieldEntries, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// CharactersEQ applies the EQ predicate on the "characters" field.
+func CharactersEQ(v int) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldEQ(FieldCharacters, v))
+}
+
+// CharactersNEQ applies the NEQ predicate on the "characters" field.
+func CharactersNEQ(v int) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldNEQ(FieldCharacters, v))
+}
+
+// CharactersIn applies the In predicate on the "characters" field.
+func CharactersIn(vs ...int) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldIn(FieldCharacters, vs...))
+}
+
+// CharactersNotIn applies the NotIn predicate on the "characters" field.
+func CharactersNotIn(vs ...int) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldNotIn(FieldCharacters, vs...))
+}
+
+// CharactersGT applies the GT predicate on the "characters" field.
+func CharactersGT(v int) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldGT(FieldCharacters, v))
+}
+
+// CharactersGTE applies the GTE predicate on the "characters" field.
+func CharactersGTE(v int) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldGTE(FieldCharacters, v))
+}
+
+// CharactersLT applies the LT predicate on the "characters" field.
+func CharactersLT(v int) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldLT(FieldCharacters, v))
+}
+
+// CharactersLTE applies the LTE predicate on the "characters" field.
+func CharactersLTE(v int) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldLTE(FieldCharacters, v))
+}
+
+// EntriesEQ applies the EQ predicate on the "entries" field.
+func EntriesEQ(v int) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldEQ(FieldEntries, v))
+}
+
+// EntriesNEQ applies the NEQ predicate on the "entries" field.
+func EntriesNEQ(v int) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldNEQ(FieldEntries, v))
+}
+
+// EntriesIn applies the In predicate on the "entries" field.
+func EntriesIn(vs ...int) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldIn(FieldEntries, vs...))
+}
+
+// EntriesNotIn applies the NotIn predicate on the "entries" field.
+func EntriesNotIn(vs ...int) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldNotIn(FieldEntries, vs...))
+}
+
+// EntriesGT applies the GT predicate on the "entries" field.
+func EntriesGT(v int) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldGT(FieldEntries, v))
+}
+
+// EntriesGTE applies the GTE predicate on the "entries" field.
+func EntriesGTE(v int) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldGTE(FieldEntries, v))
+}
+
+// EntriesLT applies the LT predicate on the "entries" field.
+func EntriesLT(v int) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldLT(FieldEntries, v))
+}
+
+// EntriesLTE applies the LTE predicate on the "entries" field.
+func EntriesLTE(v int) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldLTE(FieldEntries, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UserUsage {
+	return predicate.UserUsage(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.UserUsage {
+	return predicate.UserUsage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.UserUsage {
+	return predicate.UserUsage(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserUsage) predicate.UserUsage {
+	return predicate.UserUsage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserUsage) predicate.UserUsage {
+	return predicate.UserUsage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserUsage) predicate.UserUsage {
+	return predicate.UserUsage(sql.NotPredicates(p))
+}
@@ -0,0 +1,331 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userusage"
+)
+
+// UserUsageCreate is the builder for creating a UserUsage entity.
+type UserUsageCreate struct {
+	config
+	mutation *UserUsageMutation
+	hooks    []Hook
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_c *UserUsageCreate) SetPeriodStart(v time.Time) *UserUsageCreate {
+	_c.mutation.SetPeriodStart(v)
+	return _c
+}
+
+// SetCharacters sets the "characters" field.
+func (_c *UserUsageCreate) SetCharacters(v int) *UserUsageCreate {
+	_c.mutation.SetCharacters(v)
+	return _c
+}
+
+// SetNillableCharacters sets the "characters" field if the given value is not nil.
+func (_c *UserUsageCreate) SetNillableCharacters(v *int) *UserUsageCreate {
+	if v != nil {
+		_c.SetCharacters(*v)
+	}
+	return _c
+}
+
+// SetEntries sets the "entries" field.
+func (_c *UserUsageCreate) SetEntries(v int) *UserUsageCreate {
+	_c.mutation.SetEntries(v)
+	return _c
+}
+
+// SetNillableEntries sets the "entries" field if the given value is not nil.
+func (_c *UserUsageCreate) SetNillableEntries(v *int) *UserUsageCreate {
+	if v != nil {
+		_c.SetEntries(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UserUsageCreate) SetUpdatedAt(v time.Time) *UserUsageCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *UserUsageCreate) SetNillableUpdatedAt(v *time.Time) *UserUsageCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UserUsageCreate) SetID(v uuid.UUID) *UserUsageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *UserUsageCreate) SetNillableID(v *uuid.UUID) *UserUsageCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *UserUsageCreate) SetUserID(id uuid.UUID) *UserUsageCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *UserUsageCreate) SetUser(v *User) *UserUsageCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the UserUsageMutation object of the builder.
+func (_c *UserUsageCreate) Mutation() *UserUsageMutation {
+	return _c.mutation
+}
+
+// Save creates the UserUsage in the database.
+func (_c *UserUsageCreate) Save(ctx context.Context) (*UserUsage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserUsageCreate) SaveX(ctx context.Context) *UserUsage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserUsageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserUsageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserUsageCreate) defaults() {
+	if _, ok := _c.mutation.Characters(); !ok {
+		v := userusage.DefaultCharacters
+		_c.mutation.SetCharacters(v)
+	}
+	if _, ok := _c.mutation.Entries(); !ok {
+		v := userusage.DefaultEntries
+		_c.mutation.SetEntries(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := userusage.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := userusage.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserUsageCreate) check() error {
+	if _, ok := _c.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`generated: missing required field "UserUsage.period_start"`)}
+	}
+	if _, ok := _c.mutation.Characters(); !ok {
+		return &ValidationError{Name: "characters", err: errors.New(`generated: missing required field "UserUsage.characters"`)}
+	}
+	if v, ok := _c.mutation.Characters(); ok {
+		if err := userusage.CharactersValidator(v); err != nil {
+			return &ValidationError{Name: "characters", err: fmt.Errorf(`generated: validator failed for field "UserUsage.characters": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Entries(); !ok {
+		return &ValidationError{Name: "entries", err: errors.New(`generated: missing required field "UserUsage.entries"`)}
+	}
+	if v, ok := _c.mutation.Entries(); ok {
+		if err := userusage.EntriesValidator(v); err != nil {
+			return &ValidationError{Name: "entries", err: fmt.Errorf(`generated: validator failed for field "UserUsage.entries": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "UserUsage.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`generated: missing required edge "UserUsage.user"`)}
+	}
+	return nil
+}
+
+func (_c *UserUsageCreate) sqlSave(ctx context.Context) (*UserUsage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserUsageCreate) createSpec() (*UserUsage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserUsage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(userusage.Table, sqlgraph.NewFieldSpec(userusage.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.PeriodStart(); ok {
+		_spec.SetField(userusage.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := _c.mutation.Characters(); ok {
+		_spec.SetField(userusage.FieldCharacters, field.TypeInt, value)
+		_node.Characters = value
+	}
+	if value, ok := _c.mutation.Entries(); ok {
+		_spec.SetField(userusage.FieldEntries, field.TypeInt, value)
+		_node.Entries = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(userusage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   userusage.UserTable,
+			Columns: []string{userusage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_usage = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UserUsageCreateBulk is the builder for creating many UserUsage entities in bulk.
+type UserUsageCreateBulk struct {
+	config
+	err      error
+	builders []*UserUsageCreate
+}
+
+// Save creates the UserUsage entities in the database.
+func (_c *UserUsageCreateBulk) Save(ctx context.Context) ([]*UserUsage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserUsage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserUsageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserUsageCreateBulk) SaveX(ctx context.Context) []*UserUsage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserUsageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserUsageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
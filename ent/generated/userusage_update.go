@@ -0,0 +1,490 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userusage"
+)
+
+// UserUsageUpdate is the builder for updating UserUsage entities.
+type UserUsageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserUsageMutation
+}
+
+// Where appends a list predicates to the UserUsageUpdate builder.
+func (_u *UserUsageUpdate) Where(ps ...predicate.UserUsage) *UserUsageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *UserUsageUpdate) SetPeriodStart(v time.Time) *UserUsageUpdate {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *UserUsageUpdate) SetNillablePeriodStart(v *time.Time) *UserUsageUpdate {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetCharacters sets the "characters" field.
+func (_u *UserUsageUpdate) SetCharacters(v int) *UserUsageUpdate {
+	_u.mutation.ResetCharacters()
+	_u.mutation.SetCharacters(v)
+	return _u
+}
+
+// SetNillableCharacters sets the "characters" field if the given value is not nil.
+func (_u *UserUsageUpdate) SetNillableCharacters(v *int) *UserUsageUpdate {
+	if v != nil {
+		_u.SetCharacters(*v)
+	}
+	return _u
+}
+
+// AddCharacters adds value to the "characters" field.
+func (_u *UserUsageUpdate) AddCharacters(v int) *UserUsageUpdate {
+	_u.mutation.AddCharacters(v)
+	return _u
+}
+
+// SetEntries sets the "entries" field.
+func (_u *UserUsageUpdate) SetEntries(v int) *UserUsageUpdate {
+	_u.mutation.ResetEntries()
+	_u.mutation.SetEntries(v)
+	return _u
+}
+
+// SetNillableEntries sets the "entries" field if the given value is not nil.
+func (_u *UserUsageUpdate) SetNillableEntries(v *int) *UserUsageUpdate {
+	if v != nil {
+		_u.SetEntries(*v)
+	}
+	return _u
+}
+
+// AddEntries adds value to the "entries" field.
+func (_u *UserUsageUpdate) AddEntries(v int) *UserUsageUpdate {
+	_u.mutation.AddEntries(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserUsageUpdate) SetUpdatedAt(v time.Time) *UserUsageUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *UserUsageUpdate) SetUserID(id uuid.UUID) *UserUsageUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *UserUsageUpdate) SetUser(v *User) *UserUsageUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the UserUsageMutation object of the builder.
+func (_u *UserUsageUpdate) Mutation() *UserUsageMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *UserUsageUpdate) ClearUser() *UserUsageUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserUsageUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserUsageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserUsageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserUsageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserUsageUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := userusage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserUsageUpdate) check() error {
+	if v, ok := _u.mutation.Characters(); ok {
+		if err := userusage.CharactersValidator(v); err != nil {
+			return &ValidationError{Name: "characters", err: fmt.Errorf(`generated: validator failed for field "UserUsage.characters": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Entries(); ok {
+		if err := userusage.EntriesValidator(v); err != nil {
+			return &ValidationError{Name: "entries", err: fmt.Errorf(`generated: validator failed for field "UserUsage.entries": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "UserUsage.user"`)
+	}
+	return nil
+}
+
+func (_u *UserUsageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userusage.Table, userusage.Columns, sqlgraph.NewFieldSpec(userusage.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(userusage.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Characters(); ok {
+		_spec.SetField(userusage.FieldCharacters, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCharacters(); ok {
+		_spec.AddField(userusage.FieldCharacters, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Entries(); ok {
+		_spec.SetField(userusage.FieldEntries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntries(); ok {
+		_spec.AddField(userusage.FieldEntries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(userusage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   userusage.UserTable,
+			Columns: []string{userusage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   userusage.UserTable,
+			Columns: []string{userusage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserUsageUpdateOne is the builder for updating a single UserUsage entity.
+type UserUsageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserUsageMutation
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *UserUsageUpdateOne) SetPeriodStart(v time.Time) *UserUsageUpdateOne {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *UserUsageUpdateOne) SetNillablePeriodStart(v *time.Time) *UserUsageUpdateOne {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetCharacters sets the "characters" field.
+func (_u *UserUsageUpdateOne) SetCharacters(v int) *UserUsageUpdateOne {
+	_u.mutation.ResetCharacters()
+	_u.mutation.SetCharacters(v)
+	return _u
+}
+
+// SetNillableCharacters sets the "characters" field if the given value is not nil.
+func (_u *UserUsageUpdateOne) SetNillableCharacters(v *int) *UserUsageUpdateOne {
+	if v != nil {
+		_u.SetCharacters(*v)
+	}
+	return _u
+}
+
+// AddCharacters adds value to the "characters" field.
+func (_u *UserUsageUpdateOne) AddCharacters(v int) *UserUsageUpdateOne {
+	_u.mutation.AddCharacters(v)
+	return _u
+}
+
+// SetEntries sets the "entries" field.
+func (_u *UserUsageUpdateOne) SetEntries(v int) *UserUsageUpdateOne {
+	_u.mutation.ResetEntries()
+	_u.mutation.SetEntries(v)
+	return _u
+}
+
+// SetNillableEntries sets the "entries" field if the given value is not nil.
+func (_u *UserUsageUpdateOne) SetNillableEntries(v *int) *UserUsageUpdateOne {
+	if v != nil {
+		_u.SetEntries(*v)
+	}
+	return _u
+}
+
+// AddEntries adds value to the "entries" field.
+func (_u *UserUsageUpdateOne) AddEntries(v int) *UserUsageUpdateOne {
+	_u.mutation.AddEntries(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserUsageUpdateOne) SetUpdatedAt(v time.Time) *UserUsageUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *UserUsageUpdateOne) SetUserID(id uuid.UUID) *UserUsageUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *UserUsageUpdateOne) SetUser(v *User) *UserUsageUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the UserUsageMutation object of the builder.
+func (_u *UserUsageUpdateOne) Mutation() *UserUsageMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *UserUsageUpdateOne) ClearUser() *UserUsageUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the UserUsageUpdate builder.
+func (_u *UserUsageUpdateOne) Where(ps ...predicate.UserUsage) *UserUsageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserUsageUpdateOne) Select(field string, fields ...string) *UserUsageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserUsage entity.
+func (_u *UserUsageUpdateOne) Save(ctx context.Context) (*UserUsage, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserUsageUpdateOne) SaveX(ctx context.Context) *UserUsage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserUsageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserUsageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserUsageUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := userusage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserUsageUpdateOne) check() error {
+	if v, ok := _u.mutation.Characters(); ok {
+		if err := userusage.CharactersValidator(v); err != nil {
+			return &ValidationError{Name: "characters", err: fmt.Errorf(`generated: validator failed for field "UserUsage.characters": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Entries(); ok {
+		if err := userusage.EntriesValidator(v); err != nil {
+			return &ValidationError{Name: "entries", err: fmt.Errorf(`generated: validator failed for field "UserUsage.entries": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "UserUsage.user"`)
+	}
+	return nil
+}
+
+func (_u *UserUsageUpdateOne) sqlSave(ctx context.Context) (_node *UserUsage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userusage.Table, userusage.Columns, sqlgraph.NewFieldSpec(userusage.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "UserUsage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userusage.FieldID)
+		for _, f := range fields {
+			if !userusage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != userusage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(userusage.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Characters(); ok {
+		_spec.SetField(userusage.FieldCharacters, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCharacters(); ok {
+		_spec.AddField(userusage.FieldCharacters, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Entries(); ok {
+		_spec.SetField(userusage.FieldEntries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntries(); ok {
+		_spec.AddField(userusage.FieldEntries, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(userusage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   userusage.UserTable,
+			Columns: []string{userusage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   userusage.UserTable,
+			Columns: []string{userusage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &UserUsage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
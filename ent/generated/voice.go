@@ -0,0 +1,218 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/voice"
+)
+
+// Voice is the model entity for the Voice schema.
+type Voice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// Language holds the value of the "language" field.
+	Language string `json:"language,omitempty"`
+	// Gender holds the value of the "gender" field.
+	Gender voice.Gender `json:"gender,omitempty"`
+	// DisplayName holds the value of the "display_name" field.
+	DisplayName string `json:"displayName"`
+	// MinRate holds the value of the "min_rate" field.
+	MinRate float64 `json:"minRate"`
+	// MaxRate holds the value of the "max_rate" field.
+	MaxRate float64 `json:"maxRate"`
+	// MinPitch holds the value of the "min_pitch" field.
+	MinPitch float64 `json:"minPitch"`
+	// MaxPitch holds the value of the "max_pitch" field.
+	MaxPitch float64 `json:"maxPitch"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updatedAt"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Voice) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case voice.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case voice.FieldMinRate, voice.FieldMaxRate, voice.FieldMinPitch, voice.FieldMaxPitch:
+			values[i] = new(sql.NullFloat64)
+		case voice.FieldID, voice.FieldProvider, voice.FieldLanguage, voice.FieldGender, voice.FieldDisplayName:
+			values[i] = new(sql.NullString)
+		case voice.FieldCreatedAt, voice.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Voice fields.
+func (_m *Voice) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case voice.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case voice.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case voice.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				_m.Language = value.String
+			}
+		case voice.FieldGender:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gender", values[i])
+			} else if value.Valid {
+				_m.Gender = voice.Gender(value.String)
+			}
+		case voice.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				_m.DisplayName = value.String
+			}
+		case voice.FieldMinRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_rate", values[i])
+			} else if value.Valid {
+				_m.MinRate = value.Float64
+			}
+		case voice.FieldMaxRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_rate", values[i])
+			} else if value.Valid {
+				_m.MaxRate = value.Float64
+			}
+		case voice.FieldMinPitch:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_pitch", values[i])
+			} else if value.Valid {
+				_m.MinPitch = value.Float64
+			}
+		case voice.FieldMaxPitch:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_pitch", values[i])
+			} else if value.Valid {
+				_m.MaxPitch = value.Float64
+			}
+		case voice.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case voice.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case voice.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Voice.
+// This includes values selected through modifiers, order, etc.
+func (_m *Voice) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Voice.
+// Note that you need to call Voice.Unwrap() before calling this method if this Voice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Voice) Update() *VoiceUpdateOne {
+	return NewVoiceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Voice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Voice) Unwrap() *Voice {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: Voice is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Voice) String() string {
+	var builder strings.Builder
+	builder.WriteString("Voice(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("language=")
+	builder.WriteString(_m.Language)
+	builder.WriteString(", ")
+	builder.WriteString("gender=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Gender))
+	builder.WriteString(", ")
+	builder.WriteString("display_name=")
+	builder.WriteString(_m.DisplayName)
+	builder.WriteString(", ")
+	builder.WriteString("min_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinRate))
+	builder.WriteString(", ")
+	builder.WriteString("max_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxRate))
+	builder.WriteString(", ")
+	builder.WriteString("min_pitch=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinPitch))
+	builder.WriteString(", ")
+	builder.WriteString("max_pitch=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxPitch))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Voices is a parsable slice of Voice.
+type Voices []*Voice
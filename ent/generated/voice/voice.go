@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package voice
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the voice type in the database.
+	Label = "voice"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldLanguage holds the string denoting the language field in the database.
+	FieldLanguage = "language"
+	// FieldGender holds the string denoting the gender field in the database.
+	FieldGender = "gender"
+	// FieldDisplayName holds the string denoting the display_name field in the database.
+	FieldDisplayName = "display_name"
+	// FieldMinRate holds the string denoting the min_rate field in the database.
+	FieldMinRate = "min_rate"
+	// FieldMaxRate holds the string denoting the max_rate field in the database.
+	FieldMaxRate = "max_rate"
+	// FieldMinPitch holds the string denoting the min_pitch field in the database.
+	FieldMinPitch = "min_pitch"
+	// FieldMaxPitch holds the string denoting the max_pitch field in the database.
+	FieldMaxPitch = "max_pitch"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the voice in the database.
+	Table = "voices"
+)
+
+// Columns holds all SQL columns for voice fields.
+var Columns = []string{
+	FieldID,
+	FieldProvider,
+	FieldLanguage,
+	FieldGender,
+	FieldDisplayName,
+	FieldMinRate,
+	FieldMaxRate,
+	FieldMinPitch,
+	FieldMaxPitch,
+	FieldEnabled,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	ProviderValidator func(string) error
+	// LanguageValidator is a validator for the "language" field. It is called by the builders before save.
+	LanguageValidator func(string) error
+	// DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	DisplayNameValidator func(string) error
+	// DefaultMinRate holds the default value on creation for the "min_rate" field.
+	DefaultMinRate float64
+	// DefaultMaxRate holds the default value on creation for the "max_rate" field.
+	DefaultMaxRate float64
+	// DefaultMinPitch holds the default value on creation for the "min_pitch" field.
+	DefaultMinPitch float64
+	// DefaultMaxPitch holds the default value on creation for the "max_pitch" field.
+	DefaultMaxPitch float64
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// Gender defines the type for the "gender" enum field.
+type Gender string
+
+// Gender values.
+const (
+	GenderMale    Gender = "male"
+	GenderFemale  Gender = "female"
+	GenderNeutral Gender = "neutral"
+)
+
+func (ge Gender) String() string {
+	return string(ge)
+}
+
+// GenderValidator is a validator for the "gender" field enum values. It is called by the builders before save.
+func GenderValidator(ge Gender) error {
+	switch ge {
+	case GenderMale, GenderFemale, GenderNeutral:
+		return nil
+	default:
+		return fmt.Errorf("voice: invalid enum value for gender field: %q", ge)
+	}
+}
+
+// OrderOption defines the ordering options for the Voice queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByLanguage orders the results by the language field.
+func ByLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLanguage, opts...).ToFunc()
+}
+
+// ByGender orders the results by the gender field.
+func ByGender(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGender, opts...).ToFunc()
+}
+
+// ByDisplayName orders the results by the display_name field.
+func ByDisplayName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayName, opts...).ToFunc()
+}
+
+// ByMinRate orders the results by the min_rate field.
+func ByMinRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinRate, opts...).ToFunc()
+}
+
+// ByMaxRate orders the results by the max_rate field.
+func ByMaxRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRate, opts...).ToFunc()
+}
+
+// ByMinPitch orders the results by the min_pitch field.
+func ByMinPitch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinPitch, opts...).ToFunc()
+}
+
+// ByMaxPitch orders the results by the max_pitch field.
+func ByMaxPitch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxPitch, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,595 @@
+// Code generated by ent, DO NOT EDIT.
+
+package voice
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Voice {
+	return predicate.Voice(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Voice {
+	return predicate.Voice(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Voice {
+	return predicate.Voice(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Voice {
+	return predicate.Voice(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Voice {
+	return predicate.Voice(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Voice {
+	return predicate.Voice(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Voice {
+	return predicate.Voice(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Voice {
+	return predicate.Voice(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Voice {
+	return predicate.Voice(sql.FieldContainsFold(FieldID, id))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldProvider, v))
+}
+
+// Language applies equality check predicate on the "language" field. It's identical to LanguageEQ.
+func Language(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldLanguage, v))
+}
+
+// DisplayName applies equality check predicate on the "display_name" field. It's identical to DisplayNameEQ.
+func DisplayName(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// MinRate applies equality check predicate on the "min_rate" field. It's identical to MinRateEQ.
+func MinRate(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldMinRate, v))
+}
+
+// MaxRate applies equality check predicate on the "max_rate" field. It's identical to MaxRateEQ.
+func MaxRate(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldMaxRate, v))
+}
+
+// MinPitch applies equality check predicate on the "min_pitch" field. It's identical to MinPitchEQ.
+func MinPitch(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldMinPitch, v))
+}
+
+// MaxPitch applies equality check predicate on the "max_pitch" field. It's identical to MaxPitchEQ.
+func MaxPitch(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldMaxPitch, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.Voice {
+	return predicate.Voice(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.Voice {
+	return predicate.Voice(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// LanguageEQ applies the EQ predicate on the "language" field.
+func LanguageEQ(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldLanguage, v))
+}
+
+// LanguageNEQ applies the NEQ predicate on the "language" field.
+func LanguageNEQ(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldNEQ(FieldLanguage, v))
+}
+
+// LanguageIn applies the In predicate on the "language" field.
+func LanguageIn(vs ...string) predicate.Voice {
+	return predicate.Voice(sql.FieldIn(FieldLanguage, vs...))
+}
+
+// LanguageNotIn applies the NotIn predicate on the "language" field.
+func LanguageNotIn(vs ...string) predicate.Voice {
+	return predicate.Voice(sql.FieldNotIn(FieldLanguage, vs...))
+}
+
+// LanguageGT applies the GT predicate on the "language" field.
+func LanguageGT(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldGT(FieldLanguage, v))
+}
+
+// LanguageGTE applies the GTE predicate on the "language" field.
+func LanguageGTE(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldGTE(FieldLanguage, v))
+}
+
+// LanguageLT applies the LT predicate on the "language" field.
+func LanguageLT(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldLT(FieldLanguage, v))
+}
+
+// LanguageLTE applies the LTE predicate on the "language" field.
+func LanguageLTE(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldLTE(FieldLanguage, v))
+}
+
+// LanguageContains applies the Contains predicate on the "language" field.
+func LanguageContains(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldContains(FieldLanguage, v))
+}
+
+// LanguageHasPrefix applies the HasPrefix predicate on the "language" field.
+func LanguageHasPrefix(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldHasPrefix(FieldLanguage, v))
+}
+
+// LanguageHasSuffix applies the HasSuffix predicate on the "language" field.
+func LanguageHasSuffix(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldHasSuffix(FieldLanguage, v))
+}
+
+// LanguageEqualFold applies the EqualFold predicate on the "language" field.
+func LanguageEqualFold(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldEqualFold(FieldLanguage, v))
+}
+
+// LanguageContainsFold applies the ContainsFold predicate on the "language" field.
+func LanguageContainsFold(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldContainsFold(FieldLanguage, v))
+}
+
+// GenderEQ applies the EQ predicate on the "gender" field.
+func GenderEQ(v Gender) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldGender, v))
+}
+
+// GenderNEQ applies the NEQ predicate on the "gender" field.
+func GenderNEQ(v Gender) predicate.Voice {
+	return predicate.Voice(sql.FieldNEQ(FieldGender, v))
+}
+
+// GenderIn applies the In predicate on the "gender" field.
+func GenderIn(vs ...Gender) predicate.Voice {
+	return predicate.Voice(sql.FieldIn(FieldGender, vs...))
+}
+
+// GenderNotIn applies the NotIn predicate on the "gender" field.
+func GenderNotIn(vs ...Gender) predicate.Voice {
+	return predicate.Voice(sql.FieldNotIn(FieldGender, vs...))
+}
+
+// DisplayNameEQ applies the EQ predicate on the "display_name" field.
+func DisplayNameEQ(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// DisplayNameNEQ applies the NEQ predicate on the "display_name" field.
+func DisplayNameNEQ(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldNEQ(FieldDisplayName, v))
+}
+
+// DisplayNameIn applies the In predicate on the "display_name" field.
+func DisplayNameIn(vs ...string) predicate.Voice {
+	return predicate.Voice(sql.FieldIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameNotIn applies the NotIn predicate on the "display_name" field.
+func DisplayNameNotIn(vs ...string) predicate.Voice {
+	return predicate.Voice(sql.FieldNotIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameGT applies the GT predicate on the "display_name" field.
+func DisplayNameGT(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldGT(FieldDisplayName, v))
+}
+
+// DisplayNameGTE applies the GTE predicate on the "display_name" field.
+func DisplayNameGTE(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldGTE(FieldDisplayName, v))
+}
+
+// DisplayNameLT applies the LT predicate on the "display_name" field.
+func DisplayNameLT(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldLT(FieldDisplayName, v))
+}
+
+// DisplayNameLTE applies the LTE predicate on the "display_name" field.
+func DisplayNameLTE(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldLTE(FieldDisplayName, v))
+}
+
+// DisplayNameContains applies the Contains predicate on the "display_name" field.
+func DisplayNameContains(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldContains(FieldDisplayName, v))
+}
+
+// DisplayNameHasPrefix applies the HasPrefix predicate on the "display_name" field.
+func DisplayNameHasPrefix(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldHasPrefix(FieldDisplayName, v))
+}
+
+// DisplayNameHasSuffix applies the HasSuffix predicate on the "display_name" field.
+func DisplayNameHasSuffix(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldHasSuffix(FieldDisplayName, v))
+}
+
+// DisplayNameEqualFold applies the EqualFold predicate on the "display_name" field.
+func DisplayNameEqualFold(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldEqualFold(FieldDisplayName, v))
+}
+
+// DisplayNameContainsFold applies the ContainsFold predicate on the "display_name" field.
+func DisplayNameContainsFold(v string) predicate.Voice {
+	return predicate.Voice(sql.FieldContainsFold(FieldDisplayName, v))
+}
+
+// MinRateEQ applies the EQ predicate on the "min_rate" field.
+func MinRateEQ(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldMinRate, v))
+}
+
+// MinRateNEQ applies the NEQ predicate on the "min_rate" field.
+func MinRateNEQ(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldNEQ(FieldMinRate, v))
+}
+
+// MinRateIn applies the In predicate on the "min_rate" field.
+func MinRateIn(vs ...float64) predicate.Voice {
+	return predicate.Voice(sql.FieldIn(FieldMinRate, vs...))
+}
+
+// MinRateNotIn applies the NotIn predicate on the "min_rate" field.
+func MinRateNotIn(vs ...float64) predicate.Voice {
+	return predicate.Voice(sql.FieldNotIn(FieldMinRate, vs...))
+}
+
+// MinRateGT applies the GT predicate on the "min_rate" field.
+func MinRateGT(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldGT(FieldMinRate, v))
+}
+
+// MinRateGTE applies the GTE predicate on the "min_rate" field.
+func MinRateGTE(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldGTE(FieldMinRate, v))
+}
+
+// MinRateLT applies the LT predicate on the "min_rate" field.
+func MinRateLT(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldLT(FieldMinRate, v))
+}
+
+// MinRateLTE applies the LTE predicate on the "min_rate" field.
+func MinRateLTE(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldLTE(FieldMinRate, v))
+}
+
+// MaxRateEQ applies the EQ predicate on the "max_rate" field.
+func MaxRateEQ(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldMaxRate, v))
+}
+
+// MaxRateNEQ applies the NEQ predicate on the "max_rate" field.
+func MaxRateNEQ(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldNEQ(FieldMaxRate, v))
+}
+
+// MaxRateIn applies the In predicate on the "max_rate" field.
+func MaxRateIn(vs ...float64) predicate.Voice {
+	return predicate.Voice(sql.FieldIn(FieldMaxRate, vs...))
+}
+
+// MaxRateNotIn applies the NotIn predicate on the "max_rate" field.
+func MaxRateNotIn(vs ...float64) predicate.Voice {
+	return predicate.Voice(sql.FieldNotIn(FieldMaxRate, vs...))
+}
+
+// MaxRateGT applies the GT predicate on the "max_rate" field.
+func MaxRateGT(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldGT(FieldMaxRate, v))
+}
+
+// MaxRateGTE applies the GTE predicate on the "max_rate" field.
+func MaxRateGTE(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldGTE(FieldMaxRate, v))
+}
+
+// MaxRateLT applies the LT predicate on the "max_rate" field.
+func MaxRateLT(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldLT(FieldMaxRate, v))
+}
+
+// MaxRateLTE applies the LTE predicate on the "max_rate" field.
+func MaxRateLTE(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldLTE(FieldMaxRate, v))
+}
+
+// MinPitchEQ applies the EQ predicate on the "min_pitch" field.
+func MinPitchEQ(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldMinPitch, v))
+}
+
+// MinPitchNEQ applies the NEQ predicate on the "min_pitch" field.
+func MinPitchNEQ(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldNEQ(FieldMinPitch, v))
+}
+
+// MinPitchIn applies the In predicate on the "min_pitch" field.
+func MinPitchIn(vs ...float64) predicate.Voice {
+	return predicate.Voice(sql.FieldIn(FieldMinPitch, vs...))
+}
+
+// MinPitchNotIn applies the NotIn predicate on the "min_pitch" field.
+func MinPitchNotIn(vs ...float64) predicate.Voice {
+	return predicate.Voice(sql.FieldNotIn(FieldMinPitch, vs...))
+}
+
+// MinPitchGT applies the GT predicate on the "min_pitch" field.
+func MinPitchGT(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldGT(FieldMinPitch, v))
+}
+
+// MinPitchGTE applies the GTE predicate on the "min_pitch" field.
+func MinPitchGTE(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldGTE(FieldMinPitch, v))
+}
+
+// MinPitchLT applies the LT predicate on the "min_pitch" field.
+func MinPitchLT(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldLT(FieldMinPitch, v))
+}
+
+// MinPitchLTE applies the LTE predicate on the "min_pitch" field.
+func MinPitchLTE(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldLTE(FieldMinPitch, v))
+}
+
+// MaxPitchEQ applies the EQ predicate on the "max_pitch" field.
+func MaxPitchEQ(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldMaxPitch, v))
+}
+
+// MaxPitchNEQ applies the NEQ predicate on the "max_pitch" field.
+func MaxPitchNEQ(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldNEQ(FieldMaxPitch, v))
+}
+
+// MaxPitchIn applies the In predicate on the "max_pitch" field.
+func MaxPitchIn(vs ...float64) predicate.Voice {
+	return predicate.Voice(sql.FieldIn(FieldMaxPitch, vs...))
+}
+
+// MaxPitchNotIn applies the NotIn predicate on the "max_pitch" field.
+func MaxPitchNotIn(vs ...float64) predicate.Voice {
+	return predicate.Voice(sql.FieldNotIn(FieldMaxPitch, vs...))
+}
+
+// MaxPitchGT applies the GT predicate on the "max_pitch" field.
+func MaxPitchGT(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldGT(FieldMaxPitch, v))
+}
+
+// MaxPitchGTE applies the GTE predicate on the "max_pitch" field.
+func MaxPitchGTE(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldGTE(FieldMaxPitch, v))
+}
+
+// MaxPitchLT applies the LT predicate on the "max_pitch" field.
+func MaxPitchLT(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldLT(FieldMaxPitch, v))
+}
+
+// MaxPitchLTE applies the LTE predicate on the "max_pitch" field.
+func MaxPitchLTE(v float64) predicate.Voice {
+	return predicate.Voice(sql.FieldLTE(FieldMaxPitch, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.Voice {
+	return predicate.Voice(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Voice {
+	return predicate.Voice(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Voice {
+	return predicate.Voice(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Voice {
+	return predicate.Voice(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Voice {
+	return predicate.Voice(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Voice {
+	return predicate.Voice(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Voice {
+	return predicate.Voice(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Voice {
+	return predicate.Voice(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Voice {
+	return predicate.Voice(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Voice {
+	return predicate.Voice(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Voice {
+	return predicate.Voice(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Voice {
+	return predicate.Voice(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Voice {
+	return predicate.Voice(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Voice {
+	return predicate.Voice(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Voice {
+	return predicate.Voice(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Voice {
+	return predicate.Voice(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Voice) predicate.Voice {
+	return predicate.Voice(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Voice) predicate.Voice {
+	return predicate.Voice(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Voice) predicate.Voice {
+	return predicate.Voice(sql.NotPredicates(p))
+}
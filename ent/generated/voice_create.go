@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/voice"
+)
+
+// VoiceCreate is the builder for creating a Voice entity.
+type VoiceCreate struct {
+	config
+	mutation *VoiceMutation
+	hooks    []Hook
+}
+
+// SetProvider sets the "provider" field.
+func (_c *VoiceCreate) SetProvider(v string) *VoiceCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetLanguage sets the "language" field.
+func (_c *VoiceCreate) SetLanguage(v string) *VoiceCreate {
+	_c.mutation.SetLanguage(v)
+	return _c
+}
+
+// SetGender sets the "gender" field.
+func (_c *VoiceCreate) SetGender(v voice.Gender) *VoiceCreate {
+	_c.mutation.SetGender(v)
+	return _c
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_c *VoiceCreate) SetDisplayName(v string) *VoiceCreate {
+	_c.mutation.SetDisplayName(v)
+	return _c
+}
+
+// SetMinRate sets the "min_rate" field.
+func (_c *VoiceCreate) SetMinRate(v float64) *VoiceCreate {
+	_c.mutation.SetMinRate(v)
+	return _c
+}
+
+// SetNillableMinRate sets the "min_rate" field if the given value is not nil.
+func (_c *VoiceCreate) SetNillableMinRate(v *float64) *VoiceCreate {
+	if v != nil {
+		_c.SetMinRate(*v)
+	}
+	return _c
+}
+
+// SetMaxRate sets the "max_rate" field.
+func (_c *VoiceCreate) SetMaxRate(v float64) *VoiceCreate {
+	_c.mutation.SetMaxRate(v)
+	return _c
+}
+
+// SetNillableMaxRate sets the "max_rate" field if the given value is not nil.
+func (_c *VoiceCreate) SetNillableMaxRate(v *float64) *VoiceCreate {
+	if v != nil {
+		_c.SetMaxRate(*v)
+	}
+	return _c
+}
+
+// SetMinPitch sets the "min_pitch" field.
+func (_c *VoiceCreate) SetMinPitch(v float64) *VoiceCreate {
+	_c.mutation.SetMinPitch(v)
+	return _c
+}
+
+// SetNillableMinPitch sets the "min_pitch" field if the given value is not nil.
+func (_c *VoiceCreate) SetNillableMinPitch(v *float64) *VoiceCreate {
+	if v != nil {
+		_c.SetMinPitch(*v)
+	}
+	return _c
+}
+
+// SetMaxPitch sets the "max_pitch" field.
+func (_c *VoiceCreate) SetMaxPitch(v float64) *VoiceCreate {
+	_c.mutation.SetMaxPitch(v)
+	return _c
+}
+
+// SetNillableMaxPitch sets the "max_pitch" field if the given value is not nil.
+func (_c *VoiceCreate) SetNillableMaxPitch(v *float64) *VoiceCreate {
+	if v != nil {
+		_c.SetMaxPitch(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *VoiceCreate) SetEnabled(v bool) *VoiceCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *VoiceCreate) SetNillableEnabled(v *bool) *VoiceCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *VoiceCreate) SetCreatedAt(v time.Time) *VoiceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *VoiceCreate) SetNillableCreatedAt(v *time.Time) *VoiceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *VoiceCreate) SetUpdatedAt(v time.Time) *VoiceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *VoiceCreate) SetNillableUpdatedAt(v *time.Time) *VoiceCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *VoiceCreate) SetID(v string) *VoiceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the VoiceMutation object of the builder.
+func (_c *VoiceCreate) Mutation() *VoiceMutation {
+	return _c.mutation
+}
+
+// Save creates the Voice in the database.
+func (_c *VoiceCreate) Save(ctx context.Context) (*Voice, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VoiceCreate) SaveX(ctx context.Context) *Voice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VoiceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VoiceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VoiceCreate) defaults() {
+	if _, ok := _c.mutation.MinRate(); !ok {
+		v := voice.DefaultMinRate
+		_c.mutation.SetMinRate(v)
+	}
+	if _, ok := _c.mutation.MaxRate(); !ok {
+		v := voice.DefaultMaxRate
+		_c.mutation.SetMaxRate(v)
+	}
+	if _, ok := _c.mutation.MinPitch(); !ok {
+		v := voice.DefaultMinPitch
+		_c.mutation.SetMinPitch(v)
+	}
+	if _, ok := _c.mutation.MaxPitch(); !ok {
+		v := voice.DefaultMaxPitch
+		_c.mutation.SetMaxPitch(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := voice.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := voice.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := voice.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VoiceCreate) check() error {
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`generated: missing required field "Voice.provider"`)}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := voice.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`generated: validator failed for field "Voice.provider": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Language(); !ok {
+		return &ValidationError{Name: "language", err: errors.New(`generated: missing required field "Voice.language"`)}
+	}
+	if v, ok := _c.mutation.Language(); ok {
+		if err := voice.LanguageValidator(v); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`generated: validator failed for field "Voice.language": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Gender(); !ok {
+		return &ValidationError{Name: "gender", err: errors.New(`generated: missing required field "Voice.gender"`)}
+	}
+	if v, ok := _c.mutation.Gender(); ok {
+		if err := voice.GenderValidator(v); err != nil {
+			return &ValidationError{Name: "gender", err: fmt.Errorf(`generated: validator failed for field "Voice.gender": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DisplayName(); !ok {
+		return &ValidationError{Name: "display_name", err: errors.New(`generated: missing required field "Voice.display_name"`)}
+	}
+	if v, ok := _c.mutation.DisplayName(); ok {
+		if err := voice.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`generated: validator failed for field "Voice.display_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MinRate(); !ok {
+		return &ValidationError{Name: "min_rate", err: errors.New(`generated: missing required field "Voice.min_rate"`)}
+	}
+	if _, ok := _c.mutation.MaxRate(); !ok {
+		return &ValidationError{Name: "max_rate", err: errors.New(`generated: missing required field "Voice.max_rate"`)}
+	}
+	if _, ok := _c.mutation.MinPitch(); !ok {
+		return &ValidationError{Name: "min_pitch", err: errors.New(`generated: missing required field "Voice.min_pitch"`)}
+	}
+	if _, ok := _c.mutation.MaxPitch(); !ok {
+		return &ValidationError{Name: "max_pitch", err: errors.New(`generated: missing required field "Voice.max_pitch"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`generated: missing required field "Voice.enabled"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Voice.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "Voice.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := voice.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`generated: validator failed for field "Voice.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *VoiceCreate) sqlSave(ctx context.Context) (*Voice, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Voice.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VoiceCreate) createSpec() (*Voice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Voice{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(voice.Table, sqlgraph.NewFieldSpec(voice.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(voice.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.Language(); ok {
+		_spec.SetField(voice.FieldLanguage, field.TypeString, value)
+		_node.Language = value
+	}
+	if value, ok := _c.mutation.Gender(); ok {
+		_spec.SetField(voice.FieldGender, field.TypeEnum, value)
+		_node.Gender = value
+	}
+	if value, ok := _c.mutation.DisplayName(); ok {
+		_spec.SetField(voice.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = value
+	}
+	if value, ok := _c.mutation.MinRate(); ok {
+		_spec.SetField(voice.FieldMinRate, field.TypeFloat64, value)
+		_node.MinRate = value
+	}
+	if value, ok := _c.mutation.MaxRate(); ok {
+		_spec.SetField(voice.FieldMaxRate, field.TypeFloat64, value)
+		_node.MaxRate = value
+	}
+	if value, ok := _c.mutation.MinPitch(); ok {
+		_spec.SetField(voice.FieldMinPitch, field.TypeFloat64, value)
+		_node.MinPitch = value
+	}
+	if value, ok := _c.mutation.MaxPitch(); ok {
+		_spec.SetField(voice.FieldMaxPitch, field.TypeFloat64, value)
+		_node.MaxPitch = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(voice.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(voice.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(voice.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// VoiceCreateBulk is the builder for creating many Voice entities in bulk.
+type VoiceCreateBulk struct {
+	config
+	err      error
+	builders []*VoiceCreate
+}
+
+// Save creates the Voice entities in the database.
+func (_c *VoiceCreateBulk) Save(ctx context.Context) ([]*Voice, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Voice, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VoiceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VoiceCreateBulk) SaveX(ctx context.Context) []*Voice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VoiceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VoiceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,688 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/voice"
+)
+
+// VoiceUpdate is the builder for updating Voice entities.
+type VoiceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VoiceMutation
+}
+
+// Where appends a list predicates to the VoiceUpdate builder.
+func (_u *VoiceUpdate) Where(ps ...predicate.Voice) *VoiceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *VoiceUpdate) SetProvider(v string) *VoiceUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *VoiceUpdate) SetNillableProvider(v *string) *VoiceUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *VoiceUpdate) SetLanguage(v string) *VoiceUpdate {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *VoiceUpdate) SetNillableLanguage(v *string) *VoiceUpdate {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// SetGender sets the "gender" field.
+func (_u *VoiceUpdate) SetGender(v voice.Gender) *VoiceUpdate {
+	_u.mutation.SetGender(v)
+	return _u
+}
+
+// SetNillableGender sets the "gender" field if the given value is not nil.
+func (_u *VoiceUpdate) SetNillableGender(v *voice.Gender) *VoiceUpdate {
+	if v != nil {
+		_u.SetGender(*v)
+	}
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *VoiceUpdate) SetDisplayName(v string) *VoiceUpdate {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *VoiceUpdate) SetNillableDisplayName(v *string) *VoiceUpdate {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// SetMinRate sets the "min_rate" field.
+func (_u *VoiceUpdate) SetMinRate(v float64) *VoiceUpdate {
+	_u.mutation.ResetMinRate()
+	_u.mutation.SetMinRate(v)
+	return _u
+}
+
+// SetNillableMinRate sets the "min_rate" field if the given value is not nil.
+func (_u *VoiceUpdate) SetNillableMinRate(v *float64) *VoiceUpdate {
+	if v != nil {
+		_u.SetMinRate(*v)
+	}
+	return _u
+}
+
+// AddMinRate adds value to the "min_rate" field.
+func (_u *VoiceUpdate) AddMinRate(v float64) *VoiceUpdate {
+	_u.mutation.AddMinRate(v)
+	return _u
+}
+
+// SetMaxRate sets the "max_rate" field.
+func (_u *VoiceUpdate) SetMaxRate(v float64) *VoiceUpdate {
+	_u.mutation.ResetMaxRate()
+	_u.mutation.SetMaxRate(v)
+	return _u
+}
+
+// SetNillableMaxRate sets the "max_rate" field if the given value is not nil.
+func (_u *VoiceUpdate) SetNillableMaxRate(v *float64) *VoiceUpdate {
+	if v != nil {
+		_u.SetMaxRate(*v)
+	}
+	return _u
+}
+
+// AddMaxRate adds value to the "max_rate" field.
+func (_u *VoiceUpdate) AddMaxRate(v float64) *VoiceUpdate {
+	_u.mutation.AddMaxRate(v)
+	return _u
+}
+
+// SetMinPitch sets the "min_pitch" field.
+func (_u *VoiceUpdate) SetMinPitch(v float64) *VoiceUpdate {
+	_u.mutation.ResetMinPitch()
+	_u.mutation.SetMinPitch(v)
+	return _u
+}
+
+// SetNillableMinPitch sets the "min_pitch" field if the given value is not nil.
+func (_u *VoiceUpdate) SetNillableMinPitch(v *float64) *VoiceUpdate {
+	if v != nil {
+		_u.SetMinPitch(*v)
+	}
+	return _u
+}
+
+// AddMinPitch adds value to the "min_pitch" field.
+func (_u *VoiceUpdate) AddMinPitch(v float64) *VoiceUpdate {
+	_u.mutation.AddMinPitch(v)
+	return _u
+}
+
+// SetMaxPitch sets the "max_pitch" field.
+func (_u *VoiceUpdate) SetMaxPitch(v float64) *VoiceUpdate {
+	_u.mutation.ResetMaxPitch()
+	_u.mutation.SetMaxPitch(v)
+	return _u
+}
+
+// SetNillableMaxPitch sets the "max_pitch" field if the given value is not nil.
+func (_u *VoiceUpdate) SetNillableMaxPitch(v *float64) *VoiceUpdate {
+	if v != nil {
+		_u.SetMaxPitch(*v)
+	}
+	return _u
+}
+
+// AddMaxPitch adds value to the "max_pitch" field.
+func (_u *VoiceUpdate) AddMaxPitch(v float64) *VoiceUpdate {
+	_u.mutation.AddMaxPitch(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *VoiceUpdate) SetEnabled(v bool) *VoiceUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *VoiceUpdate) SetNillableEnabled(v *bool) *VoiceUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *VoiceUpdate) SetCreatedAt(v time.Time) *VoiceUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *VoiceUpdate) SetNillableCreatedAt(v *time.Time) *VoiceUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VoiceUpdate) SetUpdatedAt(v time.Time) *VoiceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the VoiceMutation object of the builder.
+func (_u *VoiceUpdate) Mutation() *VoiceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VoiceUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VoiceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VoiceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VoiceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VoiceUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := voice.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VoiceUpdate) check() error {
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := voice.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`generated: validator failed for field "Voice.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Language(); ok {
+		if err := voice.LanguageValidator(v); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`generated: validator failed for field "Voice.language": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Gender(); ok {
+		if err := voice.GenderValidator(v); err != nil {
+			return &ValidationError{Name: "gender", err: fmt.Errorf(`generated: validator failed for field "Voice.gender": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayName(); ok {
+		if err := voice.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`generated: validator failed for field "Voice.display_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *VoiceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(voice.Table, voice.Columns, sqlgraph.NewFieldSpec(voice.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(voice.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(voice.FieldLanguage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Gender(); ok {
+		_spec.SetField(voice.FieldGender, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(voice.FieldDisplayName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MinRate(); ok {
+		_spec.SetField(voice.FieldMinRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinRate(); ok {
+		_spec.AddField(voice.FieldMinRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxRate(); ok {
+		_spec.SetField(voice.FieldMaxRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRate(); ok {
+		_spec.AddField(voice.FieldMaxRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MinPitch(); ok {
+		_spec.SetField(voice.FieldMinPitch, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinPitch(); ok {
+		_spec.AddField(voice.FieldMinPitch, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxPitch(); ok {
+		_spec.SetField(voice.FieldMaxPitch, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxPitch(); ok {
+		_spec.AddField(voice.FieldMaxPitch, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(voice.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(voice.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(voice.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{voice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VoiceUpdateOne is the builder for updating a single Voice entity.
+type VoiceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VoiceMutation
+}
+
+// SetProvider sets the "provider" field.
+func (_u *VoiceUpdateOne) SetProvider(v string) *VoiceUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *VoiceUpdateOne) SetNillableProvider(v *string) *VoiceUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *VoiceUpdateOne) SetLanguage(v string) *VoiceUpdateOne {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *VoiceUpdateOne) SetNillableLanguage(v *string) *VoiceUpdateOne {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// SetGender sets the "gender" field.
+func (_u *VoiceUpdateOne) SetGender(v voice.Gender) *VoiceUpdateOne {
+	_u.mutation.SetGender(v)
+	return _u
+}
+
+// SetNillableGender sets the "gender" field if the given value is not nil.
+func (_u *VoiceUpdateOne) SetNillableGender(v *voice.Gender) *VoiceUpdateOne {
+	if v != nil {
+		_u.SetGender(*v)
+	}
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *VoiceUpdateOne) SetDisplayName(v string) *VoiceUpdateOne {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *VoiceUpdateOne) SetNillableDisplayName(v *string) *VoiceUpdateOne {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// SetMinRate sets the "min_rate" field.
+func (_u *VoiceUpdateOne) SetMinRate(v float64) *VoiceUpdateOne {
+	_u.mutation.ResetMinRate()
+	_u.mutation.SetMinRate(v)
+	return _u
+}
+
+// SetNillableMinRate sets the "min_rate" field if the given value is not nil.
+func (_u *VoiceUpdateOne) SetNillableMinRate(v *float64) *VoiceUpdateOne {
+	if v != nil {
+		_u.SetMinRate(*v)
+	}
+	return _u
+}
+
+// AddMinRate adds value to the "min_rate" field.
+func (_u *VoiceUpdateOne) AddMinRate(v float64) *VoiceUpdateOne {
+	_u.mutation.AddMinRate(v)
+	return _u
+}
+
+// SetMaxRate sets the "max_rate" field.
+func (_u *VoiceUpdateOne) SetMaxRate(v float64) *VoiceUpdateOne {
+	_u.mutation.ResetMaxRate()
+	_u.mutation.SetMaxRate(v)
+	return _u
+}
+
+// SetNillableMaxRate sets the "max_rate" field if the given value is not nil.
+func (_u *VoiceUpdateOne) SetNillableMaxRate(v *float64) *VoiceUpdateOne {
+	if v != nil {
+		_u.SetMaxRate(*v)
+	}
+	return _u
+}
+
+// AddMaxRate adds value to the "max_rate" field.
+func (_u *VoiceUpdateOne) AddMaxRate(v float64) *VoiceUpdateOne {
+	_u.mutation.AddMaxRate(v)
+	return _u
+}
+
+// SetMinPitch sets the "min_pitch" field.
+func (_u *VoiceUpdateOne) SetMinPitch(v float64) *VoiceUpdateOne {
+	_u.mutation.ResetMinPitch()
+	_u.mutation.SetMinPitch(v)
+	return _u
+}
+
+// SetNillableMinPitch sets the "min_pitch" field if the given value is not nil.
+func (_u *VoiceUpdateOne) SetNillableMinPitch(v *float64) *VoiceUpdateOne {
+	if v != nil {
+		_u.SetMinPitch(*v)
+	}
+	return _u
+}
+
+// AddMinPitch adds value to the "min_pitch" field.
+func (_u *VoiceUpdateOne) AddMinPitch(v float64) *VoiceUpdateOne {
+	_u.mutation.AddMinPitch(v)
+	return _u
+}
+
+// SetMaxPitch sets the "max_pitch" field.
+func (_u *VoiceUpdateOne) SetMaxPitch(v float64) *VoiceUpdateOne {
+	_u.mutation.ResetMaxPitch()
+	_u.mutation.SetMaxPitch(v)
+	return _u
+}
+
+// SetNillableMaxPitch sets the "max_pitch" field if the given value is not nil.
+func (_u *VoiceUpdateOne) SetNillableMaxPitch(v *float64) *VoiceUpdateOne {
+	if v != nil {
+		_u.SetMaxPitch(*v)
+	}
+	return _u
+}
+
+// AddMaxPitch adds value to the "max_pitch" field.
+func (_u *VoiceUpdateOne) AddMaxPitch(v float64) *VoiceUpdateOne {
+	_u.mutation.AddMaxPitch(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *VoiceUpdateOne) SetEnabled(v bool) *VoiceUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *VoiceUpdateOne) SetNillableEnabled(v *bool) *VoiceUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *VoiceUpdateOne) SetCreatedAt(v time.Time) *VoiceUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *VoiceUpdateOne) SetNillableCreatedAt(v *time.Time) *VoiceUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VoiceUpdateOne) SetUpdatedAt(v time.Time) *VoiceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the VoiceMutation object of the builder.
+func (_u *VoiceUpdateOne) Mutation() *VoiceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the VoiceUpdate builder.
+func (_u *VoiceUpdateOne) Where(ps ...predicate.Voice) *VoiceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VoiceUpdateOne) Select(field string, fields ...string) *VoiceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Voice entity.
+func (_u *VoiceUpdateOne) Save(ctx context.Context) (*Voice, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VoiceUpdateOne) SaveX(ctx context.Context) *Voice {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VoiceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VoiceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VoiceUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := voice.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VoiceUpdateOne) check() error {
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := voice.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`generated: validator failed for field "Voice.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Language(); ok {
+		if err := voice.LanguageValidator(v); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`generated: validator failed for field "Voice.language": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Gender(); ok {
+		if err := voice.GenderValidator(v); err != nil {
+			return &ValidationError{Name: "gender", err: fmt.Errorf(`generated: validator failed for field "Voice.gender": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayName(); ok {
+		if err := voice.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`generated: validator failed for field "Voice.display_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *VoiceUpdateOne) sqlSave(ctx context.Context) (_node *Voice, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(voice.Table, voice.Columns, sqlgraph.NewFieldSpec(voice.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "Voice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, voice.FieldID)
+		for _, f := range fields {
+			if !voice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != voice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(voice.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(voice.FieldLanguage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Gender(); ok {
+		_spec.SetField(voice.FieldGender, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(voice.FieldDisplayName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MinRate(); ok {
+		_spec.SetField(voice.FieldMinRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinRate(); ok {
+		_spec.AddField(voice.FieldMinRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxRate(); ok {
+		_spec.SetField(voice.FieldMaxRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRate(); ok {
+		_spec.AddField(voice.FieldMaxRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MinPitch(); ok {
+		_spec.SetField(voice.FieldMinPitch, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinPitch(); ok {
+		_spec.AddField(voice.FieldMinPitch, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxPitch(); ok {
+		_spec.SetField(voice.FieldMaxPitch, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxPitch(); ok {
+		_spec.AddField(voice.FieldMaxPitch, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(voice.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(voice.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(voice.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Voice{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{voice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,460 @@
+// Code generated by ent, DO NOT EDIT.
+
+package voicepreset
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldEQ(FieldName, v))
+}
+
+// Voice applies equality check predicate on the "voice" field. It's identical to VoiceEQ.
+func Voice(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldEQ(FieldVoice, v))
+}
+
+// Rate applies equality check predicate on the "rate" field. It's identical to RateEQ.
+func Rate(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldEQ(FieldRate, v))
+}
+
+// Pitch applies equality check predicate on the "pitch" field. It's identical to PitchEQ.
+func Pitch(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldEQ(FieldPitch, v))
+}
+
+// Volume applies equality check predicate on the "volume" field. It's identical to VolumeEQ.
+func Volume(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldEQ(FieldVolume, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldContainsFold(FieldName, v))
+}
+
+// VoiceEQ applies the EQ predicate on the "voice" field.
+func VoiceEQ(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldEQ(FieldVoice, v))
+}
+
+// VoiceNEQ applies the NEQ predicate on the "voice" field.
+func VoiceNEQ(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldNEQ(FieldVoice, v))
+}
+
+// VoiceIn applies the In predicate on the "voice" field.
+func VoiceIn(vs ...string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldIn(FieldVoice, vs...))
+}
+
+// VoiceNotIn applies the NotIn predicate on the "voice" field.
+func VoiceNotIn(vs ...string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldNotIn(FieldVoice, vs...))
+}
+
+// VoiceGT applies the GT predicate on the "voice" field.
+func VoiceGT(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldGT(FieldVoice, v))
+}
+
+// VoiceGTE applies the GTE predicate on the "voice" field.
+func VoiceGTE(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldGTE(FieldVoice, v))
+}
+
+// VoiceLT applies the LT predicate on the "voice" field.
+func VoiceLT(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldLT(FieldVoice, v))
+}
+
+// VoiceLTE applies the LTE predicate on the "voice" field.
+func VoiceLTE(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldLTE(FieldVoice, v))
+}
+
+// VoiceContains applies the Contains predicate on the "voice" field.
+func VoiceContains(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldContains(FieldVoice, v))
+}
+
+// VoiceHasPrefix applies the HasPrefix predicate on the "voice" field.
+func VoiceHasPrefix(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldHasPrefix(FieldVoice, v))
+}
+
+// VoiceHasSuffix applies the HasSuffix predicate on the "voice" field.
+func VoiceHasSuffix(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldHasSuffix(FieldVoice, v))
+}
+
+// VoiceEqualFold applies the EqualFold predicate on the "voice" field.
+func VoiceEqualFold(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldEqualFold(FieldVoice, v))
+}
+
+// VoiceContainsFold applies the ContainsFold predicate on the "voice" field.
+func VoiceContainsFold(v string) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldContainsFold(FieldVoice, v))
+}
+
+// RateEQ applies the EQ predicate on the "rate" field.
+func RateEQ(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldEQ(FieldRate, v))
+}
+
+// RateNEQ applies the NEQ predicate on the "rate" field.
+func RateNEQ(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldNEQ(FieldRate, v))
+}
+
+// RateIn applies the In predicate on the "rate" field.
+func RateIn(vs ...float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldIn(FieldRate, vs...))
+}
+
+// RateNotIn applies the NotIn predicate on the "rate" field.
+func RateNotIn(vs ...float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldNotIn(FieldRate, vs...))
+}
+
+// RateGT applies the GT predicate on the "rate" field.
+func RateGT(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldGT(FieldRate, v))
+}
+
+// RateGTE applies the GTE predicate on the "rate" field.
+func RateGTE(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldGTE(FieldRate, v))
+}
+
+// RateLT applies the LT predicate on the "rate" field.
+func RateLT(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldLT(FieldRate, v))
+}
+
+// RateLTE applies the LTE predicate on the "rate" field.
+func RateLTE(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldLTE(FieldRate, v))
+}
+
+// PitchEQ applies the EQ predicate on the "pitch" field.
+func PitchEQ(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldEQ(FieldPitch, v))
+}
+
+// PitchNEQ applies the NEQ predicate on the "pitch" field.
+func PitchNEQ(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldNEQ(FieldPitch, v))
+}
+
+// PitchIn applies the In predicate on the "pitch" field.
+func PitchIn(vs ...float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldIn(FieldPitch, vs...))
+}
+
+// PitchNotIn applies the NotIn predicate on the "pitch" field.
+func PitchNotIn(vs ...float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldNotIn(FieldPitch, vs...))
+}
+
+// PitchGT applies the GT predicate on the "pitch" field.
+func PitchGT(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldGT(FieldPitch, v))
+}
+
+// PitchGTE applies the GTE predicate on the "pitch" field.
+func PitchGTE(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldGTE(FieldPitch, v))
+}
+
+// PitchLT applies the LT predicate on the "pitch" field.
+func PitchLT(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldLT(FieldPitch, v))
+}
+
+// PitchLTE applies the LTE predicate on the "pitch" field.
+func PitchLTE(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldLTE(FieldPitch, v))
+}
+
+// VolumeEQ applies the EQ predicate on the "volume" field.
+func VolumeEQ(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldEQ(FieldVolume, v))
+}
+
+// VolumeNEQ applies the NEQ predicate on the "volume" field.
+func VolumeNEQ(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldNEQ(FieldVolume, v))
+}
+
+// VolumeIn applies the In predicate on the "volume" field.
+func VolumeIn(vs ...float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldIn(FieldVolume, vs...))
+}
+
+// VolumeNotIn applies the NotIn predicate on the "volume" field.
+func VolumeNotIn(vs ...float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldNotIn(FieldVolume, vs...))
+}
+
+// VolumeGT applies the GT predicate on the "volume" field.
+func VolumeGT(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldGT(FieldVolume, v))
+}
+
+// VolumeGTE applies the GTE predicate on the "volume" field.
+func VolumeGTE(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldGTE(FieldVolume, v))
+}
+
+// VolumeLT applies the LT predicate on the "volume" field.
+func VolumeLT(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldLT(FieldVolume, v))
+}
+
+// VolumeLTE applies the LTE predicate on the "volume" field.
+func VolumeLTE(v float64) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldLTE(FieldVolume, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.VoicePreset {
+	return predicate.VoicePreset(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.VoicePreset {
+	return predicate.VoicePreset(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VoicePreset) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VoicePreset) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VoicePreset) predicate.VoicePreset {
+	return predicate.VoicePreset(sql.NotPredicates(p))
+}
@@ -0,0 +1,409 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/voicepreset"
+)
+
+// VoicePresetCreate is the builder for creating a VoicePreset entity.
+type VoicePresetCreate struct {
+	config
+	mutation *VoicePresetMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *VoicePresetCreate) SetName(v string) *VoicePresetCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetVoice sets the "voice" field.
+func (_c *VoicePresetCreate) SetVoice(v string) *VoicePresetCreate {
+	_c.mutation.SetVoice(v)
+	return _c
+}
+
+// SetRate sets the "rate" field.
+func (_c *VoicePresetCreate) SetRate(v float64) *VoicePresetCreate {
+	_c.mutation.SetRate(v)
+	return _c
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_c *VoicePresetCreate) SetNillableRate(v *float64) *VoicePresetCreate {
+	if v != nil {
+		_c.SetRate(*v)
+	}
+	return _c
+}
+
+// SetPitch sets the "pitch" field.
+func (_c *VoicePresetCreate) SetPitch(v float64) *VoicePresetCreate {
+	_c.mutation.SetPitch(v)
+	return _c
+}
+
+// SetNillablePitch sets the "pitch" field if the given value is not nil.
+func (_c *VoicePresetCreate) SetNillablePitch(v *float64) *VoicePresetCreate {
+	if v != nil {
+		_c.SetPitch(*v)
+	}
+	return _c
+}
+
+// SetVolume sets the "volume" field.
+func (_c *VoicePresetCreate) SetVolume(v float64) *VoicePresetCreate {
+	_c.mutation.SetVolume(v)
+	return _c
+}
+
+// SetNillableVolume sets the "volume" field if the given value is not nil.
+func (_c *VoicePresetCreate) SetNillableVolume(v *float64) *VoicePresetCreate {
+	if v != nil {
+		_c.SetVolume(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *VoicePresetCreate) SetCreatedAt(v time.Time) *VoicePresetCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *VoicePresetCreate) SetNillableCreatedAt(v *time.Time) *VoicePresetCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *VoicePresetCreate) SetUpdatedAt(v time.Time) *VoicePresetCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *VoicePresetCreate) SetNillableUpdatedAt(v *time.Time) *VoicePresetCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *VoicePresetCreate) SetID(v uuid.UUID) *VoicePresetCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *VoicePresetCreate) SetNillableID(v *uuid.UUID) *VoicePresetCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *VoicePresetCreate) SetUserID(id uuid.UUID) *VoicePresetCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *VoicePresetCreate) SetUser(v *User) *VoicePresetCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the VoicePresetMutation object of the builder.
+func (_c *VoicePresetCreate) Mutation() *VoicePresetMutation {
+	return _c.mutation
+}
+
+// Save creates the VoicePreset in the database.
+func (_c *VoicePresetCreate) Save(ctx context.Context) (*VoicePreset, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VoicePresetCreate) SaveX(ctx context.Context) *VoicePreset {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VoicePresetCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VoicePresetCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VoicePresetCreate) defaults() {
+	if _, ok := _c.mutation.Rate(); !ok {
+		v := voicepreset.DefaultRate
+		_c.mutation.SetRate(v)
+	}
+	if _, ok := _c.mutation.Pitch(); !ok {
+		v := voicepreset.DefaultPitch
+		_c.mutation.SetPitch(v)
+	}
+	if _, ok := _c.mutation.Volume(); !ok {
+		v := voicepreset.DefaultVolume
+		_c.mutation.SetVolume(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := voicepreset.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := voicepreset.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := voicepreset.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VoicePresetCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`generated: missing required field "VoicePreset.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := voicepreset.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "VoicePreset.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Voice(); !ok {
+		return &ValidationError{Name: "voice", err: errors.New(`generated: missing required field "VoicePreset.voice"`)}
+	}
+	if v, ok := _c.mutation.Voice(); ok {
+		if err := voicepreset.VoiceValidator(v); err != nil {
+			return &ValidationError{Name: "voice", err: fmt.Errorf(`generated: validator failed for field "VoicePreset.voice": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`generated: missing required field "VoicePreset.rate"`)}
+	}
+	if v, ok := _c.mutation.Rate(); ok {
+		if err := voicepreset.RateValidator(v); err != nil {
+			return &ValidationError{Name: "rate", err: fmt.Errorf(`generated: validator failed for field "VoicePreset.rate": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Pitch(); !ok {
+		return &ValidationError{Name: "pitch", err: errors.New(`generated: missing required field "VoicePreset.pitch"`)}
+	}
+	if v, ok := _c.mutation.Pitch(); ok {
+		if err := voicepreset.PitchValidator(v); err != nil {
+			return &ValidationError{Name: "pitch", err: fmt.Errorf(`generated: validator failed for field "VoicePreset.pitch": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Volume(); !ok {
+		return &ValidationError{Name: "volume", err: errors.New(`generated: missing required field "VoicePreset.volume"`)}
+	}
+	if v, ok := _c.mutation.Volume(); ok {
+		if err := voicepreset.VolumeValidator(v); err != nil {
+			return &ValidationError{Name: "volume", err: fmt.Errorf(`generated: validator failed for field "VoicePreset.volume": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "VoicePreset.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "VoicePreset.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`generated: missing required edge "VoicePreset.user"`)}
+	}
+	return nil
+}
+
+func (_c *VoicePresetCreate) sqlSave(ctx context.Context) (*VoicePreset, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VoicePresetCreate) createSpec() (*VoicePreset, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VoicePreset{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(voicepreset.Table, sqlgraph.NewFieldSpec(voicepreset.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(voicepreset.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Voice(); ok {
+		_spec.SetField(voicepreset.FieldVoice, field.TypeString, value)
+		_node.Voice = value
+	}
+	if value, ok := _c.mutation.Rate(); ok {
+		_spec.SetField(voicepreset.FieldRate, field.TypeFloat64, value)
+		_node.Rate = value
+	}
+	if value, ok := _c.mutation.Pitch(); ok {
+		_spec.SetField(voicepreset.FieldPitch, field.TypeFloat64, value)
+		_node.Pitch = value
+	}
+	if value, ok := _c.mutation.Volume(); ok {
+		_spec.SetField(voicepreset.FieldVolume, field.TypeFloat64, value)
+		_node.Volume = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(voicepreset.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(voicepreset.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   voicepreset.UserTable,
+			Columns: []string{voicepreset.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_voice_presets = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// VoicePresetCreateBulk is the builder for creating many VoicePreset entities in bulk.
+type VoicePresetCreateBulk struct {
+	config
+	err      error
+	builders []*VoicePresetCreate
+}
+
+// Save creates the VoicePreset entities in the database.
+func (_c *VoicePresetCreateBulk) Save(ctx context.Context) ([]*VoicePreset, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*VoicePreset, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VoicePresetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VoicePresetCreateBulk) SaveX(ctx context.Context) []*VoicePreset {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VoicePresetCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VoicePresetCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
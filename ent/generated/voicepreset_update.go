@@ -0,0 +1,642 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/voicepreset"
+)
+
+// VoicePresetUpdate is the builder for updating VoicePreset entities.
+type VoicePresetUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VoicePresetMutation
+}
+
+// Where appends a list predicates to the VoicePresetUpdate builder.
+func (_u *VoicePresetUpdate) Where(ps ...predicate.VoicePreset) *VoicePresetUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *VoicePresetUpdate) SetName(v string) *VoicePresetUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *VoicePresetUpdate) SetNillableName(v *string) *VoicePresetUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetVoice sets the "voice" field.
+func (_u *VoicePresetUpdate) SetVoice(v string) *VoicePresetUpdate {
+	_u.mutation.SetVoice(v)
+	return _u
+}
+
+// SetNillableVoice sets the "voice" field if the given value is not nil.
+func (_u *VoicePresetUpdate) SetNillableVoice(v *string) *VoicePresetUpdate {
+	if v != nil {
+		_u.SetVoice(*v)
+	}
+	return _u
+}
+
+// SetRate sets the "rate" field.
+func (_u *VoicePresetUpdate) SetRate(v float64) *VoicePresetUpdate {
+	_u.mutation.ResetRate()
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *VoicePresetUpdate) SetNillableRate(v *float64) *VoicePresetUpdate {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// AddRate adds value to the "rate" field.
+func (_u *VoicePresetUpdate) AddRate(v float64) *VoicePresetUpdate {
+	_u.mutation.AddRate(v)
+	return _u
+}
+
+// SetPitch sets the "pitch" field.
+func (_u *VoicePresetUpdate) SetPitch(v float64) *VoicePresetUpdate {
+	_u.mutation.ResetPitch()
+	_u.mutation.SetPitch(v)
+	return _u
+}
+
+// SetNillablePitch sets the "pitch" field if the given value is not nil.
+func (_u *VoicePresetUpdate) SetNillablePitch(v *float64) *VoicePresetUpdate {
+	if v != nil {
+		_u.SetPitch(*v)
+	}
+	return _u
+}
+
+// AddPitch adds value to the "pitch" field.
+func (_u *VoicePresetUpdate) AddPitch(v float64) *VoicePresetUpdate {
+	_u.mutation.AddPitch(v)
+	return _u
+}
+
+// SetVolume sets the "volume" field.
+func (_u *VoicePresetUpdate) SetVolume(v float64) *VoicePresetUpdate {
+	_u.mutation.ResetVolume()
+	_u.mutation.SetVolume(v)
+	return _u
+}
+
+// SetNillableVolume sets the "volume" field if the given value is not nil.
+func (_u *VoicePresetUpdate) SetNillableVolume(v *float64) *VoicePresetUpdate {
+	if v != nil {
+		_u.SetVolume(*v)
+	}
+	return _u
+}
+
+// AddVolume adds value to the "volume" field.
+func (_u *VoicePresetUpdate) AddVolume(v float64) *VoicePresetUpdate {
+	_u.mutation.AddVolume(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *VoicePresetUpdate) SetCreatedAt(v time.Time) *VoicePresetUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *VoicePresetUpdate) SetNillableCreatedAt(v *time.Time) *VoicePresetUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VoicePresetUpdate) SetUpdatedAt(v time.Time) *VoicePresetUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *VoicePresetUpdate) SetUserID(id uuid.UUID) *VoicePresetUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *VoicePresetUpdate) SetUser(v *User) *VoicePresetUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the VoicePresetMutation object of the builder.
+func (_u *VoicePresetUpdate) Mutation() *VoicePresetMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *VoicePresetUpdate) ClearUser() *VoicePresetUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VoicePresetUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VoicePresetUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VoicePresetUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VoicePresetUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VoicePresetUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := voicepreset.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VoicePresetUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := voicepreset.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "VoicePreset.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Voice(); ok {
+		if err := voicepreset.VoiceValidator(v); err != nil {
+			return &ValidationError{Name: "voice", err: fmt.Errorf(`generated: validator failed for field "VoicePreset.voice": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Rate(); ok {
+		if err := voicepreset.RateValidator(v); err != nil {
+			return &ValidationError{Name: "rate", err: fmt.Errorf(`generated: validator failed for field "VoicePreset.rate": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Pitch(); ok {
+		if err := voicepreset.PitchValidator(v); err != nil {
+			return &ValidationError{Name: "pitch", err: fmt.Errorf(`generated: validator failed for field "VoicePreset.pitch": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Volume(); ok {
+		if err := voicepreset.VolumeValidator(v); err != nil {
+			return &ValidationError{Name: "volume", err: fmt.Errorf(`generated: validator failed for field "VoicePreset.volume": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "VoicePreset.user"`)
+	}
+	return nil
+}
+
+func (_u *VoicePresetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(voicepreset.Table, voicepreset.Columns, sqlgraph.NewFieldSpec(voicepreset.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(voicepreset.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Voice(); ok {
+		_spec.SetField(voicepreset.FieldVoice, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(voicepreset.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRate(); ok {
+		_spec.AddField(voicepreset.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Pitch(); ok {
+		_spec.SetField(voicepreset.FieldPitch, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPitch(); ok {
+		_spec.AddField(voicepreset.FieldPitch, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Volume(); ok {
+		_spec.SetField(voicepreset.FieldVolume, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVolume(); ok {
+		_spec.AddField(voicepreset.FieldVolume, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(voicepreset.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(voicepreset.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   voicepreset.UserTable,
+			Columns: []string{voicepreset.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   voicepreset.UserTable,
+			Columns: []string{voicepreset.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{voicepreset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VoicePresetUpdateOne is the builder for updating a single VoicePreset entity.
+type VoicePresetUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VoicePresetMutation
+}
+
+// SetName sets the "name" field.
+func (_u *VoicePresetUpdateOne) SetName(v string) *VoicePresetUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *VoicePresetUpdateOne) SetNillableName(v *string) *VoicePresetUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetVoice sets the "voice" field.
+func (_u *VoicePresetUpdateOne) SetVoice(v string) *VoicePresetUpdateOne {
+	_u.mutation.SetVoice(v)
+	return _u
+}
+
+// SetNillableVoice sets the "voice" field if the given value is not nil.
+func (_u *VoicePresetUpdateOne) SetNillableVoice(v *string) *VoicePresetUpdateOne {
+	if v != nil {
+		_u.SetVoice(*v)
+	}
+	return _u
+}
+
+// SetRate sets the "rate" field.
+func (_u *VoicePresetUpdateOne) SetRate(v float64) *VoicePresetUpdateOne {
+	_u.mutation.ResetRate()
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *VoicePresetUpdateOne) SetNillableRate(v *float64) *VoicePresetUpdateOne {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// AddRate adds value to the "rate" field.
+func (_u *VoicePresetUpdateOne) AddRate(v float64) *VoicePresetUpdateOne {
+	_u.mutation.AddRate(v)
+	return _u
+}
+
+// SetPitch sets the "pitch" field.
+func (_u *VoicePresetUpdateOne) SetPitch(v float64) *VoicePresetUpdateOne {
+	_u.mutation.ResetPitch()
+	_u.mutation.SetPitch(v)
+	return _u
+}
+
+// SetNillablePitch sets the "pitch" field if the given value is not nil.
+func (_u *VoicePresetUpdateOne) SetNillablePitch(v *float64) *VoicePresetUpdateOne {
+	if v != nil {
+		_u.SetPitch(*v)
+	}
+	return _u
+}
+
+// AddPitch adds value to the "pitch" field.
+func (_u *VoicePresetUpdateOne) AddPitch(v float64) *VoicePresetUpdateOne {
+	_u.mutation.AddPitch(v)
+	return _u
+}
+
+// SetVolume sets the "volume" field.
+func (_u *VoicePresetUpdateOne) SetVolume(v float64) *VoicePresetUpdateOne {
+	_u.mutation.ResetVolume()
+	_u.mutation.SetVolume(v)
+	return _u
+}
+
+// SetNillableVolume sets the "volume" field if the given value is not nil.
+func (_u *VoicePresetUpdateOne) SetNillableVolume(v *float64) *VoicePresetUpdateOne {
+	if v != nil {
+		_u.SetVolume(*v)
+	}
+	return _u
+}
+
+// AddVolume adds value to the "volume" field.
+func (_u *VoicePresetUpdateOne) AddVolume(v float64) *VoicePresetUpdateOne {
+	_u.mutation.AddVolume(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *VoicePresetUpdateOne) SetCreatedAt(v time.Time) *VoicePresetUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *VoicePresetUpdateOne) SetNillableCreatedAt(v *time.Time) *VoicePresetUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VoicePresetUpdateOne) SetUpdatedAt(v time.Time) *VoicePresetUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *VoicePresetUpdateOne) SetUserID(id uuid.UUID) *VoicePresetUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *VoicePresetUpdateOne) SetUser(v *User) *VoicePresetUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the VoicePresetMutation object of the builder.
+func (_u *VoicePresetUpdateOne) Mutation() *VoicePresetMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *VoicePresetUpdateOne) ClearUser() *VoicePresetUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the VoicePresetUpdate builder.
+func (_u *VoicePresetUpdateOne) Where(ps ...predicate.VoicePreset) *VoicePresetUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VoicePresetUpdateOne) Select(field string, fields ...string) *VoicePresetUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated VoicePreset entity.
+func (_u *VoicePresetUpdateOne) Save(ctx context.Context) (*VoicePreset, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VoicePresetUpdateOne) SaveX(ctx context.Context) *VoicePreset {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VoicePresetUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VoicePresetUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VoicePresetUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := voicepreset.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VoicePresetUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := voicepreset.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "VoicePreset.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Voice(); ok {
+		if err := voicepreset.VoiceValidator(v); err != nil {
+			return &ValidationError{Name: "voice", err: fmt.Errorf(`generated: validator failed for field "VoicePreset.voice": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Rate(); ok {
+		if err := voicepreset.RateValidator(v); err != nil {
+			return &ValidationError{Name: "rate", err: fmt.Errorf(`generated: validator failed for field "VoicePreset.rate": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Pitch(); ok {
+		if err := voicepreset.PitchValidator(v); err != nil {
+			return &ValidationError{Name: "pitch", err: fmt.Errorf(`generated: validator failed for field "VoicePreset.pitch": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Volume(); ok {
+		if err := voicepreset.VolumeValidator(v); err != nil {
+			return &ValidationError{Name: "volume", err: fmt.Errorf(`generated: validator failed for field "VoicePreset.volume": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "VoicePreset.user"`)
+	}
+	return nil
+}
+
+func (_u *VoicePresetUpdateOne) sqlSave(ctx context.Context) (_node *VoicePreset, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(voicepreset.Table, voicepreset.Columns, sqlgraph.NewFieldSpec(voicepreset.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "VoicePreset.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, voicepreset.FieldID)
+		for _, f := range fields {
+			if !voicepreset.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != voicepreset.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(voicepreset.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Voice(); ok {
+		_spec.SetField(voicepreset.FieldVoice, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(voicepreset.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRate(); ok {
+		_spec.AddField(voicepreset.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Pitch(); ok {
+		_spec.SetField(voicepreset.FieldPitch, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPitch(); ok {
+		_spec.AddField(voicepreset.FieldPitch, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Volume(); ok {
+		_spec.SetField(voicepreset.FieldVolume, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVolume(); ok {
+		_spec.AddField(voicepreset.FieldVolume, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(voicepreset.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(voicepreset.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   voicepreset.UserTable,
+			Columns: []string{voicepreset.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   voicepreset.UserTable,
+			Columns: []string{voicepreset.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &VoicePreset{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{voicepreset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
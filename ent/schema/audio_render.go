@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/internal/synth"
+	"time"
+)
+
+// AudioRender holds the schema definition for the AudioRender entity, the
+// render cache index. It maps the hash of the synthesis parameters to the
+// blob holding the rendered audio, so equal requests share one render.
+type AudioRender struct {
+	ent.Schema
+}
+
+// Fields of the AudioRender.
+func (AudioRender) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(
+			func() uuid.UUID {
+				id, err := uuid.NewV7()
+				if err != nil {
+					panic(err)
+				}
+				return id
+			},
+		).Immutable().Unique(),
+		field.String("cache_key").NotEmpty().Immutable().Unique().StructTag(`json:"cacheKey"`),
+		// blob_key adalah hash SHA-256 dari isi audio di blob store.
+		field.String("blob_key").NotEmpty().StructTag(`json:"blobKey"`),
+		field.String("content_type").NotEmpty().StructTag(`json:"contentType"`),
+		field.Int64("size").NonNegative(),
+		field.Int64("duration_ms").NonNegative().StructTag(`json:"durationMs"`),
+		// Kosong bila engine tidak memberikan timing per kata.
+		field.JSON("words", []synth.WordTiming{}).Optional().StructTag(`json:"words,omitempty"`),
+		field.Time("created_at").Default(func() time.Time { return time.Now() }).StructTag(`json:"createdAt"`),
+		field.Time("updated_at").Default(func() time.Time { return time.Now() }).UpdateDefault(func() time.Time { return time.Now() }).StructTag(`json:"updatedAt"`),
+	}
+}
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"entgo.io/ent"
+
+	gen "github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/hook"
+)
+
+// HistoryContentHash returns the hash used to detect duplicate histories.
+// Text and voice are compared case-insensitively with whitespace collapsed.
+func HistoryContentHash(text, voice string) string {
+	normalized := strings.ToLower(strings.Join(strings.Fields(voice), " ")) + "\x00" +
+		strings.ToLower(strings.Join(strings.Fields(text), " "))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// setContentHash keeps content_hash in sync with text and voice.
+func setContentHash(next ent.Mutator) ent.Mutator {
+	return hook.HistoryFunc(func(ctx context.Context, m *gen.HistoryMutation) (ent.Value, error) {
+		text, textSet := m.Text()
+		voice, voiceSet := m.Voice()
+		switch {
+		case !textSet && !voiceSet:
+			return next.Mutate(ctx, m)
+		case textSet && voiceSet:
+			m.SetContentHash(HistoryContentHash(text, voice))
+			return next.Mutate(ctx, m)
+		}
+
+		// Hanya salah satu yang berubah, sisanya diambil dari tiap baris.
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		v, err := next.Mutate(ctx, m)
+		if err != nil || len(ids) == 0 {
+			return v, err
+		}
+
+		client := m.Client()
+		rows, err := client.History.Query().
+			Where(history.IDIn(ids...)).
+			All(SkipSoftDelete(ctx))
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range rows {
+			err := client.History.UpdateOneID(h.ID).
+				SetContentHash(HistoryContentHash(h.Text, h.Voice)).
+				SetUpdatedAt(h.UpdatedAt).
+				Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+	})
+}
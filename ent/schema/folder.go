@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Folder holds the schema definition for the Folder entity.
+type Folder struct {
+	ent.Schema
+}
+
+// Fields of the Folder.
+func (Folder) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(
+			func() uuid.UUID {
+				id, err := uuid.NewV7()
+				if err != nil {
+					panic(err)
+				}
+				return id
+			},
+		).Immutable().Unique(),
+		field.String("name").NotEmpty().MaxLen(100),
+		field.UUID("parent_id", uuid.UUID{}).Optional().Nillable().StructTag(`json:"parentId,omitempty"`),
+		field.Time("created_at").Default(func() time.Time { return time.Now() }).StructTag(`json:"createdAt"`),
+		field.Time("updated_at").Default(func() time.Time { return time.Now() }).UpdateDefault(func() time.Time { return time.Now() }).StructTag(`json:"updatedAt"`),
+	}
+}
+
+// Edges of the Folder.
+func (Folder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("folders").Unique().Required(),
+		edge.To("children", Folder.Type).
+			From("parent").
+			Field("parent_id").
+			Unique(),
+		edge.To("histories", History.Type),
+	}
+}
+
+// Indexes of the Folder.
+func (Folder) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name", "parent_id").Edges("user").Unique(),
+	}
+}
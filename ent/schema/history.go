@@ -3,9 +3,12 @@ package schema
 import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/hook"
 	"time"
 )
 
@@ -14,6 +17,13 @@ type History struct {
 	ent.Schema
 }
 
+// Mixin of the History.
+func (History) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
 // Fields of the History.
 func (History) Fields() []ent.Field {
 	return []ent.Field{
@@ -27,18 +37,55 @@ func (History) Fields() []ent.Field {
 			},
 		).Immutable().Unique(),
 		field.String("text").NotEmpty().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		// format menentukan apakah text berupa teks biasa atau dokumen SSML.
+		field.Enum("format").Values("plain", "ssml").Default("plain"),
+		// plain_text dan char_count diturunkan dari text untuk pencarian dan
+		// penghitungan karakter yang benar-benar diucapkan.
+		field.String("plain_text").Optional().StructTag(`json:"-"`).SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Int("char_count").Default(0).NonNegative().StructTag(`json:"charCount"`),
+		// language dan language_confidence dideteksi dari plain_text; language
+		// kosong berarti teksnya terlalu pendek untuk dikenali.
+		field.String("language").Optional().StructTag(`json:"language,omitempty"`),
+		field.Float("language_confidence").Optional().Nillable().Min(0).Max(1).StructTag(`json:"languageConfidence,omitempty"`),
 		field.String("voice").NotEmpty(),
 		field.Float("rate").Default(1).Min(0.1).Max(5),
 		field.Float("pitch").Default(1).Min(0).Max(2),
 		field.Float("volume").Default(1).Min(0).Max(1),
+		field.Bool("is_favorite").Default(false).StructTag(`json:"isFavorite"`),
+		// pinned_order kosong berarti history tidak di-pin.
+		field.Int("pinned_order").Optional().Nillable().Min(0).StructTag(`json:"pinnedOrder,omitempty"`),
+		field.Int("play_count").Default(0).NonNegative().StructTag(`json:"playCount"`),
+		field.Time("last_played_at").Optional().Nillable().StructTag(`json:"lastPlayedAt,omitempty"`),
+		field.String("content_hash").Optional().StructTag(`json:"-"`),
 		field.Time("created_at").Default(func() time.Time { return time.Now() }).StructTag(`json:"createdAt"`),
 		field.Time("updated_at").Default(func() time.Time { return time.Now() }).UpdateDefault(func() time.Time { return time.Now() }).StructTag(`json:"updatedAt"`),
 	}
 }
 
+// Hooks of the History.
+func (History) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(recordRevision, ent.OpUpdate|ent.OpUpdateOne),
+		hook.On(setContentHash, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne),
+		hook.On(setPlainText, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne),
+	}
+}
+
+// Indexes of the History.
+func (History) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("content_hash"),
+		index.Fields("language"),
+	}
+}
+
 // Edges of the History.
 func (History) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("user", User.Type).Ref("histories").Unique(),
+		edge.To("revisions", HistoryRevision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("tags", Tag.Type).Ref("histories"),
+		edge.From("folder", Folder.Type).Ref("histories").Unique(),
 	}
 }
@@ -0,0 +1,172 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	gen "github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/historyrevision"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/hook"
+)
+
+// HistoryRevision holds a snapshot of a History taken right before it was updated.
+type HistoryRevision struct {
+	ent.Schema
+}
+
+// Fields of the HistoryRevision.
+func (HistoryRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(
+			func() uuid.UUID {
+				id, err := uuid.NewV7()
+				if err != nil {
+					panic(err)
+				}
+				return id
+			},
+		).Immutable().Unique(),
+		field.Int("version").Positive().Immutable(),
+		field.String("text").NotEmpty().Immutable().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Enum("format").Values("plain", "ssml").Default("plain").Immutable(),
+		field.String("voice").NotEmpty().Immutable(),
+		field.Float("rate").Immutable(),
+		field.Float("pitch").Immutable(),
+		field.Float("volume").Immutable(),
+		field.Time("created_at").Default(func() time.Time { return time.Now() }).Immutable().StructTag(`json:"createdAt"`),
+	}
+}
+
+// Edges of the HistoryRevision.
+func (HistoryRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("history", History.Type).Ref("revisions").Unique().Required().Immutable(),
+	}
+}
+
+// Indexes of the HistoryRevision.
+func (HistoryRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("version").Edges("history").Unique(),
+	}
+}
+
+// errRevisionNoTx is returned for updates of synthesis fields made outside a
+// transaction, where the revision and the update could not be atomic.
+var errRevisionNoTx = errors.New("updating history text or voice requires a transaction")
+
+// recordRevision snapshots every History touched by an update before the new
+// values are written, as long as the update changes a synthesis field. The
+// update must run in a transaction: the touched rows are locked first, so
+// concurrent updates of one history get consecutive versions.
+func recordRevision(next ent.Mutator) ent.Mutator {
+	return hook.HistoryFunc(func(ctx context.Context, m *gen.HistoryMutation) (ent.Value, error) {
+		if !revisionTracked(m) {
+			return next.Mutate(ctx, m)
+		}
+		if _, err := m.Tx(); err != nil {
+			return nil, errRevisionNoTx
+		}
+
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return next.Mutate(ctx, m)
+		}
+
+		client := m.Client()
+		olds, err := client.History.Query().
+			Where(history.IDIn(ids...), forUpdate).
+			All(SkipSoftDelete(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, old := range olds {
+			if !revisionChanged(old, m) {
+				continue
+			}
+
+			version := 1
+			last, err := client.HistoryRevision.Query().
+				Where(historyrevision.HasHistoryWith(history.ID(old.ID))).
+				Order(gen.Desc(historyrevision.FieldVersion)).
+				First(ctx)
+			switch {
+			case err == nil:
+				version = last.Version + 1
+			case !gen.IsNotFound(err):
+				return nil, err
+			}
+
+			err = client.HistoryRevision.Create().
+				SetHistoryID(old.ID).
+				SetVersion(version).
+				SetText(old.Text).
+				SetFormat(historyrevision.Format(old.Format)).
+				SetVoice(old.Voice).
+				SetRate(old.Rate).
+				SetPitch(old.Pitch).
+				SetVolume(old.Volume).
+				Exec(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("recording revision of history %s: %w", old.ID, err)
+			}
+		}
+
+		return next.Mutate(ctx, m)
+	})
+}
+
+// forUpdate locks the selected rows until the transaction ends. SQLite has
+// no row locks, but it already lets only one transaction write at a time.
+func forUpdate(s *sql.Selector) {
+	if s.Dialect() != dialect.SQLite {
+		s.ForUpdate()
+	}
+}
+
+func revisionTracked(m *gen.HistoryMutation) bool {
+	for _, f := range m.Fields() {
+		switch f {
+		case history.FieldText, history.FieldFormat, history.FieldVoice, history.FieldRate, history.FieldPitch, history.FieldVolume:
+			return true
+		}
+	}
+	return false
+}
+
+func revisionChanged(old *gen.History, m *gen.HistoryMutation) bool {
+	if v, ok := m.Text(); ok && v != old.Text {
+		return true
+	}
+	if v, ok := m.Format(); ok && v != old.Format {
+		return true
+	}
+	if v, ok := m.Voice(); ok && v != old.Voice {
+		return true
+	}
+	if v, ok := m.Rate(); ok && v != old.Rate {
+		return true
+	}
+	if v, ok := m.Pitch(); ok && v != old.Pitch {
+		return true
+	}
+	if v, ok := m.Volume(); ok && v != old.Volume {
+		return true
+	}
+	return false
+}
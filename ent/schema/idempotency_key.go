@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"time"
+)
+
+// IdempotencyKey holds the schema definition for the IdempotencyKey entity.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+// Fields of the IdempotencyKey.
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(
+			func() uuid.UUID {
+				id, err := uuid.NewV7()
+				if err != nil {
+					panic(err)
+				}
+				return id
+			},
+		).Immutable().Unique(),
+		field.String("key").NotEmpty().MaxLen(255).Immutable(),
+		field.String("request_hash").NotEmpty().Immutable().StructTag(`json:"requestHash"`),
+		// response_status bernilai 0 selama request pertama masih diproses.
+		field.Int("response_status").Default(0).StructTag(`json:"responseStatus"`),
+		field.Bytes("response_body").Optional().StructTag(`json:"-"`),
+		field.Time("expires_at").StructTag(`json:"expiresAt"`),
+		field.Time("created_at").Default(func() time.Time { return time.Now() }).Immutable().StructTag(`json:"createdAt"`),
+	}
+}
+
+// Edges of the IdempotencyKey.
+func (IdempotencyKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("idempotency_keys").Unique().Required(),
+	}
+}
+
+// Indexes of the IdempotencyKey.
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key").Edges("user").Unique(),
+		index.Fields("expires_at"),
+	}
+}
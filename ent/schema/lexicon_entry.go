@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"regexp"
+	"time"
+)
+
+// LexiconEntry holds the schema definition for the LexiconEntry entity, a
+// word the user wants read differently, either as other words (alias) or
+// as a phonetic transcription (phoneme).
+type LexiconEntry struct {
+	ent.Schema
+}
+
+// Fields of the LexiconEntry.
+func (LexiconEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(
+			func() uuid.UUID {
+				id, err := uuid.NewV7()
+				if err != nil {
+					panic(err)
+				}
+				return id
+			},
+		).Immutable().Unique(),
+		// grapheme yang hanya berisi spasi akan cocok dengan string kosong.
+		field.String("grapheme").NotEmpty().MaxLen(100).Match(regexp.MustCompile(`\S`)),
+		// alias dan phoneme saling eksklusif; salah satunya wajib diisi.
+		field.String("alias").Optional().MaxLen(200),
+		field.String("phoneme").Optional().MaxLen(200),
+		field.Enum("alphabet").Values("ipa", "x-sampa").Default("ipa"),
+		field.Bool("case_sensitive").Default(false).StructTag(`json:"caseSensitive"`),
+		field.Bool("whole_word").Default(true).StructTag(`json:"wholeWord"`),
+		field.Time("created_at").Default(func() time.Time { return time.Now() }).StructTag(`json:"createdAt"`),
+		field.Time("updated_at").Default(func() time.Time { return time.Now() }).UpdateDefault(func() time.Time { return time.Now() }).StructTag(`json:"updatedAt"`),
+	}
+}
+
+// Edges of the LexiconEntry.
+func (LexiconEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("lexicon_entries").Unique().Required(),
+	}
+}
+
+// Indexes of the LexiconEntry.
+func (LexiconEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("grapheme").Edges("user").Unique(),
+	}
+}
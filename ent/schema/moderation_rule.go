@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"time"
+)
+
+// ModerationRule holds the schema definition for the ModerationRule entity,
+// a word, phrase or pattern a user blocks or allows in text read aloud.
+type ModerationRule struct {
+	ent.Schema
+}
+
+// Fields of the ModerationRule.
+func (ModerationRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(
+			func() uuid.UUID {
+				id, err := uuid.NewV7()
+				if err != nil {
+					panic(err)
+				}
+				return id
+			},
+		).Immutable().Unique(),
+		// kind allow mengecualikan kata dari daftar bawaan maupun milik user.
+		field.Enum("kind").Values("word", "regex", "allow"),
+		field.String("pattern").NotEmpty().MaxLen(200),
+		// action dan replacement kosong berarti mengikuti pengaturan user.
+		field.Enum("action").Values("reject", "mask", "replace").Optional().Nillable(),
+		field.String("replacement").Optional().Nillable().MaxLen(100),
+		field.Time("created_at").Default(func() time.Time { return time.Now() }).StructTag(`json:"createdAt"`),
+		field.Time("updated_at").Default(func() time.Time { return time.Now() }).UpdateDefault(func() time.Time { return time.Now() }).StructTag(`json:"updatedAt"`),
+	}
+}
+
+// Edges of the ModerationRule.
+func (ModerationRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("moderation_rules").Unique().Required(),
+	}
+}
+
+// Indexes of the ModerationRule.
+func (ModerationRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind", "pattern").Edges("user").Unique(),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"time"
+)
+
+// ModerationSetting holds the schema definition for the ModerationSetting
+// entity, how the text of a user is screened before it is read aloud.
+type ModerationSetting struct {
+	ent.Schema
+}
+
+// Fields of the ModerationSetting.
+func (ModerationSetting) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(
+			func() uuid.UUID {
+				id, err := uuid.NewV7()
+				if err != nil {
+					panic(err)
+				}
+				return id
+			},
+		).Immutable().Unique(),
+		// Moderasi harus diaktifkan sendiri oleh user, karena teks yang disaring
+		// disimpan dalam bentuk tersaring.
+		field.Bool("enabled").Default(false),
+		// builtin_lists adalah bahasa daftar kata bawaan yang dipakai.
+		field.Strings("builtin_lists").Default([]string{"id", "en"}).StructTag(`json:"builtinLists"`),
+		field.Enum("action").Values("reject", "mask", "replace").Default("mask"),
+		field.String("replacement").Default("beep").MaxLen(100),
+		field.Time("created_at").Default(func() time.Time { return time.Now() }).StructTag(`json:"createdAt"`),
+		field.Time("updated_at").Default(func() time.Time { return time.Now() }).UpdateDefault(func() time.Time { return time.Now() }).StructTag(`json:"updatedAt"`),
+	}
+}
+
+// Edges of the ModerationSetting.
+func (ModerationSetting) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("moderation_setting").Unique().Required(),
+	}
+}
@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"entgo.io/ent"
+
+	gen "github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/hook"
+	"github.com/kiminodare/HOVARLAY-BE/internal/langid"
+	"github.com/kiminodare/HOVARLAY-BE/internal/ssml"
+)
+
+// HistoryPlainText returns the spoken text of a history: the text itself for
+// plain histories, or the text content of the document for SSML ones.
+func HistoryPlainText(text string, format history.Format) (string, error) {
+	if format == history.FormatSsml {
+		return ssml.PlainText(text)
+	}
+	return text, nil
+}
+
+// setPlainText keeps plain_text, char_count and the detected language in
+// sync with text and format.
+func setPlainText(next ent.Mutator) ent.Mutator {
+	return hook.HistoryFunc(func(ctx context.Context, m *gen.HistoryMutation) (ent.Value, error) {
+		text, textSet := m.Text()
+		format, formatSet := m.Format()
+		switch {
+		case !textSet && !formatSet:
+			return next.Mutate(ctx, m)
+		case textSet && formatSet:
+			plain, err := HistoryPlainText(text, format)
+			if err != nil {
+				return nil, err
+			}
+			detected := langid.Detect(plain)
+			m.SetPlainText(plain)
+			m.SetCharCount(utf8.RuneCountInString(plain))
+			m.SetLanguage(detected.Language)
+			m.SetLanguageConfidence(detected.Confidence)
+			return next.Mutate(ctx, m)
+		}
+
+		// Hanya salah satu yang berubah, sisanya diambil dari tiap baris.
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		v, err := next.Mutate(ctx, m)
+		if err != nil || len(ids) == 0 {
+			return v, err
+		}
+
+		client := m.Client()
+		rows, err := client.History.Query().
+			Where(history.IDIn(ids...)).
+			All(SkipSoftDelete(ctx))
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range rows {
+			plain, err := HistoryPlainText(h.Text, h.Format)
+			if err != nil {
+				return nil, err
+			}
+			detected := langid.Detect(plain)
+			err = client.History.UpdateOneID(h.ID).
+				SetPlainText(plain).
+				SetCharCount(utf8.RuneCountInString(plain)).
+				SetLanguage(detected.Language).
+				SetLanguageConfidence(detected.Confidence).
+				SetUpdatedAt(h.UpdatedAt).
+				Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+	})
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// Plan holds the schema definition for the Plan entity, the monthly limits a
+// user is subject to. Users without a plan are on the free plan.
+type Plan struct {
+	ent.Schema
+}
+
+// Fields of the Plan.
+func (Plan) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().MaxLen(50).Immutable().Unique(),
+		field.String("name").NotEmpty(),
+		// Batas bulanan; 0 berarti tidak dibatasi.
+		field.Int("monthly_characters").NonNegative().StructTag(`json:"monthlyCharacters"`),
+		field.Int("monthly_entries").NonNegative().StructTag(`json:"monthlyEntries"`),
+		field.Time("created_at").Default(func() time.Time { return time.Now() }).StructTag(`json:"createdAt"`),
+		field.Time("updated_at").Default(func() time.Time { return time.Now() }).UpdateDefault(func() time.Time { return time.Now() }).StructTag(`json:"updatedAt"`),
+	}
+}
+
+// Edges of the Plan.
+func (Plan) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("users", User.Type),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"time"
+)
+
+// PlayEvent holds the schema definition for the PlayEvent entity, one play
+// of a history, so statistics can place every play in time.
+type PlayEvent struct {
+	ent.Schema
+}
+
+// Fields of the PlayEvent.
+func (PlayEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(
+			func() uuid.UUID {
+				id, err := uuid.NewV7()
+				if err != nil {
+					panic(err)
+				}
+				return id
+			},
+		).Immutable().Unique(),
+		// Voice saat diputar, supaya statistik per voice tidak berubah
+		// ketika voice history diganti.
+		field.String("voice").Immutable(),
+		// Seperti UsageEntry, history tidak dijadikan edge supaya play tetap
+		// terhitung setelah history dihapus permanen.
+		field.UUID("history_id", uuid.UUID{}).StructTag(`json:"historyId"`),
+		field.Time("played_at").Default(func() time.Time { return time.Now() }).Immutable().StructTag(`json:"playedAt"`),
+	}
+}
+
+// Edges of the PlayEvent.
+func (PlayEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("play_events").Unique().Required(),
+	}
+}
+
+// Indexes of the PlayEvent.
+func (PlayEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("played_at").Edges("user"),
+		index.Fields("history_id"),
+	}
+}
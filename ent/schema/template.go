@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Template holds the schema definition for the Template entity, a message
+// with placeholders that is filled in to create histories.
+type Template struct {
+	ent.Schema
+}
+
+// Fields of the Template.
+func (Template) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(
+			func() uuid.UUID {
+				id, err := uuid.NewV7()
+				if err != nil {
+					panic(err)
+				}
+				return id
+			},
+		).Immutable().Unique(),
+		field.String("name").NotEmpty().MaxLen(50),
+		field.String("text").NotEmpty().SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Enum("format").Values("plain", "ssml").Default("plain"),
+		// language dan currency menentukan format angka dan mata uang bawaan.
+		field.String("language").Default("id-ID"),
+		field.String("currency").Default("IDR").MaxLen(3),
+		// preset_id dipakai saat membuat history dari template.
+		field.UUID("preset_id", uuid.UUID{}).Optional().Nillable().StructTag(`json:"presetId,omitempty"`),
+		field.Time("created_at").Default(func() time.Time { return time.Now() }).StructTag(`json:"createdAt"`),
+		field.Time("updated_at").Default(func() time.Time { return time.Now() }).UpdateDefault(func() time.Time { return time.Now() }).StructTag(`json:"updatedAt"`),
+	}
+}
+
+// Edges of the Template.
+func (Template) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("templates").Unique().Required(),
+	}
+}
+
+// Indexes of the Template.
+func (Template) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Edges("user").Unique(),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"time"
+)
+
+// UsageEntry holds the schema definition for the UsageEntry entity, one row
+// of the usage ledger recording the characters and entries charged to a user.
+type UsageEntry struct {
+	ent.Schema
+}
+
+// Fields of the UsageEntry.
+func (UsageEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(
+			func() uuid.UUID {
+				id, err := uuid.NewV7()
+				if err != nil {
+					panic(err)
+				}
+				return id
+			},
+		).Immutable().Unique(),
+		field.Enum("kind").Values("create", "update", "import", "refund").Immutable(),
+		// Refund dicatat dengan nilai negatif.
+		field.Int("characters").Immutable(),
+		field.Int("entries").Immutable(),
+		// History tidak dijadikan edge supaya ledger tetap utuh setelah
+		// history dihapus permanen.
+		field.UUID("history_id", uuid.UUID{}).Optional().Nillable().StructTag(`json:"historyId,omitempty"`),
+		field.Time("period_start").Immutable().StructTag(`json:"periodStart"`),
+		field.Time("created_at").Default(func() time.Time { return time.Now() }).Immutable().StructTag(`json:"createdAt"`),
+	}
+}
+
+// Edges of the UsageEntry.
+func (UsageEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("usage_entries").Unique().Required(),
+	}
+}
+
+// Indexes of the UsageEntry.
+func (UsageEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("period_start").Edges("user"),
+	}
+}
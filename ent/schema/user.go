@@ -37,5 +37,18 @@ func (User) Fields() []ent.Field {
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("histories", History.Type),
+		edge.To("idempotency_keys", IdempotencyKey.Type),
+		edge.To("tags", Tag.Type),
+		edge.To("folders", Folder.Type),
+		edge.To("voice_presets", VoicePreset.Type),
+		edge.To("preference", UserPreference.Type).Unique(),
+		edge.To("usage", UserUsage.Type).Unique(),
+		edge.To("usage_entries", UsageEntry.Type),
+		edge.To("play_events", PlayEvent.Type),
+		edge.To("lexicon_entries", LexiconEntry.Type),
+		edge.To("templates", Template.Type),
+		edge.To("moderation_rules", ModerationRule.Type),
+		edge.To("moderation_setting", ModerationSetting.Type).Unique(),
+		edge.From("plan", Plan.Type).Ref("users").Unique(),
 	}
 }
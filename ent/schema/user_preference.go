@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"time"
+)
+
+// UserPreference holds the schema definition for the UserPreference entity,
+// the TTS defaults of a user applied when a request omits them.
+type UserPreference struct {
+	ent.Schema
+}
+
+// Fields of the UserPreference.
+func (UserPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(
+			func() uuid.UUID {
+				id, err := uuid.NewV7()
+				if err != nil {
+					panic(err)
+				}
+				return id
+			},
+		).Immutable().Unique(),
+		field.String("voice").Optional(),
+		field.Float("rate").Default(1).Min(0.1).Max(5),
+		field.Float("pitch").Default(1).Min(0).Max(2),
+		field.Float("volume").Default(1).Min(0).Max(1),
+		field.String("language").Optional(),
+		field.Int("max_text_length").Default(5000).Positive().StructTag(`json:"maxTextLength"`),
+		field.Time("created_at").Default(func() time.Time { return time.Now() }).StructTag(`json:"createdAt"`),
+		field.Time("updated_at").Default(func() time.Time { return time.Now() }).UpdateDefault(func() time.Time { return time.Now() }).StructTag(`json:"updatedAt"`),
+	}
+}
+
+// Edges of the UserPreference.
+func (UserPreference) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("preference").Unique().Required(),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"time"
+)
+
+// UserUsage holds the schema definition for the UserUsage entity, the running
+// usage of a user in the current billing period. It is the sum of the
+// UsageEntry rows of the period and is reset when a new period starts.
+type UserUsage struct {
+	ent.Schema
+}
+
+// Fields of the UserUsage.
+func (UserUsage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(
+			func() uuid.UUID {
+				id, err := uuid.NewV7()
+				if err != nil {
+					panic(err)
+				}
+				return id
+			},
+		).Immutable().Unique(),
+		field.Time("period_start").StructTag(`json:"periodStart"`),
+		field.Int("characters").Default(0).NonNegative(),
+		field.Int("entries").Default(0).NonNegative(),
+		field.Time("updated_at").Default(func() time.Time { return time.Now() }).UpdateDefault(func() time.Time { return time.Now() }).StructTag(`json:"updatedAt"`),
+	}
+}
+
+// Edges of the UserUsage.
+func (UserUsage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("usage").Unique().Required(),
+	}
+}
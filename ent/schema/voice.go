@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Voice holds the schema definition for the Voice entity, one entry of the
+// voice catalog. The ID is the voice name stored in History.voice.
+type Voice struct {
+	ent.Schema
+}
+
+// Fields of the Voice.
+func (Voice) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().MaxLen(100).Immutable().Unique(),
+		field.String("provider").NotEmpty(),
+		// language adalah tag BCP 47, misalnya id-ID atau en-US.
+		field.String("language").NotEmpty(),
+		field.Enum("gender").Values("male", "female", "neutral"),
+		field.String("display_name").NotEmpty().StructTag(`json:"displayName"`),
+		field.Float("min_rate").Default(0.1).StructTag(`json:"minRate"`),
+		field.Float("max_rate").Default(5).StructTag(`json:"maxRate"`),
+		field.Float("min_pitch").Default(0).StructTag(`json:"minPitch"`),
+		field.Float("max_pitch").Default(2).StructTag(`json:"maxPitch"`),
+		// Voice yang tidak lagi ditawarkan tetap disimpan untuk history lama
+		// tetapi tidak bisa dipilih lagi.
+		field.Bool("enabled").Default(true),
+		field.Time("created_at").Default(func() time.Time { return time.Now() }).StructTag(`json:"createdAt"`),
+		field.Time("updated_at").Default(func() time.Time { return time.Now() }).UpdateDefault(func() time.Time { return time.Now() }).StructTag(`json:"updatedAt"`),
+	}
+}
+
+// Indexes of the Voice.
+func (Voice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("language"),
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"time"
+)
+
+// VoicePreset holds the schema definition for the VoicePreset entity, a named
+// voice and parameter combination saved by a user.
+type VoicePreset struct {
+	ent.Schema
+}
+
+// Fields of the VoicePreset.
+func (VoicePreset) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(
+			func() uuid.UUID {
+				id, err := uuid.NewV7()
+				if err != nil {
+					panic(err)
+				}
+				return id
+			},
+		).Immutable().Unique(),
+		field.String("name").NotEmpty().MaxLen(50),
+		field.String("voice").NotEmpty(),
+		field.Float("rate").Default(1).Min(0.1).Max(5),
+		field.Float("pitch").Default(1).Min(0).Max(2),
+		field.Float("volume").Default(1).Min(0).Max(1),
+		field.Time("created_at").Default(func() time.Time { return time.Now() }).StructTag(`json:"createdAt"`),
+		field.Time("updated_at").Default(func() time.Time { return time.Now() }).UpdateDefault(func() time.Time { return time.Now() }).StructTag(`json:"updatedAt"`),
+	}
+}
+
+// Edges of the VoicePreset.
+func (VoicePreset) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("voice_presets").Unique().Required(),
+	}
+}
+
+// Indexes of the VoicePreset.
+func (VoicePreset) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Edges("user").Unique(),
+	}
+}
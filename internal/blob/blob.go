@@ -0,0 +1,64 @@
+// Package blob stores immutable binary objects, such as rendered audio,
+// addressed by the SHA-256 hash of their content.
+package blob
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+	"regexp"
+)
+
+// ErrNotFound is returned for keys that are not in the store, including
+// blobs that were evicted.
+var ErrNotFound = errors.New("blob not found")
+
+var keyPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// Info describes a stored blob.
+type Info struct {
+	Key  string
+	Size int64
+}
+
+// Store is a content-addressed blob store. Putting the same content twice
+// returns the same key and stores it once.
+type Store interface {
+	// Put stores the content of r and returns its key.
+	Put(ctx context.Context, r io.Reader) (*Info, error)
+	Stat(ctx context.Context, key string) (*Info, error)
+	// Open reads length bytes of the blob starting at offset. A negative
+	// length reads to the end.
+	Open(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// ValidKey reports whether key has the form of a content hash.
+func ValidKey(key string) bool {
+	return keyPattern.MatchString(key)
+}
+
+// hashingReader hashes and counts everything read through it.
+type hashingReader struct {
+	r    io.Reader
+	h    hash.Hash
+	size int64
+}
+
+func newHashingReader(r io.Reader) *hashingReader {
+	return &hashingReader{r: r, h: sha256.New()}
+}
+
+func (hr *hashingReader) Read(p []byte) (int, error) {
+	n, err := hr.r.Read(p)
+	hr.h.Write(p[:n])
+	hr.size += int64(n)
+	return n, err
+}
+
+func (hr *hashingReader) key() string {
+	return hex.EncodeToString(hr.h.Sum(nil))
+}
@@ -0,0 +1,218 @@
+package blob
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// FileStore keeps blobs as files below a directory. When the total size goes
+// over the limit, the least recently used blobs are removed.
+type FileStore struct {
+	dir     string
+	maxSize int64
+
+	mu      sync.Mutex
+	lru     *list.List // *fileEntry, paling baru dipakai di depan
+	entries map[string]*list.Element
+	size    int64
+}
+
+type fileEntry struct {
+	key  string
+	size int64
+}
+
+// NewFileStore opens the store in dir, creating it when needed. Blobs already
+// in dir are ranked by modification time, which Open updates on every use.
+// A maxSize of 0 or less disables eviction.
+func NewFileStore(dir string, maxSize int64) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	s := &FileStore{dir: dir, maxSize: maxSize, lru: list.New(), entries: make(map[string]*list.Element)}
+
+	type found struct {
+		key   string
+		size  int64
+		mtime time.Time
+	}
+	var files []found
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !ValidKey(d.Name()) {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		files = append(files, found{key: d.Name(), size: info.Size(), mtime: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].mtime.After(files[j].mtime) })
+	for _, f := range files {
+		s.entries[f.key] = s.lru.PushBack(&fileEntry{key: f.key, size: f.size})
+		s.size += f.size
+	}
+
+	s.mu.Lock()
+	s.evict("")
+	s.mu.Unlock()
+	return s, nil
+}
+
+func (s *FileStore) Put(_ context.Context, r io.Reader) (*Info, error) {
+	tmp, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+
+	hr := newHashingReader(r)
+	_, err = io.Copy(tmp, hr)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key := hr.key()
+	path := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if el, ok := s.entries[key]; ok {
+		s.lru.MoveToFront(el)
+		return &Info{Key: key, Size: hr.size}, nil
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return nil, err
+	}
+	s.entries[key] = s.lru.PushFront(&fileEntry{key: key, size: hr.size})
+	s.size += hr.size
+	s.evict(key)
+	return &Info{Key: key, Size: hr.size}, nil
+}
+
+func (s *FileStore) Stat(_ context.Context, key string) (*Info, error) {
+	if !ValidKey(key) {
+		return nil, ErrNotFound
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	el, ok := s.entries[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &Info{Key: key, Size: el.Value.(*fileEntry).size}, nil
+}
+
+func (s *FileStore) Open(_ context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	if !ValidKey(key) {
+		return nil, ErrNotFound
+	}
+	s.mu.Lock()
+	el, ok := s.entries[key]
+	if ok {
+		s.lru.MoveToFront(el)
+	}
+	s.mu.Unlock()
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	path := s.path(key)
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		s.forget(key)
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if length < 0 {
+		return f, nil
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(f, length), f}, nil
+}
+
+func (s *FileStore) Delete(_ context.Context, key string) error {
+	if !ValidKey(key) || !s.forget(key) {
+		return ErrNotFound
+	}
+	err := os.Remove(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// Size returns the total size of the stored blobs.
+func (s *FileStore) Size() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.size
+}
+
+// path spreads blobs over subdirectories named after the first two
+// characters of the key.
+func (s *FileStore) path(key string) string {
+	return filepath.Join(s.dir, key[:2], key)
+}
+
+func (s *FileStore) forget(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	el, ok := s.entries[key]
+	if !ok {
+		return false
+	}
+	s.lru.Remove(el)
+	delete(s.entries, key)
+	s.size -= el.Value.(*fileEntry).size
+	return true
+}
+
+// evict removes the least recently used blobs until the store fits its
+// limit, keeping keep. The caller must hold s.mu.
+func (s *FileStore) evict(keep string) {
+	if s.maxSize <= 0 {
+		return
+	}
+	for el := s.lru.Back(); el != nil && s.size > s.maxSize; {
+		prev := el.Prev()
+		e := el.Value.(*fileEntry)
+		if e.key != keep {
+			// File yang sedang dibaca tetap bisa dibaca sampai ditutup.
+			_ = os.Remove(s.path(e.key))
+			s.lru.Remove(el)
+			delete(s.entries, e.key)
+			s.size -= e.size
+		}
+		el = prev
+	}
+}
@@ -0,0 +1,203 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3Config configures an S3-compatible bucket, such as AWS S3 or MinIO.
+type S3Config struct {
+	// Endpoint adalah base URL, misalnya https://s3.ap-southeast-1.amazonaws.com.
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	// Prefix is prepended to every key, for sharing a bucket.
+	Prefix string
+	// PathStyle addresses the bucket in the path instead of the host name,
+	// which most self-hosted servers need.
+	PathStyle bool
+}
+
+// S3Store keeps blobs in an S3-compatible bucket, signing requests with AWS
+// Signature Version 4. It does not evict; use a lifecycle rule on the bucket.
+type S3Store struct {
+	cfg    S3Config
+	base   *url.URL
+	client *http.Client
+}
+
+func NewS3Store(cfg S3Config) (*S3Store, error) {
+	base, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if base.Scheme == "" || base.Host == "" || cfg.Bucket == "" || cfg.Region == "" {
+		return nil, fmt.Errorf("s3 store needs an endpoint URL, region and bucket")
+	}
+	return &S3Store{cfg: cfg, base: base, client: &http.Client{Timeout: time.Minute}}, nil
+}
+
+// Put buffers the content to hash it, since the key must be known before
+// the upload.
+func (s *S3Store) Put(ctx context.Context, r io.Reader) (*Info, error) {
+	var buf bytes.Buffer
+	hr := newHashingReader(r)
+	if _, err := io.Copy(&buf, hr); err != nil {
+		return nil, err
+	}
+	info := &Info{Key: hr.key(), Size: hr.size}
+
+	if _, err := s.Stat(ctx, info.Key); err == nil {
+		return info, nil
+	}
+	resp, err := s.do(ctx, http.MethodPut, info.Key, buf.Bytes(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return info, nil
+}
+
+func (s *S3Store) Stat(ctx context.Context, key string) (*Info, error) {
+	if !ValidKey(key) {
+		return nil, ErrNotFound
+	}
+	resp, err := s.do(ctx, http.MethodHead, key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return &Info{Key: key, Size: resp.ContentLength}, nil
+}
+
+func (s *S3Store) Open(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	if !ValidKey(key) {
+		return nil, ErrNotFound
+	}
+	header := http.Header{}
+	switch {
+	case length >= 0:
+		header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	case offset > 0:
+		header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	resp, err := s.do(ctx, http.MethodGet, key, nil, header)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	if !ValidKey(key) {
+		return ErrNotFound
+	}
+	resp, err := s.do(ctx, http.MethodDelete, key, nil, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// do sends a signed request for key and turns error responses into errors.
+func (s *S3Store) do(ctx context.Context, method, key string, body []byte, header http.Header) (*http.Response, error) {
+	u := *s.base
+	object := "/" + s.cfg.Prefix + key
+	if s.cfg.PathStyle {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.cfg.Bucket + object
+	} else {
+		u.Host = s.cfg.Bucket + "." + u.Host
+		u.Path = strings.TrimSuffix(u.Path, "/") + object
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.ContentLength = int64(len(body))
+	s.sign(req, body, time.Now().UTC())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("s3 %s %s: %s: %s", method, key, resp.Status, bytes.TrimSpace(msg))
+	}
+	return resp, nil
+}
+
+// sign adds an AWS Signature Version 4 Authorization header to req.
+func (s *S3Store) sign(req *http.Request, body []byte, now time.Time) {
+	payload := sha256.Sum256(body)
+	payloadHash := hex.EncodeToString(payload[:])
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	scope := date + "/" + s.cfg.Region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+s.cfg.SecretKey), date)
+	key = hmacSHA256(key, s.cfg.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+s.cfg.AccessKey+"/"+scope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	m := hmac.New(sha256.New, key)
+	m.Write([]byte(data))
+	return m.Sum(nil)
+}
@@ -0,0 +1,182 @@
+// Package captions splits spoken text into timed subtitle cues and writes
+// them as SRT or WebVTT.
+package captions
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Word is a word of the spoken text and when it is heard.
+type Word struct {
+	Text  string
+	Start time.Duration
+	End   time.Duration
+}
+
+// Cue is one subtitle. Lines are shown together, one below the other.
+type Cue struct {
+	Start time.Duration
+	End   time.Duration
+	Lines []string
+}
+
+// Options are the layout rules for cues.
+type Options struct {
+	MaxLineLength int
+	MaxLines      int
+	MaxDuration   time.Duration
+}
+
+// DefaultOptions follow common subtitle guidelines.
+var DefaultOptions = Options{MaxLineLength: 42, MaxLines: 2, MaxDuration: 6 * time.Second}
+
+const (
+	// Perkiraan durasi pada rate 1, dipakai bila tidak ada timing dari engine.
+	charDuration     = 65 * time.Millisecond
+	spaceDuration    = 60 * time.Millisecond
+	phrasePause      = 200 * time.Millisecond
+	sentencePause    = 400 * time.Millisecond
+	minimumCueLength = 700 * time.Millisecond
+)
+
+// Estimate assigns timings to the words of text as spoken at rate. When
+// duration is positive, the timings are scaled to end at duration, for
+// audio whose length is known but whose word timings are not.
+func Estimate(text string, rate float64, duration time.Duration) []Word {
+	if rate <= 0 {
+		rate = 1
+	}
+	fields := strings.Fields(text)
+	words := make([]Word, len(fields))
+	var t time.Duration
+	for i, f := range fields {
+		words[i] = Word{Text: f, Start: t, End: t + time.Duration(utf8.RuneCountInString(f))*charDuration}
+		t = words[i].End + spaceDuration
+		switch {
+		case endsSentence(f):
+			t += sentencePause
+		case endsPhrase(f):
+			t += phrasePause
+		}
+	}
+	for i := range words {
+		words[i].Start = time.Duration(float64(words[i].Start) / rate)
+		words[i].End = time.Duration(float64(words[i].End) / rate)
+	}
+
+	if n := len(words); n > 0 && duration > 0 && words[n-1].End > 0 {
+		scale := float64(duration) / float64(words[n-1].End)
+		for i := range words {
+			words[i].Start = time.Duration(float64(words[i].Start) * scale)
+			words[i].End = time.Duration(float64(words[i].End) * scale)
+		}
+	}
+	return words
+}
+
+// Build groups words into cues. A cue ends after a sentence, before it would
+// need more than opts.MaxLines lines or last longer than opts.MaxDuration,
+// and after a phrase once it fills more than one line.
+func Build(words []Word, opts Options) []Cue {
+	if opts.MaxLineLength <= 0 {
+		opts.MaxLineLength = DefaultOptions.MaxLineLength
+	}
+	if opts.MaxLines <= 0 {
+		opts.MaxLines = DefaultOptions.MaxLines
+	}
+	if opts.MaxDuration <= 0 {
+		opts.MaxDuration = DefaultOptions.MaxDuration
+	}
+
+	var cues []Cue
+	var current []Word
+	flush := func() {
+		if len(current) == 0 {
+			return
+		}
+		cues = append(cues, Cue{
+			Start: current[0].Start,
+			End:   current[len(current)-1].End,
+			Lines: wrap(texts(current), opts.MaxLineLength),
+		})
+		current = nil
+	}
+
+	for _, w := range words {
+		if len(current) > 0 {
+			lines := wrap(append(texts(current), w.Text), opts.MaxLineLength)
+			if len(lines) > opts.MaxLines || w.End-current[0].Start > opts.MaxDuration {
+				flush()
+			}
+		}
+		current = append(current, w)
+
+		switch {
+		case endsSentence(w.Text):
+			flush()
+		case endsPhrase(w.Text) && len(wrap(texts(current), opts.MaxLineLength)) > 1:
+			flush()
+		}
+	}
+	flush()
+
+	// Cue yang terlalu singkat diperpanjang sampai cue berikutnya dimulai.
+	for i := range cues {
+		if cues[i].End-cues[i].Start >= minimumCueLength {
+			continue
+		}
+		end := cues[i].Start + minimumCueLength
+		if i+1 < len(cues) {
+			end = min(end, cues[i+1].Start)
+		}
+		cues[i].End = max(cues[i].End, end)
+	}
+	return cues
+}
+
+func texts(words []Word) []string {
+	result := make([]string, len(words))
+	for i, w := range words {
+		result[i] = w.Text
+	}
+	return result
+}
+
+// wrap fills lines greedily up to max characters. A word longer than max
+// gets a line of its own.
+func wrap(words []string, max int) []string {
+	var lines []string
+	var line strings.Builder
+	for _, w := range words {
+		if line.Len() > 0 && utf8.RuneCountInString(line.String())+1+utf8.RuneCountInString(w) > max {
+			lines = append(lines, line.String())
+			line.Reset()
+		}
+		if line.Len() > 0 {
+			line.WriteByte(' ')
+		}
+		line.WriteString(w)
+	}
+	if line.Len() > 0 {
+		lines = append(lines, line.String())
+	}
+	return lines
+}
+
+func endsSentence(word string) bool {
+	return strings.ContainsAny(lastRune(strings.TrimRight(word, `"')]»”’`)), ".!?…")
+}
+
+func endsPhrase(word string) bool {
+	return strings.ContainsAny(lastRune(word), ",;:–—")
+}
+
+func lastRune(s string) string {
+	r, _ := utf8.DecodeLastRuneInString(s)
+	if r == utf8.RuneError {
+		return ""
+	}
+	return string(r)
+}
@@ -0,0 +1,57 @@
+package captions
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Format is a subtitle file format.
+type Format string
+
+const (
+	FormatSRT Format = "srt"
+	FormatVTT Format = "vtt"
+)
+
+// ContentType returns the MIME type of files in f.
+func (f Format) ContentType() string {
+	if f == FormatSRT {
+		return "application/x-subrip; charset=utf-8"
+	}
+	return "text/vtt; charset=utf-8"
+}
+
+// Write writes cues to w in format f.
+func Write(w io.Writer, f Format, cues []Cue) error {
+	bw := bufio.NewWriter(w)
+	sep := ","
+	if f == FormatVTT {
+		sep = "."
+		bw.WriteString("WEBVTT\n\n")
+	}
+	for i, c := range cues {
+		if f == FormatSRT {
+			fmt.Fprintf(bw, "%d\n", i+1)
+		}
+		fmt.Fprintf(bw, "%s --> %s\n%s\n\n", timestamp(c.Start, sep), timestamp(c.End, sep), escape(f, strings.Join(c.Lines, "\n")))
+	}
+	return bw.Flush()
+}
+
+// timestamp formats d as HH:MM:SS followed by sep and milliseconds.
+func timestamp(d time.Duration, sep string) string {
+	ms := d.Milliseconds()
+	return fmt.Sprintf("%02d:%02d:%02d%s%03d", ms/3600000, ms/60000%60, ms/1000%60, sep, ms%1000)
+}
+
+// escape keeps cue text from being read as markup or as a cue separator.
+func escape(f Format, text string) string {
+	if f == FormatVTT {
+		// Mengganti ">" juga mencegah "-->" di dalam teks.
+		text = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+	}
+	return text
+}
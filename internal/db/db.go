@@ -1,9 +1,11 @@
 package db
 
 import (
+	"context"
 	"fmt"
 	"github.com/gofiber/fiber/v2/log"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	_ "github.com/kiminodare/HOVARLAY-BE/ent/generated/runtime"
 	_ "github.com/lib/pq"
 	"os"
 )
@@ -27,3 +29,28 @@ func NewClient() *generated.Client {
 	}
 	return client
 }
+
+// WithTx runs fn inside a transaction, rolling back when fn returns an error
+// or panics and committing otherwise.
+func WithTx(ctx context.Context, client *generated.Client, fn func(tx *generated.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
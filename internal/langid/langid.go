@@ -0,0 +1,227 @@
+// Package langid identifies the language of a text offline. Latin-script
+// languages are told apart by character n-gram profiles built from small
+// embedded samples; other scripts are identified by the script alone.
+package langid
+
+import (
+	"embed"
+	"math"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// MinLetters is the fewest letters a text needs to be identified at all.
+const MinLetters = 3
+
+// maxGrams caps how many n-grams of a text are scored, which bounds both the
+// cost and the certainty reached on long texts.
+const maxGrams = 2000
+
+// confidenceScale is how many n-grams it takes for a clear per-gram lead to
+// count as certain. Short texts stay uncertain even when one language wins.
+const confidenceScale = 50
+
+// temperature softens the softmax over profiles. The samples are small, so
+// raw likelihood ratios overstate how sure the detector can be.
+const temperature = 5
+
+//go:embed corpus/*.txt
+var corpus embed.FS
+
+// Result is the detected language, as an ISO 639-1 code, and how sure the
+// detector is of it, between 0 and 1. Language is empty when the text has
+// too few letters to tell.
+type Result struct {
+	Language   string  `json:"language"`
+	Confidence float64 `json:"confidence"`
+}
+
+// scripts maps writing systems used by a single supported language to it.
+// Japanese is told from Chinese by its kana.
+var scripts = []struct {
+	table    *unicode.RangeTable
+	language string
+}{
+	{unicode.Hangul, "ko"},
+	{unicode.Thai, "th"},
+	{unicode.Cyrillic, "ru"},
+	{unicode.Arabic, "ar"},
+	{unicode.Devanagari, "hi"},
+	{unicode.Hiragana, "ja"},
+	{unicode.Katakana, "ja"},
+	{unicode.Han, "zh"},
+}
+
+type profile struct {
+	language string
+	counts   map[string]float64
+	total    float64
+}
+
+var (
+	loadOnce sync.Once
+	profiles []*profile
+	// vocabulary is the number of distinct n-grams across all profiles,
+	// used for add-one smoothing.
+	vocabulary float64
+)
+
+func load() {
+	entries, err := corpus.ReadDir("corpus")
+	if err != nil {
+		panic(err)
+	}
+	seen := map[string]struct{}{}
+	for _, e := range entries {
+		b, err := corpus.ReadFile(path.Join("corpus", e.Name()))
+		if err != nil {
+			panic(err)
+		}
+		p := &profile{language: strings.TrimSuffix(e.Name(), ".txt"), counts: map[string]float64{}}
+		grams(string(b), 0, func(g string) {
+			p.counts[g]++
+			p.total++
+			seen[g] = struct{}{}
+		})
+		profiles = append(profiles, p)
+	}
+	vocabulary = float64(len(seen))
+}
+
+// Languages returns the codes Detect can return, sorted.
+func Languages() []string {
+	loadOnce.Do(load)
+	set := map[string]struct{}{}
+	for _, p := range profiles {
+		set[p.language] = struct{}{}
+	}
+	for _, s := range scripts {
+		set[s.language] = struct{}{}
+	}
+	langs := make([]string, 0, len(set))
+	for l := range set {
+		langs = append(langs, l)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// Primary returns the primary language subtag of a BCP 47 tag, the form
+// Detect reports languages in.
+func Primary(tag string) string {
+	lang, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+	return lang
+}
+
+// Detect identifies the language of text. Digits, punctuation and symbols
+// are ignored, so markup should be stripped first.
+func Detect(text string) Result {
+	var latin, total int
+	byScript := map[string]int{}
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		total++
+		if unicode.Is(unicode.Latin, r) {
+			latin++
+			continue
+		}
+		for _, s := range scripts {
+			if unicode.Is(s.table, r) {
+				byScript[s.language]++
+				break
+			}
+		}
+	}
+	if total < MinLetters {
+		return Result{}
+	}
+
+	if latin*2 < total {
+		// Kanji are shared with Chinese, so any kana makes the text Japanese.
+		if byScript["ja"] > 0 {
+			byScript["ja"] += byScript["zh"]
+			delete(byScript, "zh")
+		}
+		var best string
+		for lang, n := range byScript {
+			if best == "" || n > byScript[best] || n == byScript[best] && lang < best {
+				best = lang
+			}
+		}
+		if best == "" {
+			return Result{}
+		}
+		return Result{Language: best, Confidence: round(float64(byScript[best]) / float64(total))}
+	}
+	return classify(text, float64(latin)/float64(total))
+}
+
+// classify scores text against every profile with naive Bayes over its
+// n-grams. The per-gram log-likelihoods are turned into probabilities with
+// a softmax, scaled by how many n-grams there were, and weighted by share,
+// the part of the letters that are Latin.
+func classify(text string, share float64) Result {
+	loadOnce.Do(load)
+
+	scores := make([]float64, len(profiles))
+	n := 0
+	grams(text, maxGrams, func(g string) {
+		n++
+		for i, p := range profiles {
+			scores[i] += math.Log((p.counts[g] + 1) / (p.total + vocabulary))
+		}
+	})
+	if n == 0 {
+		return Result{}
+	}
+
+	best := 0
+	for i := range scores {
+		if scores[i] > scores[best] || scores[i] == scores[best] && profiles[i].language < profiles[best].language {
+			best = i
+		}
+	}
+	scale := math.Min(float64(n), confidenceScale) / float64(n) / temperature
+	var sum float64
+	for _, s := range scores {
+		sum += math.Exp((s - scores[best]) * scale)
+	}
+	return Result{Language: profiles[best].language, Confidence: round(share / sum)}
+}
+
+// grams calls fn with the 1-, 2- and 3-grams of every lower-cased word of
+// text, with a space marking where words start and end. At most limit
+// n-grams are produced; 0 means no limit.
+func grams(text string, limit int, fn func(string)) {
+	count := 0
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '\''
+	}) {
+		word = strings.Trim(word, "'")
+		if word == "" {
+			continue
+		}
+		runes := []rune(" " + word + " ")
+		for size := 1; size <= 3; size++ {
+			for i := 0; i+size <= len(runes); i++ {
+				if size == 1 && runes[i] == ' ' {
+					continue
+				}
+				if limit > 0 && count >= limit {
+					return
+				}
+				count++
+				fn(string(runes[i : i+size]))
+			}
+		}
+	}
+}
+
+func round(v float64) float64 {
+	return math.Round(v*1000) / 1000
+}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
 	"strings"
 )
@@ -33,3 +34,13 @@ func (m *JWTMiddleware) Auth(c *fiber.Ctx) error {
 	c.Locals("user_email", claims.Email)
 	return c.Next()
 }
+
+// UserID returns the ID of the authenticated user stored by Auth.
+func UserID(c *fiber.Ctx) (uuid.UUID, bool) {
+	userIDStr, _ := c.Locals("user_id").(string)
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return userID, true
+}
@@ -8,6 +8,7 @@ type ApiResponse struct {
 	Error      interface{} `json:"error,omitempty"`
 	Data       interface{} `json:"data,omitempty"`
 	Pagination *Pagination `json:"pagination,omitempty"`
+	Meta       interface{} `json:"meta,omitempty"`
 }
 
 type Pagination struct {
@@ -25,6 +26,18 @@ func Success(c *fiber.Ctx, data interface{}, message string, pagination *Paginat
 	})
 }
 
+// SuccessWithMeta is Success with extra information about the result set,
+// such as aggregates, next to the data.
+func SuccessWithMeta(c *fiber.Ctx, data interface{}, message string, pagination *Pagination, meta interface{}) error {
+	return c.JSON(ApiResponse{
+		Success:    true,
+		Message:    message,
+		Data:       data,
+		Pagination: pagination,
+		Meta:       meta,
+	})
+}
+
 func Error(c *fiber.Ctx, message string, statusCode int) error {
 	c.Status(statusCode)
 	return c.JSON(ApiResponse{
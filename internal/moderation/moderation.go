@@ -0,0 +1,503 @@
+// Package moderation screens text before it is read aloud. Words are matched
+// whole, after undoing common obfuscation such as leetspeak ("b4ngs4t"),
+// stretched letters ("anjiiing") and dotted spelling ("a.n.j.i.n.g");
+// regular expressions are matched against the text as written.
+package moderation
+
+import (
+	"bufio"
+	"embed"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Action is what happens to text that matches a rule.
+type Action string
+
+const (
+	// ActionReject refuses the whole text.
+	ActionReject Action = "reject"
+	// ActionMask replaces every character of the match with an asterisk,
+	// which speech engines skip.
+	ActionMask Action = "mask"
+	// ActionReplace replaces the match with a replacement word.
+	ActionReplace Action = "replace"
+)
+
+// severity orders actions, so the result reports the strongest one fired.
+var severity = map[Action]int{ActionMask: 1, ActionReplace: 2, ActionReject: 3}
+
+// Kind is how a rule matches.
+type Kind string
+
+const (
+	KindWord  Kind = "word"
+	KindRegex Kind = "regex"
+)
+
+// Sources of rules.
+const (
+	SourceBuiltin = "builtin"
+	SourceUser    = "user"
+)
+
+// DefaultReplacement is read instead of a match when neither the rule nor
+// the configuration says otherwise.
+const DefaultReplacement = "beep"
+
+//go:embed wordlists/*.txt
+var wordlists embed.FS
+
+// Rule is one blocked word, phrase or pattern. An empty Action or
+// Replacement falls back to the configuration.
+type Rule struct {
+	ID          string
+	Source      string
+	Kind        Kind
+	Pattern     string
+	Action      Action
+	Replacement string
+}
+
+// Config selects the rules of a Filter. Builtin lists the languages whose
+// built-in word lists are included. Allow holds words that are never
+// blocked, which overrides built-in words the user does not consider rude.
+type Config struct {
+	Builtin     []string
+	Rules       []Rule
+	Allow       []string
+	Action      Action
+	Replacement string
+}
+
+// Match is a span of text that fired a rule. Start and End are rune offsets.
+type Match struct {
+	Rule    string `json:"rule"`
+	Source  string `json:"source"`
+	Kind    Kind   `json:"kind"`
+	Pattern string `json:"pattern"`
+	Start   int    `json:"start"`
+	End     int    `json:"end"`
+	Text    string `json:"text"`
+	Action  Action `json:"action"`
+}
+
+// Result is the screened text. Action is the strongest action fired, or
+// empty when no rule matched. Rejected texts are returned unchanged.
+type Result struct {
+	Text    string  `json:"text"`
+	Action  Action  `json:"action,omitempty"`
+	Matches []Match `json:"matches"`
+}
+
+// Rejected reports whether the text must not be used.
+func (r *Result) Rejected() bool {
+	return r.Action == ActionReject
+}
+
+// RejectedError is returned by callers that refuse rejected text.
+type RejectedError struct {
+	Result *Result
+}
+
+func (e *RejectedError) Error() string {
+	return strings.Join(e.Messages(), "; ")
+}
+
+// Messages describes each rule that rejected the text.
+func (e *RejectedError) Messages() []string {
+	var msgs []string
+	for _, m := range e.Result.Matches {
+		if m.Action == ActionReject {
+			msgs = append(msgs, fmt.Sprintf("text contains %q, blocked by %s rule %s", m.Text, m.Kind, m.Rule))
+		}
+	}
+	return msgs
+}
+
+// wordRule is a word rule split into the tokens it matches.
+type wordRule struct {
+	rule   *Rule
+	tokens []string
+}
+
+type regexRule struct {
+	rule *Rule
+	re   *regexp.Regexp
+}
+
+// Filter applies a Config. It is safe for concurrent use.
+type Filter struct {
+	// words maps the squeezed first token of each word rule to the rules.
+	words       map[string][]*wordRule
+	regexes     []regexRule
+	allow       map[string]bool
+	action      Action
+	replacement string
+}
+
+// CompileRegex checks a regular expression rule. Patterns are matched
+// case-insensitively.
+func CompileRegex(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("(?i)" + pattern)
+}
+
+// New builds a filter. It fails on unknown built-in languages and invalid
+// regular expressions.
+func New(cfg Config) (*Filter, error) {
+	f := &Filter{
+		words:       map[string][]*wordRule{},
+		allow:       map[string]bool{},
+		action:      cfg.Action,
+		replacement: cfg.Replacement,
+	}
+	if f.action == "" {
+		f.action = ActionMask
+	}
+	if f.replacement == "" {
+		f.replacement = DefaultReplacement
+	}
+	for _, w := range cfg.Allow {
+		f.allow[strings.Join(normalize(w), " ")] = true
+	}
+
+	for _, lang := range cfg.Builtin {
+		rules, err := builtin(lang)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rules {
+			f.addWord(r)
+		}
+	}
+	for i := range cfg.Rules {
+		r := &cfg.Rules[i]
+		switch r.Kind {
+		case KindWord:
+			f.addWord(r)
+		case KindRegex:
+			re, err := CompileRegex(r.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("rule %s: %w", r.ID, err)
+			}
+			f.regexes = append(f.regexes, regexRule{rule: r, re: re})
+		default:
+			return nil, fmt.Errorf("rule %s: unknown kind %q", r.ID, r.Kind)
+		}
+	}
+	return f, nil
+}
+
+func (f *Filter) addWord(r *Rule) {
+	tokens := normalize(r.Pattern)
+	if len(tokens) == 0 {
+		return
+	}
+	key := squeeze(tokens[0])
+	f.words[key] = append(f.words[key], &wordRule{rule: r, tokens: tokens})
+}
+
+// Check screens text. A nil filter lets everything through.
+func (f *Filter) Check(text string) *Result {
+	res := &Result{Text: text, Matches: []Match{}}
+	if f == nil {
+		return res
+	}
+
+	type span struct {
+		start, end int // byte offsets
+		rule       *Rule
+	}
+	var spans []span
+
+	tokens := tokenize(text)
+	for i, t := range tokens {
+		key := squeeze(t.text)
+		candidates := f.words[key]
+		if stemmed := squeeze(stem(t.text)); stemmed != key {
+			candidates = append(candidates[:len(candidates):len(candidates)], f.words[stemmed]...)
+		}
+		for _, wr := range candidates {
+			n, ok := matchTokens(tokens[i:], wr.tokens)
+			if !ok || f.allow[joinTokens(tokens[i:i+n])] {
+				continue
+			}
+			spans = append(spans, span{t.start, tokens[i+n-1].end, wr.rule})
+			break
+		}
+	}
+	for _, rr := range f.regexes {
+		for _, loc := range rr.re.FindAllStringIndex(text, -1) {
+			if loc[0] == loc[1] || f.allow[strings.Join(normalize(text[loc[0]:loc[1]]), " ")] {
+				continue
+			}
+			spans = append(spans, span{loc[0], loc[1], rr.rule})
+		}
+	}
+	if len(spans) == 0 {
+		return res
+	}
+
+	// Rentang yang tumpang tindih: yang mulai lebih dulu menang, lalu yang
+	// lebih panjang.
+	sort.SliceStable(spans, func(i, j int) bool {
+		if spans[i].start != spans[j].start {
+			return spans[i].start < spans[j].start
+		}
+		return spans[i].end > spans[j].end
+	})
+
+	var b strings.Builder
+	last := 0
+	for _, s := range spans {
+		if s.start < last {
+			continue
+		}
+		action := s.rule.Action
+		if action == "" {
+			action = f.action
+		}
+		original := text[s.start:s.end]
+		res.Matches = append(res.Matches, Match{
+			Rule:    s.rule.ID,
+			Source:  s.rule.Source,
+			Kind:    s.rule.Kind,
+			Pattern: s.rule.Pattern,
+			Start:   utf8.RuneCountInString(text[:s.start]),
+			End:     utf8.RuneCountInString(text[:s.end]),
+			Text:    original,
+			Action:  action,
+		})
+		if severity[action] > severity[res.Action] {
+			res.Action = action
+		}
+
+		b.WriteString(text[last:s.start])
+		switch action {
+		case ActionReplace:
+			replacement := s.rule.Replacement
+			if replacement == "" {
+				replacement = f.replacement
+			}
+			b.WriteString(replacement)
+		default:
+			b.WriteString(strings.Repeat("*", utf8.RuneCountInString(original)))
+		}
+		last = s.end
+	}
+	b.WriteString(text[last:])
+
+	if !res.Rejected() {
+		res.Text = b.String()
+	}
+	return res
+}
+
+// Languages returns the languages with a built-in word list.
+func Languages() []string {
+	loadBuiltin()
+	langs := make([]string, 0, len(builtinRules))
+	for lang := range builtinRules {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+var (
+	builtinOnce  sync.Once
+	builtinRules map[string][]*Rule
+)
+
+func loadBuiltin() {
+	builtinOnce.Do(func() {
+		builtinRules = map[string][]*Rule{}
+		entries, err := wordlists.ReadDir("wordlists")
+		if err != nil {
+			panic(err)
+		}
+		for _, e := range entries {
+			lang := strings.TrimSuffix(e.Name(), ".txt")
+			file, err := wordlists.Open(path.Join("wordlists", e.Name()))
+			if err != nil {
+				panic(err)
+			}
+			scanner := bufio.NewScanner(file)
+			for scanner.Scan() {
+				word := strings.TrimSpace(scanner.Text())
+				if word == "" || strings.HasPrefix(word, "#") {
+					continue
+				}
+				builtinRules[lang] = append(builtinRules[lang], &Rule{
+					ID:      SourceBuiltin + ":" + lang,
+					Source:  SourceBuiltin,
+					Kind:    KindWord,
+					Pattern: word,
+				})
+			}
+			_ = file.Close()
+		}
+	})
+}
+
+func builtin(lang string) ([]*Rule, error) {
+	loadBuiltin()
+	rules, ok := builtinRules[lang]
+	if !ok {
+		return nil, fmt.Errorf("no built-in word list for %q", lang)
+	}
+	return rules, nil
+}
+
+// token is a word of the text as matched: lower-cased, with leetspeak
+// undone. start and end are byte offsets in the original text.
+type token struct {
+	text       string
+	start, end int
+}
+
+// leet maps digits and symbols used in place of letters.
+var leet = map[rune]rune{
+	'4': 'a', '@': 'a', '8': 'b', '3': 'e', '6': 'g', '9': 'g', '1': 'i', '!': 'i',
+	'0': 'o', '5': 's', '$': 's', '7': 't', '+': 't',
+}
+
+// joiners may separate single letters of a spelled-out word.
+const joiners = ".-_"
+
+func tokenize(text string) []token {
+	type item struct {
+		r          rune
+		start, end int
+	}
+	var runes []item
+	for i, r := range text {
+		runes = append(runes, item{r, i, i + utf8.RuneLen(r)})
+	}
+	isLetter := func(i int) bool {
+		return i >= 0 && i < len(runes) && unicode.IsLetter(runes[i].r)
+	}
+
+	var tokens []token
+	var cur []rune
+	var start, end, segment int
+	flush := func() {
+		if len(cur) > 0 {
+			tokens = append(tokens, token{text: string(cur), start: start, end: end})
+		}
+		cur, segment = nil, 0
+	}
+	for i, it := range runes {
+		c := unicode.ToLower(it.r)
+		if l, ok := leet[c]; ok {
+			// Tanda seru di akhir kata adalah tanda baca, bukan huruf i.
+			if isLetter(i+1) || (c != '!' && isLetter(i-1)) {
+				c = l
+			}
+		}
+		switch {
+		case unicode.IsLetter(c):
+			if len(cur) == 0 {
+				start = it.start
+			}
+			cur = append(cur, c)
+			end = it.end
+			segment++
+		case strings.ContainsRune(joiners, c) && segment == 1 && isLetter(i+1) &&
+			(i+2 >= len(runes) || !unicode.IsLetter(runes[i+2].r)):
+			segment = 0
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+// normalize splits a rule pattern into tokens the way text is tokenized.
+func normalize(s string) []string {
+	tokens := tokenize(s)
+	words := make([]string, len(tokens))
+	for i, t := range tokens {
+		words[i] = t.text
+	}
+	return words
+}
+
+func joinTokens(tokens []token) string {
+	words := make([]string, len(tokens))
+	for i, t := range tokens {
+		words[i] = t.text
+	}
+	return strings.Join(words, " ")
+}
+
+// squeeze collapses repeated letters, so "anjiiing" and "anjing" compare
+// equal.
+func squeeze(s string) string {
+	var b strings.Builder
+	var prev rune
+	for i, r := range s {
+		if i > 0 && r == prev {
+			continue
+		}
+		b.WriteRune(r)
+		prev = r
+	}
+	return b.String()
+}
+
+// suffixes are endings that still leave a blocked word recognizable, such
+// as "bangsatnya" or "fucking".
+var suffixes = []string{"nya", "lah", "kah", "mu", "ku", "in", "ing", "ers", "er", "ed", "es", "s", "y"}
+
+// pluralSuffixes only count after roots of five letters or more. Short roots
+// plus "s" or "y" are too often other words, such as "Asus" or "Cokes".
+var pluralSuffixes = map[string]bool{"es": true, "s": true, "y": true}
+
+// stem strips one suffix from a word, keeping at least three letters.
+func stem(word string) string {
+	for _, suffix := range suffixes {
+		if !strings.HasSuffix(word, suffix) {
+			continue
+		}
+		root := utf8.RuneCountInString(word) - len(suffix)
+		if root >= 3 && (root >= 5 || !pluralSuffixes[suffix]) {
+			return strings.TrimSuffix(word, suffix)
+		}
+	}
+	return word
+}
+
+// matchWord reports whether a token of text spells word, allowing letters
+// to be repeated and, on the last word of a rule, a suffix.
+func matchWord(tok, word string, last bool) bool {
+	if squeeze(tok) == squeeze(word) && utf8.RuneCountInString(tok) >= utf8.RuneCountInString(word) {
+		return true
+	}
+	if !last {
+		return false
+	}
+	s := stem(tok)
+	return s != tok && squeeze(s) == squeeze(word) && utf8.RuneCountInString(s) >= utf8.RuneCountInString(word)
+}
+
+// matchTokens reports whether tokens start with the words of a rule, and
+// how many tokens matched.
+func matchTokens(tokens []token, words []string) (int, bool) {
+	if len(tokens) < len(words) {
+		return 0, false
+	}
+	for i, w := range words {
+		if !matchWord(tokens[i].text, w, i == len(words)-1) {
+			return 0, false
+		}
+	}
+	return len(words), true
+}
@@ -0,0 +1,126 @@
+package moderation
+
+import "testing"
+
+func TestCheck(t *testing.T) {
+	builtin := Config{Builtin: []string{"id", "en"}}
+
+	tests := []struct {
+		name   string
+		cfg    Config
+		text   string
+		want   string
+		action Action
+	}{
+		{"clean text", builtin, "Halo semua, selamat malam", "Halo semua, selamat malam", ""},
+		{"builtin word", builtin, "dasar bangsat", "dasar *******", ActionMask},
+		{"leetspeak", builtin, "dasar b4ngs4t", "dasar *******", ActionMask},
+		{"leetspeak symbol", builtin, "@su keren", "*** keren", ActionMask},
+		{"stretched letters", builtin, "anjiiing lah", "******** lah", ActionMask},
+		{"dotted spelling", builtin, "a.n.j.i.n.g", "***********", ActionMask},
+		{"indonesian suffix", builtin, "bangsatnya kabur", "********** kabur", ActionMask},
+		{"english suffix", builtin, "fucking hell", "******* hell", ActionMask},
+		{"plural of long root", builtin, "stupid bastards", "stupid ********", ActionMask},
+		{"brand after short root", builtin, "Beli laptop Asus baru", "Beli laptop Asus baru", ""},
+		{"plural after short root", builtin, "Two Cokes please", "Two Cokes please", ""},
+		{"word inside longer word", builtin, "assessment and cocktail", "assessment and cocktail", ""},
+		{"name", builtin, "Dick Grayson", "**** Grayson", ActionMask},
+		{
+			"allowed name",
+			Config{Builtin: []string{"en"}, Allow: []string{"dick"}},
+			"Dick Grayson", "Dick Grayson", "",
+		},
+		{
+			"allowed builtin word",
+			Config{Builtin: []string{"id"}, Allow: []string{"anjing"}},
+			"anjing lucu", "anjing lucu", "",
+		},
+		{
+			"user phrase",
+			Config{Rules: []Rule{{ID: "1", Kind: KindWord, Pattern: "spoiler ending", Action: ActionReplace}}},
+			"ini Spoiler   Ending filmnya", "ini beep filmnya", ActionReplace,
+		},
+		{
+			"replacement of rule",
+			Config{Rules: []Rule{{ID: "1", Kind: KindWord, Pattern: "spoiler", Action: ActionReplace, Replacement: "rahasia"}}},
+			"awas spoiler", "awas rahasia", ActionReplace,
+		},
+		{
+			"regex reject keeps text",
+			Config{Rules: []Rule{{ID: "1", Kind: KindRegex, Pattern: `\d{4}-\d{4}`, Action: ActionReject}}},
+			"telp 1234-5678", "telp 1234-5678", ActionReject,
+		},
+		{
+			"strongest action wins",
+			Config{Builtin: []string{"id"}, Rules: []Rule{{ID: "1", Kind: KindWord, Pattern: "spoiler", Action: ActionReject}}},
+			"bangsat spoiler", "bangsat spoiler", ActionReject,
+		},
+		{
+			"configured action",
+			Config{Builtin: []string{"en"}, Action: ActionReplace, Replacement: "bip"},
+			"oh shit", "oh bip", ActionReplace,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := New(tt.cfg)
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			res := f.Check(tt.text)
+			if res.Text != tt.want {
+				t.Errorf("Text = %q, want %q", res.Text, tt.want)
+			}
+			if res.Action != tt.action {
+				t.Errorf("Action = %q, want %q", res.Action, tt.action)
+			}
+		})
+	}
+}
+
+func TestCheckNilFilter(t *testing.T) {
+	var f *Filter
+	res := f.Check("bangsat")
+	if res.Text != "bangsat" || res.Action != "" || len(res.Matches) != 0 {
+		t.Errorf("nil filter changed text: %+v", res)
+	}
+}
+
+func TestStem(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"bangsatnya", "bangsat"},
+		{"fucking", "fuck"},
+		{"bastards", "bastard"},
+		{"asus", "asus"},
+		{"cokes", "cokes"},
+		{"assy", "assy"},
+		{"asumu", "asu"},
+		{"as", "as"},
+	}
+	for _, tt := range tests {
+		if got := stem(tt.word); got != tt.want {
+			t.Errorf("stem(%q) = %q, want %q", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestCheckSSML(t *testing.T) {
+	f, err := New(Config{Builtin: []string{"id"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := f.CheckSSML(`<speak>halo <sub alias="bangsat">B</sub> semua</speak>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `<speak>halo <sub alias="*******">B</sub> semua</speak>`; res.Text != want {
+		t.Errorf("Text = %q, want %q", res.Text, want)
+	}
+	if _, err := f.CheckSSML("<speak>halo"); err == nil {
+		t.Error("invalid SSML was accepted")
+	}
+}
@@ -0,0 +1,60 @@
+package moderation
+
+import "github.com/kiminodare/HOVARLAY-BE/internal/ssml"
+
+// CheckSSML screens the spoken text of an SSML document: its text nodes and
+// the alias of <sub> elements, which is read instead of their content.
+// Offsets count runes of that spoken text in document order. Markup is left
+// as it is and Text is the serialized document.
+func (f *Filter) CheckSSML(text string) (*Result, error) {
+	root, err := ssml.Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	res := &Result{Text: text, Matches: []Match{}}
+	if f == nil {
+		return res, nil
+	}
+
+	offset := 0
+	changed := false
+	check := func(s string) string {
+		r := f.Check(s)
+		for _, m := range r.Matches {
+			m.Start += offset
+			m.End += offset
+			res.Matches = append(res.Matches, m)
+		}
+		if severity[r.Action] > severity[res.Action] {
+			res.Action = r.Action
+		}
+		offset += len([]rune(s))
+		if r.Text != s {
+			changed = true
+		}
+		return r.Text
+	}
+
+	var walk func(n *ssml.Node)
+	walk = func(n *ssml.Node) {
+		if n.IsText() {
+			n.Text = check(n.Text)
+			return
+		}
+		if n.Name == "sub" {
+			if alias, ok := n.Attrs["alias"]; ok {
+				n.Attrs["alias"] = check(alias)
+				return
+			}
+		}
+		for _, c := range n.Children {
+			walk(c)
+		}
+	}
+	walk(root)
+
+	if changed && !res.Rejected() {
+		res.Text = root.String()
+	}
+	return res, nil
+}
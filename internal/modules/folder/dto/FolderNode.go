@@ -0,0 +1,16 @@
+package dtoFolder
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type FolderNode struct {
+	ID        uuid.UUID     `json:"id"`
+	Name      string        `json:"name"`
+	ParentID  *uuid.UUID    `json:"parentId,omitempty"`
+	CreatedAt time.Time     `json:"createdAt"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+	Children  []*FolderNode `json:"children"`
+}
@@ -0,0 +1,21 @@
+package dtoFolder
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+var validate *validator.Validate
+
+func init() {
+	validate = validator.New()
+}
+
+type FolderRequest struct {
+	Name     string     `json:"name" validate:"required,min=1,max=100"`
+	ParentID *uuid.UUID `json:"parentId"`
+}
+
+func (r *FolderRequest) Validate() error {
+	return validate.Struct(r)
+}
@@ -0,0 +1,115 @@
+package folder
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/internal/middleware"
+	dtoFolder "github.com/kiminodare/HOVARLAY-BE/internal/modules/folder/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) Create(c *fiber.Ctx) error {
+	var req dtoFolder.FolderRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	folder, err := h.service.Create(c.Context(), userID, &req)
+	if err != nil {
+		return folderError(c, err, "Failed to create folder")
+	}
+
+	return middleware.Success(c, folder, "Folder created successfully", nil)
+}
+
+func (h *Handler) GetTree(c *fiber.Ctx) error {
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	tree, err := h.service.GetTree(c.Context(), userID)
+	if err != nil {
+		return middleware.Error(c, "Failed to fetch folders", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, tree, "Folders fetched successfully", nil)
+}
+
+func (h *Handler) Update(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	var req dtoFolder.FolderRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	folder, err := h.service.Update(c.Context(), userID, id, &req)
+	if err != nil {
+		return folderError(c, err, "Failed to update folder")
+	}
+
+	return middleware.Success(c, folder, "Folder updated successfully", nil)
+}
+
+func (h *Handler) Delete(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	if err := h.service.Delete(c.Context(), userID, id); err != nil {
+		return folderError(c, err, "Failed to delete folder")
+	}
+
+	return middleware.Success(c, nil, "Folder deleted successfully", nil)
+}
+
+func folderError(c *fiber.Ctx, err error, fallback string) error {
+	switch {
+	case errors.Is(err, utils.ErrFolderNotFound):
+		return middleware.Error(c, "Folder not found", fiber.StatusNotFound)
+	case errors.Is(err, utils.ErrFolderExists):
+		return middleware.Error(c, "A folder with this name already exists here", fiber.StatusConflict)
+	case errors.Is(err, utils.ErrFolderCycle):
+		return middleware.Error(c, "Folder cannot be moved into itself or its subfolders", fiber.StatusUnprocessableEntity)
+	default:
+		return middleware.Error(c, fallback, fiber.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,99 @@
+package folder
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/folder"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	user2 "github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/schema"
+	"github.com/kiminodare/HOVARLAY-BE/internal/db"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Repository struct {
+	client *generated.Client
+}
+
+func NewFolderRepository(client *generated.Client) *Repository {
+	return &Repository{client: client}
+}
+
+func (r *Repository) Create(ctx context.Context, userID uuid.UUID, name string, parentID *uuid.UUID) (*generated.Folder, error) {
+	return r.client.Folder.Create().
+		SetName(name).
+		SetNillableParentID(parentID).
+		SetUserID(userID).
+		Save(ctx)
+}
+
+func (r *Repository) GetByUser(ctx context.Context, userID uuid.UUID) ([]*generated.Folder, error) {
+	return r.client.Folder.Query().
+		Where(folder.HasUserWith(user2.ID(userID))).
+		Order(folder.ByName()).
+		All(ctx)
+}
+
+func (r *Repository) GetOwned(ctx context.Context, userID, id uuid.UUID) (*generated.Folder, error) {
+	f, err := r.client.Folder.Query().
+		Where(folder.ID(id), folder.HasUserWith(user2.ID(userID))).
+		Only(ctx)
+	if generated.IsNotFound(err) {
+		return nil, utils.ErrFolderNotFound
+	}
+	return f, err
+}
+
+// NameTaken reports whether a sibling folder under parentID already uses name.
+// The unique index cannot enforce this for root folders because NULL parents
+// never collide.
+func (r *Repository) NameTaken(ctx context.Context, userID uuid.UUID, parentID *uuid.UUID, name string, exclude uuid.UUID) (bool, error) {
+	q := r.client.Folder.Query().
+		Where(
+			folder.HasUserWith(user2.ID(userID)),
+			folder.NameEqualFold(name),
+			folder.IDNEQ(exclude),
+		)
+	if parentID == nil {
+		q.Where(folder.ParentIDIsNil())
+	} else {
+		q.Where(folder.ParentID(*parentID))
+	}
+	return q.Exist(ctx)
+}
+
+func (r *Repository) Update(ctx context.Context, id uuid.UUID, name string, parentID *uuid.UUID) (*generated.Folder, error) {
+	update := r.client.Folder.UpdateOneID(id).SetName(name)
+	if parentID == nil {
+		update.ClearParentID()
+	} else {
+		update.SetParentID(*parentID)
+	}
+	return update.Save(ctx)
+}
+
+// Delete removes the folder and moves its subfolders and histories, trashed
+// ones included, up to the folder's parent.
+func (r *Repository) Delete(ctx context.Context, f *generated.Folder) error {
+	return db.WithTx(ctx, r.client, func(tx *generated.Tx) error {
+		children := tx.Folder.Update().Where(folder.ParentID(f.ID))
+		histories := tx.History.Update().Where(history.HasFolderWith(folder.ID(f.ID)))
+		if f.ParentID == nil {
+			children.ClearParentID()
+			histories.ClearFolder()
+		} else {
+			children.SetParentID(*f.ParentID)
+			histories.SetFolderID(*f.ParentID)
+		}
+
+		if _, err := children.Save(ctx); err != nil {
+			return err
+		}
+		if _, err := histories.Save(schema.SkipSoftDelete(ctx)); err != nil {
+			return err
+		}
+		return tx.Folder.DeleteOneID(f.ID).Exec(ctx)
+	})
+}
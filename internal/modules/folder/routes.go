@@ -0,0 +1,10 @@
+package folder
+
+import "github.com/gofiber/fiber/v2"
+
+func SetupFolderRoutes(router fiber.Router, handler *Handler) {
+	router.Get("/folders", handler.GetTree)
+	router.Post("/folders", handler.Create)
+	router.Put("/folders/:id", handler.Update)
+	router.Delete("/folders/:id", handler.Delete)
+}
@@ -0,0 +1,131 @@
+package folder
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	dtoFolder "github.com/kiminodare/HOVARLAY-BE/internal/modules/folder/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Service struct {
+	repo *Repository
+}
+
+func NewService(repo *Repository) *Service {
+	return &Service{repo: repo}
+}
+
+func (s *Service) Create(ctx context.Context, userID uuid.UUID, req *dtoFolder.FolderRequest) (*generated.Folder, error) {
+	if err := s.checkPlacement(ctx, userID, uuid.Nil, req); err != nil {
+		return nil, err
+	}
+
+	f, err := s.repo.Create(ctx, userID, req.Name, req.ParentID)
+	if generated.IsConstraintError(err) {
+		return nil, utils.ErrFolderExists
+	}
+	return f, err
+}
+
+// GetTree returns the folders of the user nested under their parents.
+func (s *Service) GetTree(ctx context.Context, userID uuid.UUID) ([]*dtoFolder.FolderNode, error) {
+	folders, err := s.repo.GetByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make(map[uuid.UUID]*dtoFolder.FolderNode, len(folders))
+	for _, f := range folders {
+		nodes[f.ID] = &dtoFolder.FolderNode{
+			ID:        f.ID,
+			Name:      f.Name,
+			ParentID:  f.ParentID,
+			CreatedAt: f.CreatedAt,
+			UpdatedAt: f.UpdatedAt,
+			Children:  []*dtoFolder.FolderNode{},
+		}
+	}
+
+	roots := []*dtoFolder.FolderNode{}
+	for _, f := range folders {
+		node := nodes[f.ID]
+		if f.ParentID != nil {
+			if parent, ok := nodes[*f.ParentID]; ok {
+				parent.Children = append(parent.Children, node)
+				continue
+			}
+		}
+		roots = append(roots, node)
+	}
+	return roots, nil
+}
+
+func (s *Service) GetOwned(ctx context.Context, userID, id uuid.UUID) (*generated.Folder, error) {
+	return s.repo.GetOwned(ctx, userID, id)
+}
+
+func (s *Service) Update(ctx context.Context, userID, id uuid.UUID, req *dtoFolder.FolderRequest) (*generated.Folder, error) {
+	if _, err := s.repo.GetOwned(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	if err := s.checkPlacement(ctx, userID, id, req); err != nil {
+		return nil, err
+	}
+
+	f, err := s.repo.Update(ctx, id, req.Name, req.ParentID)
+	if generated.IsConstraintError(err) {
+		return nil, utils.ErrFolderExists
+	}
+	return f, err
+}
+
+func (s *Service) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	f, err := s.repo.GetOwned(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.Delete(ctx, f)
+	if generated.IsConstraintError(err) {
+		return utils.ErrFolderExists
+	}
+	return err
+}
+
+// checkPlacement verifies that the parent belongs to the user, that the
+// folder (id, uuid.Nil when creating) is not moved below itself and that no
+// sibling already uses the name.
+func (s *Service) checkPlacement(ctx context.Context, userID, id uuid.UUID, req *dtoFolder.FolderRequest) error {
+	if req.ParentID != nil {
+		if *req.ParentID == id {
+			return utils.ErrFolderCycle
+		}
+
+		parent, err := s.repo.GetOwned(ctx, userID, *req.ParentID)
+		if err != nil {
+			return err
+		}
+
+		if id != uuid.Nil {
+			for parent.ParentID != nil {
+				if *parent.ParentID == id {
+					return utils.ErrFolderCycle
+				}
+				if parent, err = s.repo.GetOwned(ctx, userID, *parent.ParentID); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	taken, err := s.repo.NameTaken(ctx, userID, req.ParentID, req.Name, id)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return utils.ErrFolderExists
+	}
+	return nil
+}
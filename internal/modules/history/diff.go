@@ -0,0 +1,133 @@
+package history
+
+import (
+	"strings"
+	"unicode"
+
+	dtoHistory "github.com/kiminodare/HOVARLAY-BE/internal/modules/history/dto"
+)
+
+// maxDiffCells membatasi ukuran tabel LCS; teks yang lebih panjang
+// ditampilkan sebagai satu delete dan satu insert.
+const maxDiffCells = 1 << 20
+
+const (
+	diffEqual  = "equal"
+	diffInsert = "insert"
+	diffDelete = "delete"
+)
+
+func diffSnapshots(from, to dtoHistory.RevisionSnapshot) *dtoHistory.RevisionDiff {
+	diff := &dtoHistory.RevisionDiff{From: from, To: to, Changes: []dtoHistory.FieldChange{}}
+
+	if from.Text != to.Text {
+		diff.Changes = append(diff.Changes, dtoHistory.FieldChange{Field: "text", From: from.Text, To: to.Text})
+		diff.TextDiff = diffWords(from.Text, to.Text)
+	}
+	if from.Format != to.Format {
+		diff.Changes = append(diff.Changes, dtoHistory.FieldChange{Field: "format", From: from.Format, To: to.Format})
+	}
+	if from.Voice != to.Voice {
+		diff.Changes = append(diff.Changes, dtoHistory.FieldChange{Field: "voice", From: from.Voice, To: to.Voice})
+	}
+	if from.Rate != to.Rate {
+		diff.Changes = append(diff.Changes, dtoHistory.FieldChange{Field: "rate", From: from.Rate, To: to.Rate})
+	}
+	if from.Pitch != to.Pitch {
+		diff.Changes = append(diff.Changes, dtoHistory.FieldChange{Field: "pitch", From: from.Pitch, To: to.Pitch})
+	}
+	if from.Volume != to.Volume {
+		diff.Changes = append(diff.Changes, dtoHistory.FieldChange{Field: "volume", From: from.Volume, To: to.Volume})
+	}
+	return diff
+}
+
+// diffWords returns a word level diff of a and b based on their longest
+// common subsequence. Whitespace is kept attached to the following token so
+// joining all segments of one side reproduces that side exactly.
+func diffWords(a, b string) []dtoHistory.DiffSegment {
+	x, y := tokenize(a), tokenize(b)
+	if len(x)*len(y) > maxDiffCells {
+		return compactSegments([]dtoHistory.DiffSegment{
+			{Op: diffDelete, Text: a},
+			{Op: diffInsert, Text: b},
+		})
+	}
+
+	// lcs[i][j] = panjang LCS dari x[i:] dan y[j:]
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var segments []dtoHistory.DiffSegment
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		switch {
+		case x[i] == y[j]:
+			segments = append(segments, dtoHistory.DiffSegment{Op: diffEqual, Text: x[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			segments = append(segments, dtoHistory.DiffSegment{Op: diffDelete, Text: x[i]})
+			i++
+		default:
+			segments = append(segments, dtoHistory.DiffSegment{Op: diffInsert, Text: y[j]})
+			j++
+		}
+	}
+	for ; i < len(x); i++ {
+		segments = append(segments, dtoHistory.DiffSegment{Op: diffDelete, Text: x[i]})
+	}
+	for ; j < len(y); j++ {
+		segments = append(segments, dtoHistory.DiffSegment{Op: diffInsert, Text: y[j]})
+	}
+	return compactSegments(segments)
+}
+
+// tokenize splits s into words, each carrying its leading whitespace.
+func tokenize(s string) []string {
+	var tokens []string
+	var b strings.Builder
+	inWord := false
+	for _, r := range s {
+		if unicode.IsSpace(r) && inWord {
+			tokens = append(tokens, b.String())
+			b.Reset()
+			inWord = false
+		}
+		if !unicode.IsSpace(r) {
+			inWord = true
+		}
+		b.WriteRune(r)
+	}
+	if b.Len() > 0 {
+		tokens = append(tokens, b.String())
+	}
+	return tokens
+}
+
+// compactSegments merges neighbouring segments with the same op and drops empty ones.
+func compactSegments(segments []dtoHistory.DiffSegment) []dtoHistory.DiffSegment {
+	out := make([]dtoHistory.DiffSegment, 0, len(segments))
+	for _, seg := range segments {
+		if seg.Text == "" {
+			continue
+		}
+		if n := len(out); n > 0 && out[n-1].Op == seg.Op {
+			out[n-1].Text += seg.Text
+			continue
+		}
+		out = append(out, seg)
+	}
+	return out
+}
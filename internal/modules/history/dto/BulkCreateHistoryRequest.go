@@ -0,0 +1,14 @@
+package dtoHistory
+
+// MaxBulkItems membatasi jumlah item dalam satu operasi bulk.
+const MaxBulkItems = 100
+
+type BulkCreateHistoryRequest struct {
+	Items []CreateHistoryRequest `json:"items" validate:"required,min=1,max=100"`
+}
+
+// Validate only checks the envelope; every item is validated separately so
+// invalid rows can be reported without rejecting the whole batch.
+func (r *BulkCreateHistoryRequest) Validate() error {
+	return validate.Struct(r)
+}
@@ -0,0 +1,27 @@
+package dtoHistory
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type BulkDeleteHistoryRequest struct {
+	IDs    []uuid.UUID    `json:"ids" validate:"omitempty,max=100"`
+	Filter *HistoryFilter `json:"filter"`
+}
+
+func (r *BulkDeleteHistoryRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	hasFilter := r.Filter != nil && !r.Filter.IsEmpty()
+	switch {
+	case len(r.IDs) == 0 && !hasFilter:
+		return errors.New("either ids or a non-empty filter is required")
+	case len(r.IDs) > 0 && hasFilter:
+		return errors.New("ids and filter cannot be combined")
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package dtoHistory
+
+import (
+	"github.com/google/uuid"
+	filter "github.com/kiminodare/HOVARLAY-BE/internal/moderation"
+)
+
+// BulkItemResult is the outcome of one item. Moderation lists the
+// moderation rules that fired on its text, if any.
+type BulkItemResult struct {
+	Index      int            `json:"index"`
+	ID         *uuid.UUID     `json:"id,omitempty"`
+	Success    bool           `json:"success"`
+	Errors     []string       `json:"errors,omitempty"`
+	Moderation *filter.Result `json:"moderation,omitempty"`
+}
+
+type BulkResult struct {
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Items     []BulkItemResult `json:"items,omitempty"`
+}
+
+func NewBulkResult(items []BulkItemResult) *BulkResult {
+	res := &BulkResult{Items: items}
+	for _, item := range items {
+		if item.Success {
+			res.Succeeded++
+		} else {
+			res.Failed++
+		}
+	}
+	return res
+}
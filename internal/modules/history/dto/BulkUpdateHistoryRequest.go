@@ -0,0 +1,25 @@
+package dtoHistory
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+type BulkUpdateHistoryRequest struct {
+	IDs    []uuid.UUID `json:"ids" validate:"required,min=1,max=100"`
+	Voice  *string     `json:"voice" validate:"omitempty,min=1"`
+	Rate   *float64    `json:"rate" validate:"omitempty,min=0.1,max=5"`
+	Pitch  *float64    `json:"pitch" validate:"omitempty,min=0,max=2"`
+	Volume *float64    `json:"volume" validate:"omitempty,min=0,max=1"`
+}
+
+func (r *BulkUpdateHistoryRequest) Validate() error {
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+	if r.Voice == nil && r.Rate == nil && r.Pitch == nil && r.Volume == nil {
+		return errors.New("at least one of voice, rate, pitch or volume is required")
+	}
+	return nil
+}
@@ -1,7 +1,11 @@
 package dtoHistory
 
 import (
+	"fmt"
+
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/internal/ssml"
 )
 
 // Singleton validator instance
@@ -12,17 +16,40 @@ func init() {
 }
 
 type CreateHistoryRequest struct {
-	Text   string  `json:"text" validate:"required,min=1"`
-	Voice  string  `json:"voice" validate:"required"`
-	Rate   float64 `json:"rate" validate:"min=0.1,max=5"`
-	Pitch  float64 `json:"pitch" validate:"min=0,max=2"`
-	Volume float64 `json:"volume" validate:"min=0,max=1"`
+	Text string `json:"text" validate:"required,min=1"`
+	// Format is plain (default) or ssml. SSML text must be a <speak> document.
+	Format string `json:"format" validate:"omitempty,oneof=plain ssml"`
+	// Omitted voice parameters are taken from the preset, then from the user
+	// preferences.
+	PresetID *uuid.UUID `json:"presetId"`
+	Voice    string     `json:"voice"`
+	Rate     *float64   `json:"rate" validate:"omitempty,min=0.1,max=5"`
+	Pitch    *float64   `json:"pitch" validate:"omitempty,min=0,max=2"`
+	Volume   *float64   `json:"volume" validate:"omitempty,min=0,max=1"`
+	// Dedupe bumps an existing history with the same text and voice instead
+	// of creating a new one.
+	Dedupe bool `json:"dedupe"`
 }
 
 func (r *CreateHistoryRequest) Validate() error {
-	return validate.Struct(r)
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+	return validateText(r.Text, r.Format)
 }
 
 func ValidateCreateHistoryRequest(req *CreateHistoryRequest) error {
-	return validate.Struct(req)
+	return req.Validate()
+}
+
+// validateText checks SSML text with line and column information so clients
+// can point at the broken markup.
+func validateText(text, format string) error {
+	if format != "ssml" {
+		return nil
+	}
+	if err := ssml.Validate(text); err != nil {
+		return fmt.Errorf("text is not valid SSML: %w", err)
+	}
+	return nil
 }
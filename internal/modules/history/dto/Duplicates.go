@@ -0,0 +1,25 @@
+package dtoHistory
+
+import (
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+)
+
+// MaxDuplicateGroups membatasi jumlah grup duplikat per response.
+const MaxDuplicateGroups = 100
+
+type DuplicateGroup struct {
+	Count     int                  `json:"count"`
+	Histories []*generated.History `json:"histories"`
+}
+
+// MergeDuplicatesRequest merges MergeIDs into KeepID. Play counts, favorites,
+// pins and tags are combined and the merged histories are moved to the trash.
+type MergeDuplicatesRequest struct {
+	KeepID   uuid.UUID   `json:"keepId" validate:"required"`
+	MergeIDs []uuid.UUID `json:"mergeIds" validate:"required,min=1,max=100"`
+}
+
+func (r *MergeDuplicatesRequest) Validate() error {
+	return validate.Struct(r)
+}
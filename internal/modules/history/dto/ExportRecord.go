@@ -0,0 +1,19 @@
+package dtoHistory
+
+import "time"
+
+// ExportRecord is one history as written by the JSON and CSV exports.
+type ExportRecord struct {
+	ID         string    `json:"id"`
+	Text       string    `json:"text"`
+	Format     string    `json:"format"`
+	Voice      string    `json:"voice"`
+	Rate       float64   `json:"rate"`
+	Pitch      float64   `json:"pitch"`
+	Volume     float64   `json:"volume"`
+	IsFavorite bool      `json:"isFavorite"`
+	PlayCount  int       `json:"playCount"`
+	Tags       []string  `json:"tags"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
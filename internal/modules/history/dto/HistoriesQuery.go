@@ -1,6 +1,62 @@
 package dtoHistory
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+	dtoTag "github.com/kiminodare/HOVARLAY-BE/internal/modules/tag/dto"
+)
+
 type GetHistoriesQuery struct {
 	Page  int `json:"page" validate:"min=0"`
 	Limit int `json:"limit" validate:"min=1,max=100"`
 }
+
+type HistoryFilter struct {
+	Voice       string      `json:"voice"`
+	Language    string      `json:"language"`
+	Search      string      `json:"search"`
+	CreatedFrom *time.Time  `json:"createdFrom"`
+	CreatedTo   *time.Time  `json:"createdTo"`
+	TagIDs      []uuid.UUID `json:"tagIds"`
+	FolderID    *uuid.UUID  `json:"folderId"`
+	Favorite    *bool       `json:"favorite"`
+	Played      *bool       `json:"played"`
+	MinPlays    int         `json:"minPlays"`
+	PlayedFrom  *time.Time  `json:"playedFrom"`
+}
+
+// IsEmpty reports whether no criterion is set.
+func (f *HistoryFilter) IsEmpty() bool {
+	return f.Voice == "" && f.Language == "" && f.Search == "" && f.CreatedFrom == nil && f.CreatedTo == nil &&
+		len(f.TagIDs) == 0 && f.FolderID == nil && f.Favorite == nil &&
+		f.Played == nil && f.MinPlays == 0 && f.PlayedFrom == nil
+}
+
+// HistorySort is the order of the history list.
+type HistorySort string
+
+const (
+	// SortUpdated lists pinned histories first, then the most recently updated.
+	SortUpdated        HistorySort = "updated"
+	SortCreated        HistorySort = "created"
+	SortMostPlayed     HistorySort = "mostPlayed"
+	SortRecentlyPlayed HistorySort = "recentlyPlayed"
+)
+
+// ParseHistorySort returns SortUpdated for an empty value and false for an
+// unknown one.
+func ParseHistorySort(v string) (HistorySort, bool) {
+	switch s := HistorySort(v); s {
+	case "":
+		return SortUpdated, true
+	case SortUpdated, SortCreated, SortMostPlayed, SortRecentlyPlayed:
+		return s, true
+	default:
+		return "", false
+	}
+}
+
+type HistoryListMeta struct {
+	TagCounts []dtoTag.TagCount `json:"tagCounts"`
+}
@@ -0,0 +1,62 @@
+package dtoHistory
+
+import (
+	"github.com/google/uuid"
+	filter "github.com/kiminodare/HOVARLAY-BE/internal/moderation"
+)
+
+// MaxImportRows membatasi jumlah baris dalam satu file import.
+const MaxImportRows = 10000
+
+// DuplicateMode decides what happens to an imported row whose text and voice
+// match an existing history or an earlier row of the same file.
+type DuplicateMode string
+
+const (
+	DuplicateSkip      DuplicateMode = "skip"
+	DuplicateOverwrite DuplicateMode = "overwrite"
+	DuplicateKeepBoth  DuplicateMode = "keep"
+)
+
+func ParseDuplicateMode(v string) (DuplicateMode, bool) {
+	switch m := DuplicateMode(v); m {
+	case "":
+		return DuplicateSkip, true
+	case DuplicateSkip, DuplicateOverwrite, DuplicateKeepBoth:
+		return m, true
+	default:
+		return "", false
+	}
+}
+
+// ImportRow is one parsed row of an import file. ParseErrors holds problems
+// found before validation, such as a rate that is not a number.
+type ImportRow struct {
+	Row         int
+	Request     CreateHistoryRequest
+	ParseErrors []string
+}
+
+const (
+	ImportCreated = "created"
+	ImportUpdated = "updated"
+	ImportSkipped = "skipped"
+	ImportInvalid = "invalid"
+)
+
+type ImportRowResult struct {
+	Row        int            `json:"row"`
+	Status     string         `json:"status"`
+	ID         *uuid.UUID     `json:"id,omitempty"`
+	Errors     []string       `json:"errors,omitempty"`
+	Moderation *filter.Result `json:"moderation,omitempty"`
+}
+
+type ImportResult struct {
+	DryRun  bool              `json:"dryRun"`
+	Created int               `json:"created"`
+	Updated int               `json:"updated"`
+	Skipped int               `json:"skipped"`
+	Invalid int               `json:"invalid"`
+	Rows    []ImportRowResult `json:"rows"`
+}
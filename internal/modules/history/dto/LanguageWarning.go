@@ -0,0 +1,25 @@
+package dtoHistory
+
+import filter "github.com/kiminodare/HOVARLAY-BE/internal/moderation"
+
+// WarningLanguageMismatch is the code of a LanguageWarning.
+const WarningLanguageMismatch = "language_mismatch"
+
+// LanguageWarning reports that the text of a history looks like a different
+// language than its voice speaks. SuggestedVoices lists voices of the
+// detected language, if the catalog has any.
+type LanguageWarning struct {
+	Code             string   `json:"code"`
+	Message          string   `json:"message"`
+	DetectedLanguage string   `json:"detectedLanguage"`
+	Confidence       float64  `json:"confidence"`
+	VoiceLanguage    string   `json:"voiceLanguage"`
+	SuggestedVoices  []string `json:"suggestedVoices"`
+}
+
+// HistoryMeta accompanies a created or updated history. Moderation is set
+// when the moderation filter changed the text.
+type HistoryMeta struct {
+	Warnings   []LanguageWarning `json:"warnings,omitempty"`
+	Moderation *filter.Result    `json:"moderation,omitempty"`
+}
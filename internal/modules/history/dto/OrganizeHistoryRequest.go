@@ -0,0 +1,27 @@
+package dtoHistory
+
+import "github.com/google/uuid"
+
+type SetHistoryTagsRequest struct {
+	TagIDs []uuid.UUID `json:"tagIds" validate:"max=50"`
+}
+
+func (r *SetHistoryTagsRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+// MoveHistoryRequest moves a history into a folder; a null folderId moves it
+// back to the root.
+type MoveHistoryRequest struct {
+	FolderID *uuid.UUID `json:"folderId"`
+}
+
+// ReorderPinsRequest lists the pinned histories in their new order. Histories
+// that are pinned but missing from IDs are unpinned.
+type ReorderPinsRequest struct {
+	IDs []uuid.UUID `json:"ids" validate:"max=100"`
+}
+
+func (r *ReorderPinsRequest) Validate() error {
+	return validate.Struct(r)
+}
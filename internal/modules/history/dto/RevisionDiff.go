@@ -0,0 +1,39 @@
+package dtoHistory
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RevisionSnapshot describes one side of a diff. Version 0 with a nil ID
+// refers to the current state of the history.
+type RevisionSnapshot struct {
+	ID        *uuid.UUID `json:"id,omitempty"`
+	Version   int        `json:"version"`
+	Text      string     `json:"text"`
+	Format    string     `json:"format"`
+	Voice     string     `json:"voice"`
+	Rate      float64    `json:"rate"`
+	Pitch     float64    `json:"pitch"`
+	Volume    float64    `json:"volume"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+type FieldChange struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
+
+type DiffSegment struct {
+	Op   string `json:"op"` // equal, insert atau delete
+	Text string `json:"text"`
+}
+
+type RevisionDiff struct {
+	From     RevisionSnapshot `json:"from"`
+	To       RevisionSnapshot `json:"to"`
+	Changes  []FieldChange    `json:"changes"`
+	TextDiff []DiffSegment    `json:"textDiff,omitempty"`
+}
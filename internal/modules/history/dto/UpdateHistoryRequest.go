@@ -7,7 +7,9 @@ func init() {
 }
 
 type UpdateHistoryRequest struct {
-	Text   string  `json:"text" validate:"min=1"`
+	Text string `json:"text" validate:"min=1"`
+	// Format is plain when omitted, like every other field of a full update.
+	Format string  `json:"format" validate:"omitempty,oneof=plain ssml"`
 	Voice  string  `json:"voice" validate:"required"`
 	Rate   float64 `json:"rate" validate:"min=0.1,max=5"`
 	Pitch  float64 `json:"pitch" validate:"min=0,max=2"`
@@ -15,9 +17,12 @@ type UpdateHistoryRequest struct {
 }
 
 func (r *UpdateHistoryRequest) Validate() error {
-	return validate.Struct(r)
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+	return validateText(r.Text, r.Format)
 }
 
 func ValidateUpdateHistoryRequest(req *UpdateHistoryRequest) error {
-	return validate.Struct(req)
+	return req.Validate()
 }
@@ -0,0 +1,248 @@
+package history
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	dtoHistory "github.com/kiminodare/HOVARLAY-BE/internal/modules/history/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/lexicon"
+	"github.com/kiminodare/HOVARLAY-BE/internal/ssml"
+)
+
+// exportBatchSize adalah jumlah baris yang dibaca per query saat export.
+const exportBatchSize = 500
+
+type ExportFormat string
+
+const (
+	ExportJSON ExportFormat = "json"
+	ExportCSV  ExportFormat = "csv"
+	ExportSSML ExportFormat = "ssml"
+)
+
+// csvHeader is shared with the importer so exported files can be imported back.
+var csvHeader = []string{"id", "text", "format", "voice", "rate", "pitch", "volume", "isFavorite", "playCount", "tags", "createdAt", "updatedAt"}
+
+func ParseExportFormat(v string) (ExportFormat, bool) {
+	switch f := ExportFormat(strings.ToLower(v)); f {
+	case "":
+		return ExportJSON, true
+	case ExportJSON, ExportCSV, ExportSSML:
+		return f, true
+	default:
+		return "", false
+	}
+}
+
+func (f ExportFormat) ContentType() string {
+	switch f {
+	case ExportCSV:
+		return "text/csv; charset=utf-8"
+	case ExportSSML:
+		return "application/ssml+xml; charset=utf-8"
+	default:
+		return "application/json; charset=utf-8"
+	}
+}
+
+func (f ExportFormat) Extension() string {
+	if f == ExportSSML {
+		return "ssml"
+	}
+	return string(f)
+}
+
+// exporter writes histories one at a time so exports never hold the whole
+// result set in memory.
+type exporter interface {
+	begin() error
+	write(h *generated.History) error
+	end() error
+}
+
+// newExporter returns the exporter of format. SSML exports apply lex, the
+// lexicon of the user, which may be nil.
+func newExporter(format ExportFormat, w *bufio.Writer, lex *lexicon.Rewriter) exporter {
+	switch format {
+	case ExportCSV:
+		return &csvExporter{w: csv.NewWriter(w)}
+	case ExportSSML:
+		return &ssmlExporter{w: w, lexicon: lex}
+	default:
+		return &jsonExporter{w: w}
+	}
+}
+
+func exportRecord(h *generated.History) dtoHistory.ExportRecord {
+	tags := make([]string, 0, len(h.Edges.Tags))
+	for _, t := range h.Edges.Tags {
+		tags = append(tags, t.Name)
+	}
+	return dtoHistory.ExportRecord{
+		ID:         h.ID.String(),
+		Text:       h.Text,
+		Format:     string(h.Format),
+		Voice:      h.Voice,
+		Rate:       h.Rate,
+		Pitch:      h.Pitch,
+		Volume:     h.Volume,
+		IsFavorite: h.IsFavorite,
+		PlayCount:  h.PlayCount,
+		Tags:       tags,
+		CreatedAt:  h.CreatedAt,
+		UpdatedAt:  h.UpdatedAt,
+	}
+}
+
+type jsonExporter struct {
+	w     *bufio.Writer
+	count int
+}
+
+func (e *jsonExporter) begin() error {
+	_, err := e.w.WriteString("[")
+	return err
+}
+
+func (e *jsonExporter) write(h *generated.History) error {
+	if e.count > 0 {
+		if _, err := e.w.WriteString(","); err != nil {
+			return err
+		}
+	}
+	e.count++
+
+	b, err := json.Marshal(exportRecord(h))
+	if err != nil {
+		return err
+	}
+	_, err = e.w.Write(b)
+	return err
+}
+
+func (e *jsonExporter) end() error {
+	_, err := e.w.WriteString("]\n")
+	return err
+}
+
+type csvExporter struct {
+	w *csv.Writer
+}
+
+func (e *csvExporter) begin() error {
+	return e.w.Write(csvHeader)
+}
+
+func (e *csvExporter) write(h *generated.History) error {
+	r := exportRecord(h)
+	return e.w.Write([]string{
+		r.ID,
+		r.Text,
+		r.Format,
+		r.Voice,
+		strconv.FormatFloat(r.Rate, 'f', -1, 64),
+		strconv.FormatFloat(r.Pitch, 'f', -1, 64),
+		strconv.FormatFloat(r.Volume, 'f', -1, 64),
+		strconv.FormatBool(r.IsFavorite),
+		strconv.Itoa(r.PlayCount),
+		strings.Join(r.Tags, ";"),
+		r.CreatedAt.Format(time.RFC3339),
+		r.UpdatedAt.Format(time.RFC3339),
+	})
+}
+
+func (e *csvExporter) end() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type ssmlExporter struct {
+	w       *bufio.Writer
+	lexicon *lexicon.Rewriter
+}
+
+func (e *ssmlExporter) begin() error {
+	_, err := e.w.WriteString(xml.Header +
+		`<speak version="1.1" xmlns="http://www.w3.org/2001/10/synthesis">` + "\n")
+	return err
+}
+
+func (e *ssmlExporter) write(h *generated.History) error {
+	if _, err := fmt.Fprintf(e.w, `  <voice name="%s"><prosody rate="%s" pitch="%s" volume="%s">`,
+		xmlAttr(h.Voice), SSMLRate(h.Rate), SSMLPitch(h.Pitch), SSMLVolume(h.Volume)); err != nil {
+		return err
+	}
+	if _, err := e.w.WriteString(ssmlBody(h, e.lexicon)); err != nil {
+		return err
+	}
+	_, err := e.w.WriteString("</prosody></voice>\n  <break time=\"500ms\"/>\n")
+	return err
+}
+
+// ssmlBody returns the content of a history as SSML markup, with the words
+// of lex marked as <sub> or <phoneme>. SSML histories are embedded without
+// their own <speak> element, keeping its language.
+func ssmlBody(h *generated.History, lex *lexicon.Rewriter) string {
+	if h.Format != history.FormatSsml {
+		root := &ssml.Node{Name: "speak", Children: []*ssml.Node{{Text: h.Text}}}
+		lex.ApplyNode(root)
+		return root.InnerXML()
+	}
+	root, err := ssml.Parse(h.Text)
+	if err != nil {
+		return ssml.Escape(h.PlainText)
+	}
+	lex.ApplyNode(root)
+	if lang, ok := root.Attrs["xml:lang"]; ok {
+		return `<lang xml:lang="` + xmlAttr(lang) + `">` + root.InnerXML() + "</lang>"
+	}
+	return root.InnerXML()
+}
+
+func (e *ssmlExporter) end() error {
+	_, err := e.w.WriteString("</speak>\n")
+	return err
+}
+
+// SSMLRate converts a rate multiplier (1 = normal speed) to a percentage.
+func SSMLRate(rate float64) string {
+	return strconv.FormatFloat(math.Round(rate*100), 'f', -1, 64) + "%"
+}
+
+// SSMLPitch converts a pitch multiplier (1 = default pitch) to a relative
+// percentage change.
+func SSMLPitch(pitch float64) string {
+	change := math.Round((pitch - 1) * 100)
+	if change >= 0 {
+		return "+" + strconv.FormatFloat(change, 'f', -1, 64) + "%"
+	}
+	return strconv.FormatFloat(change, 'f', -1, 64) + "%"
+}
+
+// SSMLVolume converts a linear volume between 0 and 1 to a relative change in
+// decibels, or "silent" for zero.
+func SSMLVolume(volume float64) string {
+	if volume <= 0 {
+		return "silent"
+	}
+	db := math.Round(20*math.Log10(volume)*10) / 10
+	if db >= 0 {
+		return "+" + strconv.FormatFloat(db, 'f', -1, 64) + "dB"
+	}
+	return strconv.FormatFloat(db, 'f', -1, 64) + "dB"
+}
+
+func xmlAttr(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -1,10 +1,23 @@
 package history
 
 import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
 	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/internal/langid"
 	"github.com/kiminodare/HOVARLAY-BE/internal/middleware"
+	filter "github.com/kiminodare/HOVARLAY-BE/internal/moderation"
 	dtoHistory "github.com/kiminodare/HOVARLAY-BE/internal/modules/history/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/quota"
 	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
 )
 
@@ -37,37 +50,50 @@ func (h *Handler) Create(c *fiber.Ctx) error {
 		return middleware.Error(c, "Invalid user ID format", fiber.StatusBadRequest)
 	}
 
-	history, err := h.service.Create(
-		c.Context(),
-		userID,
-		req.Text,
-		req.Voice,
-		req.Rate,
-		req.Pitch,
-		req.Volume,
-	)
+	history, screened, err := h.service.Create(c.Context(), userID, &req)
 
 	if err != nil {
+		if errors.Is(err, utils.ErrPresetNotFound) {
+			return middleware.Error(c, "Preset not found", fiber.StatusNotFound)
+		}
+		if msgs := ValidationMessages(err); msgs != nil {
+			return middleware.ValidationError(c, msgs)
+		}
+		if status := quota.Status(err); status != 0 {
+			return middleware.Error(c, quota.Message(err), status)
+		}
 		return middleware.Error(c, "Failed to create history", fiber.StatusInternalServerError)
 	}
 
+	if meta := h.historyMeta(c, history, screened); meta != nil {
+		return middleware.SuccessWithMeta(c, history, "History created successfully", nil, meta)
+	}
 	return middleware.Success(c, history, "History created successfully", nil)
 }
 
-func (h *Handler) GetByUser(c *fiber.Ctx) error {
-	userIDStr := c.Locals("user_id").(string)
-	var query dtoHistory.GetHistoriesQuery
-	if err := c.QueryParser(&query); err != nil {
-		query.Page = 1
-		query.Limit = 10
+// historyMeta returns the language warnings of history and the moderation
+// result of its text as response meta, or nil when there is nothing to
+// report. Warnings are advisory, so failing to compute them is only logged.
+func (h *Handler) historyMeta(c *fiber.Ctx, history *generated.History, screened *filter.Result) *dtoHistory.HistoryMeta {
+	meta := &dtoHistory.HistoryMeta{}
+	if screened != nil && screened.Action != "" {
+		meta.Moderation = screened
 	}
-
-	if query.Page < 1 {
-		query.Page = 1
+	warnings, err := h.service.LanguageWarnings(c.Context(), history)
+	if err != nil {
+		log.Errorf("failed to check language of history %s: %v", history.ID, err)
+	} else {
+		meta.Warnings = warnings
 	}
-	if query.Limit <= 0 {
-		query.Limit = 10
+	if len(meta.Warnings) == 0 && meta.Moderation == nil {
+		return nil
 	}
+	return meta
+}
+
+func (h *Handler) GetByUser(c *fiber.Ctx) error {
+	userIDStr := c.Locals("user_id").(string)
+	query := paginationQuery(c)
 
 	if userIDStr == "" {
 		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
@@ -78,27 +104,40 @@ func (h *Handler) GetByUser(c *fiber.Ctx) error {
 		return middleware.Error(c, "Invalid user ID format", fiber.StatusBadRequest)
 	}
 
+	filter, err := historyFilterFromQuery(c)
+	if err != nil {
+		return middleware.Error(c, err.Error(), fiber.StatusBadRequest)
+	}
+
+	sort, ok := dtoHistory.ParseHistorySort(c.Query("sort"))
+	if !ok {
+		return middleware.Error(c, "Invalid sort", fiber.StatusBadRequest)
+	}
+
 	offset := (query.Page - 1) * query.Limit
 
-	histories, err := h.service.GetByUser(c.Context(), userID, offset, query.Limit)
+	histories, err := h.service.GetByUser(c.Context(), userID, filter, sort, offset, query.Limit)
+	if err != nil {
+		return middleware.Error(c, "Failed to fetch history", fiber.StatusInternalServerError)
+	}
+
+	total, err := h.service.CountByUser(c.Context(), userID, filter)
 	if err != nil {
 		return middleware.Error(c, "Failed to fetch history", fiber.StatusInternalServerError)
 	}
 
-	// Optional: hitung total, misal dari repo count
-	total, _ := h.service.CountByUser(c.Context(), userID)
+	meta, err := h.service.ListMeta(c.Context(), userID, filter)
+	if err != nil {
+		return middleware.Error(c, "Failed to fetch history", fiber.StatusInternalServerError)
+	}
 
-	// Buat pagination struct
-	var pagination *middleware.Pagination
-	if total >= 0 {
-		pagination = &middleware.Pagination{
-			Page:  query.Page,
-			Limit: query.Limit,
-			Total: total,
-		}
+	pagination := &middleware.Pagination{
+		Page:  query.Page,
+		Limit: query.Limit,
+		Total: total,
 	}
 
-	return middleware.Success(c, histories, "History fetched successfully", pagination)
+	return middleware.SuccessWithMeta(c, histories, "History fetched successfully", pagination, meta)
 }
 
 func (h *Handler) GetByID(c *fiber.Ctx) error {
@@ -107,8 +146,16 @@ func (h *Handler) GetByID(c *fiber.Ctx) error {
 		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
 	}
 
-	history, err := h.service.GetByID(c.Context(), id)
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	history, err := h.service.GetOwned(c.Context(), userID, id)
 	if err != nil {
+		if errors.Is(err, utils.ErrHistoryNotFound) {
+			return middleware.Error(c, "History not found", fiber.StatusNotFound)
+		}
 		return middleware.Error(c, "Failed to fetch history", fiber.StatusInternalServerError)
 	}
 
@@ -130,11 +177,30 @@ func (h *Handler) Update(c *fiber.Ctx) error {
 		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
 	}
 
-	err = h.service.Update(c.Context(), id, req.Text, req.Voice, req.Rate, req.Pitch, req.Volume)
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	screened, err := h.service.Update(c.Context(), userID, id, &req)
 	if err != nil {
+		if errors.Is(err, utils.ErrHistoryNotFound) {
+			return middleware.Error(c, "History not found", fiber.StatusNotFound)
+		}
+		if msgs := ValidationMessages(err); msgs != nil {
+			return middleware.ValidationError(c, msgs)
+		}
+		if status := quota.Status(err); status != 0 {
+			return middleware.Error(c, quota.Message(err), status)
+		}
 		return middleware.Error(c, "Failed to update history", fiber.StatusInternalServerError)
 	}
 
+	if updated, err := h.service.GetOwned(c.Context(), userID, id); err == nil {
+		if meta := h.historyMeta(c, updated, screened); meta != nil {
+			return middleware.SuccessWithMeta(c, nil, "History updated successfully", nil, meta)
+		}
+	}
 	return middleware.Success(c, nil, "History updated successfully", nil)
 }
 
@@ -144,10 +210,715 @@ func (h *Handler) Delete(c *fiber.Ctx) error {
 		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
 	}
 
-	err = h.service.Delete(c.Context(), id)
-	if err != nil {
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	if err := h.service.Delete(c.Context(), userID, id); err != nil {
+		if errors.Is(err, utils.ErrHistoryNotFound) {
+			return middleware.Error(c, "History not found", fiber.StatusNotFound)
+		}
 		return middleware.Error(c, "Failed to delete history", fiber.StatusInternalServerError)
 	}
 
 	return middleware.Success(c, nil, "History deleted successfully", nil)
 }
+
+func (h *Handler) BulkCreate(c *fiber.Ctx) error {
+	var req dtoHistory.BulkCreateHistoryRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	result, err := h.service.BulkCreate(c.Context(), userID, req.Items)
+	if err != nil {
+		if status := quota.Status(err); status != 0 {
+			return middleware.Error(c, quota.Message(err), status)
+		}
+		return middleware.Error(c, "Failed to create histories", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, result, "Histories created successfully", nil)
+}
+
+func (h *Handler) BulkDelete(c *fiber.Ctx) error {
+	var req dtoHistory.BulkDeleteHistoryRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	result, err := h.service.BulkDelete(c.Context(), userID, &req)
+	if err != nil {
+		return middleware.Error(c, "Failed to delete histories", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, result, "Histories deleted successfully", nil)
+}
+
+func (h *Handler) BulkUpdate(c *fiber.Ctx) error {
+	var req dtoHistory.BulkUpdateHistoryRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	result, err := h.service.BulkUpdate(c.Context(), userID, &req)
+	if err != nil {
+		if status := quota.Status(err); status != 0 {
+			return middleware.Error(c, quota.Message(err), status)
+		}
+		return middleware.Error(c, "Failed to update histories", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, result, "Histories updated successfully", nil)
+}
+
+func (h *Handler) GetTrash(c *fiber.Ctx) error {
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	query := paginationQuery(c)
+	offset := (query.Page - 1) * query.Limit
+
+	histories, err := h.service.GetTrashByUser(c.Context(), userID, offset, query.Limit)
+	if err != nil {
+		return middleware.Error(c, "Failed to fetch trash", fiber.StatusInternalServerError)
+	}
+
+	total, err := h.service.CountTrashByUser(c.Context(), userID)
+	if err != nil {
+		return middleware.Error(c, "Failed to fetch trash", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, histories, "Trash fetched successfully", &middleware.Pagination{
+		Page:  query.Page,
+		Limit: query.Limit,
+		Total: total,
+	})
+}
+
+func (h *Handler) Restore(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	if err := h.service.Restore(c.Context(), userID, id); err != nil {
+		if errors.Is(err, utils.ErrHistoryNotFound) {
+			return middleware.Error(c, "History not found in trash", fiber.StatusNotFound)
+		}
+		return middleware.Error(c, "Failed to restore history", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, nil, "History restored successfully", nil)
+}
+
+func (h *Handler) DeletePermanent(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	if err := h.service.DeletePermanent(c.Context(), userID, id); err != nil {
+		if errors.Is(err, utils.ErrHistoryNotFound) {
+			return middleware.Error(c, "History not found", fiber.StatusNotFound)
+		}
+		return middleware.Error(c, "Failed to delete history", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, nil, "History permanently deleted", nil)
+}
+
+func (h *Handler) EmptyTrash(c *fiber.Ctx) error {
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	deleted, err := h.service.EmptyTrash(c.Context(), userID)
+	if err != nil {
+		return middleware.Error(c, "Failed to empty trash", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, &dtoHistory.BulkResult{Succeeded: deleted}, "Trash emptied successfully", nil)
+}
+
+func (h *Handler) GetRevisions(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	revisions, err := h.service.GetRevisions(c.Context(), userID, id)
+	if err != nil {
+		if errors.Is(err, utils.ErrHistoryNotFound) {
+			return middleware.Error(c, "History not found", fiber.StatusNotFound)
+		}
+		return middleware.Error(c, "Failed to fetch revisions", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, revisions, "Revisions fetched successfully", nil)
+}
+
+// DiffRevisions compares ?from= and ?to= revision IDs. Either may be omitted
+// or set to "current" to compare against the current state.
+func (h *Handler) DiffRevisions(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	fromID, err := revisionParam(c.Query("from"))
+	if err != nil {
+		return middleware.Error(c, "Invalid from revision ID", fiber.StatusBadRequest)
+	}
+	toID, err := revisionParam(c.Query("to"))
+	if err != nil {
+		return middleware.Error(c, "Invalid to revision ID", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	diff, err := h.service.DiffRevisions(c.Context(), userID, id, fromID, toID)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrHistoryNotFound):
+			return middleware.Error(c, "History not found", fiber.StatusNotFound)
+		case errors.Is(err, utils.ErrRevisionNotFound):
+			return middleware.Error(c, "Revision not found", fiber.StatusNotFound)
+		}
+		return middleware.Error(c, "Failed to diff revisions", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, diff, "Revisions compared successfully", nil)
+}
+
+func (h *Handler) RevertRevision(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	revisionID, err := uuid.Parse(c.Params("revisionId"))
+	if err != nil {
+		return middleware.Error(c, "Invalid revision ID format", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	history, screened, err := h.service.Revert(c.Context(), userID, id, revisionID)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrHistoryNotFound):
+			return middleware.Error(c, "History not found", fiber.StatusNotFound)
+		case errors.Is(err, utils.ErrRevisionNotFound):
+			return middleware.Error(c, "Revision not found", fiber.StatusNotFound)
+		}
+		if msgs := ValidationMessages(err); msgs != nil {
+			return middleware.ValidationError(c, msgs)
+		}
+		if status := quota.Status(err); status != 0 {
+			return middleware.Error(c, quota.Message(err), status)
+		}
+		return middleware.Error(c, "Failed to revert history", fiber.StatusInternalServerError)
+	}
+
+	if screened != nil && screened.Action != "" {
+		return middleware.SuccessWithMeta(c, history, "History reverted successfully", nil, &dtoHistory.HistoryMeta{Moderation: screened})
+	}
+	return middleware.Success(c, history, "History reverted successfully", nil)
+}
+
+func revisionParam(v string) (*uuid.UUID, error) {
+	if v == "" || v == "current" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(v)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func (h *Handler) SetTags(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	var req dtoHistory.SetHistoryTagsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	history, err := h.service.SetTags(c.Context(), userID, id, req.TagIDs)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrHistoryNotFound):
+			return middleware.Error(c, "History not found", fiber.StatusNotFound)
+		case errors.Is(err, utils.ErrTagNotFound):
+			return middleware.Error(c, "Tag not found", fiber.StatusNotFound)
+		}
+		return middleware.Error(c, "Failed to update history tags", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, history, "History tags updated successfully", nil)
+}
+
+func (h *Handler) MoveToFolder(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	var req dtoHistory.MoveHistoryRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	history, err := h.service.MoveToFolder(c.Context(), userID, id, req.FolderID)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrHistoryNotFound):
+			return middleware.Error(c, "History not found", fiber.StatusNotFound)
+		case errors.Is(err, utils.ErrFolderNotFound):
+			return middleware.Error(c, "Folder not found", fiber.StatusNotFound)
+		}
+		return middleware.Error(c, "Failed to move history", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, history, "History moved successfully", nil)
+}
+
+func (h *Handler) Favorite(c *fiber.Ctx) error {
+	return h.setFavorite(c, true)
+}
+
+func (h *Handler) Unfavorite(c *fiber.Ctx) error {
+	return h.setFavorite(c, false)
+}
+
+func (h *Handler) setFavorite(c *fiber.Ctx, favorite bool) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	if err := h.service.SetFavorite(c.Context(), userID, id, favorite); err != nil {
+		if errors.Is(err, utils.ErrHistoryNotFound) {
+			return middleware.Error(c, "History not found", fiber.StatusNotFound)
+		}
+		return middleware.Error(c, "Failed to update favorite", fiber.StatusInternalServerError)
+	}
+
+	if favorite {
+		return middleware.Success(c, nil, "History added to favorites", nil)
+	}
+	return middleware.Success(c, nil, "History removed from favorites", nil)
+}
+
+func (h *Handler) Pin(c *fiber.Ctx) error {
+	return h.setPinned(c, true)
+}
+
+func (h *Handler) Unpin(c *fiber.Ctx) error {
+	return h.setPinned(c, false)
+}
+
+func (h *Handler) setPinned(c *fiber.Ctx, pinned bool) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	if pinned {
+		err = h.service.Pin(c.Context(), userID, id)
+	} else {
+		err = h.service.Unpin(c.Context(), userID, id)
+	}
+	if err != nil {
+		if errors.Is(err, utils.ErrHistoryNotFound) {
+			return middleware.Error(c, "History not found", fiber.StatusNotFound)
+		}
+		return middleware.Error(c, "Failed to update pin", fiber.StatusInternalServerError)
+	}
+
+	if pinned {
+		return middleware.Success(c, nil, "History pinned successfully", nil)
+	}
+	return middleware.Success(c, nil, "History unpinned successfully", nil)
+}
+
+func (h *Handler) ReorderPins(c *fiber.Ctx) error {
+	var req dtoHistory.ReorderPinsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	if err := h.service.ReorderPins(c.Context(), userID, req.IDs); err != nil {
+		if errors.Is(err, utils.ErrHistoryNotFound) {
+			return middleware.Error(c, "History not found", fiber.StatusNotFound)
+		}
+		return middleware.Error(c, "Failed to reorder pins", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, nil, "Pins reordered successfully", nil)
+}
+
+func (h *Handler) MarkPlayed(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	history, err := h.service.MarkPlayed(c.Context(), userID, id)
+	if err != nil {
+		if errors.Is(err, utils.ErrHistoryNotFound) {
+			return middleware.Error(c, "History not found", fiber.StatusNotFound)
+		}
+		return middleware.Error(c, "Failed to record playback", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, history, "Playback recorded successfully", nil)
+}
+
+// Export streams the histories matching the list filters as
+// ?format=json|csv|ssml. The response is written after the handler returns,
+// so errors past this point can only be logged.
+func (h *Handler) Export(c *fiber.Ctx) error {
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	format, ok := ParseExportFormat(c.Query("format"))
+	if !ok {
+		return middleware.Error(c, "Format must be json, csv or ssml", fiber.StatusBadRequest)
+	}
+
+	filter, err := historyFilterFromQuery(c)
+	if err != nil {
+		return middleware.Error(c, err.Error(), fiber.StatusBadRequest)
+	}
+
+	filename := fmt.Sprintf("histories-%s.%s", time.Now().Format("20060102-150405"), format.Extension())
+	c.Attachment(filename)
+	c.Set(fiber.HeaderContentType, format.ContentType())
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		if err := h.service.Export(context.Background(), userID, filter, format, w); err != nil {
+			log.Errorf("failed to export histories for user %s: %v", userID, err)
+		}
+	})
+	return nil
+}
+
+// Import reads a multipart upload with the file in the "file" field. Optional
+// form fields: format (json or csv, defaults to the file extension), dryRun
+// and duplicates (skip, overwrite or keep).
+func (h *Handler) Import(c *fiber.Ctx) error {
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return middleware.Error(c, "File is required", fiber.StatusBadRequest)
+	}
+
+	format, err := importFormat(c.FormValue("format"), fileHeader.Filename)
+	if err != nil {
+		return middleware.Error(c, "Format must be json or csv", fiber.StatusBadRequest)
+	}
+
+	mode, ok := dtoHistory.ParseDuplicateMode(c.FormValue("duplicates"))
+	if !ok {
+		return middleware.Error(c, "Duplicates must be skip, overwrite or keep", fiber.StatusBadRequest)
+	}
+
+	dryRun := false
+	if v := c.FormValue("dryRun"); v != "" {
+		if dryRun, err = strconv.ParseBool(v); err != nil {
+			return middleware.Error(c, "dryRun must be true or false", fiber.StatusBadRequest)
+		}
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return middleware.Error(c, "Failed to read file", fiber.StatusBadRequest)
+	}
+	defer file.Close()
+
+	rows, err := parseImport(format, file)
+	if err != nil {
+		return middleware.Error(c, "Failed to parse file: "+err.Error(), fiber.StatusBadRequest)
+	}
+
+	result, err := h.service.Import(c.Context(), userID, rows, mode, dryRun)
+	if err != nil {
+		if status := quota.Status(err); status != 0 {
+			return middleware.Error(c, quota.Message(err), status)
+		}
+		return middleware.Error(c, "Failed to import histories", fiber.StatusInternalServerError)
+	}
+
+	if dryRun {
+		return middleware.Success(c, result, "Import checked successfully", nil)
+	}
+	return middleware.Success(c, result, "Histories imported successfully", nil)
+}
+
+func (h *Handler) GetDuplicates(c *fiber.Ctx) error {
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	groups, err := h.service.GetDuplicates(c.Context(), userID)
+	if err != nil {
+		return middleware.Error(c, "Failed to fetch duplicates", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, groups, "Duplicates fetched successfully", nil)
+}
+
+func (h *Handler) MergeDuplicates(c *fiber.Ctx) error {
+	var req dtoHistory.MergeDuplicatesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	history, err := h.service.MergeDuplicates(c.Context(), userID, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrHistoryNotFound):
+			return middleware.Error(c, "History not found", fiber.StatusNotFound)
+		case errors.Is(err, utils.ErrNotDuplicate):
+			return middleware.Error(c, "Histories are not duplicates of each other", fiber.StatusUnprocessableEntity)
+		}
+		return middleware.Error(c, "Failed to merge duplicates", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, history, "Duplicates merged successfully", nil)
+}
+
+// historyFilterFromQuery reads the list filters: voice, search, createdFrom
+// createdTo and playedFrom (RFC 3339), tag (repeatable or comma separated),
+// folderId, favorite, played and minPlays.
+func historyFilterFromQuery(c *fiber.Ctx) (*dtoHistory.HistoryFilter, error) {
+	filter := &dtoHistory.HistoryFilter{
+		Voice:    c.Query("voice"),
+		Language: langid.Primary(c.Query("language")),
+		Search:   c.Query("search"),
+	}
+	if filter.Language != "" && !isLanguageSubtag(filter.Language) {
+		return nil, fmt.Errorf("language must be a BCP 47 language tag")
+	}
+
+	for _, p := range []struct {
+		name string
+		dst  **time.Time
+	}{
+		{"createdFrom", &filter.CreatedFrom},
+		{"createdTo", &filter.CreatedTo},
+		{"playedFrom", &filter.PlayedFrom},
+	} {
+		v := c.Query(p.name)
+		if v == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be an RFC 3339 timestamp", p.name)
+		}
+		*p.dst = &t
+	}
+
+	for _, v := range queryValues(c, "tag") {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag ID %q", v)
+		}
+		filter.TagIDs = append(filter.TagIDs, id)
+	}
+
+	if v := c.Query("folderId"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid folder ID %q", v)
+		}
+		filter.FolderID = &id
+	}
+
+	if v := c.Query("favorite"); v != "" {
+		favorite, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("favorite must be true or false")
+		}
+		filter.Favorite = &favorite
+	}
+
+	if v := c.Query("played"); v != "" {
+		played, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("played must be true or false")
+		}
+		filter.Played = &played
+	}
+
+	if v := c.Query("minPlays"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("minPlays must be a non-negative integer")
+		}
+		filter.MinPlays = n
+	}
+
+	return filter, nil
+}
+
+// queryValues returns every value of a repeatable query parameter, also
+// splitting comma separated lists.
+func queryValues(c *fiber.Ctx, key string) []string {
+	var values []string
+	for _, raw := range c.Context().QueryArgs().PeekMulti(key) {
+		for _, v := range strings.Split(string(raw), ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
+
+// isLanguageSubtag reports whether v is a primary language subtag: two or
+// three ASCII letters.
+func isLanguageSubtag(v string) bool {
+	if len(v) < 2 || len(v) > 3 {
+		return false
+	}
+	for _, r := range v {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// paginationQuery parses page and limit, falling back to page 1 and 10 items.
+func paginationQuery(c *fiber.Ctx) dtoHistory.GetHistoriesQuery {
+	var query dtoHistory.GetHistoriesQuery
+	if err := c.QueryParser(&query); err != nil {
+		query.Page = 1
+		query.Limit = 10
+	}
+
+	if query.Page < 1 {
+		query.Page = 1
+	}
+	if query.Limit <= 0 {
+		query.Limit = 10
+	}
+	if query.Limit > 100 {
+		query.Limit = 100
+	}
+	return query
+}
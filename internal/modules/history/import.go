@@ -0,0 +1,144 @@
+package history
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	dtoHistory "github.com/kiminodare/HOVARLAY-BE/internal/modules/history/dto"
+)
+
+// importBatchSize adalah jumlah baris yang diproses per query saat import.
+const importBatchSize = 100
+
+var (
+	errImportFormat   = errors.New("import file must be json or csv")
+	errImportTooLarge = fmt.Errorf("import file has more than %d rows", dtoHistory.MaxImportRows)
+)
+
+// importFormat picks the import format from an explicit value or, when that
+// is empty, from the file extension.
+func importFormat(explicit, filename string) (ExportFormat, error) {
+	v := strings.ToLower(explicit)
+	if v == "" {
+		v = strings.TrimPrefix(strings.ToLower(filepath.Ext(filename)), ".")
+	}
+	switch ExportFormat(v) {
+	case ExportJSON, ExportCSV:
+		return ExportFormat(v), nil
+	default:
+		return "", errImportFormat
+	}
+}
+
+func parseImport(format ExportFormat, r io.Reader) ([]dtoHistory.ImportRow, error) {
+	if format == ExportCSV {
+		return parseImportCSV(r)
+	}
+	return parseImportJSON(r)
+}
+
+// parseImportJSON reads an array of objects with the CreateHistoryRequest
+// fields, the same shape the JSON export produces.
+func parseImportJSON(r io.Reader) ([]dtoHistory.ImportRow, error) {
+	dec := json.NewDecoder(r)
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('[') {
+		return nil, errors.New("JSON import must be an array of histories")
+	}
+
+	var rows []dtoHistory.ImportRow
+	for dec.More() {
+		if len(rows) == dtoHistory.MaxImportRows {
+			return nil, errImportTooLarge
+		}
+
+		row := dtoHistory.ImportRow{Row: len(rows) + 1}
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, fmt.Errorf("row %d: %w", row.Row, err)
+		}
+		if err := json.Unmarshal(raw, &row.Request); err != nil {
+			row.ParseErrors = []string{err.Error()}
+		}
+		rows = append(rows, row)
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// parseImportCSV reads a CSV file with a header row. Only the text, format,
+// voice, rate, pitch and volume columns are used; other columns, like the ones the
+// CSV export adds, are ignored.
+func parseImportCSV(r io.Reader) ([]dtoHistory.ImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.New("CSV import must start with a header row")
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"text", "voice"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("CSV import is missing the %s column", name)
+		}
+	}
+
+	var rows []dtoHistory.ImportRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == dtoHistory.MaxImportRows {
+			return nil, errImportTooLarge
+		}
+
+		row := dtoHistory.ImportRow{Row: len(rows) + 1}
+		get := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return record[i]
+			}
+			return ""
+		}
+
+		row.Request.Text = get("text")
+		row.Request.Format = strings.ToLower(strings.TrimSpace(get("format")))
+		row.Request.Voice = get("voice")
+		for _, f := range []struct {
+			name string
+			dst  **float64
+		}{
+			{"rate", &row.Request.Rate},
+			{"pitch", &row.Request.Pitch},
+			{"volume", &row.Request.Volume},
+		} {
+			raw := strings.TrimSpace(get(f.name))
+			if raw == "" {
+				continue
+			}
+			v, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				row.ParseErrors = append(row.ParseErrors, fmt.Sprintf("%s must be a number", f.name))
+				continue
+			}
+			*f.dst = &v
+		}
+		rows = append(rows, row)
+	}
+}
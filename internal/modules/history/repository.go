@@ -2,8 +2,20 @@ package history
 
 import (
 	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/folder"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/historyrevision"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/playevent"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/tag"
 	user2 "github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/schema"
+	"github.com/kiminodare/HOVARLAY-BE/internal/db"
+	dtoHistory "github.com/kiminodare/HOVARLAY-BE/internal/modules/history/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
 
 	"github.com/google/uuid"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
@@ -17,27 +29,30 @@ func NewHistoryRepository(client *generated.Client) *Repository {
 	return &Repository{client: client}
 }
 
-func (r *Repository) Create(
-	ctx context.Context,
-	userID uuid.UUID,
-	text string,
-	voice string,
-	rate, pitch, volume float64,
-) (*generated.History, error) {
+func (r *Repository) Create(ctx context.Context, userID uuid.UUID, req *dtoHistory.CreateHistoryRequest) (*generated.History, error) {
 	return r.client.History.Create().
-		SetText(text).
-		SetVoice(voice).
-		SetRate(rate).
-		SetPitch(pitch).
-		SetVolume(volume).
+		SetText(req.Text).
+		SetFormat(textFormat(req.Format)).
+		SetVoice(req.Voice).
+		SetRate(*req.Rate).
+		SetPitch(*req.Pitch).
+		SetVolume(*req.Volume).
 		SetUserID(userID).
 		Save(ctx)
 }
 
-func (r *Repository) GetByUser(ctx context.Context, userID uuid.UUID, offset, limit int) ([]*generated.History, error) {
+func (r *Repository) GetByUser(
+	ctx context.Context,
+	userID uuid.UUID,
+	filter *dtoHistory.HistoryFilter,
+	sort dtoHistory.HistorySort,
+	offset, limit int,
+) ([]*generated.History, error) {
 	user, err := r.client.History.Query().
-		Where(history.HasUserWith(user2.ID(userID))).
-		Order(generated.Desc(history.FieldUpdatedAt)).
+		Where(filterPredicates(userID, filter)...).
+		WithTags().
+		WithFolder().
+		Order(sortOrder(sort)...).
 		Limit(limit).
 		Offset(offset).
 		All(ctx)
@@ -49,9 +64,9 @@ func (r *Repository) GetByUser(ctx context.Context, userID uuid.UUID, offset, li
 	return user, nil
 }
 
-func (r *Repository) CountByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+func (r *Repository) CountByUser(ctx context.Context, userID uuid.UUID, filter *dtoHistory.HistoryFilter) (int, error) {
 	return r.client.History.Query().
-		Where(history.HasUserWith(user2.ID(userID))).
+		Where(filterPredicates(userID, filter)...).
 		Count(ctx)
 }
 
@@ -59,16 +74,605 @@ func (r *Repository) GetByID(ctx context.Context, id uuid.UUID) (*generated.Hist
 	return r.client.History.Get(ctx, id)
 }
 
-func (r *Repository) Update(ctx context.Context, id uuid.UUID, text string, voice string, rate, pitch, volume float64) error {
+// Update replaces the content of a history. It must run on a repository from
+// InTx, so the revision recorded by the History hook is written atomically
+// with the update.
+func (r *Repository) Update(ctx context.Context, id uuid.UUID, req *dtoHistory.UpdateHistoryRequest) error {
 	return r.client.History.UpdateOneID(id).
-		SetText(text).
-		SetVoice(voice).
-		SetRate(rate).
-		SetPitch(pitch).
-		SetVolume(volume).
+		SetText(req.Text).
+		SetFormat(textFormat(req.Format)).
+		SetVoice(req.Voice).
+		SetRate(req.Rate).
+		SetPitch(req.Pitch).
+		SetVolume(req.Volume).
 		Exec(ctx)
 }
 
 func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
 	return r.client.History.DeleteOneID(id).Exec(ctx)
 }
+
+func (r *Repository) BulkCreate(ctx context.Context, userID uuid.UUID, items []dtoHistory.CreateHistoryRequest) ([]*generated.History, error) {
+	var created []*generated.History
+	err := r.InTx(ctx, func(txRepo *Repository) error {
+		var err error
+		created, err = txRepo.CreateMany(ctx, userID, items)
+		return err
+	})
+	return created, err
+}
+
+// CreateMany inserts items with a single bulk statement. Callers that need
+// atomicity across several calls should run it through InTx.
+func (r *Repository) CreateMany(ctx context.Context, userID uuid.UUID, items []dtoHistory.CreateHistoryRequest) ([]*generated.History, error) {
+	builders := make([]*generated.HistoryCreate, len(items))
+	for i, item := range items {
+		builders[i] = r.client.History.Create().
+			SetText(item.Text).
+			SetFormat(textFormat(item.Format)).
+			SetVoice(item.Voice).
+			SetRate(*item.Rate).
+			SetPitch(*item.Pitch).
+			SetVolume(*item.Volume).
+			SetUserID(userID)
+	}
+	return r.client.History.CreateBulk(builders...).Save(ctx)
+}
+
+// InTx runs fn with a repository bound to a new transaction.
+func (r *Repository) InTx(ctx context.Context, fn func(txRepo *Repository) error) error {
+	return db.WithTx(ctx, r.client, func(tx *generated.Tx) error {
+		return fn(&Repository{client: tx.Client()})
+	})
+}
+
+// FindByContentHashes returns the user's histories whose content hash is one
+// of hashes, so a batch needs a single query.
+func (r *Repository) FindByContentHashes(ctx context.Context, userID uuid.UUID, hashes []string) ([]*generated.History, error) {
+	return r.client.History.Query().
+		Where(history.HasUserWith(user2.ID(userID)), history.ContentHashIn(hashes...)).
+		All(ctx)
+}
+
+// BulkDeleteByIDs deletes the given histories owned by userID and returns the
+// IDs that were actually deleted.
+func (r *Repository) BulkDeleteByIDs(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) ([]uuid.UUID, error) {
+	var owned []uuid.UUID
+	err := db.WithTx(ctx, r.client, func(tx *generated.Tx) error {
+		var err error
+		owned, err = tx.History.Query().
+			Where(history.IDIn(ids...), history.HasUserWith(user2.ID(userID))).
+			IDs(ctx)
+		if err != nil || len(owned) == 0 {
+			return err
+		}
+
+		_, err = tx.History.Delete().
+			Where(history.IDIn(owned...)).
+			Exec(ctx)
+		return err
+	})
+	return owned, err
+}
+
+func (r *Repository) BulkDeleteByFilter(ctx context.Context, userID uuid.UUID, filter *dtoHistory.HistoryFilter) (int, error) {
+	var deleted int
+	err := db.WithTx(ctx, r.client, func(tx *generated.Tx) error {
+		var err error
+		deleted, err = tx.History.Delete().
+			Where(filterPredicates(userID, filter)...).
+			Exec(ctx)
+		return err
+	})
+	return deleted, err
+}
+
+// BulkUpdate applies the non-nil fields of req to the histories owned by
+// userID and returns the IDs that were updated.
+func (r *Repository) BulkUpdate(ctx context.Context, userID uuid.UUID, req *dtoHistory.BulkUpdateHistoryRequest) ([]uuid.UUID, error) {
+	var owned []uuid.UUID
+	err := db.WithTx(ctx, r.client, func(tx *generated.Tx) error {
+		var err error
+		owned, err = tx.History.Query().
+			Where(history.IDIn(req.IDs...), history.HasUserWith(user2.ID(userID))).
+			IDs(ctx)
+		if err != nil || len(owned) == 0 {
+			return err
+		}
+
+		update := tx.History.Update().Where(history.IDIn(owned...))
+		if req.Voice != nil {
+			update.SetVoice(*req.Voice)
+		}
+		if req.Rate != nil {
+			update.SetRate(*req.Rate)
+		}
+		if req.Pitch != nil {
+			update.SetPitch(*req.Pitch)
+		}
+		if req.Volume != nil {
+			update.SetVolume(*req.Volume)
+		}
+		_, err = update.Save(ctx)
+		return err
+	})
+	return owned, err
+}
+
+func (r *Repository) GetTrashByUser(ctx context.Context, userID uuid.UUID, offset, limit int) ([]*generated.History, error) {
+	return r.client.History.Query().
+		Where(history.HasUserWith(user2.ID(userID)), history.DeletedAtNotNil()).
+		Order(generated.Desc(history.FieldDeletedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(schema.SkipSoftDelete(ctx))
+}
+
+func (r *Repository) CountTrashByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	return r.client.History.Query().
+		Where(history.HasUserWith(user2.ID(userID)), history.DeletedAtNotNil()).
+		Count(schema.SkipSoftDelete(ctx))
+}
+
+func (r *Repository) Restore(ctx context.Context, userID, id uuid.UUID) error {
+	n, err := r.client.History.Update().
+		Where(
+			history.ID(id),
+			history.HasUserWith(user2.ID(userID)),
+			history.DeletedAtNotNil(),
+		).
+		ClearDeletedAt().
+		Save(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return utils.ErrHistoryNotFound
+	}
+	return nil
+}
+
+// DeletePermanent removes the history row regardless of whether it is trashed.
+func (r *Repository) DeletePermanent(ctx context.Context, userID, id uuid.UUID) error {
+	n, err := r.client.History.Delete().
+		Where(history.ID(id), history.HasUserWith(user2.ID(userID))).
+		Exec(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return utils.ErrHistoryNotFound
+	}
+	return nil
+}
+
+func (r *Repository) EmptyTrash(ctx context.Context, userID uuid.UUID) (int, error) {
+	return r.client.History.Delete().
+		Where(history.HasUserWith(user2.ID(userID)), history.DeletedAtNotNil()).
+		Exec(schema.SkipSoftDelete(ctx))
+}
+
+// PurgeTrash permanently deletes every history trashed before the given time.
+func (r *Repository) PurgeTrash(ctx context.Context, before time.Time) (int, error) {
+	return r.client.History.Delete().
+		Where(history.DeletedAtLT(before)).
+		Exec(schema.SkipSoftDelete(ctx))
+}
+
+// GetOwned returns the history only when it belongs to userID.
+func (r *Repository) GetOwned(ctx context.Context, userID, id uuid.UUID) (*generated.History, error) {
+	h, err := r.client.History.Query().
+		Where(history.ID(id), history.HasUserWith(user2.ID(userID))).
+		Only(ctx)
+	if generated.IsNotFound(err) {
+		return nil, utils.ErrHistoryNotFound
+	}
+	return h, err
+}
+
+func (r *Repository) GetRevisions(ctx context.Context, historyID uuid.UUID) ([]*generated.HistoryRevision, error) {
+	return r.client.HistoryRevision.Query().
+		Where(historyrevision.HasHistoryWith(history.ID(historyID))).
+		Order(generated.Desc(historyrevision.FieldVersion)).
+		All(ctx)
+}
+
+func (r *Repository) GetRevision(ctx context.Context, historyID, revisionID uuid.UUID) (*generated.HistoryRevision, error) {
+	rev, err := r.client.HistoryRevision.Query().
+		Where(
+			historyrevision.ID(revisionID),
+			historyrevision.HasHistoryWith(history.ID(historyID)),
+		).
+		Only(ctx)
+	if generated.IsNotFound(err) {
+		return nil, utils.ErrRevisionNotFound
+	}
+	return rev, err
+}
+
+func (r *Repository) SetTags(ctx context.Context, id uuid.UUID, tagIDs []uuid.UUID) error {
+	return r.client.History.UpdateOneID(id).
+		ClearTags().
+		AddTagIDs(tagIDs...).
+		Exec(ctx)
+}
+
+func (r *Repository) SetFolder(ctx context.Context, id uuid.UUID, folderID *uuid.UUID) error {
+	update := r.client.History.UpdateOneID(id)
+	if folderID == nil {
+		update.ClearFolder()
+	} else {
+		update.SetFolderID(*folderID)
+	}
+	return update.Exec(ctx)
+}
+
+func (r *Repository) GetWithEdges(ctx context.Context, id uuid.UUID) (*generated.History, error) {
+	return r.client.History.Query().
+		Where(history.ID(id)).
+		WithTags().
+		WithFolder().
+		Only(ctx)
+}
+
+// SetFavorite keeps updated_at untouched so organizing a history does not move
+// it around in the list. Pin, Unpin and ReorderPins do the same.
+func (r *Repository) SetFavorite(ctx context.Context, h *generated.History, favorite bool) error {
+	return r.client.History.UpdateOneID(h.ID).
+		SetIsFavorite(favorite).
+		SetUpdatedAt(h.UpdatedAt).
+		Exec(ctx)
+}
+
+// Pin appends the history after the user's last pinned history.
+func (r *Repository) Pin(ctx context.Context, userID uuid.UUID, h *generated.History) error {
+	next := 0
+	last, err := r.client.History.Query().
+		Where(history.HasUserWith(user2.ID(userID)), history.PinnedOrderNotNil()).
+		Order(history.ByPinnedOrder(sql.OrderDesc())).
+		First(ctx)
+	switch {
+	case err == nil:
+		next = *last.PinnedOrder + 1
+	case !generated.IsNotFound(err):
+		return err
+	}
+
+	return r.client.History.UpdateOneID(h.ID).
+		SetPinnedOrder(next).
+		SetUpdatedAt(h.UpdatedAt).
+		Exec(ctx)
+}
+
+func (r *Repository) Unpin(ctx context.Context, h *generated.History) error {
+	return r.client.History.UpdateOneID(h.ID).
+		ClearPinnedOrder().
+		SetUpdatedAt(h.UpdatedAt).
+		Exec(ctx)
+}
+
+// ReorderPins pins ids in the given order and unpins every other history of
+// the user. It returns utils.ErrHistoryNotFound when an ID is not owned.
+func (r *Repository) ReorderPins(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) error {
+	return db.WithTx(ctx, r.client, func(tx *generated.Tx) error {
+		affected, err := tx.History.Query().
+			Where(
+				history.HasUserWith(user2.ID(userID)),
+				history.Or(history.IDIn(ids...), history.PinnedOrderNotNil()),
+			).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		byID := make(map[uuid.UUID]*generated.History, len(affected))
+		for _, h := range affected {
+			byID[h.ID] = h
+		}
+
+		order := make(map[uuid.UUID]int, len(ids))
+		for _, id := range ids {
+			if _, ok := byID[id]; !ok {
+				return utils.ErrHistoryNotFound
+			}
+			if _, dup := order[id]; !dup {
+				order[id] = len(order)
+			}
+		}
+
+		for _, h := range affected {
+			update := tx.History.UpdateOneID(h.ID).SetUpdatedAt(h.UpdatedAt)
+			if pos, ok := order[h.ID]; ok {
+				update.SetPinnedOrder(pos)
+			} else {
+				update.ClearPinnedOrder()
+			}
+			if err := update.Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// MarkPlayed atomically increments the play count, records the play time
+// and logs the play for the statistics.
+func (r *Repository) MarkPlayed(ctx context.Context, userID uuid.UUID, h *generated.History, at time.Time) (*generated.History, error) {
+	var played *generated.History
+	err := r.InTx(ctx, func(txRepo *Repository) error {
+		var err error
+		played, err = txRepo.client.History.UpdateOneID(h.ID).
+			AddPlayCount(1).
+			SetLastPlayedAt(at).
+			SetUpdatedAt(h.UpdatedAt).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		return txRepo.client.PlayEvent.Create().
+			SetVoice(played.Voice).
+			SetHistoryID(h.ID).
+			SetPlayedAt(at).
+			SetUserID(userID).
+			Exec(ctx)
+	})
+	return played, err
+}
+
+// Iterate calls fn with consecutive batches of the user's histories matching
+// filter, paging by ID so only one batch is held in memory at a time.
+func (r *Repository) Iterate(
+	ctx context.Context,
+	userID uuid.UUID,
+	filter *dtoHistory.HistoryFilter,
+	batchSize int,
+	fn func([]*generated.History) error,
+) error {
+	preds := filterPredicates(userID, filter)
+	var after *uuid.UUID
+	for {
+		q := r.client.History.Query().
+			Where(preds...).
+			WithTags().
+			Order(history.ByID()).
+			Limit(batchSize)
+		if after != nil {
+			q.Where(history.IDGT(*after))
+		}
+
+		batch, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := fn(batch); err != nil {
+			return err
+		}
+		if len(batch) < batchSize {
+			return nil
+		}
+		after = &batch[len(batch)-1].ID
+	}
+}
+
+// GetOwnedMany returns the histories among ids that belong to the user.
+func (r *Repository) GetOwnedMany(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) ([]*generated.History, error) {
+	return r.client.History.Query().
+		Where(history.IDIn(ids...), history.HasUserWith(user2.ID(userID))).
+		All(ctx)
+}
+
+// FindByContentHash returns the most recently updated history of the user
+// with the given content hash.
+func (r *Repository) FindByContentHash(ctx context.Context, userID uuid.UUID, hash string) (*generated.History, error) {
+	return r.client.History.Query().
+		Where(history.HasUserWith(user2.ID(userID)), history.ContentHash(hash)).
+		Order(history.ByUpdatedAt(sql.OrderDesc())).
+		First(ctx)
+}
+
+func (r *Repository) Touch(ctx context.Context, id uuid.UUID) (*generated.History, error) {
+	return r.client.History.UpdateOneID(id).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+}
+
+// DuplicateGroups returns up to limit groups of the user's histories that
+// share a content hash, largest groups first.
+func (r *Repository) DuplicateGroups(ctx context.Context, userID uuid.UUID, limit int) ([]dtoHistory.DuplicateGroup, error) {
+	var hashes []struct {
+		ContentHash string `json:"content_hash"`
+		Count       int    `json:"count"`
+	}
+	err := r.client.History.Query().
+		Where(history.HasUserWith(user2.ID(userID)), history.ContentHashNEQ("")).
+		GroupBy(history.FieldContentHash).
+		Aggregate(func(s *sql.Selector) string {
+			s.Having(sql.GT(sql.Count("*"), 1))
+			s.OrderBy(sql.Desc(sql.Count("*")), history.FieldContentHash)
+			s.Limit(limit)
+			return sql.As(sql.Count("*"), "count")
+		}).
+		Scan(ctx, &hashes)
+	if err != nil || len(hashes) == 0 {
+		return []dtoHistory.DuplicateGroup{}, err
+	}
+
+	keys := make([]string, len(hashes))
+	for i, h := range hashes {
+		keys[i] = h.ContentHash
+	}
+	rows, err := r.client.History.Query().
+		Where(history.HasUserWith(user2.ID(userID)), history.ContentHashIn(keys...)).
+		WithTags().
+		Order(history.ByCreatedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byHash := make(map[string][]*generated.History, len(hashes))
+	for _, h := range rows {
+		byHash[h.ContentHash] = append(byHash[h.ContentHash], h)
+	}
+	groups := make([]dtoHistory.DuplicateGroup, 0, len(hashes))
+	for _, h := range hashes {
+		groups = append(groups, dtoHistory.DuplicateGroup{Count: len(byHash[h.ContentHash]), Histories: byHash[h.ContentHash]})
+	}
+	return groups, nil
+}
+
+// MergeDuplicates folds mergeIDs into keepID and moves them to the trash.
+func (r *Repository) MergeDuplicates(ctx context.Context, userID, keepID uuid.UUID, mergeIDs []uuid.UUID) error {
+	return db.WithTx(ctx, r.client, func(tx *generated.Tx) error {
+		keep, err := tx.History.Query().
+			Where(history.ID(keepID), history.HasUserWith(user2.ID(userID))).
+			WithTags().
+			Only(ctx)
+		if generated.IsNotFound(err) {
+			return utils.ErrHistoryNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		merges, err := tx.History.Query().
+			Where(history.IDIn(mergeIDs...), history.HasUserWith(user2.ID(userID))).
+			WithTags().
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		if len(merges) != len(mergeIDs) {
+			return utils.ErrHistoryNotFound
+		}
+
+		update := tx.History.UpdateOneID(keep.ID)
+		hasTag := make(map[uuid.UUID]bool, len(keep.Edges.Tags))
+		for _, t := range keep.Edges.Tags {
+			hasTag[t.ID] = true
+		}
+		plays := 0
+		favorite := keep.IsFavorite
+		lastPlayed := keep.LastPlayedAt
+		pinned := keep.PinnedOrder
+		for _, m := range merges {
+			if m.ID == keep.ID || m.ContentHash != keep.ContentHash {
+				return utils.ErrNotDuplicate
+			}
+			plays += m.PlayCount
+			favorite = favorite || m.IsFavorite
+			if m.LastPlayedAt != nil && (lastPlayed == nil || m.LastPlayedAt.After(*lastPlayed)) {
+				lastPlayed = m.LastPlayedAt
+			}
+			if m.PinnedOrder != nil && (pinned == nil || *m.PinnedOrder < *pinned) {
+				pinned = m.PinnedOrder
+			}
+			for _, t := range m.Edges.Tags {
+				if !hasTag[t.ID] {
+					hasTag[t.ID] = true
+					update.AddTagIDs(t.ID)
+				}
+			}
+		}
+
+		err = update.
+			AddPlayCount(plays).
+			SetIsFavorite(favorite).
+			SetNillableLastPlayedAt(lastPlayed).
+			SetNillablePinnedOrder(pinned).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Play yang sudah tercatat ikut pindah ke history yang disimpan.
+		err = tx.PlayEvent.Update().
+			Where(playevent.HistoryIDIn(mergeIDs...)).
+			SetHistoryID(keep.ID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.History.Delete().Where(history.IDIn(mergeIDs...)).Exec(ctx)
+		return err
+	})
+}
+
+// textFormat maps a request format to the enum, defaulting to plain text.
+func textFormat(format string) history.Format {
+	if format == "" {
+		return history.FormatPlain
+	}
+	return history.Format(format)
+}
+
+func sortOrder(sort dtoHistory.HistorySort) []history.OrderOption {
+	switch sort {
+	case dtoHistory.SortCreated:
+		return []history.OrderOption{history.ByCreatedAt(sql.OrderDesc())}
+	case dtoHistory.SortMostPlayed:
+		return []history.OrderOption{
+			history.ByPlayCount(sql.OrderDesc()),
+			history.ByLastPlayedAt(sql.OrderDesc(), sql.OrderNullsLast()),
+		}
+	case dtoHistory.SortRecentlyPlayed:
+		return []history.OrderOption{
+			history.ByLastPlayedAt(sql.OrderDesc(), sql.OrderNullsLast()),
+			history.ByUpdatedAt(sql.OrderDesc()),
+		}
+	default:
+		return []history.OrderOption{
+			history.ByPinnedOrder(sql.OrderNullsLast()),
+			history.ByUpdatedAt(sql.OrderDesc()),
+		}
+	}
+}
+
+func filterPredicates(userID uuid.UUID, filter *dtoHistory.HistoryFilter) []predicate.History {
+	preds := []predicate.History{history.HasUserWith(user2.ID(userID))}
+	if filter == nil {
+		return preds
+	}
+	if filter.Voice != "" {
+		preds = append(preds, history.Voice(filter.Voice))
+	}
+	if filter.Language != "" {
+		preds = append(preds, history.Language(filter.Language))
+	}
+	if filter.Search != "" {
+		preds = append(preds, history.PlainTextContainsFold(filter.Search))
+	}
+	if filter.CreatedFrom != nil {
+		preds = append(preds, history.CreatedAtGTE(*filter.CreatedFrom))
+	}
+	if filter.CreatedTo != nil {
+		preds = append(preds, history.CreatedAtLTE(*filter.CreatedTo))
+	}
+	if len(filter.TagIDs) > 0 {
+		preds = append(preds, history.HasTagsWith(tag.IDIn(filter.TagIDs...)))
+	}
+	if filter.FolderID != nil {
+		preds = append(preds, history.HasFolderWith(folder.ID(*filter.FolderID)))
+	}
+	if filter.Favorite != nil {
+		preds = append(preds, history.IsFavorite(*filter.Favorite))
+	}
+	if filter.Played != nil {
+		if *filter.Played {
+			preds = append(preds, history.PlayCountGT(0))
+		} else {
+			preds = append(preds, history.PlayCount(0))
+		}
+	}
+	if filter.MinPlays > 0 {
+		preds = append(preds, history.PlayCountGTE(filter.MinPlays))
+	}
+	if filter.PlayedFrom != nil {
+		preds = append(preds, history.LastPlayedAtGTE(*filter.PlayedFrom))
+	}
+	return preds
+}
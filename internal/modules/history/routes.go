@@ -1,11 +1,43 @@
 package history
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/idempotency"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/quota"
+)
 
-func SetupHistoryRoutes(router fiber.Router, handler *Handler) {
-	router.Post("/history", handler.Create)
-	router.Put("/history/:id", handler.Update)
+func SetupHistoryRoutes(router fiber.Router, handler *Handler, idempotencyMiddleware *idempotency.Middleware, quotaMiddleware *quota.Middleware) {
+	router.Post("/history", quotaMiddleware.Headers, idempotencyMiddleware.Handle, handler.Create)
+	router.Put("/history/:id", quotaMiddleware.Headers, handler.Update)
 	router.Get("/histories", handler.GetByUser)
+	router.Get("/histories/export", handler.Export)
+	router.Post("/histories/import", quotaMiddleware.Headers, handler.Import)
+	router.Get("/histories/duplicates", handler.GetDuplicates)
+	router.Post("/histories/duplicates/merge", handler.MergeDuplicates)
 	router.Get("/history/:id", handler.GetByID)
 	router.Delete("/history/:id", handler.Delete)
+
+	router.Post("/histories/bulk", quotaMiddleware.Headers, idempotencyMiddleware.Handle, handler.BulkCreate)
+	router.Patch("/histories/bulk", quotaMiddleware.Headers, handler.BulkUpdate)
+	router.Delete("/histories", handler.BulkDelete)
+
+	router.Get("/histories/trash", handler.GetTrash)
+	router.Delete("/histories/trash", handler.EmptyTrash)
+	router.Post("/history/:id/restore", handler.Restore)
+	router.Delete("/history/:id/permanent", handler.DeletePermanent)
+
+	router.Get("/history/:id/revisions", handler.GetRevisions)
+	router.Get("/history/:id/revisions/diff", handler.DiffRevisions)
+	router.Post("/history/:id/revisions/:revisionId/revert", quotaMiddleware.Headers, handler.RevertRevision)
+
+	router.Put("/history/:id/tags", handler.SetTags)
+	router.Put("/history/:id/folder", handler.MoveToFolder)
+
+	router.Post("/history/:id/favorite", handler.Favorite)
+	router.Delete("/history/:id/favorite", handler.Unfavorite)
+	router.Post("/history/:id/pin", handler.Pin)
+	router.Delete("/history/:id/pin", handler.Unpin)
+	router.Put("/histories/pins", handler.ReorderPins)
+
+	router.Post("/history/:id/played", handler.MarkPlayed)
 }
@@ -1,45 +1,1096 @@
 package history
 
 import (
+	"bufio"
 	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gofiber/fiber/v2/log"
 	"github.com/google/uuid"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/usageentry"
+	"github.com/kiminodare/HOVARLAY-BE/ent/schema"
+	"github.com/kiminodare/HOVARLAY-BE/internal/langid"
+	filter "github.com/kiminodare/HOVARLAY-BE/internal/moderation"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/folder"
+	dtoHistory "github.com/kiminodare/HOVARLAY-BE/internal/modules/history/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/lexicon"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/moderation"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/preference"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/preset"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/quota"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/tag"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/voice"
+	dtoVoice "github.com/kiminodare/HOVARLAY-BE/internal/modules/voice/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
 )
 
 type Service struct {
-	repo *Repository
+	repo        *Repository
+	tags        *tag.Service
+	folders     *folder.Service
+	voices      *voice.Service
+	presets     *preset.Service
+	preferences *preference.Service
+	quotas      *quota.Service
+	lexicons    *lexicon.Service
+	moderation  *moderation.Service
+}
+
+func NewService(
+	repo *Repository,
+	tags *tag.Service,
+	folders *folder.Service,
+	voices *voice.Service,
+	presets *preset.Service,
+	preferences *preference.Service,
+	quotas *quota.Service,
+	lexicons *lexicon.Service,
+	moderation *moderation.Service,
+) *Service {
+	return &Service{
+		repo:        repo,
+		tags:        tags,
+		folders:     folders,
+		voices:      voices,
+		presets:     presets,
+		preferences: preferences,
+		quotas:      quotas,
+		lexicons:    lexicons,
+		moderation:  moderation,
+	}
+}
+
+// Create stores a new history and charges it to the quota of the user. With
+// dedupe set, an existing history with the same text and voice is bumped to
+// the top of the list and returned instead, free of charge. The text is
+// screened by the moderation filter of the user first; the returned result
+// lists the rules that fired.
+func (s *Service) Create(ctx context.Context, userID uuid.UUID, req *dtoHistory.CreateHistoryRequest) (*generated.History, *filter.Result, error) {
+	pref, err := s.preferences.Get(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	f, err := s.moderation.Filter(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	screened, err := s.resolve(ctx, userID, req, pref, f)
+	if err != nil {
+		return nil, nil, err
+	}
+	if req.Dedupe {
+		existing, err := s.repo.FindByContentHash(ctx, userID, schema.HistoryContentHash(req.Text, req.Voice))
+		switch {
+		case err == nil:
+			h, err := s.repo.Touch(ctx, existing.ID)
+			return h, screened, err
+		case !generated.IsNotFound(err):
+			return nil, nil, err
+		}
+	}
+
+	characters, err := spokenLength(req.Text, req.Format)
+	if err != nil {
+		return nil, nil, err
+	}
+	entry, err := s.quotas.Reserve(ctx, userID, quota.Charge{Kind: usageentry.KindCreate, Characters: characters, Entries: 1})
+	if err != nil {
+		return nil, nil, err
+	}
+	h, err := s.repo.Create(ctx, userID, req)
+	if err != nil {
+		s.refund(ctx, userID, entry)
+		return nil, nil, err
+	}
+	if err := s.quotas.Link(ctx, entry, h.ID); err != nil {
+		log.Errorf("failed to link usage entry %s to history %s: %v", entry.ID, h.ID, err)
+	}
+	return h, screened, nil
 }
 
-func NewService(repo *Repository) *Service {
-	return &Service{repo: repo}
+// MismatchConfidence is how sure language detection must be before a voice
+// of another language is reported.
+const MismatchConfidence = 0.6
+
+// LanguageWarnings reports when the detected language of h differs from the
+// language of its voice. Texts detected with less than MismatchConfidence
+// are not reported, since short or mixed texts are often misjudged.
+func (s *Service) LanguageWarnings(ctx context.Context, h *generated.History) ([]dtoHistory.LanguageWarning, error) {
+	if h.Language == "" || h.LanguageConfidence == nil || *h.LanguageConfidence < MismatchConfidence {
+		return nil, nil
+	}
+	v, err := s.voices.Get(ctx, h.Voice)
+	if err != nil {
+		return nil, err
+	}
+	if langid.Primary(v.Language) == h.Language {
+		return nil, nil
+	}
+
+	voices, err := s.voices.List(ctx, &dtoVoice.VoiceQuery{Language: h.Language})
+	if err != nil {
+		return nil, err
+	}
+	suggested := make([]string, len(voices))
+	for i, sv := range voices {
+		suggested[i] = sv.ID
+	}
+	return []dtoHistory.LanguageWarning{{
+		Code:             dtoHistory.WarningLanguageMismatch,
+		Message:          fmt.Sprintf("text looks like %q but voice %s speaks %s", h.Language, v.ID, v.Language),
+		DetectedLanguage: h.Language,
+		Confidence:       *h.LanguageConfidence,
+		VoiceLanguage:    v.Language,
+		SuggestedVoices:  suggested,
+	}}, nil
 }
 
-func (s *Service) Create(
+func (s *Service) GetByUser(
 	ctx context.Context,
 	userID uuid.UUID,
-	text string,
-	voice string,
-	rate, pitch, volume float64,
-) (*generated.History, error) {
-	return s.repo.Create(ctx, userID, text, voice, rate, pitch, volume)
+	filter *dtoHistory.HistoryFilter,
+	sort dtoHistory.HistorySort,
+	offset, limit int,
+) ([]*generated.History, error) {
+	return s.repo.GetByUser(ctx, userID, filter, sort, offset, limit)
 }
 
-func (s *Service) GetByUser(ctx context.Context, userID uuid.UUID, offset, limit int) ([]*generated.History, error) {
-	return s.repo.GetByUser(ctx, userID, offset, limit)
+func (s *Service) CountByUser(ctx context.Context, userID uuid.UUID, filter *dtoHistory.HistoryFilter) (int, error) {
+	return s.repo.CountByUser(ctx, userID, filter)
 }
 
-func (s *Service) CountByUser(ctx context.Context, userID uuid.UUID) (int, error) {
-	return s.repo.CountByUser(ctx, userID)
+// ListMeta returns aggregates over every history matching filter, not just
+// the current page.
+func (s *Service) ListMeta(ctx context.Context, userID uuid.UUID, filter *dtoHistory.HistoryFilter) (*dtoHistory.HistoryListMeta, error) {
+	tagCounts, err := s.tags.CountHistories(ctx, userID, filterPredicates(userID, filter)...)
+	if err != nil {
+		return nil, err
+	}
+	return &dtoHistory.HistoryListMeta{TagCounts: tagCounts}, nil
 }
 
-func (s *Service) GetByID(ctx context.Context, id uuid.UUID) (*generated.History, error) {
-	return s.repo.GetByID(ctx, id)
+// SetTags replaces the tags of a history with tagIDs. Repeated IDs are
+// applied once.
+func (s *Service) SetTags(ctx context.Context, userID, id uuid.UUID, tagIDs []uuid.UUID) (*generated.History, error) {
+	if _, err := s.repo.GetOwned(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	tagIDs = uniqueIDs(tagIDs)
+	if err := s.tags.EnsureOwned(ctx, userID, tagIDs); err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetTags(ctx, id, tagIDs); err != nil {
+		return nil, err
+	}
+	return s.repo.GetWithEdges(ctx, id)
 }
 
-func (s *Service) Update(ctx context.Context, id uuid.UUID, text string, voice string, rate, pitch, volume float64) error {
-	return s.repo.Update(ctx, id, text, voice, rate, pitch, volume)
+func (s *Service) MoveToFolder(ctx context.Context, userID, id uuid.UUID, folderID *uuid.UUID) (*generated.History, error) {
+	if _, err := s.repo.GetOwned(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	if folderID != nil {
+		if _, err := s.folders.GetOwned(ctx, userID, *folderID); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.repo.SetFolder(ctx, id, folderID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetWithEdges(ctx, id)
 }
 
-func (s *Service) Delete(ctx context.Context, id uuid.UUID) error {
+// GetOwned returns a history of the user or ErrHistoryNotFound.
+func (s *Service) GetOwned(ctx context.Context, userID, id uuid.UUID) (*generated.History, error) {
+	return s.repo.GetOwned(ctx, userID, id)
+}
+
+// Update replaces the content of a history. Changes that need the text to be
+// synthesized again charge its characters to the quota of the user. The new
+// text is screened like in Create.
+func (s *Service) Update(ctx context.Context, userID, id uuid.UUID, req *dtoHistory.UpdateHistoryRequest) (*filter.Result, error) {
+	current, err := s.repo.GetOwned(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	pref, err := s.preferences.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	f, err := s.moderation.Filter(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	screened, err := screen(f, &req.Text, req.Format)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkTextLength(req.Text, req.Format, pref.MaxTextLength); err != nil {
+		return nil, err
+	}
+	if err := s.voices.Check(ctx, req.Voice, req.Rate, req.Pitch); err != nil {
+		return nil, err
+	}
+	return screened, s.chargedUpdate(ctx, userID, current, req)
+}
+
+// chargedUpdate applies req to h, charging the new text unless nothing that
+// affects the synthesized audio changes.
+func (s *Service) chargedUpdate(ctx context.Context, userID uuid.UUID, h *generated.History, req *dtoHistory.UpdateHistoryRequest) error {
+	if h.Text == req.Text && h.Format == textFormat(req.Format) && h.Voice == req.Voice &&
+		h.Rate == req.Rate && h.Pitch == req.Pitch && h.Volume == req.Volume {
+		return s.update(ctx, h.ID, req)
+	}
+
+	characters, err := spokenLength(req.Text, req.Format)
+	if err != nil {
+		return err
+	}
+	id := h.ID
+	entry, err := s.quotas.Reserve(ctx, userID, quota.Charge{Kind: usageentry.KindUpdate, Characters: characters, HistoryID: &id})
+	if err != nil {
+		return err
+	}
+	if err := s.update(ctx, h.ID, req); err != nil {
+		s.refund(ctx, userID, entry)
+		return err
+	}
+	return nil
+}
+
+// update replaces the content of a history in its own transaction.
+func (s *Service) update(ctx context.Context, id uuid.UUID, req *dtoHistory.UpdateHistoryRequest) error {
+	return s.repo.InTx(ctx, func(txRepo *Repository) error {
+		return txRepo.Update(ctx, id, req)
+	})
+}
+
+// refund returns a charge whose operation failed. A failed refund is only
+// logged, the original error is what the caller reports.
+func (s *Service) refund(ctx context.Context, userID uuid.UUID, entry *generated.UsageEntry) {
+	if err := s.quotas.Refund(ctx, userID, entry); err != nil {
+		log.Errorf("failed to refund usage entry %s: %v", entry.ID, err)
+	}
+}
+
+// Delete moves a history of the user to the trash.
+func (s *Service) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	if _, err := s.repo.GetOwned(ctx, userID, id); err != nil {
+		return err
+	}
 	return s.repo.Delete(ctx, id)
 }
+
+// BulkCreate validates every item on its own and inserts the valid ones in a
+// single transaction. Invalid items, and items that no longer fit the quota
+// of the user, are reported back without failing the batch.
+func (s *Service) BulkCreate(ctx context.Context, userID uuid.UUID, items []dtoHistory.CreateHistoryRequest) (*dtoHistory.BulkResult, error) {
+	results := make([]dtoHistory.BulkItemResult, len(items))
+	valid := make([]dtoHistory.CreateHistoryRequest, 0, len(items))
+	indexes := make([]int, 0, len(items))
+
+	pref, err := s.preferences.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	f, err := s.moderation.Filter(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := s.quotas.Usage(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	characters := 0
+
+	for i := range items {
+		results[i].Index = i
+		if err := items[i].Validate(); err != nil {
+			results[i].Errors = utils.FormatValidationErrors(err)
+			continue
+		}
+		screened, errs, err := s.resolveErrors(ctx, userID, &items[i], pref, f)
+		if err != nil {
+			return nil, err
+		}
+		results[i].Moderation = screened
+		if errs != nil {
+			results[i].Errors = errs
+			continue
+		}
+		n, err := spokenLength(items[i].Text, items[i].Format)
+		if err != nil {
+			return nil, err
+		}
+		if err := usage.Check(characters+n, len(valid)+1); err != nil {
+			results[i].Errors = []string{err.Error()}
+			continue
+		}
+		characters += n
+		valid = append(valid, items[i])
+		indexes = append(indexes, i)
+	}
+
+	if len(valid) > 0 {
+		entry, err := s.quotas.Reserve(ctx, userID, quota.Charge{Kind: usageentry.KindCreate, Characters: characters, Entries: len(valid)})
+		if err != nil {
+			return nil, err
+		}
+		created, err := s.repo.BulkCreate(ctx, userID, valid)
+		if err != nil {
+			s.refund(ctx, userID, entry)
+			return nil, err
+		}
+		for j, h := range created {
+			id := h.ID
+			results[indexes[j]].ID = &id
+			results[indexes[j]].Success = true
+		}
+	}
+
+	return dtoHistory.NewBulkResult(results), nil
+}
+
+func (s *Service) BulkDelete(ctx context.Context, userID uuid.UUID, req *dtoHistory.BulkDeleteHistoryRequest) (*dtoHistory.BulkResult, error) {
+	if len(req.IDs) == 0 {
+		deleted, err := s.repo.BulkDeleteByFilter(ctx, userID, req.Filter)
+		if err != nil {
+			return nil, err
+		}
+		return &dtoHistory.BulkResult{Succeeded: deleted}, nil
+	}
+
+	deleted, err := s.repo.BulkDeleteByIDs(ctx, userID, req.IDs)
+	if err != nil {
+		return nil, err
+	}
+	return dtoHistory.NewBulkResult(idResults(req.IDs, deleted)), nil
+}
+
+// BulkUpdate applies the changes to every history whose resulting voice,
+// rate and pitch fit the voice catalog; the others are reported as failed.
+// Like Update, the characters of histories whose audio must be synthesized
+// again are charged to the quota of the user, in one charge for the batch.
+func (s *Service) BulkUpdate(ctx context.Context, userID uuid.UUID, req *dtoHistory.BulkUpdateHistoryRequest) (*dtoHistory.BulkResult, error) {
+	rejected := make(map[uuid.UUID][]string)
+	characters := 0
+	if req.Voice != nil || req.Rate != nil || req.Pitch != nil || req.Volume != nil {
+		rows, err := s.repo.GetOwnedMany(ctx, userID, req.IDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range rows {
+			v, rate, pitch, volume := h.Voice, h.Rate, h.Pitch, h.Volume
+			if req.Voice != nil {
+				v = *req.Voice
+			}
+			if req.Rate != nil {
+				rate = *req.Rate
+			}
+			if req.Pitch != nil {
+				pitch = *req.Pitch
+			}
+			if req.Volume != nil {
+				volume = *req.Volume
+			}
+			errs, err := s.voiceErrors(ctx, v, rate, pitch)
+			if err != nil {
+				return nil, err
+			}
+			if errs != nil {
+				rejected[h.ID] = errs
+				continue
+			}
+			if v == h.Voice && rate == h.Rate && pitch == h.Pitch && volume == h.Volume {
+				continue
+			}
+			n, err := spokenLength(h.Text, string(h.Format))
+			if err != nil {
+				return nil, err
+			}
+			characters += n
+		}
+	}
+
+	accepted := *req
+	accepted.IDs = make([]uuid.UUID, 0, len(req.IDs))
+	for _, id := range req.IDs {
+		if _, ok := rejected[id]; !ok {
+			accepted.IDs = append(accepted.IDs, id)
+		}
+	}
+
+	var updated []uuid.UUID
+	if len(accepted.IDs) > 0 {
+		var entry *generated.UsageEntry
+		if characters > 0 {
+			var err error
+			entry, err = s.quotas.Reserve(ctx, userID, quota.Charge{Kind: usageentry.KindUpdate, Characters: characters})
+			if err != nil {
+				return nil, err
+			}
+		}
+		var err error
+		if updated, err = s.repo.BulkUpdate(ctx, userID, &accepted); err != nil {
+			if entry != nil {
+				s.refund(ctx, userID, entry)
+			}
+			return nil, err
+		}
+	}
+
+	results := idResults(req.IDs, updated)
+	for i := range results {
+		if errs, ok := rejected[*results[i].ID]; ok {
+			results[i].Errors = errs
+		}
+	}
+	return dtoHistory.NewBulkResult(results), nil
+}
+
+func (s *Service) GetTrashByUser(ctx context.Context, userID uuid.UUID, offset, limit int) ([]*generated.History, error) {
+	return s.repo.GetTrashByUser(ctx, userID, offset, limit)
+}
+
+func (s *Service) CountTrashByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	return s.repo.CountTrashByUser(ctx, userID)
+}
+
+func (s *Service) Restore(ctx context.Context, userID, id uuid.UUID) error {
+	return s.repo.Restore(ctx, userID, id)
+}
+
+func (s *Service) DeletePermanent(ctx context.Context, userID, id uuid.UUID) error {
+	return s.repo.DeletePermanent(ctx, userID, id)
+}
+
+func (s *Service) EmptyTrash(ctx context.Context, userID uuid.UUID) (int, error) {
+	return s.repo.EmptyTrash(ctx, userID)
+}
+
+// RunTrashPurge permanently deletes histories that have been in the trash
+// longer than retention, checking every interval until ctx is cancelled.
+func (s *Service) RunTrashPurge(ctx context.Context, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := s.repo.PurgeTrash(ctx, time.Now().Add(-retention))
+			if err != nil {
+				log.Errorf("failed to purge trashed histories: %v", err)
+				continue
+			}
+			if n > 0 {
+				log.Infof("purged %d trashed histories", n)
+			}
+		}
+	}
+}
+
+func (s *Service) GetRevisions(ctx context.Context, userID, id uuid.UUID) ([]*generated.HistoryRevision, error) {
+	if _, err := s.repo.GetOwned(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	return s.repo.GetRevisions(ctx, id)
+}
+
+// DiffRevisions compares two revisions of a history. An empty revision ID
+// stands for the current state of the history.
+func (s *Service) DiffRevisions(ctx context.Context, userID, id uuid.UUID, fromID, toID *uuid.UUID) (*dtoHistory.RevisionDiff, error) {
+	current, err := s.repo.GetOwned(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := s.snapshot(ctx, current, fromID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.snapshot(ctx, current, toID)
+	if err != nil {
+		return nil, err
+	}
+	return diffSnapshots(from, to), nil
+}
+
+// Revert restores the values of a revision. The update itself is recorded as
+// a new revision by the History hook, so reverting can be undone. The
+// revision is checked like in Update, since the moderation rules, the text
+// limit and the voice catalog may have changed since it was written.
+func (s *Service) Revert(ctx context.Context, userID, id, revisionID uuid.UUID) (*generated.History, *filter.Result, error) {
+	current, err := s.repo.GetOwned(ctx, userID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rev, err := s.repo.GetRevision(ctx, id, revisionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req := &dtoHistory.UpdateHistoryRequest{
+		Text:   rev.Text,
+		Format: string(rev.Format),
+		Voice:  rev.Voice,
+		Rate:   rev.Rate,
+		Pitch:  rev.Pitch,
+		Volume: rev.Volume,
+	}
+	pref, err := s.preferences.Get(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	f, err := s.moderation.Filter(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	screened, err := screen(f, &req.Text, req.Format)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := checkTextLength(req.Text, req.Format, pref.MaxTextLength); err != nil {
+		return nil, nil, err
+	}
+	// Voice revisi bisa saja sudah dinonaktifkan sejak revisi itu dibuat.
+	if err := s.voices.Check(ctx, req.Voice, req.Rate, req.Pitch); err != nil {
+		return nil, nil, err
+	}
+	if err := s.chargedUpdate(ctx, userID, current, req); err != nil {
+		return nil, nil, err
+	}
+	h, err := s.repo.GetByID(ctx, id)
+	return h, screened, err
+}
+
+func (s *Service) snapshot(ctx context.Context, current *generated.History, revisionID *uuid.UUID) (dtoHistory.RevisionSnapshot, error) {
+	if revisionID == nil {
+		return dtoHistory.RevisionSnapshot{
+			Text:      current.Text,
+			Format:    string(current.Format),
+			Voice:     current.Voice,
+			Rate:      current.Rate,
+			Pitch:     current.Pitch,
+			Volume:    current.Volume,
+			CreatedAt: current.UpdatedAt,
+		}, nil
+	}
+
+	rev, err := s.repo.GetRevision(ctx, current.ID, *revisionID)
+	if err != nil {
+		return dtoHistory.RevisionSnapshot{}, err
+	}
+	return dtoHistory.RevisionSnapshot{
+		ID:        &rev.ID,
+		Version:   rev.Version,
+		Text:      rev.Text,
+		Format:    string(rev.Format),
+		Voice:     rev.Voice,
+		Rate:      rev.Rate,
+		Pitch:     rev.Pitch,
+		Volume:    rev.Volume,
+		CreatedAt: rev.CreatedAt,
+	}, nil
+}
+
+func (s *Service) SetFavorite(ctx context.Context, userID, id uuid.UUID, favorite bool) error {
+	h, err := s.repo.GetOwned(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if h.IsFavorite == favorite {
+		return nil
+	}
+	return s.repo.SetFavorite(ctx, h, favorite)
+}
+
+func (s *Service) Pin(ctx context.Context, userID, id uuid.UUID) error {
+	h, err := s.repo.GetOwned(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if h.PinnedOrder != nil {
+		return nil
+	}
+	return s.repo.Pin(ctx, userID, h)
+}
+
+func (s *Service) Unpin(ctx context.Context, userID, id uuid.UUID) error {
+	h, err := s.repo.GetOwned(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if h.PinnedOrder == nil {
+		return nil
+	}
+	return s.repo.Unpin(ctx, h)
+}
+
+func (s *Service) ReorderPins(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) error {
+	return s.repo.ReorderPins(ctx, userID, ids)
+}
+
+func (s *Service) MarkPlayed(ctx context.Context, userID, id uuid.UUID) (*generated.History, error) {
+	h, err := s.repo.GetOwned(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.MarkPlayed(ctx, userID, h, time.Now())
+}
+
+// Export writes every history of the user matching filter to w in format.
+// Rows are read in batches and flushed as they are written.
+func (s *Service) Export(ctx context.Context, userID uuid.UUID, filter *dtoHistory.HistoryFilter, format ExportFormat, w *bufio.Writer) error {
+	var lex *lexicon.Rewriter
+	if format == ExportSSML {
+		var err error
+		if lex, err = s.lexicons.Rewriter(ctx, userID); err != nil {
+			return err
+		}
+	}
+
+	exp := newExporter(format, w, lex)
+	if err := exp.begin(); err != nil {
+		return err
+	}
+
+	err := s.repo.Iterate(ctx, userID, filter, exportBatchSize, func(batch []*generated.History) error {
+		for _, h := range batch {
+			if err := exp.write(h); err != nil {
+				return err
+			}
+		}
+		return w.Flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := exp.end(); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// errDryRun rolls back the import transaction once the report is complete.
+var errDryRun = errors.New("dry run")
+
+// Import validates every row and inserts the valid ones in batches inside one
+// transaction. Rows whose text and voice already exist are handled according
+// to mode. With dryRun the transaction is rolled back after the report is
+// built, so the result shows exactly what a real import would do.
+func (s *Service) Import(
+	ctx context.Context,
+	userID uuid.UUID,
+	rows []dtoHistory.ImportRow,
+	mode dtoHistory.DuplicateMode,
+	dryRun bool,
+) (*dtoHistory.ImportResult, error) {
+	result := &dtoHistory.ImportResult{DryRun: dryRun, Rows: make([]dtoHistory.ImportRowResult, len(rows))}
+
+	pref, err := s.preferences.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	f, err := s.moderation.Filter(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	valid := make([]int, 0, len(rows))
+	for i, row := range rows {
+		result.Rows[i].Row = row.Row
+		errs := row.ParseErrors
+		if len(errs) == 0 {
+			if err := row.Request.Validate(); err != nil {
+				errs = utils.FormatValidationErrors(err)
+			}
+		}
+		if len(errs) == 0 {
+			var err error
+			if result.Rows[i].Moderation, errs, err = s.resolveErrors(ctx, userID, &rows[i].Request, pref, f); err != nil {
+				return nil, err
+			}
+		}
+		if len(errs) > 0 {
+			result.Rows[i].Status = dtoHistory.ImportInvalid
+			result.Rows[i].Errors = errs
+			continue
+		}
+		valid = append(valid, i)
+	}
+
+	var entry *generated.UsageEntry
+	err = s.repo.InTx(ctx, func(txRepo *Repository) error {
+		seen := make(map[string]uuid.UUID)
+		charge := quota.Charge{Kind: usageentry.KindImport}
+		for start := 0; start < len(valid); start += importBatchSize {
+			batch := valid[start:min(start+importBatchSize, len(valid))]
+			if err := importBatch(ctx, txRepo, userID, rows, batch, mode, seen, result, &charge); err != nil {
+				return err
+			}
+		}
+
+		// Kuota dibebankan sebelum commit supaya import yang melebihinya
+		// dibatalkan seluruhnya.
+		if dryRun {
+			if err := s.quotas.Check(ctx, userID, charge.Characters, charge.Entries); err != nil {
+				return err
+			}
+			return errDryRun
+		}
+		if charge.Characters == 0 && charge.Entries == 0 {
+			return nil
+		}
+		var err error
+		entry, err = s.quotas.Reserve(ctx, userID, charge)
+		return err
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		if entry != nil {
+			s.refund(ctx, userID, entry)
+		}
+		return nil, err
+	}
+
+	// IDs of rows created during a dry run were rolled back and must not leak
+	// into the report, including duplicates that point at them.
+	rolledBack := make(map[uuid.UUID]bool)
+	for _, row := range result.Rows {
+		if dryRun && row.Status == dtoHistory.ImportCreated {
+			rolledBack[*row.ID] = true
+		}
+	}
+
+	for i := range result.Rows {
+		row := &result.Rows[i]
+		if row.ID != nil && rolledBack[*row.ID] {
+			row.ID = nil
+		}
+		switch row.Status {
+		case dtoHistory.ImportCreated:
+			result.Created++
+		case dtoHistory.ImportUpdated:
+			result.Updated++
+		case dtoHistory.ImportSkipped:
+			result.Skipped++
+		case dtoHistory.ImportInvalid:
+			result.Invalid++
+		}
+	}
+	return result, nil
+}
+
+// importBatch handles one batch of valid rows. seen maps the content hash of
+// every history known so far, existing or imported, to its ID, so imports
+// find the same duplicates as dedupe and FindDuplicates. The content
+// written is added to charge once per history, so a row that replaces an
+// earlier row of the same batch is not charged twice.
+func importBatch(
+	ctx context.Context,
+	repo *Repository,
+	userID uuid.UUID,
+	rows []dtoHistory.ImportRow,
+	batch []int,
+	mode dtoHistory.DuplicateMode,
+	seen map[string]uuid.UUID,
+	result *dtoHistory.ImportResult,
+	charge *quota.Charge,
+) error {
+	hashes := make([]string, len(batch))
+	for j, i := range batch {
+		hashes[j] = schema.HistoryContentHash(rows[i].Request.Text, rows[i].Request.Voice)
+	}
+	existing, err := repo.FindByContentHashes(ctx, userID, hashes)
+	if err != nil {
+		return err
+	}
+	for _, h := range existing {
+		if _, ok := seen[h.ContentHash]; !ok {
+			seen[h.ContentHash] = h.ID
+		}
+	}
+
+	var creates []dtoHistory.CreateHistoryRequest
+	var createRows []int
+	pending := make(map[string]int) // content hash -> index in creates
+	linked := make(map[int]int)     // row index -> index in creates
+	for _, i := range batch {
+		req := rows[i].Request
+		key := schema.HistoryContentHash(req.Text, req.Voice)
+		res := &result.Rows[i]
+
+		if mode != dtoHistory.DuplicateKeepBoth {
+			if id, ok := seen[key]; ok {
+				id := id
+				res.ID = &id
+				if mode == dtoHistory.DuplicateSkip {
+					res.Status = dtoHistory.ImportSkipped
+					continue
+				}
+				err := repo.Update(ctx, id, &dtoHistory.UpdateHistoryRequest{
+					Text:   req.Text,
+					Format: req.Format,
+					Voice:  req.Voice,
+					Rate:   *req.Rate,
+					Pitch:  *req.Pitch,
+					Volume: *req.Volume,
+				})
+				if err != nil {
+					return err
+				}
+				n, err := spokenLength(req.Text, req.Format)
+				if err != nil {
+					return err
+				}
+				charge.Characters += n
+				res.Status = dtoHistory.ImportUpdated
+				continue
+			}
+			if j, ok := pending[key]; ok {
+				// Duplikat dari baris sebelumnya di batch yang sama.
+				linked[i] = j
+				if mode == dtoHistory.DuplicateSkip {
+					res.Status = dtoHistory.ImportSkipped
+					continue
+				}
+				creates[j] = req
+				res.Status = dtoHistory.ImportUpdated
+				continue
+			}
+		}
+
+		pending[key] = len(creates)
+		creates = append(creates, req)
+		createRows = append(createRows, i)
+	}
+
+	if len(creates) == 0 {
+		return nil
+	}
+	for _, req := range creates {
+		n, err := spokenLength(req.Text, req.Format)
+		if err != nil {
+			return err
+		}
+		charge.Characters += n
+		charge.Entries++
+	}
+	created, err := repo.CreateMany(ctx, userID, creates)
+	if err != nil {
+		return err
+	}
+	for j, h := range created {
+		id := h.ID
+		result.Rows[createRows[j]].Status = dtoHistory.ImportCreated
+		result.Rows[createRows[j]].ID = &id
+		seen[h.ContentHash] = id
+	}
+	for i, j := range linked {
+		id := created[j].ID
+		result.Rows[i].ID = &id
+	}
+	return nil
+}
+
+func (s *Service) GetDuplicates(ctx context.Context, userID uuid.UUID) ([]dtoHistory.DuplicateGroup, error) {
+	return s.repo.DuplicateGroups(ctx, userID, dtoHistory.MaxDuplicateGroups)
+}
+
+func (s *Service) MergeDuplicates(ctx context.Context, userID uuid.UUID, req *dtoHistory.MergeDuplicatesRequest) (*generated.History, error) {
+	if err := s.repo.MergeDuplicates(ctx, userID, req.KeepID, uniqueIDs(req.MergeIDs)); err != nil {
+		return nil, err
+	}
+	return s.repo.GetWithEdges(ctx, req.KeepID)
+}
+
+// applyPreset fills the voice parameters omitted by req from its preset.
+func (s *Service) applyPreset(ctx context.Context, userID uuid.UUID, req *dtoHistory.CreateHistoryRequest) error {
+	if req.PresetID == nil {
+		return nil
+	}
+	p, err := s.presets.GetOwned(ctx, userID, *req.PresetID)
+	if err != nil {
+		return err
+	}
+	if req.Voice == "" {
+		req.Voice = p.Voice
+	}
+	if req.Rate == nil {
+		req.Rate = &p.Rate
+	}
+	if req.Pitch == nil {
+		req.Pitch = &p.Pitch
+	}
+	if req.Volume == nil {
+		req.Volume = &p.Volume
+	}
+	return nil
+}
+
+// applyPreferences fills what is still omitted from the user preferences.
+func applyPreferences(req *dtoHistory.CreateHistoryRequest, pref *generated.UserPreference) {
+	if req.Voice == "" {
+		req.Voice = pref.Voice
+	}
+	if req.Rate == nil {
+		req.Rate = &pref.Rate
+	}
+	if req.Pitch == nil {
+		req.Pitch = &pref.Pitch
+	}
+	if req.Volume == nil {
+		req.Volume = &pref.Volume
+	}
+}
+
+// resolve fills the omitted values of req from its preset and the user
+// preferences, then checks the result against the limits of the user and
+// the voice catalog. The text is screened by f, which may rewrite it.
+func (s *Service) resolve(ctx context.Context, userID uuid.UUID, req *dtoHistory.CreateHistoryRequest, pref *generated.UserPreference, f *filter.Filter) (*filter.Result, error) {
+	if err := s.applyPreset(ctx, userID, req); err != nil {
+		return nil, err
+	}
+	applyPreferences(req, pref)
+	if req.Voice == "" {
+		return nil, &requestError{"voice is required when no preset or default voice is set"}
+	}
+	screened, err := screen(f, &req.Text, req.Format)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkTextLength(req.Text, req.Format, pref.MaxTextLength); err != nil {
+		return nil, err
+	}
+	return screened, s.voices.Check(ctx, req.Voice, *req.Rate, *req.Pitch)
+}
+
+// resolveErrors resolves a batch item, returning problems with the item as
+// validation messages. The moderation result is returned when a rule fired,
+// whether the item was masked or rejected.
+func (s *Service) resolveErrors(ctx context.Context, userID uuid.UUID, req *dtoHistory.CreateHistoryRequest, pref *generated.UserPreference, f *filter.Filter) (*filter.Result, []string, error) {
+	screened, err := s.resolve(ctx, userID, req, pref, f)
+	var rejected *filter.RejectedError
+	if errors.As(err, &rejected) {
+		screened = rejected.Result
+	}
+	if screened != nil && screened.Action == "" {
+		screened = nil
+	}
+	if msgs := ValidationMessages(err); msgs != nil {
+		return screened, msgs, nil
+	}
+	return screened, nil, err
+}
+
+// screen runs text through f, replacing it with the masked text. Text that
+// must be rejected returns a RejectedError listing the rules that fired.
+func screen(f *filter.Filter, text *string, format string) (*filter.Result, error) {
+	res, err := moderation.Screen(f, *text, textFormat(format) == history.FormatSsml)
+	if err != nil {
+		return nil, &requestError{err.Error()}
+	}
+	if res.Rejected() {
+		return nil, &filter.RejectedError{Result: res}
+	}
+	*text = res.Text
+	return res, nil
+}
+
+// requestError is a problem with a request found once defaults are applied.
+type requestError struct {
+	message string
+}
+
+func (e *requestError) Error() string {
+	return e.message
+}
+
+// ValidationMessages returns the message of an error caused by the request
+// itself, or nil for any other error.
+func ValidationMessages(err error) []string {
+	var rejected *filter.RejectedError
+	if errors.As(err, &rejected) {
+		return rejected.Messages()
+	}
+	var reqErr *requestError
+	if errors.As(err, &reqErr) || errors.Is(err, utils.ErrPresetNotFound) || voice.IsValidationError(err) {
+		return []string{err.Error()}
+	}
+	return nil
+}
+
+// checkTextLength limits the spoken length of text, so SSML markup does not
+// count against the user.
+func checkTextLength(text, format string, max int) error {
+	n, err := spokenLength(text, format)
+	if err != nil {
+		return &requestError{err.Error()}
+	}
+	if n > max {
+		return &requestError{fmt.Sprintf("text must be at most %d characters, got %d", max, n)}
+	}
+	return nil
+}
+
+// spokenLength returns the number of characters that are synthesized for
+// text, which is also what the quota is charged.
+func spokenLength(text, format string) (int, error) {
+	plain, err := schema.HistoryPlainText(text, textFormat(format))
+	if err != nil {
+		return 0, err
+	}
+	return utf8.RuneCountInString(plain), nil
+}
+
+// voiceErrors returns the catalog violations of a voice and its parameters
+// as validation messages. Other errors are returned as is.
+func (s *Service) voiceErrors(ctx context.Context, v string, rate, pitch float64) ([]string, error) {
+	err := s.voices.Check(ctx, v, rate, pitch)
+	if err == nil {
+		return nil, nil
+	}
+	if voice.IsValidationError(err) {
+		return []string{err.Error()}, nil
+	}
+	return nil, err
+}
+
+// idResults marks every requested ID as succeeded when it appears in done.
+func idResults(requested, done []uuid.UUID) []dtoHistory.BulkItemResult {
+	ok := make(map[uuid.UUID]bool, len(done))
+	for _, id := range done {
+		ok[id] = true
+	}
+
+	results := make([]dtoHistory.BulkItemResult, len(requested))
+	for i, id := range requested {
+		id := id
+		results[i] = dtoHistory.BulkItemResult{Index: i, ID: &id, Success: ok[id]}
+		if !ok[id] {
+			results[i].Errors = []string{"history not found"}
+		}
+	}
+	return results
+}
+
+// uniqueIDs returns ids without repeats, keeping the first occurrence.
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,102 @@
+package idempotency
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/internal/middleware"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+const (
+	HeaderKey      = "Idempotency-Key"
+	HeaderReplayed = "Idempotent-Replayed"
+
+	maxKeyLength = 255
+)
+
+type Middleware struct {
+	service *Service
+}
+
+func NewMiddleware(service *Service) *Middleware {
+	return &Middleware{service: service}
+}
+
+// Handle replays the stored response when a request is retried with the same
+// Idempotency-Key header. Requests without the header pass through untouched.
+// It must run after JWTMiddleware.Auth because keys are scoped per user.
+func (m *Middleware) Handle(c *fiber.Ctx) error {
+	key := strings.TrimSpace(c.Get(HeaderKey))
+	if key == "" {
+		return c.Next()
+	}
+	if len(key) > maxKeyLength {
+		return middleware.Error(c, "Idempotency-Key is too long", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	record, replay, err := m.service.Begin(c.Context(), userID, key, hashRequest(c))
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrIdempotencyKeyMismatch):
+			return middleware.Error(c, "Idempotency-Key was already used with a different request", fiber.StatusUnprocessableEntity)
+		case errors.Is(err, utils.ErrIdempotencyKeyInProgress):
+			return middleware.Error(c, "A request with this Idempotency-Key is still being processed", fiber.StatusConflict)
+		default:
+			return middleware.Error(c, "Failed to check idempotency key", fiber.StatusInternalServerError)
+		}
+	}
+
+	if replay {
+		c.Set(HeaderReplayed, "true")
+		c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		return c.Status(record.ResponseStatus).Send(record.ResponseBody)
+	}
+
+	if err := c.Next(); err != nil {
+		m.release(c, record.ID)
+		return err
+	}
+
+	status := c.Response().StatusCode()
+	if status >= fiber.StatusInternalServerError || status == fiber.StatusPaymentRequired || status == fiber.StatusTooManyRequests {
+		// Error server dan kuota habis tidak disimpan supaya client bisa retry.
+		m.release(c, record.ID)
+		return nil
+	}
+
+	body := append([]byte(nil), c.Response().Body()...)
+	if err := m.service.Complete(c.Context(), record.ID, status, body); err != nil {
+		log.Errorf("failed to store idempotent response for key %s: %v", key, err)
+		// Tanpa release, key tetap "in progress" sampai TTL habis dan
+		// setiap retry mendapat 409.
+		m.release(c, record.ID)
+	}
+	return nil
+}
+
+func (m *Middleware) release(c *fiber.Ctx, id uuid.UUID) {
+	if err := m.service.Release(c.Context(), id); err != nil {
+		log.Errorf("failed to release idempotency key %s: %v", id, err)
+	}
+}
+
+func hashRequest(c *fiber.Ctx) string {
+	h := sha256.New()
+	h.Write([]byte(c.Method()))
+	h.Write([]byte{'\n'})
+	h.Write([]byte(c.Path()))
+	h.Write([]byte{'\n'})
+	h.Write(c.Body())
+	return hex.EncodeToString(h.Sum(nil))
+}
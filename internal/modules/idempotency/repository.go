@@ -0,0 +1,60 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/idempotencykey"
+	user2 "github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+)
+
+type Repository struct {
+	client *generated.Client
+}
+
+func NewIdempotencyRepository(client *generated.Client) *Repository {
+	return &Repository{client: client}
+}
+
+func (r *Repository) Create(
+	ctx context.Context,
+	userID uuid.UUID,
+	key string,
+	requestHash string,
+	expiresAt time.Time,
+) (*generated.IdempotencyKey, error) {
+	return r.client.IdempotencyKey.Create().
+		SetKey(key).
+		SetRequestHash(requestHash).
+		SetExpiresAt(expiresAt).
+		SetUserID(userID).
+		Save(ctx)
+}
+
+func (r *Repository) GetByKey(ctx context.Context, userID uuid.UUID, key string) (*generated.IdempotencyKey, error) {
+	return r.client.IdempotencyKey.Query().
+		Where(
+			idempotencykey.Key(key),
+			idempotencykey.HasUserWith(user2.ID(userID)),
+		).
+		Only(ctx)
+}
+
+func (r *Repository) Complete(ctx context.Context, id uuid.UUID, status int, body []byte) error {
+	return r.client.IdempotencyKey.UpdateOneID(id).
+		SetResponseStatus(status).
+		SetResponseBody(body).
+		Exec(ctx)
+}
+
+func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.client.IdempotencyKey.DeleteOneID(id).Exec(ctx)
+}
+
+func (r *Repository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	return r.client.IdempotencyKey.Delete().
+		Where(idempotencykey.ExpiresAtLT(now)).
+		Exec(ctx)
+}
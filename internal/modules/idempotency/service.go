@@ -0,0 +1,102 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+// DefaultTTL adalah masa berlaku idempotency key jika IDEMPOTENCY_TTL tidak diset.
+const DefaultTTL = 24 * time.Hour
+
+type Service struct {
+	repo *Repository
+	ttl  time.Duration
+}
+
+func NewService(repo *Repository, ttl time.Duration) *Service {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Service{repo: repo, ttl: ttl}
+}
+
+// Begin reserves key for the user. When the key was already used with the same
+// request hash the stored record is returned with replay set to true so the
+// caller can send back the original response.
+func (s *Service) Begin(
+	ctx context.Context,
+	userID uuid.UUID,
+	key string,
+	requestHash string,
+) (record *generated.IdempotencyKey, replay bool, err error) {
+	existing, err := s.repo.GetByKey(ctx, userID, key)
+	switch {
+	case err == nil:
+		if time.Now().After(existing.ExpiresAt) {
+			if err := s.repo.Delete(ctx, existing.ID); err != nil && !generated.IsNotFound(err) {
+				return nil, false, err
+			}
+			break
+		}
+		if existing.RequestHash != requestHash {
+			return nil, false, utils.ErrIdempotencyKeyMismatch
+		}
+		if existing.ResponseStatus == 0 {
+			return nil, false, utils.ErrIdempotencyKeyInProgress
+		}
+		return existing, true, nil
+	case !generated.IsNotFound(err):
+		return nil, false, err
+	}
+
+	record, err = s.repo.Create(ctx, userID, key, requestHash, time.Now().Add(s.ttl))
+	if err != nil {
+		// Request lain dengan key yang sama menang balapan insert.
+		if generated.IsConstraintError(err) {
+			return nil, false, utils.ErrIdempotencyKeyInProgress
+		}
+		return nil, false, err
+	}
+	return record, false, nil
+}
+
+// Complete stores the response produced for a reserved key.
+func (s *Service) Complete(ctx context.Context, id uuid.UUID, status int, body []byte) error {
+	return s.repo.Complete(ctx, id, status, body)
+}
+
+// Release drops a reserved key so the client may retry with it.
+func (s *Service) Release(ctx context.Context, id uuid.UUID) error {
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *Service) PurgeExpired(ctx context.Context) (int, error) {
+	return s.repo.DeleteExpired(ctx, time.Now())
+}
+
+// RunCleanup purges expired keys every interval until ctx is cancelled.
+func (s *Service) RunCleanup(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := s.PurgeExpired(ctx)
+			if err != nil {
+				log.Errorf("failed to purge idempotency keys: %v", err)
+				continue
+			}
+			if n > 0 {
+				log.Infof("purged %d expired idempotency keys", n)
+			}
+		}
+	}
+}
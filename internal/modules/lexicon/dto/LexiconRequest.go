@@ -0,0 +1,58 @@
+package dtoLexicon
+
+import (
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/non-standard/validators"
+)
+
+var validate *validator.Validate
+
+func init() {
+	validate = validator.New()
+	_ = validate.RegisterValidation("notblank", validators.NotBlank)
+}
+
+// LexiconRequest describes one entry. Exactly one of Alias and Phoneme is
+// set. CaseSensitive defaults to false and WholeWord to true.
+type LexiconRequest struct {
+	Grapheme      string `json:"grapheme" validate:"notblank,max=100"`
+	Alias         string `json:"alias" validate:"required_without=Phoneme,excluded_with=Phoneme,max=200"`
+	Phoneme       string `json:"phoneme" validate:"max=200"`
+	Alphabet      string `json:"alphabet" validate:"omitempty,oneof=ipa x-sampa"`
+	CaseSensitive *bool  `json:"caseSensitive"`
+	WholeWord     *bool  `json:"wholeWord"`
+}
+
+// Validate trims the grapheme first, since the rewriter matches it word by
+// word and surrounding spaces mean nothing.
+func (r *LexiconRequest) Validate() error {
+	r.Grapheme = strings.TrimSpace(r.Grapheme)
+	return validate.Struct(r)
+}
+
+func (r *LexiconRequest) IsCaseSensitive() bool {
+	return r.CaseSensitive != nil && *r.CaseSensitive
+}
+
+func (r *LexiconRequest) IsWholeWord() bool {
+	return r.WholeWord == nil || *r.WholeWord
+}
+
+type ExportQuery struct {
+	Language string `query:"language" validate:"omitempty,bcp47_language_tag"`
+}
+
+func (q *ExportQuery) Validate() error {
+	return validate.Struct(q)
+}
+
+// ImportResult reports a PLS import. Entries whose grapheme already exists
+// are updated. Errors lists the skipped lexemes and why.
+type ImportResult struct {
+	Created int      `json:"created"`
+	Updated int      `json:"updated"`
+	Skipped int      `json:"skipped"`
+	Errors  []string `json:"errors"`
+}
@@ -0,0 +1,207 @@
+package lexicon
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/internal/middleware"
+	dtoLexicon "github.com/kiminodare/HOVARLAY-BE/internal/modules/lexicon/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) Create(c *fiber.Ctx) error {
+	var req dtoLexicon.LexiconRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	entry, err := h.service.Create(c.Context(), userID, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrLexiconEntryExists):
+			return middleware.Error(c, "Lexicon entry already exists", fiber.StatusConflict)
+		case errors.Is(err, utils.ErrLexiconFull):
+			return middleware.Error(c, "Lexicon is full", fiber.StatusUnprocessableEntity)
+		}
+		return middleware.Error(c, "Failed to create lexicon entry", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, entry, "Lexicon entry created successfully", nil)
+}
+
+func (h *Handler) GetByUser(c *fiber.Ctx) error {
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	entries, err := h.service.GetByUser(c.Context(), userID)
+	if err != nil {
+		return middleware.Error(c, "Failed to fetch lexicon", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, entries, "Lexicon fetched successfully", nil)
+}
+
+func (h *Handler) GetByID(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	entry, err := h.service.GetOwned(c.Context(), userID, id)
+	if err != nil {
+		if errors.Is(err, utils.ErrLexiconEntryNotFound) {
+			return middleware.Error(c, "Lexicon entry not found", fiber.StatusNotFound)
+		}
+		return middleware.Error(c, "Failed to fetch lexicon entry", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, entry, "Lexicon entry fetched successfully", nil)
+}
+
+func (h *Handler) Update(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	var req dtoLexicon.LexiconRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	entry, err := h.service.Update(c.Context(), userID, id, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrLexiconEntryNotFound):
+			return middleware.Error(c, "Lexicon entry not found", fiber.StatusNotFound)
+		case errors.Is(err, utils.ErrLexiconEntryExists):
+			return middleware.Error(c, "Lexicon entry already exists", fiber.StatusConflict)
+		}
+		return middleware.Error(c, "Failed to update lexicon entry", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, entry, "Lexicon entry updated successfully", nil)
+}
+
+func (h *Handler) Delete(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	if err := h.service.Delete(c.Context(), userID, id); err != nil {
+		if errors.Is(err, utils.ErrLexiconEntryNotFound) {
+			return middleware.Error(c, "Lexicon entry not found", fiber.StatusNotFound)
+		}
+		return middleware.Error(c, "Failed to delete lexicon entry", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, nil, "Lexicon entry deleted successfully", nil)
+}
+
+// Import reads a PLS document from the "file" field of a multipart upload.
+// With the form field replace=true the current lexicon is replaced.
+func (h *Handler) Import(c *fiber.Ctx) error {
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return middleware.Error(c, "File is required", fiber.StatusBadRequest)
+	}
+
+	replace := false
+	if v := c.FormValue("replace"); v != "" {
+		if replace, err = strconv.ParseBool(v); err != nil {
+			return middleware.Error(c, "replace must be true or false", fiber.StatusBadRequest)
+		}
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return middleware.Error(c, "Failed to read file", fiber.StatusBadRequest)
+	}
+	defer file.Close()
+
+	result, err := h.service.Import(c.Context(), userID, file, replace)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrLexiconFull):
+			return middleware.Error(c, "Lexicon is full", fiber.StatusUnprocessableEntity)
+		case errors.Is(err, utils.ErrInvalidPLS):
+			return middleware.Error(c, "Failed to parse file: "+err.Error(), fiber.StatusBadRequest)
+		}
+		return middleware.Error(c, "Failed to import lexicon", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, result, "Lexicon imported successfully", nil)
+}
+
+// Export downloads the lexicon as a PLS document.
+func (h *Handler) Export(c *fiber.Ctx) error {
+	var query dtoLexicon.ExportQuery
+	if err := c.QueryParser(&query); err != nil {
+		return middleware.Error(c, "Invalid query parameters", fiber.StatusBadRequest)
+	}
+
+	if err := query.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	var buf bytes.Buffer
+	if err := h.service.Export(c.Context(), userID, query.Language, &buf); err != nil {
+		return middleware.Error(c, "Failed to export lexicon", fiber.StatusInternalServerError)
+	}
+
+	c.Attachment("lexicon.pls")
+	c.Set(fiber.HeaderContentType, ContentTypePLS)
+	return c.Send(buf.Bytes())
+}
@@ -0,0 +1,136 @@
+package lexicon
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/lexiconentry"
+	dtoLexicon "github.com/kiminodare/HOVARLAY-BE/internal/modules/lexicon/dto"
+)
+
+// Pronunciation Lexicon Specification (PLS) 1.0. Case sensitivity and whole
+// word matching have no PLS equivalent and travel in attributes of their own
+// namespace, which other tools ignore.
+const (
+	plsNamespace   = "http://www.w3.org/2005/01/pronunciation-lexicon"
+	matchNamespace = "urn:hovarlay:lexicon"
+	ContentTypePLS = "application/pls+xml; charset=utf-8"
+)
+
+type plsDocument struct {
+	XMLName  xml.Name    `xml:"lexicon"`
+	Alphabet string      `xml:"alphabet,attr"`
+	Lexemes  []plsLexeme `xml:"lexeme"`
+}
+
+type plsLexeme struct {
+	Graphemes     []string     `xml:"grapheme"`
+	Phonemes      []plsPhoneme `xml:"phoneme"`
+	Aliases       []string     `xml:"alias"`
+	CaseSensitive string       `xml:"urn:hovarlay:lexicon case-sensitive,attr"`
+	WholeWord     string       `xml:"urn:hovarlay:lexicon whole-word,attr"`
+}
+
+type plsPhoneme struct {
+	Value    string `xml:",chardata"`
+	Alphabet string `xml:"alphabet,attr"`
+	Prefer   string `xml:"prefer,attr"`
+}
+
+// ParsePLS reads a PLS document into one request per grapheme. Lexemes that
+// cannot be used are skipped and described in the returned messages. A
+// lexeme with both pronunciations keeps the preferred phoneme.
+func ParsePLS(r io.Reader) ([]*dtoLexicon.LexiconRequest, []string, error) {
+	var doc plsDocument
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, nil, err
+	}
+
+	var reqs []*dtoLexicon.LexiconRequest
+	var skipped []string
+	for i, lx := range doc.Lexemes {
+		fail := func(msg string) {
+			skipped = append(skipped, fmt.Sprintf("lexeme %d: %s", i+1, msg))
+		}
+
+		req := dtoLexicon.LexiconRequest{}
+		if ph, ok := preferred(lx.Phonemes); ok {
+			req.Phoneme = strings.TrimSpace(ph.Value)
+			req.Alphabet = ph.Alphabet
+			if req.Alphabet == "" {
+				req.Alphabet = doc.Alphabet
+			}
+		} else if len(lx.Aliases) > 0 {
+			req.Alias = strings.TrimSpace(lx.Aliases[0])
+		} else {
+			fail("no phoneme or alias")
+			continue
+		}
+		if req.Phoneme != "" && req.Alphabet != lexiconentry.AlphabetIpa.String() && req.Alphabet != lexiconentry.AlphabetXSampa.String() {
+			fail(fmt.Sprintf("unsupported alphabet %q", req.Alphabet))
+			continue
+		}
+		if v, err := strconv.ParseBool(lx.CaseSensitive); err == nil {
+			req.CaseSensitive = &v
+		}
+		if v, err := strconv.ParseBool(lx.WholeWord); err == nil {
+			req.WholeWord = &v
+		}
+
+		if len(lx.Graphemes) == 0 {
+			fail("no grapheme")
+			continue
+		}
+		for _, g := range lx.Graphemes {
+			r := req
+			r.Grapheme = strings.TrimSpace(g)
+			reqs = append(reqs, &r)
+		}
+	}
+	return reqs, skipped, nil
+}
+
+func preferred(phonemes []plsPhoneme) (plsPhoneme, bool) {
+	for _, ph := range phonemes {
+		if ph.Prefer == "true" {
+			return ph, true
+		}
+	}
+	if len(phonemes) == 0 {
+		return plsPhoneme{}, false
+	}
+	return phonemes[0], true
+}
+
+// WritePLS writes entries as a PLS document in language lang.
+func WritePLS(w io.Writer, lang string, entries []*generated.LexiconEntry) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, `%s<lexicon version="1.0" xmlns="%s" xmlns:hv="%s" alphabet="ipa" xml:lang="%s">`+"\n",
+		xml.Header, plsNamespace, matchNamespace, escape(lang))
+	for _, e := range entries {
+		fmt.Fprintf(bw, `  <lexeme hv:case-sensitive="%t" hv:whole-word="%t">`+"\n", e.CaseSensitive, e.WholeWord)
+		fmt.Fprintf(bw, "    <grapheme>%s</grapheme>\n", escape(e.Grapheme))
+		switch {
+		case e.Phoneme != "" && e.Alphabet != lexiconentry.AlphabetIpa:
+			fmt.Fprintf(bw, `    <phoneme alphabet="%s">%s</phoneme>`+"\n", e.Alphabet, escape(e.Phoneme))
+		case e.Phoneme != "":
+			fmt.Fprintf(bw, "    <phoneme>%s</phoneme>\n", escape(e.Phoneme))
+		default:
+			fmt.Fprintf(bw, "    <alias>%s</alias>\n", escape(e.Alias))
+		}
+		bw.WriteString("  </lexeme>\n")
+	}
+	bw.WriteString("</lexicon>\n")
+	return bw.Flush()
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
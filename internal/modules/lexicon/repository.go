@@ -0,0 +1,125 @@
+package lexicon
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/lexiconentry"
+	user2 "github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/internal/db"
+	dtoLexicon "github.com/kiminodare/HOVARLAY-BE/internal/modules/lexicon/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Repository struct {
+	client *generated.Client
+}
+
+func NewLexiconRepository(client *generated.Client) *Repository {
+	return &Repository{client: client}
+}
+
+func (r *Repository) Create(ctx context.Context, userID uuid.UUID, req *dtoLexicon.LexiconRequest) (*generated.LexiconEntry, error) {
+	return create(r.client.LexiconEntry, userID, req).Save(ctx)
+}
+
+func (r *Repository) GetByUser(ctx context.Context, userID uuid.UUID) ([]*generated.LexiconEntry, error) {
+	return r.client.LexiconEntry.Query().
+		Where(lexiconentry.HasUserWith(user2.ID(userID))).
+		Order(lexiconentry.ByGrapheme()).
+		All(ctx)
+}
+
+func (r *Repository) CountByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	return r.client.LexiconEntry.Query().
+		Where(lexiconentry.HasUserWith(user2.ID(userID))).
+		Count(ctx)
+}
+
+func (r *Repository) GetOwned(ctx context.Context, userID, id uuid.UUID) (*generated.LexiconEntry, error) {
+	e, err := r.client.LexiconEntry.Query().
+		Where(lexiconentry.ID(id), lexiconentry.HasUserWith(user2.ID(userID))).
+		Only(ctx)
+	if generated.IsNotFound(err) {
+		return nil, utils.ErrLexiconEntryNotFound
+	}
+	return e, err
+}
+
+func (r *Repository) Update(ctx context.Context, id uuid.UUID, req *dtoLexicon.LexiconRequest) (*generated.LexiconEntry, error) {
+	return update(r.client.LexiconEntry.UpdateOneID(id), req).Save(ctx)
+}
+
+func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.client.LexiconEntry.DeleteOneID(id).Exec(ctx)
+}
+
+// Import writes entries in one transaction, updating those whose grapheme
+// already exists. With replace the previous lexicon is removed first.
+func (r *Repository) Import(ctx context.Context, userID uuid.UUID, entries []*dtoLexicon.LexiconRequest, replace bool) (created, updated int, err error) {
+	err = db.WithTx(ctx, r.client, func(tx *generated.Tx) error {
+		created, updated = 0, 0
+		owned := lexiconentry.HasUserWith(user2.ID(userID))
+		if replace {
+			if _, err := tx.LexiconEntry.Delete().Where(owned).Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		existing, err := tx.LexiconEntry.Query().Where(owned).All(ctx)
+		if err != nil {
+			return err
+		}
+		ids := make(map[string]uuid.UUID, len(existing))
+		for _, e := range existing {
+			ids[e.Grapheme] = e.ID
+		}
+
+		for _, req := range entries {
+			if id, ok := ids[req.Grapheme]; ok {
+				if err := update(tx.LexiconEntry.UpdateOneID(id), req).Exec(ctx); err != nil {
+					return err
+				}
+				updated++
+				continue
+			}
+			e, err := create(tx.LexiconEntry, userID, req).Save(ctx)
+			if err != nil {
+				return err
+			}
+			ids[e.Grapheme] = e.ID
+			created++
+		}
+		return nil
+	})
+	return created, updated, err
+}
+
+func create(c *generated.LexiconEntryClient, userID uuid.UUID, req *dtoLexicon.LexiconRequest) *generated.LexiconEntryCreate {
+	q := c.Create().
+		SetGrapheme(req.Grapheme).
+		SetAlias(req.Alias).
+		SetPhoneme(req.Phoneme).
+		SetCaseSensitive(req.IsCaseSensitive()).
+		SetWholeWord(req.IsWholeWord()).
+		SetUserID(userID)
+	if req.Alphabet != "" {
+		q.SetAlphabet(lexiconentry.Alphabet(req.Alphabet))
+	}
+	return q
+}
+
+func update(q *generated.LexiconEntryUpdateOne, req *dtoLexicon.LexiconRequest) *generated.LexiconEntryUpdateOne {
+	alphabet := lexiconentry.DefaultAlphabet
+	if req.Alphabet != "" {
+		alphabet = lexiconentry.Alphabet(req.Alphabet)
+	}
+	return q.
+		SetGrapheme(req.Grapheme).
+		SetAlias(req.Alias).
+		SetPhoneme(req.Phoneme).
+		SetAlphabet(alphabet).
+		SetCaseSensitive(req.IsCaseSensitive()).
+		SetWholeWord(req.IsWholeWord())
+}
@@ -0,0 +1,193 @@
+package lexicon
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/internal/ssml"
+)
+
+// Rewriter marks the words of a lexicon in text as <sub> or <phoneme>
+// elements. A nil Rewriter leaves text unchanged.
+type Rewriter struct {
+	pattern *regexp.Regexp
+	entries []*generated.LexiconEntry
+	// anchored holds the pattern of each entry, matching only at the start.
+	anchored []*regexp.Regexp
+}
+
+// NewRewriter compiles entries into one pattern. Longer graphemes are tried
+// first, so "Hovarlay Live" wins over "Hovarlay".
+func NewRewriter(entries []*generated.LexiconEntry) *Rewriter {
+	if len(entries) == 0 {
+		return nil
+	}
+	sorted := append([]*generated.LexiconEntry(nil), entries...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return len(sorted[i].Grapheme) > len(sorted[j].Grapheme)
+	})
+
+	var parts []string
+	var anchored []*regexp.Regexp
+	kept := sorted[:0]
+	for _, e := range sorted {
+		// Spasi di grapheme cocok dengan spasi apa pun, termasuk baris baru.
+		p := strings.Join(strings.Fields(regexp.QuoteMeta(e.Grapheme)), `\s+`)
+		if p == "" {
+			// Grapheme kosong cocok dengan nol byte di mana-mana.
+			continue
+		}
+		if !e.CaseSensitive {
+			p = "(?i:" + p + ")"
+		}
+		parts = append(parts, "("+p+")")
+		anchored = append(anchored, regexp.MustCompile("^("+p+")"))
+		kept = append(kept, e)
+	}
+	if len(kept) == 0 {
+		return nil
+	}
+	return &Rewriter{
+		pattern:  regexp.MustCompile(strings.Join(parts, "|")),
+		entries:  kept,
+		anchored: anchored,
+	}
+}
+
+// Apply rewrites text and reports whether anything matched. Plain text with
+// matches is returned as an SSML document, since pronunciations need markup.
+func (r *Rewriter) Apply(text string, isSSML bool) (string, bool) {
+	if r == nil {
+		return text, isSSML
+	}
+	if isSSML {
+		root, err := ssml.Parse(text)
+		if err != nil || !r.ApplyNode(root) {
+			return text, true
+		}
+		return root.String(), true
+	}
+
+	root := &ssml.Node{Name: "speak", Children: []*ssml.Node{{Text: text}}}
+	if !r.ApplyNode(root) {
+		return text, false
+	}
+	return root.String(), true
+}
+
+// ApplyNode rewrites the text below n in place and reports whether anything
+// matched. Content of <say-as>, <sub> and <phoneme> already says how to read
+// it and is left alone.
+func (r *Rewriter) ApplyNode(n *ssml.Node) bool {
+	if r == nil || n.IsText() || n.Name == "say-as" || n.Name == "sub" || n.Name == "phoneme" {
+		return false
+	}
+	changed := false
+	children := make([]*ssml.Node, 0, len(n.Children))
+	for _, c := range n.Children {
+		if !c.IsText() {
+			changed = r.ApplyNode(c) || changed
+			children = append(children, c)
+			continue
+		}
+		if parts := r.split(c.Text); parts != nil {
+			children = append(children, parts...)
+			changed = true
+			continue
+		}
+		children = append(children, c)
+	}
+	n.Children = children
+	return changed
+}
+
+// split returns text as text nodes around the matched words, or nil when no
+// word matched.
+func (r *Rewriter) split(text string) []*ssml.Node {
+	var nodes []*ssml.Node
+	pos, search := 0, 0
+	for search < len(text) {
+		loc := r.pattern.FindStringSubmatchIndex(text[search:])
+		if loc == nil {
+			break
+		}
+		start := search + loc[0]
+		e, end := r.match(text, start, loc)
+		if e == nil || end == start {
+			_, size := utf8.DecodeRuneInString(text[start:])
+			search = start + size
+			continue
+		}
+
+		if start > pos {
+			nodes = append(nodes, &ssml.Node{Text: text[pos:start]})
+		}
+		nodes = append(nodes, element(e, text[start:end]))
+		pos, search = end, end
+	}
+	if nodes == nil {
+		return nil
+	}
+	if pos < len(text) {
+		nodes = append(nodes, &ssml.Node{Text: text[pos:]})
+	}
+	return nodes
+}
+
+// match returns the longest entry at start that keeps its word boundary,
+// with the end of its match. loc is the match of the combined pattern, so
+// entries before the one it found do not match at start.
+func (r *Rewriter) match(text string, start int, loc []int) (*generated.LexiconEntry, int) {
+	first := 0
+	for first < len(r.entries) && loc[2+2*first] < 0 {
+		first++
+	}
+	for i := first; i < len(r.entries); i++ {
+		e, end := r.entries[i], start+loc[1]-loc[0]
+		if i > first {
+			m := r.anchored[i].FindStringIndex(text[start:])
+			if m == nil {
+				continue
+			}
+			end = start + m[1]
+		}
+		if !e.WholeWord || wordBoundary(text, start, end) {
+			return e, end
+		}
+	}
+	return nil, 0
+}
+
+func element(e *generated.LexiconEntry, matched string) *ssml.Node {
+	n := &ssml.Node{Children: []*ssml.Node{{Text: matched}}}
+	if e.Phoneme != "" {
+		n.Name = "phoneme"
+		n.Attrs = map[string]string{"alphabet": e.Alphabet.String(), "ph": e.Phoneme}
+	} else {
+		n.Name = "sub"
+		n.Attrs = map[string]string{"alias": e.Alias}
+	}
+	return n
+}
+
+// wordBoundary reports whether text[start:end] is not part of a longer word.
+// Graphemes that begin or end with punctuation, such as "C++", only need a
+// boundary on their word side.
+func wordBoundary(text string, start, end int) bool {
+	first, _ := utf8.DecodeRuneInString(text[start:])
+	before, _ := utf8.DecodeLastRuneInString(text[:start])
+	if isWord(first) && start > 0 && isWord(before) {
+		return false
+	}
+	last, _ := utf8.DecodeLastRuneInString(text[:end])
+	after, _ := utf8.DecodeRuneInString(text[end:])
+	return !(isWord(last) && end < len(text) && isWord(after))
+}
+
+func isWord(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
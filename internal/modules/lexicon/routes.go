@@ -0,0 +1,13 @@
+package lexicon
+
+import "github.com/gofiber/fiber/v2"
+
+func SetupLexiconRoutes(router fiber.Router, handler *Handler) {
+	router.Get("/lexicon", handler.GetByUser)
+	router.Post("/lexicon", handler.Create)
+	router.Get("/lexicon/export", handler.Export)
+	router.Post("/lexicon/import", handler.Import)
+	router.Get("/lexicon/:id", handler.GetByID)
+	router.Put("/lexicon/:id", handler.Update)
+	router.Delete("/lexicon/:id", handler.Delete)
+}
@@ -0,0 +1,150 @@
+package lexicon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	dtoLexicon "github.com/kiminodare/HOVARLAY-BE/internal/modules/lexicon/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+// MaxEntries membatasi ukuran lexicon per user, karena semua entry
+// digabung menjadi satu pola setiap kali render.
+const MaxEntries = 1000
+
+// DefaultLanguage is the xml:lang of exported lexicons when none is asked for.
+const DefaultLanguage = "id-ID"
+
+type Service struct {
+	repo *Repository
+}
+
+func NewService(repo *Repository) *Service {
+	return &Service{repo: repo}
+}
+
+func (s *Service) Create(ctx context.Context, userID uuid.UUID, req *dtoLexicon.LexiconRequest) (*generated.LexiconEntry, error) {
+	n, err := s.repo.CountByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if n >= MaxEntries {
+		return nil, utils.ErrLexiconFull
+	}
+
+	e, err := s.repo.Create(ctx, userID, req)
+	if generated.IsConstraintError(err) {
+		return nil, utils.ErrLexiconEntryExists
+	}
+	return e, err
+}
+
+func (s *Service) GetByUser(ctx context.Context, userID uuid.UUID) ([]*generated.LexiconEntry, error) {
+	return s.repo.GetByUser(ctx, userID)
+}
+
+// GetOwned returns the entry or ErrLexiconEntryNotFound when it is not the user's.
+func (s *Service) GetOwned(ctx context.Context, userID, id uuid.UUID) (*generated.LexiconEntry, error) {
+	return s.repo.GetOwned(ctx, userID, id)
+}
+
+func (s *Service) Update(ctx context.Context, userID, id uuid.UUID, req *dtoLexicon.LexiconRequest) (*generated.LexiconEntry, error) {
+	if _, err := s.repo.GetOwned(ctx, userID, id); err != nil {
+		return nil, err
+	}
+
+	e, err := s.repo.Update(ctx, id, req)
+	if generated.IsConstraintError(err) {
+		return nil, utils.ErrLexiconEntryExists
+	}
+	return e, err
+}
+
+func (s *Service) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	if _, err := s.repo.GetOwned(ctx, userID, id); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// Import adds the lexemes of a PLS document, updating entries whose
+// grapheme already exists, or replacing the whole lexicon with replace.
+// Invalid lexemes are skipped; the import fails only when the result would
+// exceed MaxEntries.
+func (s *Service) Import(ctx context.Context, userID uuid.UUID, r io.Reader, replace bool) (*dtoLexicon.ImportResult, error) {
+	reqs, skipped, err := ParsePLS(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", utils.ErrInvalidPLS, err)
+	}
+
+	result := &dtoLexicon.ImportResult{Errors: append([]string{}, skipped...)}
+	valid := make([]*dtoLexicon.LexiconRequest, 0, len(reqs))
+	seen := make(map[string]bool, len(reqs))
+	for _, req := range reqs {
+		if err := req.Validate(); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("grapheme %q: %s", req.Grapheme, strings.Join(utils.FormatValidationErrors(err), "; ")))
+			continue
+		}
+		if !seen[req.Grapheme] {
+			seen[req.Grapheme] = true
+			valid = append(valid, req)
+			continue
+		}
+		// Grapheme ganda di satu dokumen: yang terakhir menang.
+		for i, v := range valid {
+			if v.Grapheme == req.Grapheme {
+				valid[i] = req
+			}
+		}
+	}
+	result.Skipped = len(result.Errors)
+
+	total := len(valid)
+	if !replace {
+		existing, err := s.repo.GetByUser(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		total += len(existing)
+		for _, e := range existing {
+			if seen[e.Grapheme] {
+				total--
+			}
+		}
+	}
+	if total > MaxEntries {
+		return nil, utils.ErrLexiconFull
+	}
+
+	result.Created, result.Updated, err = s.repo.Import(ctx, userID, valid, replace)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Export writes the lexicon of the user as a PLS document.
+func (s *Service) Export(ctx context.Context, userID uuid.UUID, lang string, w io.Writer) error {
+	entries, err := s.repo.GetByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if lang == "" {
+		lang = DefaultLanguage
+	}
+	return WritePLS(w, lang, entries)
+}
+
+// Rewriter returns the lexicon of the user ready to apply, or nil when it
+// is empty.
+func (s *Service) Rewriter(ctx context.Context, userID uuid.UUID) (*Rewriter, error) {
+	entries, err := s.repo.GetByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return NewRewriter(entries), nil
+}
@@ -0,0 +1,45 @@
+package dtoModeration
+
+import "github.com/go-playground/validator/v10"
+
+var validate *validator.Validate
+
+func init() {
+	validate = validator.New()
+}
+
+// SettingsRequest replaces the moderation settings of a user.
+type SettingsRequest struct {
+	Enabled      bool     `json:"enabled"`
+	BuiltinLists []string `json:"builtinLists" validate:"max=10,dive,oneof=id en"`
+	Action       string   `json:"action" validate:"required,oneof=reject mask replace"`
+	Replacement  string   `json:"replacement" validate:"max=100"`
+}
+
+func (r *SettingsRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+// RuleRequest describes a blocked word or phrase, a regular expression, or
+// an allowed word. Allowed words take no action.
+type RuleRequest struct {
+	Kind        string  `json:"kind" validate:"required,oneof=word regex allow"`
+	Pattern     string  `json:"pattern" validate:"required,max=200"`
+	Action      string  `json:"action" validate:"excluded_if=Kind allow,omitempty,oneof=reject mask replace"`
+	Replacement *string `json:"replacement" validate:"excluded_if=Kind allow,omitempty,max=100"`
+}
+
+func (r *RuleRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+// CheckRequest screens a message, for example one submitted by a viewer,
+// without saving it.
+type CheckRequest struct {
+	Text   string `json:"text" validate:"required,max=20000"`
+	Format string `json:"format" validate:"omitempty,oneof=plain ssml"`
+}
+
+func (r *CheckRequest) Validate() error {
+	return validate.Struct(r)
+}
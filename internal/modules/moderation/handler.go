@@ -0,0 +1,186 @@
+package moderation
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/internal/middleware"
+	dtoModeration "github.com/kiminodare/HOVARLAY-BE/internal/modules/moderation/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) GetSettings(c *fiber.Ctx) error {
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	settings, err := h.service.Settings(c.Context(), userID)
+	if err != nil {
+		return middleware.Error(c, "Failed to fetch moderation settings", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, settings, "Moderation settings fetched successfully", nil)
+}
+
+func (h *Handler) SaveSettings(c *fiber.Ctx) error {
+	var req dtoModeration.SettingsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	settings, err := h.service.SaveSettings(c.Context(), userID, &req)
+	if err != nil {
+		return middleware.Error(c, "Failed to save moderation settings", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, settings, "Moderation settings saved successfully", nil)
+}
+
+func (h *Handler) GetRules(c *fiber.Ctx) error {
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	rules, err := h.service.GetRules(c.Context(), userID)
+	if err != nil {
+		return middleware.Error(c, "Failed to fetch moderation rules", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, rules, "Moderation rules fetched successfully", nil)
+}
+
+func (h *Handler) CreateRule(c *fiber.Ctx) error {
+	var req dtoModeration.RuleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	rule, err := h.service.CreateRule(c.Context(), userID, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrInvalidPattern):
+			return middleware.ValidationError(c, []string{err.Error()})
+		case errors.Is(err, utils.ErrModerationRuleExists):
+			return middleware.Error(c, "Moderation rule already exists", fiber.StatusConflict)
+		case errors.Is(err, utils.ErrModerationRulesFull):
+			return middleware.Error(c, err.Error(), fiber.StatusUnprocessableEntity)
+		}
+		return middleware.Error(c, "Failed to create moderation rule", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, rule, "Moderation rule created successfully", nil)
+}
+
+func (h *Handler) UpdateRule(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	var req dtoModeration.RuleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	rule, err := h.service.UpdateRule(c.Context(), userID, id, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrModerationRuleNotFound):
+			return middleware.Error(c, "Moderation rule not found", fiber.StatusNotFound)
+		case errors.Is(err, utils.ErrInvalidPattern):
+			return middleware.ValidationError(c, []string{err.Error()})
+		case errors.Is(err, utils.ErrModerationRuleExists):
+			return middleware.Error(c, "Moderation rule already exists", fiber.StatusConflict)
+		}
+		return middleware.Error(c, "Failed to update moderation rule", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, rule, "Moderation rule updated successfully", nil)
+}
+
+func (h *Handler) DeleteRule(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	if err := h.service.DeleteRule(c.Context(), userID, id); err != nil {
+		if errors.Is(err, utils.ErrModerationRuleNotFound) {
+			return middleware.Error(c, "Moderation rule not found", fiber.StatusNotFound)
+		}
+		return middleware.Error(c, "Failed to delete moderation rule", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, nil, "Moderation rule deleted successfully", nil)
+}
+
+// Check screens a message and reports which rules fired. Rejected messages
+// are still a successful check; the action of the result says what to do.
+func (h *Handler) Check(c *fiber.Ctx) error {
+	var req dtoModeration.CheckRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	result, err := h.service.Check(c.Context(), userID, &req)
+	if err != nil {
+		if errors.Is(err, utils.ErrInvalidData) {
+			return middleware.ValidationError(c, []string{err.Error()})
+		}
+		return middleware.Error(c, "Failed to check text", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, result, "Text checked successfully", nil)
+}
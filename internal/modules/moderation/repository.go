@@ -0,0 +1,103 @@
+package moderation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationrule"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationsetting"
+	user2 "github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	dtoModeration "github.com/kiminodare/HOVARLAY-BE/internal/modules/moderation/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Repository struct {
+	client *generated.Client
+}
+
+func NewModerationRepository(client *generated.Client) *Repository {
+	return &Repository{client: client}
+}
+
+func (r *Repository) GetSettings(ctx context.Context, userID uuid.UUID) (*generated.ModerationSetting, error) {
+	return r.client.ModerationSetting.Query().
+		Where(moderationsetting.HasUserWith(user2.ID(userID))).
+		Only(ctx)
+}
+
+func (r *Repository) CreateSettings(ctx context.Context, userID uuid.UUID, req *dtoModeration.SettingsRequest) (*generated.ModerationSetting, error) {
+	return r.client.ModerationSetting.Create().
+		SetEnabled(req.Enabled).
+		SetBuiltinLists(req.BuiltinLists).
+		SetAction(moderationsetting.Action(req.Action)).
+		SetReplacement(req.Replacement).
+		SetUserID(userID).
+		Save(ctx)
+}
+
+func (r *Repository) UpdateSettings(ctx context.Context, id uuid.UUID, req *dtoModeration.SettingsRequest) (*generated.ModerationSetting, error) {
+	return r.client.ModerationSetting.UpdateOneID(id).
+		SetEnabled(req.Enabled).
+		SetBuiltinLists(req.BuiltinLists).
+		SetAction(moderationsetting.Action(req.Action)).
+		SetReplacement(req.Replacement).
+		Save(ctx)
+}
+
+func (r *Repository) CreateRule(ctx context.Context, userID uuid.UUID, req *dtoModeration.RuleRequest) (*generated.ModerationRule, error) {
+	create := r.client.ModerationRule.Create().
+		SetKind(moderationrule.Kind(req.Kind)).
+		SetPattern(req.Pattern).
+		SetNillableReplacement(req.Replacement).
+		SetUserID(userID)
+	if req.Action != "" {
+		create.SetAction(moderationrule.Action(req.Action))
+	}
+	return create.Save(ctx)
+}
+
+// GetRules returns the rules of the user, blocked words first.
+func (r *Repository) GetRules(ctx context.Context, userID uuid.UUID) ([]*generated.ModerationRule, error) {
+	return r.client.ModerationRule.Query().
+		Where(moderationrule.HasUserWith(user2.ID(userID))).
+		Order(moderationrule.ByKind(), moderationrule.ByPattern()).
+		All(ctx)
+}
+
+func (r *Repository) CountRules(ctx context.Context, userID uuid.UUID) (int, error) {
+	return r.client.ModerationRule.Query().
+		Where(moderationrule.HasUserWith(user2.ID(userID))).
+		Count(ctx)
+}
+
+func (r *Repository) GetOwnedRule(ctx context.Context, userID, id uuid.UUID) (*generated.ModerationRule, error) {
+	rule, err := r.client.ModerationRule.Query().
+		Where(moderationrule.ID(id), moderationrule.HasUserWith(user2.ID(userID))).
+		Only(ctx)
+	if generated.IsNotFound(err) {
+		return nil, utils.ErrModerationRuleNotFound
+	}
+	return rule, err
+}
+
+func (r *Repository) UpdateRule(ctx context.Context, id uuid.UUID, req *dtoModeration.RuleRequest) (*generated.ModerationRule, error) {
+	update := r.client.ModerationRule.UpdateOneID(id).
+		SetKind(moderationrule.Kind(req.Kind)).
+		SetPattern(req.Pattern)
+	if req.Action != "" {
+		update.SetAction(moderationrule.Action(req.Action))
+	} else {
+		update.ClearAction()
+	}
+	if req.Replacement != nil {
+		update.SetReplacement(*req.Replacement)
+	} else {
+		update.ClearReplacement()
+	}
+	return update.Save(ctx)
+}
+
+func (r *Repository) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	return r.client.ModerationRule.DeleteOneID(id).Exec(ctx)
+}
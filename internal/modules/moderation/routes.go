@@ -0,0 +1,13 @@
+package moderation
+
+import "github.com/gofiber/fiber/v2"
+
+func SetupModerationRoutes(router fiber.Router, handler *Handler) {
+	router.Get("/moderation/settings", handler.GetSettings)
+	router.Put("/moderation/settings", handler.SaveSettings)
+	router.Get("/moderation/rules", handler.GetRules)
+	router.Post("/moderation/rules", handler.CreateRule)
+	router.Put("/moderation/rules/:id", handler.UpdateRule)
+	router.Delete("/moderation/rules/:id", handler.DeleteRule)
+	router.Post("/moderation/check", handler.Check)
+}
@@ -0,0 +1,248 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationrule"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/moderationsetting"
+	filter "github.com/kiminodare/HOVARLAY-BE/internal/moderation"
+	dtoModeration "github.com/kiminodare/HOVARLAY-BE/internal/modules/moderation/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+// MaxRules adalah jumlah aturan moderasi maksimum per user.
+const MaxRules = 500
+
+// filterCacheTTL bounds how long a compiled filter is reused. Writes through
+// this service drop it at once; the expiry covers writes made elsewhere,
+// such as by another instance.
+const filterCacheTTL = 5 * time.Minute
+
+// maxCachedFilters triggers a sweep of expired filters, so users that went
+// away do not stay in memory.
+const maxCachedFilters = 1000
+
+type cachedFilter struct {
+	filter  *filter.Filter
+	expires time.Time
+}
+
+type Service struct {
+	repo *Repository
+
+	mu       sync.Mutex
+	filters  map[uuid.UUID]cachedFilter
+	versions map[uuid.UUID]uint64
+}
+
+func NewService(repo *Repository) *Service {
+	return &Service{repo: repo, filters: make(map[uuid.UUID]cachedFilter), versions: make(map[uuid.UUID]uint64)}
+}
+
+// Settings returns the moderation settings of the user. Users that never
+// saved any get the defaults, which are not stored.
+func (s *Service) Settings(ctx context.Context, userID uuid.UUID) (*generated.ModerationSetting, error) {
+	settings, err := s.repo.GetSettings(ctx, userID)
+	if generated.IsNotFound(err) {
+		return &generated.ModerationSetting{
+			Enabled:      moderationsetting.DefaultEnabled,
+			BuiltinLists: moderationsetting.DefaultBuiltinLists,
+			Action:       moderationsetting.DefaultAction,
+			Replacement:  moderationsetting.DefaultReplacement,
+		}, nil
+	}
+	return settings, err
+}
+
+// SaveSettings replaces the settings of the user, creating them on first use.
+// When two first saves race, the later one updates what the earlier created.
+func (s *Service) SaveSettings(ctx context.Context, userID uuid.UUID, req *dtoModeration.SettingsRequest) (*generated.ModerationSetting, error) {
+	if req.BuiltinLists == nil {
+		req.BuiltinLists = []string{}
+	}
+	if req.Replacement == "" {
+		req.Replacement = moderationsetting.DefaultReplacement
+	}
+
+	defer s.invalidate(userID)
+	settings, err := s.repo.GetSettings(ctx, userID)
+	switch {
+	case generated.IsNotFound(err):
+		created, err := s.repo.CreateSettings(ctx, userID, req)
+		if !generated.IsConstraintError(err) {
+			return created, err
+		}
+		// Permintaan lain membuatnya lebih dulu, jadi yang ini menimpanya.
+		if settings, err = s.repo.GetSettings(ctx, userID); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	}
+	return s.repo.UpdateSettings(ctx, settings.ID, req)
+}
+
+func (s *Service) GetRules(ctx context.Context, userID uuid.UUID) ([]*generated.ModerationRule, error) {
+	return s.repo.GetRules(ctx, userID)
+}
+
+func (s *Service) CreateRule(ctx context.Context, userID uuid.UUID, req *dtoModeration.RuleRequest) (*generated.ModerationRule, error) {
+	if err := checkPattern(req); err != nil {
+		return nil, err
+	}
+	n, err := s.repo.CountRules(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if n >= MaxRules {
+		return nil, utils.ErrModerationRulesFull
+	}
+
+	defer s.invalidate(userID)
+	rule, err := s.repo.CreateRule(ctx, userID, req)
+	if generated.IsConstraintError(err) {
+		return nil, utils.ErrModerationRuleExists
+	}
+	return rule, err
+}
+
+func (s *Service) UpdateRule(ctx context.Context, userID, id uuid.UUID, req *dtoModeration.RuleRequest) (*generated.ModerationRule, error) {
+	if _, err := s.repo.GetOwnedRule(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	if err := checkPattern(req); err != nil {
+		return nil, err
+	}
+
+	defer s.invalidate(userID)
+	rule, err := s.repo.UpdateRule(ctx, id, req)
+	if generated.IsConstraintError(err) {
+		return nil, utils.ErrModerationRuleExists
+	}
+	return rule, err
+}
+
+func (s *Service) DeleteRule(ctx context.Context, userID, id uuid.UUID) error {
+	if _, err := s.repo.GetOwnedRule(ctx, userID, id); err != nil {
+		return err
+	}
+	defer s.invalidate(userID)
+	return s.repo.DeleteRule(ctx, id)
+}
+
+// Filter returns the filter of the user, built from the settings and rules,
+// or nil when moderation is disabled. A nil filter passes every text. Built
+// filters are cached until the settings or rules of the user change.
+func (s *Service) Filter(ctx context.Context, userID uuid.UUID) (*filter.Filter, error) {
+	s.mu.Lock()
+	cached, ok := s.filters[userID]
+	version := s.versions[userID]
+	s.mu.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.filter, nil
+	}
+
+	f, err := s.build(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	// Aturan yang berubah selama filter dibangun membuat hasilnya usang.
+	if s.versions[userID] == version {
+		now := time.Now()
+		if len(s.filters) >= maxCachedFilters {
+			for id, c := range s.filters {
+				if now.After(c.expires) {
+					delete(s.filters, id)
+				}
+			}
+		}
+		s.filters[userID] = cachedFilter{filter: f, expires: now.Add(filterCacheTTL)}
+	}
+	s.mu.Unlock()
+	return f, nil
+}
+
+// invalidate drops the cached filter of the user.
+func (s *Service) invalidate(userID uuid.UUID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.filters, userID)
+	s.versions[userID]++
+}
+
+func (s *Service) build(ctx context.Context, userID uuid.UUID) (*filter.Filter, error) {
+	settings, err := s.Settings(ctx, userID)
+	if err != nil || !settings.Enabled {
+		return nil, err
+	}
+	rules, err := s.repo.GetRules(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := filter.Config{
+		Builtin:     settings.BuiltinLists,
+		Action:      filter.Action(settings.Action),
+		Replacement: settings.Replacement,
+	}
+	for _, r := range rules {
+		if r.Kind == moderationrule.KindAllow {
+			cfg.Allow = append(cfg.Allow, r.Pattern)
+			continue
+		}
+		rule := filter.Rule{
+			ID:      r.ID.String(),
+			Source:  filter.SourceUser,
+			Kind:    filter.Kind(r.Kind),
+			Pattern: r.Pattern,
+		}
+		if r.Action != nil {
+			rule.Action = filter.Action(*r.Action)
+		}
+		if r.Replacement != nil {
+			rule.Replacement = *r.Replacement
+		}
+		cfg.Rules = append(cfg.Rules, rule)
+	}
+	return filter.New(cfg)
+}
+
+// Check screens text with the filter of the user without saving anything,
+// so integrations can screen messages from viewers before using them.
+func (s *Service) Check(ctx context.Context, userID uuid.UUID, req *dtoModeration.CheckRequest) (*filter.Result, error) {
+	f, err := s.Filter(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return Screen(f, req.Text, req.Format == "ssml")
+}
+
+// Screen applies f to plain text or to an SSML document.
+func Screen(f *filter.Filter, text string, isSSML bool) (*filter.Result, error) {
+	if isSSML {
+		res, err := f.CheckSSML(text)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", utils.ErrInvalidData, err)
+		}
+		return res, nil
+	}
+	return f.Check(text), nil
+}
+
+// checkPattern rejects regular expressions that do not compile.
+func checkPattern(req *dtoModeration.RuleRequest) error {
+	if req.Kind != string(moderationrule.KindRegex) {
+		return nil
+	}
+	if _, err := filter.CompileRegex(req.Pattern); err != nil {
+		return fmt.Errorf("%w: %v", utils.ErrInvalidPattern, err)
+	}
+	return nil
+}
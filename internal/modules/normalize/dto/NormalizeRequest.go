@@ -0,0 +1,33 @@
+package dtoNormalize
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/kiminodare/HOVARLAY-BE/internal/textnorm"
+)
+
+var validate *validator.Validate
+
+func init() {
+	validate = validator.New()
+}
+
+type NormalizeRequest struct {
+	Text string `json:"text" validate:"required,max=20000"`
+	// Language defaults to the language of the user's preferences.
+	Language string `json:"language" validate:"omitempty,bcp47_language_tag"`
+	// Rules limits the rules applied; all of them when empty.
+	Rules []string `json:"rules" validate:"omitempty,dive,oneof=url emoji date time currency percent fraction number abbreviation"`
+	Emoji string   `json:"emoji" validate:"omitempty,oneof=name strip"`
+}
+
+func (r *NormalizeRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+func (r *NormalizeRequest) Options(language string) textnorm.Options {
+	return textnorm.Options{
+		Language: language,
+		Rules:    r.Rules,
+		Emoji:    textnorm.EmojiMode(r.Emoji),
+	}
+}
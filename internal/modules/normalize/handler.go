@@ -0,0 +1,48 @@
+package normalize
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiminodare/HOVARLAY-BE/internal/middleware"
+	dtoNormalize "github.com/kiminodare/HOVARLAY-BE/internal/modules/normalize/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/textnorm"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) Preview(c *fiber.Ctx) error {
+	var req dtoNormalize.NormalizeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	result, err := h.service.Preview(c.Context(), userID, &req)
+	if err != nil {
+		if errors.Is(err, textnorm.ErrUnsupportedLanguage) {
+			return middleware.ValidationError(c, []string{
+				"Language is not supported, use one of: " + strings.Join(textnorm.Languages(), ", "),
+			})
+		}
+		return middleware.Error(c, "Failed to normalize text", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, result, "Text normalized successfully", nil)
+}
@@ -0,0 +1,7 @@
+package normalize
+
+import "github.com/gofiber/fiber/v2"
+
+func SetupNormalizeRoutes(router fiber.Router, handler *Handler) {
+	router.Post("/normalize", handler.Preview)
+}
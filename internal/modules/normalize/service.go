@@ -0,0 +1,54 @@
+package normalize
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	dtoNormalize "github.com/kiminodare/HOVARLAY-BE/internal/modules/normalize/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/preference"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/voice"
+	"github.com/kiminodare/HOVARLAY-BE/internal/textnorm"
+)
+
+// DefaultLanguage dipakai bila bahasa tidak diminta dan tidak ada di preferensi.
+const DefaultLanguage = "id"
+
+type Service struct {
+	preferences *preference.Service
+	voices      *voice.Service
+}
+
+func NewService(preferences *preference.Service, voices *voice.Service) *Service {
+	return &Service{preferences: preferences, voices: voices}
+}
+
+// Preview normalizes text without storing anything. Without a language in
+// the request it uses the preferred language of the user, then the language
+// of their preferred voice.
+func (s *Service) Preview(ctx context.Context, userID uuid.UUID, req *dtoNormalize.NormalizeRequest) (*textnorm.Result, error) {
+	language := req.Language
+	if language == "" {
+		var err error
+		if language, err = s.language(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+	return textnorm.Normalize(req.Text, req.Options(language))
+}
+
+func (s *Service) language(ctx context.Context, userID uuid.UUID) (string, error) {
+	p, err := s.preferences.Get(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if p.Language != "" {
+		return p.Language, nil
+	}
+	if p.Voice != "" {
+		// Suara yang sudah dihapus dari katalog tidak menggagalkan preview.
+		if v, err := s.voices.Get(ctx, p.Voice); err == nil {
+			return v.Language, nil
+		}
+	}
+	return DefaultLanguage, nil
+}
@@ -0,0 +1,22 @@
+package dtoPreference
+
+import "github.com/go-playground/validator/v10"
+
+var validate *validator.Validate
+
+func init() {
+	validate = validator.New()
+}
+
+type PreferenceRequest struct {
+	Voice         string  `json:"voice"`
+	Rate          float64 `json:"rate" validate:"min=0.1,max=5"`
+	Pitch         float64 `json:"pitch" validate:"min=0,max=2"`
+	Volume        float64 `json:"volume" validate:"min=0,max=1"`
+	Language      string  `json:"language" validate:"omitempty,bcp47_language_tag"`
+	MaxTextLength int     `json:"maxTextLength" validate:"min=1,max=20000"`
+}
+
+func (r *PreferenceRequest) Validate() error {
+	return validate.Struct(r)
+}
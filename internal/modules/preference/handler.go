@@ -0,0 +1,57 @@
+package preference
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiminodare/HOVARLAY-BE/internal/middleware"
+	dtoPreference "github.com/kiminodare/HOVARLAY-BE/internal/modules/preference/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/voice"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) Get(c *fiber.Ctx) error {
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	pref, err := h.service.Get(c.Context(), userID)
+	if err != nil {
+		return middleware.Error(c, "Failed to fetch preferences", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, pref, "Preferences fetched successfully", nil)
+}
+
+func (h *Handler) Save(c *fiber.Ctx) error {
+	var req dtoPreference.PreferenceRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	pref, err := h.service.Save(c.Context(), userID, &req)
+	if err != nil {
+		if voice.IsValidationError(err) {
+			return middleware.ValidationError(c, []string{err.Error()})
+		}
+		return middleware.Error(c, "Failed to save preferences", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, pref, "Preferences saved successfully", nil)
+}
@@ -0,0 +1,48 @@
+package preference
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	user2 "github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userpreference"
+	dtoPreference "github.com/kiminodare/HOVARLAY-BE/internal/modules/preference/dto"
+)
+
+type Repository struct {
+	client *generated.Client
+}
+
+func NewPreferenceRepository(client *generated.Client) *Repository {
+	return &Repository{client: client}
+}
+
+func (r *Repository) GetByUser(ctx context.Context, userID uuid.UUID) (*generated.UserPreference, error) {
+	return r.client.UserPreference.Query().
+		Where(userpreference.HasUserWith(user2.ID(userID))).
+		Only(ctx)
+}
+
+func (r *Repository) Create(ctx context.Context, userID uuid.UUID, req *dtoPreference.PreferenceRequest) (*generated.UserPreference, error) {
+	return r.client.UserPreference.Create().
+		SetVoice(req.Voice).
+		SetRate(req.Rate).
+		SetPitch(req.Pitch).
+		SetVolume(req.Volume).
+		SetLanguage(req.Language).
+		SetMaxTextLength(req.MaxTextLength).
+		SetUserID(userID).
+		Save(ctx)
+}
+
+func (r *Repository) Update(ctx context.Context, id uuid.UUID, req *dtoPreference.PreferenceRequest) (*generated.UserPreference, error) {
+	return r.client.UserPreference.UpdateOneID(id).
+		SetVoice(req.Voice).
+		SetRate(req.Rate).
+		SetPitch(req.Pitch).
+		SetVolume(req.Volume).
+		SetLanguage(req.Language).
+		SetMaxTextLength(req.MaxTextLength).
+		Save(ctx)
+}
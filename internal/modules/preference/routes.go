@@ -0,0 +1,8 @@
+package preference
+
+import "github.com/gofiber/fiber/v2"
+
+func SetupPreferenceRoutes(router fiber.Router, handler *Handler) {
+	router.Get("/me/preferences", handler.Get)
+	router.Put("/me/preferences", handler.Save)
+}
@@ -0,0 +1,61 @@
+package preference
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userpreference"
+	dtoPreference "github.com/kiminodare/HOVARLAY-BE/internal/modules/preference/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/voice"
+)
+
+type Service struct {
+	repo   *Repository
+	voices *voice.Service
+}
+
+func NewService(repo *Repository, voices *voice.Service) *Service {
+	return &Service{repo: repo, voices: voices}
+}
+
+// Get returns the preferences of the user. Users that never saved any get
+// the defaults, which are not stored.
+func (s *Service) Get(ctx context.Context, userID uuid.UUID) (*generated.UserPreference, error) {
+	p, err := s.repo.GetByUser(ctx, userID)
+	if generated.IsNotFound(err) {
+		return &generated.UserPreference{
+			Rate:          userpreference.DefaultRate,
+			Pitch:         userpreference.DefaultPitch,
+			Volume:        userpreference.DefaultVolume,
+			MaxTextLength: userpreference.DefaultMaxTextLength,
+		}, nil
+	}
+	return p, err
+}
+
+// Save replaces the preferences of the user, creating them on first use. When
+// two first saves race, the later one updates what the earlier one created.
+func (s *Service) Save(ctx context.Context, userID uuid.UUID, req *dtoPreference.PreferenceRequest) (*generated.UserPreference, error) {
+	if req.Voice != "" {
+		if err := s.voices.Check(ctx, req.Voice, req.Rate, req.Pitch); err != nil {
+			return nil, err
+		}
+	}
+
+	p, err := s.repo.GetByUser(ctx, userID)
+	switch {
+	case generated.IsNotFound(err):
+		created, err := s.repo.Create(ctx, userID, req)
+		if !generated.IsConstraintError(err) {
+			return created, err
+		}
+		// Permintaan lain membuatnya lebih dulu, jadi yang ini menimpanya.
+		if p, err = s.repo.GetByUser(ctx, userID); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	}
+	return s.repo.Update(ctx, p.ID, req)
+}
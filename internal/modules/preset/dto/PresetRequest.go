@@ -0,0 +1,21 @@
+package dtoPreset
+
+import "github.com/go-playground/validator/v10"
+
+var validate *validator.Validate
+
+func init() {
+	validate = validator.New()
+}
+
+type PresetRequest struct {
+	Name   string  `json:"name" validate:"required,min=1,max=50"`
+	Voice  string  `json:"voice" validate:"required"`
+	Rate   float64 `json:"rate" validate:"min=0.1,max=5"`
+	Pitch  float64 `json:"pitch" validate:"min=0,max=2"`
+	Volume float64 `json:"volume" validate:"min=0,max=1"`
+}
+
+func (r *PresetRequest) Validate() error {
+	return validate.Struct(r)
+}
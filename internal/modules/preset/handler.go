@@ -0,0 +1,142 @@
+package preset
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/internal/middleware"
+	dtoPreset "github.com/kiminodare/HOVARLAY-BE/internal/modules/preset/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/voice"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) Create(c *fiber.Ctx) error {
+	var req dtoPreset.PresetRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	preset, err := h.service.Create(c.Context(), userID, &req)
+	if err != nil {
+		switch {
+		case voice.IsValidationError(err):
+			return middleware.ValidationError(c, []string{err.Error()})
+		case errors.Is(err, utils.ErrPresetExists):
+			return middleware.Error(c, "Preset already exists", fiber.StatusConflict)
+		}
+		return middleware.Error(c, "Failed to create preset", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, preset, "Preset created successfully", nil)
+}
+
+func (h *Handler) GetByUser(c *fiber.Ctx) error {
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	presets, err := h.service.GetByUser(c.Context(), userID)
+	if err != nil {
+		return middleware.Error(c, "Failed to fetch presets", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, presets, "Presets fetched successfully", nil)
+}
+
+func (h *Handler) GetByID(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	preset, err := h.service.GetOwned(c.Context(), userID, id)
+	if err != nil {
+		if errors.Is(err, utils.ErrPresetNotFound) {
+			return middleware.Error(c, "Preset not found", fiber.StatusNotFound)
+		}
+		return middleware.Error(c, "Failed to fetch preset", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, preset, "Preset fetched successfully", nil)
+}
+
+func (h *Handler) Update(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	var req dtoPreset.PresetRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	preset, err := h.service.Update(c.Context(), userID, id, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrPresetNotFound):
+			return middleware.Error(c, "Preset not found", fiber.StatusNotFound)
+		case voice.IsValidationError(err):
+			return middleware.ValidationError(c, []string{err.Error()})
+		case errors.Is(err, utils.ErrPresetExists):
+			return middleware.Error(c, "Preset already exists", fiber.StatusConflict)
+		}
+		return middleware.Error(c, "Failed to update preset", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, preset, "Preset updated successfully", nil)
+}
+
+func (h *Handler) Delete(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	if err := h.service.Delete(c.Context(), userID, id); err != nil {
+		if errors.Is(err, utils.ErrPresetNotFound) {
+			return middleware.Error(c, "Preset not found", fiber.StatusNotFound)
+		}
+		return middleware.Error(c, "Failed to delete preset", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, nil, "Preset deleted successfully", nil)
+}
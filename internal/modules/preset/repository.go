@@ -0,0 +1,62 @@
+package preset
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	user2 "github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/voicepreset"
+	dtoPreset "github.com/kiminodare/HOVARLAY-BE/internal/modules/preset/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Repository struct {
+	client *generated.Client
+}
+
+func NewPresetRepository(client *generated.Client) *Repository {
+	return &Repository{client: client}
+}
+
+func (r *Repository) Create(ctx context.Context, userID uuid.UUID, req *dtoPreset.PresetRequest) (*generated.VoicePreset, error) {
+	return r.client.VoicePreset.Create().
+		SetName(req.Name).
+		SetVoice(req.Voice).
+		SetRate(req.Rate).
+		SetPitch(req.Pitch).
+		SetVolume(req.Volume).
+		SetUserID(userID).
+		Save(ctx)
+}
+
+func (r *Repository) GetByUser(ctx context.Context, userID uuid.UUID) ([]*generated.VoicePreset, error) {
+	return r.client.VoicePreset.Query().
+		Where(voicepreset.HasUserWith(user2.ID(userID))).
+		Order(voicepreset.ByName()).
+		All(ctx)
+}
+
+func (r *Repository) GetOwned(ctx context.Context, userID, id uuid.UUID) (*generated.VoicePreset, error) {
+	p, err := r.client.VoicePreset.Query().
+		Where(voicepreset.ID(id), voicepreset.HasUserWith(user2.ID(userID))).
+		Only(ctx)
+	if generated.IsNotFound(err) {
+		return nil, utils.ErrPresetNotFound
+	}
+	return p, err
+}
+
+func (r *Repository) Update(ctx context.Context, id uuid.UUID, req *dtoPreset.PresetRequest) (*generated.VoicePreset, error) {
+	return r.client.VoicePreset.UpdateOneID(id).
+		SetName(req.Name).
+		SetVoice(req.Voice).
+		SetRate(req.Rate).
+		SetPitch(req.Pitch).
+		SetVolume(req.Volume).
+		Save(ctx)
+}
+
+func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.client.VoicePreset.DeleteOneID(id).Exec(ctx)
+}
@@ -0,0 +1,11 @@
+package preset
+
+import "github.com/gofiber/fiber/v2"
+
+func SetupPresetRoutes(router fiber.Router, handler *Handler) {
+	router.Get("/presets", handler.GetByUser)
+	router.Post("/presets", handler.Create)
+	router.Get("/presets/:id", handler.GetByID)
+	router.Put("/presets/:id", handler.Update)
+	router.Delete("/presets/:id", handler.Delete)
+}
@@ -0,0 +1,63 @@
+package preset
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	dtoPreset "github.com/kiminodare/HOVARLAY-BE/internal/modules/preset/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/voice"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Service struct {
+	repo   *Repository
+	voices *voice.Service
+}
+
+func NewService(repo *Repository, voices *voice.Service) *Service {
+	return &Service{repo: repo, voices: voices}
+}
+
+func (s *Service) Create(ctx context.Context, userID uuid.UUID, req *dtoPreset.PresetRequest) (*generated.VoicePreset, error) {
+	if err := s.voices.Check(ctx, req.Voice, req.Rate, req.Pitch); err != nil {
+		return nil, err
+	}
+
+	p, err := s.repo.Create(ctx, userID, req)
+	if generated.IsConstraintError(err) {
+		return nil, utils.ErrPresetExists
+	}
+	return p, err
+}
+
+func (s *Service) GetByUser(ctx context.Context, userID uuid.UUID) ([]*generated.VoicePreset, error) {
+	return s.repo.GetByUser(ctx, userID)
+}
+
+// GetOwned returns the preset or ErrPresetNotFound when it is not the user's.
+func (s *Service) GetOwned(ctx context.Context, userID, id uuid.UUID) (*generated.VoicePreset, error) {
+	return s.repo.GetOwned(ctx, userID, id)
+}
+
+func (s *Service) Update(ctx context.Context, userID, id uuid.UUID, req *dtoPreset.PresetRequest) (*generated.VoicePreset, error) {
+	if _, err := s.repo.GetOwned(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	if err := s.voices.Check(ctx, req.Voice, req.Rate, req.Pitch); err != nil {
+		return nil, err
+	}
+
+	p, err := s.repo.Update(ctx, id, req)
+	if generated.IsConstraintError(err) {
+		return nil, utils.ErrPresetExists
+	}
+	return p, err
+}
+
+func (s *Service) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	if _, err := s.repo.GetOwned(ctx, userID, id); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
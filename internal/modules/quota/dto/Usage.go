@@ -0,0 +1,54 @@
+package dtoQuota
+
+import (
+	"time"
+
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+// Quota is the usage of one limit in the current period. Limit and Remaining
+// are nil when the plan does not limit it.
+type Quota struct {
+	Used      int  `json:"used"`
+	Limit     *int `json:"limit"`
+	Remaining *int `json:"remaining"`
+}
+
+func NewQuota(used, limit int) Quota {
+	q := Quota{Used: used}
+	if limit > 0 {
+		remaining := max(limit-used, 0)
+		q.Limit = &limit
+		q.Remaining = &remaining
+	}
+	return q
+}
+
+// Exceeded reports whether using n more would go over the limit.
+func (q Quota) Exceeded(n int) bool {
+	return q.Remaining != nil && n > *q.Remaining
+}
+
+type UsagePlan struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type Usage struct {
+	Plan        UsagePlan `json:"plan"`
+	PeriodStart time.Time `json:"periodStart"`
+	ResetsAt    time.Time `json:"resetsAt"`
+	Characters  Quota     `json:"characters"`
+	Entries     Quota     `json:"entries"`
+}
+
+// Check returns the error using characters and entries more would cause.
+func (u *Usage) Check(characters, entries int) error {
+	if u.Characters.Exceeded(characters) {
+		return utils.ErrCharacterQuotaExceeded
+	}
+	if u.Entries.Exceeded(entries) {
+		return utils.ErrEntryQuotaExceeded
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package quota
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiminodare/HOVARLAY-BE/internal/middleware"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) Usage(c *fiber.Ctx) error {
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	usage, err := h.service.Usage(c.Context(), userID)
+	if err != nil {
+		return middleware.Error(c, "Failed to fetch usage", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, usage, "Usage fetched successfully", nil)
+}
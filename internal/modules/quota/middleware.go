@@ -0,0 +1,72 @@
+package quota
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/kiminodare/HOVARLAY-BE/internal/middleware"
+	dtoQuota "github.com/kiminodare/HOVARLAY-BE/internal/modules/quota/dto"
+)
+
+const (
+	HeaderCharactersLimit     = "X-Quota-Characters-Limit"
+	HeaderCharactersRemaining = "X-Quota-Characters-Remaining"
+	HeaderEntriesLimit        = "X-Quota-Entries-Limit"
+	HeaderEntriesRemaining    = "X-Quota-Entries-Remaining"
+	HeaderReset               = "X-Quota-Reset"
+)
+
+// ExposedHeaders lists the headers set by Middleware, for CORS.
+var ExposedHeaders = []string{
+	HeaderCharactersLimit,
+	HeaderCharactersRemaining,
+	HeaderEntriesLimit,
+	HeaderEntriesRemaining,
+	HeaderReset,
+	fiber.HeaderRetryAfter,
+}
+
+type Middleware struct {
+	service *Service
+}
+
+func NewMiddleware(service *Service) *Middleware {
+	return &Middleware{service: service}
+}
+
+// Headers adds the remaining quota of the user to the response of the
+// routes that use it. Unlimited quotas have no limit and remaining headers.
+// It must run after JWTMiddleware.Auth.
+func (m *Middleware) Headers(c *fiber.Ctx) error {
+	err := c.Next()
+
+	userID, ok := middleware.UserID(c)
+	status := c.Response().StatusCode()
+	if !ok || status >= fiber.StatusInternalServerError {
+		return err
+	}
+
+	usage, uerr := m.service.Usage(c.Context(), userID)
+	if uerr != nil {
+		log.Errorf("failed to load quota of user %s: %v", userID, uerr)
+		return err
+	}
+	setQuotaHeaders(c, HeaderCharactersLimit, HeaderCharactersRemaining, usage.Characters)
+	setQuotaHeaders(c, HeaderEntriesLimit, HeaderEntriesRemaining, usage.Entries)
+	c.Set(HeaderReset, strconv.FormatInt(usage.ResetsAt.Unix(), 10))
+	if status == fiber.StatusTooManyRequests {
+		retry := int(time.Until(usage.ResetsAt).Seconds()) + 1
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(retry))
+	}
+	return err
+}
+
+func setQuotaHeaders(c *fiber.Ctx, limitHeader, remainingHeader string, q dtoQuota.Quota) {
+	if q.Limit == nil {
+		return
+	}
+	c.Set(limitHeader, strconv.Itoa(*q.Limit))
+	c.Set(remainingHeader, strconv.Itoa(*q.Remaining))
+}
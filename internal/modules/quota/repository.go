@@ -0,0 +1,182 @@
+package quota
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/plan"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/usageentry"
+	user2 "github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/userusage"
+	"github.com/kiminodare/HOVARLAY-BE/internal/db"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Repository struct {
+	client *generated.Client
+}
+
+func NewQuotaRepository(client *generated.Client) *Repository {
+	return &Repository{client: client}
+}
+
+// GetPlan returns the plan of the user, or the default plan when the user
+// has none.
+func (r *Repository) GetPlan(ctx context.Context, userID uuid.UUID) (*generated.Plan, error) {
+	p, err := r.client.Plan.Query().
+		Where(plan.HasUsersWith(user2.ID(userID))).
+		Only(ctx)
+	if generated.IsNotFound(err) {
+		return r.client.Plan.Get(ctx, DefaultPlanID)
+	}
+	return p, err
+}
+
+func (r *Repository) GetUsage(ctx context.Context, userID uuid.UUID) (*generated.UserUsage, error) {
+	return r.client.UserUsage.Query().
+		Where(userusage.HasUserWith(user2.ID(userID))).
+		Only(ctx)
+}
+
+// Charge adds charge to the usage of the user in period and records it in
+// the ledger. Limits of 0 are unlimited. The check and the increment are a
+// single conditional update, so concurrent requests cannot overshoot the
+// limits together.
+func (r *Repository) Charge(ctx context.Context, userID uuid.UUID, period time.Time, p *generated.Plan, charge Charge) (*generated.UsageEntry, error) {
+	if err := r.ensureUsage(ctx, userID, period); err != nil {
+		return nil, err
+	}
+
+	var entry *generated.UsageEntry
+	err := db.WithTx(ctx, r.client, func(tx *generated.Tx) error {
+		// Periode lama di-reset di sini juga, jadi tidak bergantung pada job.
+		err := tx.UserUsage.Update().
+			Where(userusage.HasUserWith(user2.ID(userID)), userusage.PeriodStartLT(period)).
+			SetPeriodStart(period).
+			SetCharacters(0).
+			SetEntries(0).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		upd := tx.UserUsage.Update().Where(userusage.HasUserWith(user2.ID(userID)))
+		if p.MonthlyCharacters > 0 {
+			upd.Where(userusage.CharactersLTE(p.MonthlyCharacters - charge.Characters))
+		}
+		if p.MonthlyEntries > 0 {
+			upd.Where(userusage.EntriesLTE(p.MonthlyEntries - charge.Entries))
+		}
+		n, err := upd.AddCharacters(charge.Characters).AddEntries(charge.Entries).Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			u, err := tx.UserUsage.Query().Where(userusage.HasUserWith(user2.ID(userID))).Only(ctx)
+			if err != nil {
+				return err
+			}
+			if p.MonthlyCharacters > 0 && u.Characters+charge.Characters > p.MonthlyCharacters {
+				return utils.ErrCharacterQuotaExceeded
+			}
+			return utils.ErrEntryQuotaExceeded
+		}
+
+		entry, err = tx.UsageEntry.Create().
+			SetKind(charge.Kind).
+			SetCharacters(charge.Characters).
+			SetEntries(charge.Entries).
+			SetNillableHistoryID(charge.HistoryID).
+			SetPeriodStart(period).
+			SetUserID(userID).
+			Save(ctx)
+		return err
+	})
+	return entry, err
+}
+
+// Refund reverses a charge. Usage of a period that has already ended is left
+// alone, only the ledger records the refund.
+func (r *Repository) Refund(ctx context.Context, userID uuid.UUID, entry *generated.UsageEntry) error {
+	return db.WithTx(ctx, r.client, func(tx *generated.Tx) error {
+		err := tx.UserUsage.Update().
+			Where(userusage.HasUserWith(user2.ID(userID)), userusage.PeriodStart(entry.PeriodStart)).
+			AddCharacters(-entry.Characters).
+			AddEntries(-entry.Entries).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		return tx.UsageEntry.Create().
+			SetKind(usageentry.KindRefund).
+			SetCharacters(-entry.Characters).
+			SetEntries(-entry.Entries).
+			SetNillableHistoryID(entry.HistoryID).
+			SetPeriodStart(entry.PeriodStart).
+			SetUserID(userID).
+			Exec(ctx)
+	})
+}
+
+func (r *Repository) SetHistory(ctx context.Context, entryID, historyID uuid.UUID) error {
+	return r.client.UsageEntry.UpdateOneID(entryID).
+		SetHistoryID(historyID).
+		Exec(ctx)
+}
+
+// ResetExpired starts period for every usage still counting an earlier one.
+func (r *Repository) ResetExpired(ctx context.Context, period time.Time) (int, error) {
+	return r.client.UserUsage.Update().
+		Where(userusage.PeriodStartLT(period)).
+		SetPeriodStart(period).
+		SetCharacters(0).
+		SetEntries(0).
+		Save(ctx)
+}
+
+// SeedPlans creates the plans that do not exist yet. Existing plans keep
+// their limits so they can be tuned in the database.
+func (r *Repository) SeedPlans(ctx context.Context, plans []generated.Plan) (int, error) {
+	created := 0
+	for _, p := range plans {
+		exists, err := r.client.Plan.Query().Where(plan.ID(p.ID)).Exist(ctx)
+		if err != nil {
+			return created, err
+		}
+		if exists {
+			continue
+		}
+		err = r.client.Plan.Create().
+			SetID(p.ID).
+			SetName(p.Name).
+			SetMonthlyCharacters(p.MonthlyCharacters).
+			SetMonthlyEntries(p.MonthlyEntries).
+			Exec(ctx)
+		if err != nil {
+			return created, err
+		}
+		created++
+	}
+	return created, nil
+}
+
+// ensureUsage creates the usage row of the user on first use.
+func (r *Repository) ensureUsage(ctx context.Context, userID uuid.UUID, period time.Time) error {
+	exists, err := r.client.UserUsage.Query().
+		Where(userusage.HasUserWith(user2.ID(userID))).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+	err = r.client.UserUsage.Create().
+		SetPeriodStart(period).
+		SetUserID(userID).
+		Exec(ctx)
+	if generated.IsConstraintError(err) {
+		// Dibuat oleh request lain secara bersamaan.
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,7 @@
+package quota
+
+import "github.com/gofiber/fiber/v2"
+
+func SetupQuotaRoutes(router fiber.Router, handler *Handler) {
+	router.Get("/me/usage", handler.Usage)
+}
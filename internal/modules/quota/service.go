@@ -0,0 +1,165 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/usageentry"
+	dtoQuota "github.com/kiminodare/HOVARLAY-BE/internal/modules/quota/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+// DefaultPlanID is the plan of users that were not assigned one.
+const DefaultPlanID = "free"
+
+// DefaultPlans are seeded by cmd/migrate. A limit of 0 is unlimited.
+var DefaultPlans = []generated.Plan{
+	{ID: DefaultPlanID, Name: "Free", MonthlyCharacters: 10000, MonthlyEntries: 100},
+	{ID: "pro", Name: "Pro", MonthlyCharacters: 1000000, MonthlyEntries: 0},
+}
+
+// Charge is usage to add to the current period of a user.
+type Charge struct {
+	Kind       usageentry.Kind
+	Characters int
+	Entries    int
+	HistoryID  *uuid.UUID
+}
+
+// Status returns the HTTP status for a quota error: 402 when the characters
+// paid for are used up and 429 when too many entries were created. It
+// returns 0 for any other error.
+func Status(err error) int {
+	switch {
+	case errors.Is(err, utils.ErrCharacterQuotaExceeded):
+		return fiber.StatusPaymentRequired
+	case errors.Is(err, utils.ErrEntryQuotaExceeded):
+		return fiber.StatusTooManyRequests
+	}
+	return 0
+}
+
+// Message returns the response message for a quota error.
+func Message(err error) string {
+	if errors.Is(err, utils.ErrCharacterQuotaExceeded) {
+		return "Monthly character quota exceeded"
+	}
+	return "Monthly entry limit reached"
+}
+
+type Service struct {
+	repo *Repository
+}
+
+func NewService(repo *Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// PeriodStart returns the start of the billing period containing t. Periods
+// are calendar months in UTC.
+func PeriodStart(t time.Time) time.Time {
+	y, m, _ := t.UTC().Date()
+	return time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Usage returns the plan of the user and the usage of the current period.
+func (s *Service) Usage(ctx context.Context, userID uuid.UUID) (*dtoQuota.Usage, error) {
+	p, err := s.plan(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	period := PeriodStart(time.Now())
+	var characters, entries int
+	u, err := s.repo.GetUsage(ctx, userID)
+	switch {
+	case err == nil:
+		if !u.PeriodStart.Before(period) {
+			characters, entries = u.Characters, u.Entries
+		}
+	case !generated.IsNotFound(err):
+		return nil, err
+	}
+
+	return &dtoQuota.Usage{
+		Plan:        dtoQuota.UsagePlan{ID: p.ID, Name: p.Name},
+		PeriodStart: period,
+		ResetsAt:    period.AddDate(0, 1, 0),
+		Characters:  dtoQuota.NewQuota(characters, p.MonthlyCharacters),
+		Entries:     dtoQuota.NewQuota(entries, p.MonthlyEntries),
+	}, nil
+}
+
+// Check returns the quota error charging characters and entries would cause,
+// without charging them.
+func (s *Service) Check(ctx context.Context, userID uuid.UUID, characters, entries int) error {
+	usage, err := s.Usage(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return usage.Check(characters, entries)
+}
+
+// Reserve charges usage to the current period of the user, failing with
+// ErrCharacterQuotaExceeded or ErrEntryQuotaExceeded when the plan does not
+// allow it. The returned ledger entry can be passed to Refund when the
+// operation it pays for fails.
+func (s *Service) Reserve(ctx context.Context, userID uuid.UUID, charge Charge) (*generated.UsageEntry, error) {
+	p, err := s.plan(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.Charge(ctx, userID, PeriodStart(time.Now()), p, charge)
+}
+
+func (s *Service) Refund(ctx context.Context, userID uuid.UUID, entry *generated.UsageEntry) error {
+	return s.repo.Refund(ctx, userID, entry)
+}
+
+// Link records the history a charge made before it existed was for.
+func (s *Service) Link(ctx context.Context, entry *generated.UsageEntry, historyID uuid.UUID) error {
+	return s.repo.SetHistory(ctx, entry.ID, historyID)
+}
+
+// SeedPlans creates the default plans missing from the database.
+func (s *Service) SeedPlans(ctx context.Context) (int, error) {
+	return s.repo.SeedPlans(ctx, DefaultPlans)
+}
+
+// RunMonthlyReset resets the usage of every user once a new period starts,
+// checking every interval until ctx is cancelled.
+func (s *Service) RunMonthlyReset(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := s.repo.ResetExpired(ctx, PeriodStart(time.Now()))
+			if err != nil {
+				log.Errorf("failed to reset monthly usage: %v", err)
+				continue
+			}
+			if n > 0 {
+				log.Infof("reset monthly usage of %d users", n)
+			}
+		}
+	}
+}
+
+// plan returns the plan of the user, falling back to the built-in default
+// plan when the plans were not seeded.
+func (s *Service) plan(ctx context.Context, userID uuid.UUID) (*generated.Plan, error) {
+	p, err := s.repo.GetPlan(ctx, userID)
+	if generated.IsNotFound(err) {
+		return &DefaultPlans[0], nil
+	}
+	return p, err
+}
@@ -0,0 +1,50 @@
+package dtoRender
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kiminodare/HOVARLAY-BE/internal/captions"
+)
+
+var validate *validator.Validate
+
+func init() {
+	validate = validator.New()
+}
+
+type CaptionsQuery struct {
+	Format        string  `query:"format" validate:"omitempty,oneof=srt vtt"`
+	MaxLineLength int     `query:"maxLineLength" validate:"omitempty,min=10,max=100"`
+	MaxLines      int     `query:"maxLines" validate:"omitempty,min=1,max=3"`
+	MaxDuration   float64 `query:"maxDuration" validate:"omitempty,min=1,max=30"` // detik
+	// Normalize memilih render yang dibuat dengan ?normalize=true.
+	Normalize bool `query:"normalize"`
+}
+
+func (q *CaptionsQuery) Validate() error {
+	return validate.Struct(q)
+}
+
+// CaptionFormat returns the requested format, WebVTT by default.
+func (q *CaptionsQuery) CaptionFormat() captions.Format {
+	if q.Format == "" {
+		return captions.FormatVTT
+	}
+	return captions.Format(q.Format)
+}
+
+// Options returns the layout rules, with defaults for what is not set.
+func (q *CaptionsQuery) Options() captions.Options {
+	opts := captions.DefaultOptions
+	if q.MaxLineLength > 0 {
+		opts.MaxLineLength = q.MaxLineLength
+	}
+	if q.MaxLines > 0 {
+		opts.MaxLines = q.MaxLines
+	}
+	if q.MaxDuration > 0 {
+		opts.MaxDuration = time.Duration(q.MaxDuration * float64(time.Second))
+	}
+	return opts
+}
@@ -0,0 +1,18 @@
+package dtoRender
+
+import "github.com/kiminodare/HOVARLAY-BE/internal/segment"
+
+type SegmentsQuery struct {
+	// Limit defaults to the segment limit of the server.
+	Limit     int  `query:"limit" validate:"omitempty,min=50,max=20000"`
+	Normalize bool `query:"normalize"`
+}
+
+func (q *SegmentsQuery) Validate() error {
+	return validate.Struct(q)
+}
+
+type SegmentsResponse struct {
+	Limit    int               `json:"limit"`
+	Segments []segment.Segment `json:"segments"`
+}
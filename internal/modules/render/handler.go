@@ -0,0 +1,229 @@
+package render
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/internal/captions"
+	"github.com/kiminodare/HOVARLAY-BE/internal/middleware"
+	dtoRender "github.com/kiminodare/HOVARLAY-BE/internal/modules/render/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/synth"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+// HeaderDuration carries the play time of the audio in seconds.
+const HeaderDuration = "X-Audio-Duration"
+
+var errUnsatisfiableRange = errors.New("unsatisfiable range")
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) Render(c *fiber.Ctx) error {
+	return h.serve(c, false)
+}
+
+// Audio serves the same audio as Render and supports Range requests, so
+// players can seek without downloading the whole file.
+func (h *Handler) Audio(c *fiber.Ctx) error {
+	return h.serve(c, true)
+}
+
+func (h *Handler) Captions(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	var query dtoRender.CaptionsQuery
+	if err := c.QueryParser(&query); err != nil {
+		return middleware.Error(c, "Invalid query parameters", fiber.StatusBadRequest)
+	}
+
+	if err := query.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	cues, err := h.service.Captions(c.Context(), userID, id, query.Normalize, query.Options())
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrHistoryNotFound):
+			return middleware.Error(c, "History not found", fiber.StatusNotFound)
+		case errors.Is(err, utils.ErrVoiceNotFound):
+			return middleware.Error(c, "The voice of this history is no longer available", fiber.StatusUnprocessableEntity)
+		}
+		return middleware.Error(c, "Failed to build captions", fiber.StatusInternalServerError)
+	}
+
+	format := query.CaptionFormat()
+	var buf bytes.Buffer
+	if err := captions.Write(&buf, format, cues); err != nil {
+		return middleware.Error(c, "Failed to build captions", fiber.StatusInternalServerError)
+	}
+	c.Set(fiber.HeaderContentType, format.ContentType())
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`inline; filename="%s.%s"`, id, format))
+	return c.Send(buf.Bytes())
+}
+
+// Segments shows how the text of a history is split into utterances for
+// rendering, after the lexicon and, with ?normalize=true, normalization.
+func (h *Handler) Segments(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	var query dtoRender.SegmentsQuery
+	if err := c.QueryParser(&query); err != nil {
+		return middleware.Error(c, "Invalid query parameters", fiber.StatusBadRequest)
+	}
+
+	if err := query.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	segments, err := h.service.Segments(c.Context(), userID, id, query.Normalize, query.Limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrHistoryNotFound):
+			return middleware.Error(c, "History not found", fiber.StatusNotFound)
+		case errors.Is(err, utils.ErrVoiceNotFound):
+			return middleware.Error(c, "The voice of this history is no longer available", fiber.StatusUnprocessableEntity)
+		}
+		return middleware.Error(c, "Failed to split history", fiber.StatusInternalServerError)
+	}
+
+	limit := query.Limit
+	if limit == 0 {
+		limit = h.service.SegmentLimit()
+	}
+	return middleware.Success(c, dtoRender.SegmentsResponse{Limit: limit, Segments: segments}, "Segments fetched successfully", nil)
+}
+
+func (h *Handler) serve(c *fiber.Ctx, ranges bool) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	audio, err := h.service.Render(c.Context(), userID, id, c.QueryBool("normalize"))
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrHistoryNotFound):
+			return middleware.Error(c, "History not found", fiber.StatusNotFound)
+		case errors.Is(err, utils.ErrVoiceNotFound):
+			return middleware.Error(c, "The voice of this history is no longer available", fiber.StatusUnprocessableEntity)
+		case errors.Is(err, synth.ErrNoSynthesizer):
+			return middleware.Error(c, "No speech engine is available for this voice", fiber.StatusNotImplemented)
+		}
+		return middleware.Error(c, "Failed to render history", fiber.StatusInternalServerError)
+	}
+
+	etag := `"` + audio.BlobKey + `"`
+	c.Set(fiber.HeaderETag, etag)
+	c.Set(HeaderDuration, strconv.FormatFloat(float64(audio.DurationMs)/1000, 'f', 3, 64))
+	if ranges {
+		c.Set(fiber.HeaderAcceptRanges, "bytes")
+		if c.Get(fiber.HeaderIfNoneMatch) == etag {
+			return c.SendStatus(fiber.StatusNotModified)
+		}
+	}
+
+	start, length := int64(0), audio.Size
+	if header := c.Get(fiber.HeaderRange); ranges && header != "" && ifRange(c, etag) {
+		var partial bool
+		start, length, partial, err = parseRange(header, audio.Size)
+		if errors.Is(err, errUnsatisfiableRange) {
+			c.Set(fiber.HeaderContentRange, fmt.Sprintf("bytes */%d", audio.Size))
+			return middleware.Error(c, "Requested range not satisfiable", fiber.StatusRequestedRangeNotSatisfiable)
+		}
+		if partial {
+			c.Status(fiber.StatusPartialContent)
+			c.Set(fiber.HeaderContentRange, fmt.Sprintf("bytes %d-%d/%d", start, start+length-1, audio.Size))
+		}
+	}
+
+	stream, err := h.service.Open(c.Context(), audio, start, length)
+	if err != nil {
+		c.Response().Header.Del(fiber.HeaderContentRange)
+		return middleware.Error(c, "Failed to read audio", fiber.StatusInternalServerError)
+	}
+	c.Set(fiber.HeaderContentType, audio.ContentType)
+	// Stream ditutup oleh fasthttp setelah selesai dikirim.
+	return c.SendStream(stream, int(length))
+}
+
+// ifRange reports whether a Range header applies: it does unless If-Range
+// names another version of the audio.
+func ifRange(c *fiber.Ctx, etag string) bool {
+	v := c.Get(fiber.HeaderIfRange)
+	return v == "" || v == etag
+}
+
+// parseRange parses a Range header with a single byte range over size bytes
+// and returns the part to send. partial is false when the header is ignored,
+// as allowed for malformed or multiple ranges, and the whole audio is sent.
+func parseRange(header string, size int64) (start, length int64, partial bool, err error) {
+	spec, ok := strings.CutPrefix(header, "bytes=")
+	if !ok || strings.Contains(spec, ",") {
+		return 0, size, false, nil
+	}
+	first, last, ok := strings.Cut(strings.TrimSpace(spec), "-")
+	if !ok {
+		return 0, size, false, nil
+	}
+
+	if first == "" {
+		// Suffix: N byte terakhir.
+		n, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || n < 0 {
+			return 0, size, false, nil
+		}
+		if n == 0 || size == 0 {
+			return 0, 0, false, errUnsatisfiableRange
+		}
+		n = min(n, size)
+		return size - n, n, true, nil
+	}
+
+	start, err = strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 {
+		return 0, size, false, nil
+	}
+	end := size - 1
+	if last != "" {
+		if end, err = strconv.ParseInt(last, 10, 64); err != nil || end < start {
+			return 0, size, false, nil
+		}
+		end = min(end, size-1)
+	}
+	if start >= size {
+		return 0, 0, false, errUnsatisfiableRange
+	}
+	return start, end - start + 1, true, nil
+}
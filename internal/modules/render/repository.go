@@ -0,0 +1,54 @@
+package render
+
+import (
+	"context"
+
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/audiorender"
+)
+
+type Repository struct {
+	client *generated.Client
+}
+
+func NewRenderRepository(client *generated.Client) *Repository {
+	return &Repository{client: client}
+}
+
+func (r *Repository) GetByKey(ctx context.Context, cacheKey string) (*generated.AudioRender, error) {
+	return r.client.AudioRender.Query().
+		Where(audiorender.CacheKey(cacheKey)).
+		Only(ctx)
+}
+
+// Save stores the render for cacheKey, replacing the blob of an earlier
+// render whose blob was evicted.
+func (r *Repository) Save(ctx context.Context, cacheKey string, ar *generated.AudioRender) (*generated.AudioRender, error) {
+	existing, err := r.GetByKey(ctx, cacheKey)
+	switch {
+	case err == nil:
+		return existing.Update().
+			SetBlobKey(ar.BlobKey).
+			SetContentType(ar.ContentType).
+			SetSize(ar.Size).
+			SetDurationMs(ar.DurationMs).
+			SetWords(ar.Words).
+			Save(ctx)
+	case !generated.IsNotFound(err):
+		return nil, err
+	}
+
+	saved, err := r.client.AudioRender.Create().
+		SetCacheKey(cacheKey).
+		SetBlobKey(ar.BlobKey).
+		SetContentType(ar.ContentType).
+		SetSize(ar.Size).
+		SetDurationMs(ar.DurationMs).
+		SetWords(ar.Words).
+		Save(ctx)
+	if generated.IsConstraintError(err) {
+		// Render yang sama disimpan bersamaan oleh request lain.
+		return r.GetByKey(ctx, cacheKey)
+	}
+	return saved, err
+}
@@ -0,0 +1,10 @@
+package render
+
+import "github.com/gofiber/fiber/v2"
+
+func SetupRenderRoutes(router fiber.Router, handler *Handler) {
+	router.Post("/history/:id/render", handler.Render)
+	router.Get("/history/:id/audio", handler.Audio)
+	router.Get("/history/:id/captions", handler.Captions)
+	router.Get("/history/:id/segments", handler.Segments)
+}
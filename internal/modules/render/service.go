@@ -0,0 +1,295 @@
+package render
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	historyent "github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	"github.com/kiminodare/HOVARLAY-BE/internal/blob"
+	"github.com/kiminodare/HOVARLAY-BE/internal/captions"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/history"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/lexicon"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/voice"
+	"github.com/kiminodare/HOVARLAY-BE/internal/segment"
+	"github.com/kiminodare/HOVARLAY-BE/internal/ssml"
+	"github.com/kiminodare/HOVARLAY-BE/internal/synth"
+	"github.com/kiminodare/HOVARLAY-BE/internal/textnorm"
+)
+
+type Service struct {
+	repo      *Repository
+	histories *history.Service
+	voices    *voice.Service
+	lexicons  *lexicon.Service
+	synths    *synth.Registry
+	blobs     blob.Store
+	// segmentLimit adalah jumlah karakter maksimum per request ke engine.
+	segmentLimit int
+}
+
+func NewService(repo *Repository, histories *history.Service, voices *voice.Service, lexicons *lexicon.Service, synths *synth.Registry, blobs blob.Store, segmentLimit int) *Service {
+	if segmentLimit <= 0 {
+		segmentLimit = segment.DefaultLimit
+	}
+	return &Service{repo: repo, histories: histories, voices: voices, lexicons: lexicons, synths: synths, blobs: blobs, segmentLimit: segmentLimit}
+}
+
+// SegmentLimit returns the largest segment, in characters, sent to an engine.
+func (s *Service) SegmentLimit() int {
+	return s.segmentLimit
+}
+
+// Render returns the audio of the current content of a history of the user.
+// Audio is rendered once per distinct text, voice and parameters; later
+// requests, from any user, are served from the cache. The lexicon of the
+// user is applied first. With normalize the rest of the text is then
+// rewritten by the rules of the voice language, so amounts, dates and URLs
+// are read out as words. Text longer than the segment limit is rendered
+// segment by segment and joined into one stream.
+func (s *Service) Render(ctx context.Context, userID, id uuid.UUID, normalize bool) (*generated.AudioRender, error) {
+	h, err := s.histories.GetOwned(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.voices.Get(ctx, h.Voice)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.request(ctx, userID, h, v.Language, normalize)
+	if err != nil {
+		return nil, err
+	}
+
+	key := CacheKey(req)
+	cached, err := s.repo.GetByKey(ctx, key)
+	switch {
+	case err == nil:
+		if _, err := s.blobs.Stat(ctx, cached.BlobKey); err == nil {
+			return cached, nil
+		} else if !errors.Is(err, blob.ErrNotFound) {
+			return nil, err
+		}
+	case !generated.IsNotFound(err):
+		return nil, err
+	}
+
+	engine, err := s.synths.Get(v.Provider)
+	if err != nil {
+		return nil, err
+	}
+	segments, err := segment.Split(req.Text, req.SSML, s.segmentLimit)
+	if err != nil {
+		return nil, err
+	}
+	reqs := []*synth.Request{req}
+	if len(segments) > 1 {
+		reqs = make([]*synth.Request, len(segments))
+		for i, seg := range segments {
+			r := *req
+			r.Text = seg.Text
+			reqs[i] = &r
+		}
+	}
+	audio, err := synth.Concat(ctx, engine, reqs)
+	if err != nil {
+		return nil, err
+	}
+	defer audio.Close()
+
+	info, err := s.blobs.Put(ctx, audio)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.Save(ctx, key, &generated.AudioRender{
+		BlobKey:     info.Key,
+		ContentType: audio.ContentType,
+		Size:        info.Size,
+		DurationMs:  audio.Duration.Milliseconds(),
+		Words:       audio.Words,
+	})
+}
+
+// Segments returns how a history of the user is split for rendering, at
+// most limit characters per segment, or the configured limit when limit is 0.
+func (s *Service) Segments(ctx context.Context, userID, id uuid.UUID, normalize bool, limit int) ([]segment.Segment, error) {
+	h, err := s.histories.GetOwned(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.requestFor(ctx, userID, h, normalize)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = s.segmentLimit
+	}
+	return segment.Split(req.Text, req.SSML, limit)
+}
+
+// Captions returns the subtitle cues of a history of the user. Word timings
+// come from the cached render when its engine reported them. Otherwise they
+// are estimated from the rate and, when the history was rendered, fitted to
+// the length of the audio. Captions never trigger a render themselves.
+// normalize selects the render made with normalized text.
+func (s *Service) Captions(ctx context.Context, userID, id uuid.UUID, normalize bool, opts captions.Options) ([]captions.Cue, error) {
+	h, err := s.histories.GetOwned(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.requestFor(ctx, userID, h, normalize)
+	if err != nil {
+		return nil, err
+	}
+
+	var duration time.Duration
+	cached, err := s.repo.GetByKey(ctx, CacheKey(req))
+	switch {
+	case err == nil:
+		if len(cached.Words) > 0 {
+			words := make([]captions.Word, len(cached.Words))
+			for i, w := range cached.Words {
+				words[i] = captions.Word{Text: w.Word, Start: w.Start, End: w.End}
+			}
+			return captions.Build(words, opts), nil
+		}
+		duration = time.Duration(cached.DurationMs) * time.Millisecond
+	case !generated.IsNotFound(err):
+		return nil, err
+	}
+
+	text := h.PlainText
+	if text == "" {
+		text = h.Text
+	}
+	return captions.Build(captions.Estimate(text, h.Rate, duration), opts), nil
+}
+
+// Open reads length bytes of a render starting at offset, or up to the end
+// when length is negative.
+func (s *Service) Open(ctx context.Context, ar *generated.AudioRender, offset, length int64) (io.ReadCloser, error) {
+	return s.blobs.Open(ctx, ar.BlobKey, offset, length)
+}
+
+// request describes the audio of h as rendered for the user, with their
+// lexicon and, when asked, normalization applied.
+func (s *Service) request(ctx context.Context, userID uuid.UUID, h *generated.History, language string, normalize bool) (*synth.Request, error) {
+	lex, err := s.lexicons.Rewriter(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	req := synthRequest(h)
+	req.Language = language
+	req.Text, req.SSML = lex.Apply(req.Text, req.SSML)
+	if normalize {
+		normalizeRequest(req)
+	}
+	return req, nil
+}
+
+// requestFor is request for callers that do not synthesize. The language
+// of the voice only matters to normalization, so it is looked up only then.
+func (s *Service) requestFor(ctx context.Context, userID uuid.UUID, h *generated.History, normalize bool) (*synth.Request, error) {
+	var language string
+	if normalize {
+		v, err := s.voices.Get(ctx, h.Voice)
+		if err != nil {
+			return nil, err
+		}
+		language = v.Language
+	}
+	return s.request(ctx, userID, h, language, normalize)
+}
+
+// synthRequest describes the audio of h. Language is left to the caller,
+// since it comes from the voice catalog and does not change the cache key.
+func synthRequest(h *generated.History) *synth.Request {
+	return &synth.Request{
+		Text:   h.Text,
+		SSML:   h.Format == historyent.FormatSsml,
+		Voice:  h.Voice,
+		Rate:   h.Rate,
+		Pitch:  h.Pitch,
+		Volume: h.Volume,
+	}
+}
+
+// normalizeRequest rewrites the text of req with the rules of its language.
+// In SSML only text outside <say-as>, <sub> and <phoneme> is rewritten,
+// since those already say how to read their content. Languages without
+// rules are left as they are.
+func normalizeRequest(req *synth.Request) {
+	opts := textnorm.Options{Language: req.Language}
+	if !req.SSML {
+		if res, err := textnorm.Normalize(req.Text, opts); err == nil {
+			req.Text = res.Text
+		}
+		return
+	}
+
+	root, err := ssml.Parse(req.Text)
+	if err != nil {
+		return
+	}
+	var walk func(n *ssml.Node) error
+	walk = func(n *ssml.Node) error {
+		if n.IsText() {
+			res, err := textnorm.Normalize(n.Text, opts)
+			if err != nil {
+				return err
+			}
+			n.Text = res.Text
+			return nil
+		}
+		if n.Name == "say-as" || n.Name == "sub" || n.Name == "phoneme" {
+			return nil
+		}
+		for _, c := range n.Children {
+			if err := walk(c); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if walk(root) == nil {
+		req.Text = root.String()
+	}
+}
+
+// CacheKey hashes everything that changes the rendered audio. Whitespace in
+// plain text is collapsed and SSML is compared in canonical form, so
+// formatting differences do not cause a new render.
+func CacheKey(req *synth.Request) string {
+	text := strings.Join(strings.Fields(req.Text), " ")
+	format := "plain"
+	if req.SSML {
+		format = "ssml"
+		if root, err := ssml.Parse(req.Text); err == nil {
+			text = root.String()
+		}
+	}
+
+	h := sha256.New()
+	for _, part := range []string{
+		format,
+		text,
+		req.Voice,
+		strconv.FormatFloat(req.Rate, 'g', -1, 64),
+		strconv.FormatFloat(req.Pitch, 'g', -1, 64),
+		strconv.FormatFloat(req.Volume, 'g', -1, 64),
+	} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,70 @@
+package dtoStats
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var validate *validator.Validate
+
+func init() {
+	validate = validator.New()
+}
+
+// MaxBuckets membatasi jumlah titik dalam satu series.
+const MaxBuckets = 400
+
+type StatsQuery struct {
+	Bucket   string `query:"bucket" validate:"omitempty,oneof=day week month"`
+	Timezone string `query:"tz" validate:"omitempty,timezone"`
+	From     string `query:"from" validate:"omitempty,datetime=2006-01-02"`
+	To       string `query:"to" validate:"omitempty,datetime=2006-01-02"`
+}
+
+func (q *StatsQuery) Validate() error {
+	return validate.Struct(q)
+}
+
+// Activity is what a user did, overall or for one voice.
+type Activity struct {
+	Histories  int `json:"histories"`
+	Characters int `json:"characters"`
+	Plays      int `json:"plays"`
+}
+
+type VoiceActivity struct {
+	Voice string `json:"voice"`
+	Activity
+}
+
+// SeriesPoint covers one bucket starting at Start in the requested timezone.
+type SeriesPoint struct {
+	Start      time.Time               `json:"start"`
+	Histories  int                     `json:"histories"`
+	Characters int                     `json:"characters"`
+	Plays      int                     `json:"plays"`
+	ByVoice    map[string]*SeriesVoice `json:"byVoice"`
+}
+
+type SeriesVoice struct {
+	Histories  int `json:"histories"`
+	Characters int `json:"characters"`
+	Plays      int `json:"plays"`
+}
+
+type StatsRange struct {
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	Bucket   string    `json:"bucket"`
+	Timezone string    `json:"timezone"`
+}
+
+// Stats is the response of GET /api/stats. Totals cover all time, including
+// trashed histories; the series covers Range.
+type Stats struct {
+	Range   StatsRange      `json:"range"`
+	Totals  Activity        `json:"totals"`
+	ByVoice []VoiceActivity `json:"byVoice"`
+	Series  []SeriesPoint   `json:"series"`
+}
@@ -0,0 +1,44 @@
+package stats
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiminodare/HOVARLAY-BE/internal/middleware"
+	dtoStats "github.com/kiminodare/HOVARLAY-BE/internal/modules/stats/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) Get(c *fiber.Ctx) error {
+	var query dtoStats.StatsQuery
+	if err := c.QueryParser(&query); err != nil {
+		return middleware.Error(c, "Invalid query parameters", fiber.StatusBadRequest)
+	}
+
+	if err := query.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	stats, err := h.service.Get(c.Context(), userID, &query)
+	if err != nil {
+		if errors.Is(err, utils.ErrInvalidStatsRange) || errors.Is(err, utils.ErrStatsRangeTooLarge) || errors.Is(err, utils.ErrInvalidTimezone) {
+			return middleware.ValidationError(c, []string{err.Error()})
+		}
+		return middleware.Error(c, "Failed to fetch stats", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, stats, "Stats fetched successfully", nil)
+}
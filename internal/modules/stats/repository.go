@@ -0,0 +1,144 @@
+package stats
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/playevent"
+	user2 "github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	"github.com/kiminodare/HOVARLAY-BE/ent/schema"
+	dtoStats "github.com/kiminodare/HOVARLAY-BE/internal/modules/stats/dto"
+)
+
+type Repository struct {
+	client *generated.Client
+}
+
+func NewStatsRepository(client *generated.Client) *Repository {
+	return &Repository{client: client}
+}
+
+// bucketRow is one voice in one bucket. Bucket is the local start date of the
+// bucket formatted as YYYY-MM-DD.
+type bucketRow struct {
+	Voice      string `json:"voice"`
+	Bucket     string `json:"bucket"`
+	Count      int    `json:"count"`
+	Characters int    `json:"characters"`
+}
+
+// TotalsByVoice aggregates all histories of the user, trashed ones included,
+// per voice. Plays are counted from the play events, so they include plays
+// of purged histories, like the series.
+func (r *Repository) TotalsByVoice(ctx context.Context, userID uuid.UUID) ([]dtoStats.VoiceActivity, error) {
+	var rows []struct {
+		Voice      string `json:"voice"`
+		Histories  int    `json:"histories"`
+		Characters int    `json:"characters"`
+	}
+	err := r.client.History.Query().
+		Where(history.HasUserWith(user2.ID(userID))).
+		GroupBy(history.FieldVoice).
+		Aggregate(
+			generated.As(generated.Count(), "histories"),
+			generated.As(generated.Sum(history.FieldCharCount), "characters"),
+		).
+		Scan(schema.SkipSoftDelete(ctx), &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	var plays []struct {
+		Voice string `json:"voice"`
+		Plays int    `json:"plays"`
+	}
+	err = r.client.PlayEvent.Query().
+		Where(playevent.HasUserWith(user2.ID(userID))).
+		GroupBy(playevent.FieldVoice).
+		Aggregate(generated.As(generated.Count(), "plays")).
+		Scan(ctx, &plays)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dtoStats.VoiceActivity, len(rows))
+	index := make(map[string]int, len(rows))
+	for i, row := range rows {
+		index[row.Voice] = i
+		result[i] = dtoStats.VoiceActivity{
+			Voice:    row.Voice,
+			Activity: dtoStats.Activity{Histories: row.Histories, Characters: row.Characters},
+		}
+	}
+	for _, row := range plays {
+		i, ok := index[row.Voice]
+		if !ok {
+			// Semua history dengan voice ini sudah dihapus permanen.
+			i = len(result)
+			index[row.Voice] = i
+			result = append(result, dtoStats.VoiceActivity{Voice: row.Voice})
+		}
+		result[i].Plays = row.Plays
+	}
+	return result, nil
+}
+
+// CreatedSeries counts the histories created in [from, to) and their
+// characters per voice and bucket.
+func (r *Repository) CreatedSeries(ctx context.Context, userID uuid.UUID, from, to time.Time, unit, tz string) ([]bucketRow, error) {
+	var rows []bucketRow
+	err := r.client.History.Query().
+		Where(
+			history.HasUserWith(user2.ID(userID)),
+			history.CreatedAtGTE(from),
+			history.CreatedAtLT(to),
+		).
+		GroupBy(history.FieldVoice).
+		Aggregate(bucketAggregate(history.FieldCreatedAt, unit, tz, history.FieldCharCount)).
+		Scan(schema.SkipSoftDelete(ctx), &rows)
+	return rows, err
+}
+
+// PlaySeries counts the plays in [from, to) per voice and bucket.
+func (r *Repository) PlaySeries(ctx context.Context, userID uuid.UUID, from, to time.Time, unit, tz string) ([]bucketRow, error) {
+	var rows []bucketRow
+	err := r.client.PlayEvent.Query().
+		Where(
+			playevent.HasUserWith(user2.ID(userID)),
+			playevent.PlayedAtGTE(from),
+			playevent.PlayedAtLT(to),
+		).
+		GroupBy(playevent.FieldVoice).
+		Aggregate(bucketAggregate(playevent.FieldPlayedAt, unit, tz, "")).
+		Scan(ctx, &rows)
+	return rows, err
+}
+
+// bucketAggregate groups by the local start date of the unit (day, week or
+// month) containing column, in the timezone tz, and sums the characters
+// column unless it is empty. It selects every column itself, so it must be
+// the only aggregation of the query, on a table with a voice column.
+func bucketAggregate(column, unit, tz, characters string) generated.AggregateFunc {
+	return func(s *sql.Selector) string {
+		bucket := sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("to_char(date_trunc(").Arg(unit).
+				WriteString(", ").WriteString(s.C(column)).
+				WriteString(" AT TIME ZONE ").Arg(tz).
+				WriteString("), 'YYYY-MM-DD')")
+		})
+		s.Select(s.C(history.FieldVoice))
+		s.AppendSelectExprAs(bucket, "bucket")
+		s.AppendSelectAs(sql.Count("*"), "count")
+		if characters != "" {
+			s.AppendSelectAs(sql.Sum(s.C(characters)), "characters")
+		} else {
+			s.AppendSelectExprAs(sql.Expr("0"), "characters")
+		}
+		s.GroupBy("bucket")
+		return ""
+	}
+}
@@ -0,0 +1,7 @@
+package stats
+
+import "github.com/gofiber/fiber/v2"
+
+func SetupStatsRoutes(router fiber.Router, handler *Handler) {
+	router.Get("/stats", handler.Get)
+}
@@ -0,0 +1,202 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	dtoStats "github.com/kiminodare/HOVARLAY-BE/internal/modules/stats/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+const (
+	// cacheTTL adalah lama statistik user berat disimpan di memori.
+	cacheTTL = 5 * time.Minute
+	// heavyUserHistories adalah jumlah history minimal agar statistik di-cache.
+	heavyUserHistories = 1000
+)
+
+type cachedStats struct {
+	stats   *dtoStats.Stats
+	expires time.Time
+}
+
+type Service struct {
+	repo *Repository
+
+	mu    sync.Mutex
+	cache map[string]cachedStats
+}
+
+func NewService(repo *Repository) *Service {
+	return &Service{repo: repo, cache: make(map[string]cachedStats)}
+}
+
+// Get returns the activity totals of the user and a series over the range
+// of query. Results of users with many histories are cached for a few
+// minutes, so they can lag behind recent activity.
+func (s *Service) Get(ctx context.Context, userID uuid.UUID, query *dtoStats.StatsQuery) (*dtoStats.Stats, error) {
+	rng, starts, err := statsRange(query, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%s|%s|%s|%s|%s", userID, rng.Bucket, rng.Timezone, rng.From.Format(time.RFC3339), rng.To.Format(time.RFC3339))
+	if stats, ok := s.cached(key); ok {
+		return stats, nil
+	}
+
+	byVoice, err := s.repo.TotalsByVoice(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.repo.CreatedSeries(ctx, userID, rng.From, rng.To, rng.Bucket, rng.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	plays, err := s.repo.PlaySeries(ctx, userID, rng.From, rng.To, rng.Bucket, rng.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &dtoStats.Stats{Range: *rng, ByVoice: byVoice, Series: make([]dtoStats.SeriesPoint, len(starts))}
+	sort.Slice(byVoice, func(i, j int) bool { return byVoice[i].Histories > byVoice[j].Histories })
+	for _, v := range byVoice {
+		stats.Totals.Histories += v.Histories
+		stats.Totals.Characters += v.Characters
+		stats.Totals.Plays += v.Plays
+	}
+
+	points := make(map[string]*dtoStats.SeriesPoint, len(starts))
+	for i, start := range starts {
+		stats.Series[i] = dtoStats.SeriesPoint{Start: start, ByVoice: map[string]*dtoStats.SeriesVoice{}}
+		points[start.Format(time.DateOnly)] = &stats.Series[i]
+	}
+	voiceOf := func(p *dtoStats.SeriesPoint, v string) *dtoStats.SeriesVoice {
+		if p.ByVoice[v] == nil {
+			p.ByVoice[v] = &dtoStats.SeriesVoice{}
+		}
+		return p.ByVoice[v]
+	}
+	for _, row := range created {
+		if p, ok := points[row.Bucket]; ok {
+			p.Histories += row.Count
+			p.Characters += row.Characters
+			v := voiceOf(p, row.Voice)
+			v.Histories += row.Count
+			v.Characters += row.Characters
+		}
+	}
+	for _, row := range plays {
+		if p, ok := points[row.Bucket]; ok {
+			p.Plays += row.Count
+			voiceOf(p, row.Voice).Plays += row.Count
+		}
+	}
+
+	if stats.Totals.Histories >= heavyUserHistories {
+		s.store(key, stats)
+	}
+	return stats, nil
+}
+
+func (s *Service) cached(key string) (*dtoStats.Stats, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.cache[key]
+	if !ok || time.Now().After(c.expires) {
+		return nil, false
+	}
+	return c.stats, true
+}
+
+func (s *Service) store(key string, stats *dtoStats.Stats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for k, c := range s.cache {
+		if now.After(c.expires) {
+			delete(s.cache, k)
+		}
+	}
+	s.cache[key] = cachedStats{stats: stats, expires: now.Add(cacheTTL)}
+}
+
+// statsRange resolves the query to a range aligned to whole buckets in the
+// requested timezone and returns the start of every bucket. Without dates the
+// range ends with the current bucket and spans 30 days, 12 weeks or 12 months.
+func statsRange(query *dtoStats.StatsQuery, now time.Time) (*dtoStats.StatsRange, []time.Time, error) {
+	rng := &dtoStats.StatsRange{Bucket: query.Bucket, Timezone: query.Timezone}
+	if rng.Bucket == "" {
+		rng.Bucket = "day"
+	}
+	if rng.Timezone == "" {
+		rng.Timezone = "UTC"
+	}
+	// Local adalah zona server; Postgres tidak mengenalnya di AT TIME ZONE.
+	loc, err := time.LoadLocation(rng.Timezone)
+	if err != nil || loc == time.Local {
+		return nil, nil, utils.ErrInvalidTimezone
+	}
+
+	last := now.In(loc)
+	if query.To != "" {
+		if last, err = time.ParseInLocation(time.DateOnly, query.To, loc); err != nil {
+			return nil, nil, err
+		}
+	}
+	last = bucketStart(last, rng.Bucket)
+
+	var first time.Time
+	if query.From != "" {
+		if first, err = time.ParseInLocation(time.DateOnly, query.From, loc); err != nil {
+			return nil, nil, err
+		}
+		first = bucketStart(first, rng.Bucket)
+	} else {
+		first = addBuckets(last, rng.Bucket, map[string]int{"day": -29, "week": -11, "month": -11}[rng.Bucket])
+	}
+	if first.After(last) {
+		return nil, nil, utils.ErrInvalidStatsRange
+	}
+
+	var starts []time.Time
+	for t := first; !t.After(last); t = addBuckets(t, rng.Bucket, 1) {
+		if len(starts) == dtoStats.MaxBuckets {
+			return nil, nil, utils.ErrStatsRangeTooLarge
+		}
+		starts = append(starts, t)
+	}
+
+	rng.From = first
+	rng.To = addBuckets(last, rng.Bucket, 1)
+	return rng, starts, nil
+}
+
+// bucketStart returns the local midnight starting the day, the ISO week
+// (Monday) or the month containing t.
+func bucketStart(t time.Time, unit string) time.Time {
+	y, m, d := t.Date()
+	switch unit {
+	case "week":
+		return time.Date(y, m, d-(int(t.Weekday())+6)%7, 0, 0, 0, 0, t.Location())
+	case "month":
+		return time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	}
+}
+
+func addBuckets(t time.Time, unit string, n int) time.Time {
+	switch unit {
+	case "week":
+		return t.AddDate(0, 0, 7*n)
+	case "month":
+		return t.AddDate(0, n, 0)
+	default:
+		return t.AddDate(0, 0, n)
+	}
+}
@@ -0,0 +1,11 @@
+package dtoTag
+
+import "github.com/google/uuid"
+
+// TagCount is a tag together with the number of histories carrying it.
+type TagCount struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Color string    `json:"color,omitempty"`
+	Count int       `json:"count"`
+}
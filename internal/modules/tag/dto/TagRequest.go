@@ -0,0 +1,28 @@
+package dtoTag
+
+import (
+	"regexp"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var validate *validator.Validate
+
+// hexRGB matches the colors the tag schema stores: #RGB or #RRGGBB.
+var hexRGB = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+func init() {
+	validate = validator.New()
+	_ = validate.RegisterValidation("hexrgb", func(fl validator.FieldLevel) bool {
+		return hexRGB.MatchString(fl.Field().String())
+	})
+}
+
+type TagRequest struct {
+	Name  string `json:"name" validate:"required,min=1,max=50"`
+	Color string `json:"color" validate:"omitempty,hexrgb"`
+}
+
+func (r *TagRequest) Validate() error {
+	return validate.Struct(r)
+}
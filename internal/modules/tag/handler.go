@@ -0,0 +1,114 @@
+package tag
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/internal/middleware"
+	dtoTag "github.com/kiminodare/HOVARLAY-BE/internal/modules/tag/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) Create(c *fiber.Ctx) error {
+	var req dtoTag.TagRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	tag, err := h.service.Create(c.Context(), userID, &req)
+	if err != nil {
+		if errors.Is(err, utils.ErrTagAlreadyExists) {
+			return middleware.Error(c, "Tag already exists", fiber.StatusConflict)
+		}
+		return middleware.Error(c, "Failed to create tag", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, tag, "Tag created successfully", nil)
+}
+
+func (h *Handler) GetByUser(c *fiber.Ctx) error {
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	tags, err := h.service.GetByUser(c.Context(), userID)
+	if err != nil {
+		return middleware.Error(c, "Failed to fetch tags", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, tags, "Tags fetched successfully", nil)
+}
+
+func (h *Handler) Update(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	var req dtoTag.TagRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	tag, err := h.service.Update(c.Context(), userID, id, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrTagNotFound):
+			return middleware.Error(c, "Tag not found", fiber.StatusNotFound)
+		case errors.Is(err, utils.ErrTagAlreadyExists):
+			return middleware.Error(c, "Tag already exists", fiber.StatusConflict)
+		}
+		return middleware.Error(c, "Failed to update tag", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, tag, "Tag updated successfully", nil)
+}
+
+func (h *Handler) Delete(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	if err := h.service.Delete(c.Context(), userID, id); err != nil {
+		if errors.Is(err, utils.ErrTagNotFound) {
+			return middleware.Error(c, "Tag not found", fiber.StatusNotFound)
+		}
+		return middleware.Error(c, "Failed to delete tag", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, nil, "Tag deleted successfully", nil)
+}
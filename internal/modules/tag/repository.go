@@ -0,0 +1,98 @@
+package tag
+
+import (
+	"context"
+	"sort"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/history"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/tag"
+	user2 "github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	dtoTag "github.com/kiminodare/HOVARLAY-BE/internal/modules/tag/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Repository struct {
+	client *generated.Client
+}
+
+func NewTagRepository(client *generated.Client) *Repository {
+	return &Repository{client: client}
+}
+
+func (r *Repository) Create(ctx context.Context, userID uuid.UUID, req *dtoTag.TagRequest) (*generated.Tag, error) {
+	return r.client.Tag.Create().
+		SetName(req.Name).
+		SetColor(req.Color).
+		SetUserID(userID).
+		Save(ctx)
+}
+
+func (r *Repository) GetByUser(ctx context.Context, userID uuid.UUID) ([]*generated.Tag, error) {
+	return r.client.Tag.Query().
+		Where(tag.HasUserWith(user2.ID(userID))).
+		Order(tag.ByName()).
+		All(ctx)
+}
+
+func (r *Repository) GetOwned(ctx context.Context, userID, id uuid.UUID) (*generated.Tag, error) {
+	t, err := r.client.Tag.Query().
+		Where(tag.ID(id), tag.HasUserWith(user2.ID(userID))).
+		Only(ctx)
+	if generated.IsNotFound(err) {
+		return nil, utils.ErrTagNotFound
+	}
+	return t, err
+}
+
+// CountOwned returns how many of ids are tags owned by userID.
+func (r *Repository) CountOwned(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) (int, error) {
+	return r.client.Tag.Query().
+		Where(tag.IDIn(ids...), tag.HasUserWith(user2.ID(userID))).
+		Count(ctx)
+}
+
+func (r *Repository) Update(ctx context.Context, id uuid.UUID, req *dtoTag.TagRequest) (*generated.Tag, error) {
+	return r.client.Tag.UpdateOneID(id).
+		SetName(req.Name).
+		SetColor(req.Color).
+		Save(ctx)
+}
+
+func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.client.Tag.DeleteOneID(id).Exec(ctx)
+}
+
+// CountHistories returns every tag of the user with the number of its
+// histories that are not trashed and match historyPreds.
+func (r *Repository) CountHistories(ctx context.Context, userID uuid.UUID, historyPreds ...predicate.History) ([]dtoTag.TagCount, error) {
+	ht := sql.Table(history.Table)
+	matching := sql.Select(ht.C(history.FieldID)).From(ht)
+	history.DeletedAtIsNil()(matching)
+	for _, p := range historyPreds {
+		p(matching)
+	}
+
+	counts := []dtoTag.TagCount{}
+	err := r.client.Tag.Query().
+		Where(tag.HasUserWith(user2.ID(userID))).
+		GroupBy(tag.FieldID, tag.FieldName, tag.FieldColor).
+		Aggregate(func(s *sql.Selector) string {
+			jt := sql.Table(tag.HistoriesTable)
+			s.LeftJoin(jt).OnP(sql.And(
+				sql.ColumnsEQ(s.C(tag.FieldID), jt.C(tag.HistoriesPrimaryKey[0])),
+				sql.In(jt.C(tag.HistoriesPrimaryKey[1]), matching),
+			))
+			return sql.As(sql.Count(jt.C(tag.HistoriesPrimaryKey[1])), "count")
+		}).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(counts, func(i, j int) bool { return counts[i].Name < counts[j].Name })
+	return counts, nil
+}
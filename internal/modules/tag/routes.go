@@ -0,0 +1,10 @@
+package tag
+
+import "github.com/gofiber/fiber/v2"
+
+func SetupTagRoutes(router fiber.Router, handler *Handler) {
+	router.Get("/tags", handler.GetByUser)
+	router.Post("/tags", handler.Create)
+	router.Put("/tags/:id", handler.Update)
+	router.Delete("/tags/:id", handler.Delete)
+}
@@ -0,0 +1,77 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/predicate"
+	dtoTag "github.com/kiminodare/HOVARLAY-BE/internal/modules/tag/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Service struct {
+	repo *Repository
+}
+
+func NewService(repo *Repository) *Service {
+	return &Service{repo: repo}
+}
+
+func (s *Service) Create(ctx context.Context, userID uuid.UUID, req *dtoTag.TagRequest) (*generated.Tag, error) {
+	t, err := s.repo.Create(ctx, userID, req)
+	if generated.IsConstraintError(err) {
+		return nil, utils.ErrTagAlreadyExists
+	}
+	return t, err
+}
+
+// GetByUser returns the tags of the user with their history counts.
+func (s *Service) GetByUser(ctx context.Context, userID uuid.UUID) ([]dtoTag.TagCount, error) {
+	return s.repo.CountHistories(ctx, userID)
+}
+
+// CountHistories counts, per tag, the histories matching historyPreds.
+func (s *Service) CountHistories(ctx context.Context, userID uuid.UUID, historyPreds ...predicate.History) ([]dtoTag.TagCount, error) {
+	return s.repo.CountHistories(ctx, userID, historyPreds...)
+}
+
+// EnsureOwned returns ErrTagNotFound unless every id is a tag of the user.
+func (s *Service) EnsureOwned(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	unique := make(map[uuid.UUID]struct{}, len(ids))
+	for _, id := range ids {
+		unique[id] = struct{}{}
+	}
+
+	n, err := s.repo.CountOwned(ctx, userID, ids)
+	if err != nil {
+		return err
+	}
+	if n != len(unique) {
+		return utils.ErrTagNotFound
+	}
+	return nil
+}
+
+func (s *Service) Update(ctx context.Context, userID, id uuid.UUID, req *dtoTag.TagRequest) (*generated.Tag, error) {
+	if _, err := s.repo.GetOwned(ctx, userID, id); err != nil {
+		return nil, err
+	}
+
+	t, err := s.repo.Update(ctx, id, req)
+	if generated.IsConstraintError(err) {
+		return nil, utils.ErrTagAlreadyExists
+	}
+	return t, err
+}
+
+func (s *Service) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	if _, err := s.repo.GetOwned(ctx, userID, id); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
@@ -0,0 +1,41 @@
+package dtoTemplate
+
+import (
+	"github.com/google/uuid"
+	dtoHistory "github.com/kiminodare/HOVARLAY-BE/internal/modules/history/dto"
+)
+
+// RenderTemplateRequest fills in a template. With CreateHistory the text is
+// saved as a history, using the voice parameters given here, then those of
+// the template preset, then the user preferences.
+type RenderTemplateRequest struct {
+	Variables     map[string]any `json:"variables" validate:"max=100"`
+	CreateHistory bool           `json:"createHistory"`
+	PresetID      *uuid.UUID     `json:"presetId"`
+	Voice         string         `json:"voice"`
+	Rate          *float64       `json:"rate" validate:"omitempty,min=0.1,max=5"`
+	Pitch         *float64       `json:"pitch" validate:"omitempty,min=0,max=2"`
+	Volume        *float64       `json:"volume" validate:"omitempty,min=0,max=1"`
+	Dedupe        bool           `json:"dedupe"`
+}
+
+func (r *RenderTemplateRequest) Validate() error {
+	return validate.Struct(r)
+}
+
+// HistoryRequest returns the request that creates a history with text.
+func (r *RenderTemplateRequest) HistoryRequest(text, format string, presetID *uuid.UUID) *dtoHistory.CreateHistoryRequest {
+	if r.PresetID != nil {
+		presetID = r.PresetID
+	}
+	return &dtoHistory.CreateHistoryRequest{
+		Text:     text,
+		Format:   format,
+		PresetID: presetID,
+		Voice:    r.Voice,
+		Rate:     r.Rate,
+		Pitch:    r.Pitch,
+		Volume:   r.Volume,
+		Dedupe:   r.Dedupe,
+	}
+}
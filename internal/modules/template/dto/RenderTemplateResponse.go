@@ -0,0 +1,14 @@
+package dtoTemplate
+
+import (
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	filter "github.com/kiminodare/HOVARLAY-BE/internal/moderation"
+)
+
+type RenderTemplateResponse struct {
+	Text string `json:"text"`
+	// History is the created history, when asked for.
+	History *generated.History `json:"history,omitempty"`
+	// Moderation lists the moderation rules that changed the text.
+	Moderation *filter.Result `json:"moderation,omitempty"`
+}
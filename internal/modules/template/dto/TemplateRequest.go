@@ -0,0 +1,27 @@
+package dtoTemplate
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+var validate *validator.Validate
+
+func init() {
+	validate = validator.New()
+}
+
+type TemplateRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=50"`
+	Text string `json:"text" validate:"required,max=20000"`
+	// Format is plain (default) or ssml. Values are escaped in SSML templates.
+	Format string `json:"format" validate:"omitempty,oneof=plain ssml"`
+	// Language and Currency are used by the number and currency formatters.
+	Language string     `json:"language" validate:"omitempty,bcp47_language_tag"`
+	Currency string     `json:"currency" validate:"omitempty,iso4217"`
+	PresetID *uuid.UUID `json:"presetId"`
+}
+
+func (r *TemplateRequest) Validate() error {
+	return validate.Struct(r)
+}
@@ -0,0 +1,9 @@
+package dtoTemplate
+
+import "github.com/kiminodare/HOVARLAY-BE/ent/generated"
+
+// TemplateResponse is a template with the variables it expects.
+type TemplateResponse struct {
+	*generated.Template
+	Variables []Variable `json:"variables"`
+}
@@ -0,0 +1,10 @@
+package dtoTemplate
+
+// Variable is a placeholder of a template. Default is nil when the
+// variable is required.
+type Variable struct {
+	Name      string  `json:"name"`
+	Formatter string  `json:"formatter,omitempty"`
+	Argument  string  `json:"argument,omitempty"`
+	Default   *string `json:"default,omitempty"`
+}
@@ -0,0 +1,358 @@
+package template
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	dtoTemplate "github.com/kiminodare/HOVARLAY-BE/internal/modules/template/dto"
+)
+
+// Formatters that placeholders can name after a colon.
+const (
+	FormatNumber   = "number"
+	FormatCurrency = "currency"
+)
+
+// placeholderPattern matches the inside of {...}: a name, an optional
+// formatter with an optional argument, and an optional default after "|".
+var placeholderPattern = regexp.MustCompile(`(?s)^\s*([\p{L}_][\p{L}\p{N}_]*)\s*(?::\s*([a-z]+)\s*(?:\(\s*([^()]*?)\s*\))?\s*)?(?:\|(.*))?$`)
+
+// SyntaxError is a malformed template. Offset counts runes from the start,
+// or is negative when the problem has no single position.
+type SyntaxError struct {
+	Offset  int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	if e.Offset < 0 {
+		return "template error: " + e.Message
+	}
+	return fmt.Sprintf("template error at character %d: %s", e.Offset+1, e.Message)
+}
+
+// RenderError lists the variables that could not be filled in.
+type RenderError struct {
+	Problems []string
+}
+
+func (e *RenderError) Error() string {
+	return strings.Join(e.Problems, "; ")
+}
+
+// Compiled is a parsed template: literal text alternating with placeholders.
+type Compiled struct {
+	parts []part
+}
+
+type part struct {
+	text        string
+	placeholder *dtoTemplate.Variable
+}
+
+// FormatOptions control how values are written. Escape, when set, is
+// applied to every substituted value, for example to keep SSML valid.
+type FormatOptions struct {
+	Language string
+	Currency string
+	Escape   func(string) string
+}
+
+// Parse reads a template. Placeholders are written {name}, {name|default}
+// or {name:formatter(argument)|default}; {{ and }} stand for literal braces.
+func Parse(text string) (*Compiled, error) {
+	c := &Compiled{}
+	var literal strings.Builder
+	for i := 0; i < len(text); {
+		switch {
+		case strings.HasPrefix(text[i:], "{{"):
+			literal.WriteByte('{')
+			i += 2
+		case strings.HasPrefix(text[i:], "}}"):
+			literal.WriteByte('}')
+			i += 2
+		case text[i] == '}':
+			return nil, &SyntaxError{Offset: utf8.RuneCountInString(text[:i]), Message: `unexpected "}", write "}}" for a literal brace`}
+		case text[i] == '{':
+			end := strings.IndexByte(text[i+1:], '}')
+			if end < 0 {
+				return nil, &SyntaxError{Offset: utf8.RuneCountInString(text[:i]), Message: "placeholder is not closed"}
+			}
+			v, err := parsePlaceholder(text[i+1 : i+1+end])
+			if err != nil {
+				return nil, &SyntaxError{Offset: utf8.RuneCountInString(text[:i]), Message: err.Error()}
+			}
+			if literal.Len() > 0 {
+				c.parts = append(c.parts, part{text: literal.String()})
+				literal.Reset()
+			}
+			c.parts = append(c.parts, part{placeholder: v})
+			i += end + 2
+		default:
+			literal.WriteByte(text[i])
+			i++
+		}
+	}
+	if literal.Len() > 0 {
+		c.parts = append(c.parts, part{text: literal.String()})
+	}
+	return c, nil
+}
+
+func parsePlaceholder(inner string) (*dtoTemplate.Variable, error) {
+	m := placeholderPattern.FindStringSubmatch(inner)
+	if m == nil {
+		return nil, fmt.Errorf("invalid placeholder %q", "{"+inner+"}")
+	}
+	v := &dtoTemplate.Variable{Name: m[1], Formatter: m[2], Argument: m[3]}
+	if strings.Contains(inner, "|") {
+		def := m[4]
+		v.Default = &def
+	}
+
+	switch v.Formatter {
+	case "":
+		if v.Argument != "" {
+			return nil, fmt.Errorf("%s has an argument but no formatter", v.Name)
+		}
+	case FormatNumber:
+		if v.Argument != "" {
+			if n, err := strconv.Atoi(v.Argument); err != nil || n < 0 || n > 6 {
+				return nil, fmt.Errorf("number decimals must be between 0 and 6, got %q", v.Argument)
+			}
+		}
+	case FormatCurrency:
+		if v.Argument != "" && !isCurrencyCode(v.Argument) {
+			return nil, fmt.Errorf("currency must be a three-letter code, got %q", v.Argument)
+		}
+	default:
+		return nil, fmt.Errorf("unknown formatter %q", v.Formatter)
+	}
+	if v.Default != nil && v.Formatter != "" && *v.Default != "" {
+		if _, err := parseNumber(*v.Default); err != nil {
+			return nil, fmt.Errorf("default of %s must be a number", v.Name)
+		}
+	}
+	return v, nil
+}
+
+// Variables returns the placeholders of c, once per name, in order of first
+// use.
+func (c *Compiled) Variables() []dtoTemplate.Variable {
+	seen := map[string]bool{}
+	vars := make([]dtoTemplate.Variable, 0)
+	for _, p := range c.parts {
+		if p.placeholder == nil || seen[p.placeholder.Name] {
+			continue
+		}
+		seen[p.placeholder.Name] = true
+		vars = append(vars, *p.placeholder)
+	}
+	return vars
+}
+
+// Execute fills in c with values. A value that is missing, null or an empty
+// string falls back to the default of the placeholder; without one, the
+// variable is reported in a RenderError together with values that the
+// formatter cannot read.
+func (c *Compiled) Execute(values map[string]any, opts FormatOptions) (string, error) {
+	var b strings.Builder
+	var problems []string
+	reported := map[string]bool{}
+	report := func(name, msg string) {
+		if !reported[name] {
+			reported[name] = true
+			problems = append(problems, msg)
+		}
+	}
+
+	for _, p := range c.parts {
+		if p.placeholder == nil {
+			b.WriteString(p.text)
+			continue
+		}
+		v := p.placeholder
+		raw, ok := lookup(values, v.Name)
+		if !ok {
+			if v.Default == nil {
+				report(v.Name, fmt.Sprintf("%s is required", v.Name))
+				continue
+			}
+			raw = *v.Default
+			if raw == "" {
+				continue
+			}
+		}
+		s, err := format(v, raw, opts)
+		if err != nil {
+			report(v.Name, err.Error())
+			continue
+		}
+		if opts.Escape != nil {
+			s = opts.Escape(s)
+		}
+		b.WriteString(s)
+	}
+	if len(problems) > 0 {
+		return "", &RenderError{Problems: problems}
+	}
+	return b.String(), nil
+}
+
+// lookup returns the value of name, or false when it is unset or blank.
+func lookup(values map[string]any, name string) (any, bool) {
+	raw, ok := values[name]
+	if !ok || raw == nil {
+		return nil, false
+	}
+	if s, isString := raw.(string); isString && strings.TrimSpace(s) == "" {
+		return nil, false
+	}
+	return raw, true
+}
+
+func format(v *dtoTemplate.Variable, raw any, opts FormatOptions) (string, error) {
+	if v.Formatter == "" {
+		switch x := raw.(type) {
+		case string:
+			return x, nil
+		case float64:
+			return strconv.FormatFloat(x, 'f', -1, 64), nil
+		default:
+			return fmt.Sprint(x), nil
+		}
+	}
+
+	var n float64
+	switch x := raw.(type) {
+	case float64:
+		n = x
+	case string:
+		parsed, err := parseNumber(x)
+		if err != nil {
+			return "", fmt.Errorf("%s must be a number", v.Name)
+		}
+		n = parsed
+	default:
+		return "", fmt.Errorf("%s must be a number", v.Name)
+	}
+
+	sep := separatorsFor(opts.Language)
+	if v.Formatter == FormatNumber {
+		decimals := -1
+		if v.Argument != "" {
+			decimals, _ = strconv.Atoi(v.Argument)
+		}
+		return formatNumber(n, decimals, sep), nil
+	}
+
+	code := strings.ToUpper(v.Argument)
+	if code == "" {
+		code = strings.ToUpper(opts.Currency)
+	}
+	return formatCurrency(n, code, sep), nil
+}
+
+// parseNumber reads a plain decimal number such as "50000" or "12.5".
+func parseNumber(s string) (float64, error) {
+	n, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil || math.IsInf(n, 0) || math.IsNaN(n) {
+		return 0, fmt.Errorf("not a number")
+	}
+	return n, nil
+}
+
+// separators are the digit group and decimal marks of a language.
+type separators struct {
+	group   string
+	decimal string
+}
+
+// commaDecimal lists languages that group digits with a dot and write
+// decimals after a comma.
+var commaDecimal = map[string]bool{
+	"id": true, "de": true, "es": true, "pt": true, "it": true, "nl": true, "tr": true, "da": true,
+}
+
+func separatorsFor(language string) separators {
+	lang, _, _ := strings.Cut(strings.ToLower(language), "-")
+	switch {
+	case commaDecimal[lang]:
+		return separators{group: ".", decimal: ","}
+	case lang == "fr":
+		return separators{group: " ", decimal: ","}
+	default:
+		return separators{group: ",", decimal: "."}
+	}
+}
+
+// formatNumber writes n with grouped digits. A negative decimals keeps up
+// to two decimals, and none for whole numbers.
+func formatNumber(n float64, decimals int, sep separators) string {
+	if decimals < 0 {
+		decimals = 2
+		if n == math.Trunc(n) {
+			decimals = 0
+		}
+	}
+	s := strconv.FormatFloat(math.Abs(n), 'f', decimals, 64)
+	whole, frac, _ := strings.Cut(s, ".")
+
+	var b strings.Builder
+	if n < 0 && strings.Trim(s, "0.") != "" {
+		b.WriteByte('-')
+	}
+	for i, r := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteString(sep.group)
+		}
+		b.WriteRune(r)
+	}
+	if frac != "" {
+		b.WriteString(sep.decimal)
+		b.WriteString(frac)
+	}
+	return b.String()
+}
+
+// currencySymbols are written before the amount; other currencies use
+// their code followed by a space.
+var currencySymbols = map[string]string{
+	"IDR": "Rp", "USD": "$", "EUR": "€", "GBP": "£", "JPY": "¥",
+	"SGD": "S$", "MYR": "RM", "AUD": "A$",
+}
+
+// zeroDecimalCurrencies have no minor unit in everyday use.
+var zeroDecimalCurrencies = map[string]bool{"IDR": true, "JPY": true, "KRW": true, "VND": true}
+
+func formatCurrency(n float64, code string, sep separators) string {
+	decimals := 2
+	if zeroDecimalCurrencies[code] {
+		decimals = 0
+	}
+	amount := formatNumber(math.Abs(n), decimals, sep)
+	symbol, ok := currencySymbols[code]
+	if !ok {
+		symbol = code + " "
+	}
+	if n < 0 && strings.Trim(amount, "0., ") != "" {
+		return "-" + symbol + amount
+	}
+	return symbol + amount
+}
+
+func isCurrencyCode(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+	for _, r := range s {
+		if (r < 'A' || r > 'Z') && (r < 'a' || r > 'z') {
+			return false
+		}
+	}
+	return true
+}
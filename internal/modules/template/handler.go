@@ -0,0 +1,209 @@
+package template
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/internal/middleware"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/history"
+	dtoHistory "github.com/kiminodare/HOVARLAY-BE/internal/modules/history/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/quota"
+	dtoTemplate "github.com/kiminodare/HOVARLAY-BE/internal/modules/template/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) Create(c *fiber.Ctx) error {
+	var req dtoTemplate.TemplateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	template, err := h.service.Create(c.Context(), userID, &req)
+	if err != nil {
+		if msgs := templateErrors(err); msgs != nil {
+			return middleware.ValidationError(c, msgs)
+		}
+		if errors.Is(err, utils.ErrTemplateExists) {
+			return middleware.Error(c, "Template already exists", fiber.StatusConflict)
+		}
+		return middleware.Error(c, "Failed to create template", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, template, "Template created successfully", nil)
+}
+
+func (h *Handler) GetByUser(c *fiber.Ctx) error {
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	templates, err := h.service.GetByUser(c.Context(), userID)
+	if err != nil {
+		return middleware.Error(c, "Failed to fetch templates", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, templates, "Templates fetched successfully", nil)
+}
+
+func (h *Handler) GetByID(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	template, err := h.service.GetOwned(c.Context(), userID, id)
+	if err != nil {
+		if errors.Is(err, utils.ErrTemplateNotFound) {
+			return middleware.Error(c, "Template not found", fiber.StatusNotFound)
+		}
+		return middleware.Error(c, "Failed to fetch template", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, template, "Template fetched successfully", nil)
+}
+
+func (h *Handler) Update(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	var req dtoTemplate.TemplateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	template, err := h.service.Update(c.Context(), userID, id, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, utils.ErrTemplateNotFound):
+			return middleware.Error(c, "Template not found", fiber.StatusNotFound)
+		case errors.Is(err, utils.ErrTemplateExists):
+			return middleware.Error(c, "Template already exists", fiber.StatusConflict)
+		}
+		if msgs := templateErrors(err); msgs != nil {
+			return middleware.ValidationError(c, msgs)
+		}
+		return middleware.Error(c, "Failed to update template", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, template, "Template updated successfully", nil)
+}
+
+func (h *Handler) Delete(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	if err := h.service.Delete(c.Context(), userID, id); err != nil {
+		if errors.Is(err, utils.ErrTemplateNotFound) {
+			return middleware.Error(c, "Template not found", fiber.StatusNotFound)
+		}
+		return middleware.Error(c, "Failed to delete template", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, nil, "Template deleted successfully", nil)
+}
+
+// Render fills in a template with the variables of the body. With
+// createHistory the text is also saved as a history, and voice language
+// warnings are returned in meta like on history creation.
+func (h *Handler) Render(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.Error(c, "Invalid ID format", fiber.StatusBadRequest)
+	}
+
+	var req dtoTemplate.RenderTemplateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return middleware.Error(c, "Invalid request body", fiber.StatusBadRequest)
+	}
+
+	if err := req.Validate(); err != nil {
+		return middleware.ValidationError(c, utils.FormatValidationErrors(err))
+	}
+
+	userID, ok := middleware.UserID(c)
+	if !ok {
+		return middleware.Error(c, "User ID not found", fiber.StatusUnauthorized)
+	}
+
+	result, err := h.service.Render(c.Context(), userID, id, &req)
+	if err != nil {
+		if errors.Is(err, utils.ErrTemplateNotFound) {
+			return middleware.Error(c, "Template not found", fiber.StatusNotFound)
+		}
+		if msgs := templateErrors(err); msgs != nil {
+			return middleware.ValidationError(c, msgs)
+		}
+		if status := quota.Status(err); status != 0 {
+			return middleware.Error(c, quota.Message(err), status)
+		}
+		return middleware.Error(c, "Failed to render template", fiber.StatusInternalServerError)
+	}
+
+	if result.History != nil {
+		warnings, err := h.service.LanguageWarnings(c.Context(), result.History)
+		if err != nil {
+			log.Errorf("failed to check language of history %s: %v", result.History.ID, err)
+		} else if len(warnings) > 0 {
+			return middleware.SuccessWithMeta(c, result, "Template rendered successfully", nil, &dtoHistory.HistoryMeta{Warnings: warnings})
+		}
+	}
+	return middleware.Success(c, result, "Template rendered successfully", nil)
+}
+
+// templateErrors returns the messages of an error caused by the template or
+// its values, including problems creating the history, or nil for any
+// other error.
+func templateErrors(err error) []string {
+	var syntaxErr *SyntaxError
+	var renderErr *RenderError
+	switch {
+	case errors.As(err, &syntaxErr):
+		return []string{syntaxErr.Error()}
+	case errors.As(err, &renderErr):
+		return renderErr.Problems
+	}
+	return history.ValidationMessages(err)
+}
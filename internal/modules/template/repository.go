@@ -0,0 +1,68 @@
+package template
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	templateent "github.com/kiminodare/HOVARLAY-BE/ent/generated/template"
+	user2 "github.com/kiminodare/HOVARLAY-BE/ent/generated/user"
+	dtoTemplate "github.com/kiminodare/HOVARLAY-BE/internal/modules/template/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Repository struct {
+	client *generated.Client
+}
+
+func NewTemplateRepository(client *generated.Client) *Repository {
+	return &Repository{client: client}
+}
+
+func (r *Repository) Create(ctx context.Context, userID uuid.UUID, req *dtoTemplate.TemplateRequest) (*generated.Template, error) {
+	return r.client.Template.Create().
+		SetName(req.Name).
+		SetText(req.Text).
+		SetFormat(templateent.Format(req.Format)).
+		SetLanguage(req.Language).
+		SetCurrency(req.Currency).
+		SetNillablePresetID(req.PresetID).
+		SetUserID(userID).
+		Save(ctx)
+}
+
+func (r *Repository) GetByUser(ctx context.Context, userID uuid.UUID) ([]*generated.Template, error) {
+	return r.client.Template.Query().
+		Where(templateent.HasUserWith(user2.ID(userID))).
+		Order(templateent.ByName()).
+		All(ctx)
+}
+
+func (r *Repository) GetOwned(ctx context.Context, userID, id uuid.UUID) (*generated.Template, error) {
+	t, err := r.client.Template.Query().
+		Where(templateent.ID(id), templateent.HasUserWith(user2.ID(userID))).
+		Only(ctx)
+	if generated.IsNotFound(err) {
+		return nil, utils.ErrTemplateNotFound
+	}
+	return t, err
+}
+
+func (r *Repository) Update(ctx context.Context, id uuid.UUID, req *dtoTemplate.TemplateRequest) (*generated.Template, error) {
+	update := r.client.Template.UpdateOneID(id).
+		SetName(req.Name).
+		SetText(req.Text).
+		SetFormat(templateent.Format(req.Format)).
+		SetLanguage(req.Language).
+		SetCurrency(req.Currency)
+	if req.PresetID != nil {
+		update.SetPresetID(*req.PresetID)
+	} else {
+		update.ClearPresetID()
+	}
+	return update.Save(ctx)
+}
+
+func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.client.Template.DeleteOneID(id).Exec(ctx)
+}
@@ -0,0 +1,17 @@
+package template
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/idempotency"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/quota"
+)
+
+func SetupTemplateRoutes(router fiber.Router, handler *Handler, idempotencyMiddleware *idempotency.Middleware, quotaMiddleware *quota.Middleware) {
+	router.Get("/templates", handler.GetByUser)
+	router.Post("/templates", handler.Create)
+	router.Get("/templates/:id", handler.GetByID)
+	router.Put("/templates/:id", handler.Update)
+	router.Delete("/templates/:id", handler.Delete)
+	// render dapat membuat history, jadi memakai middleware yang sama.
+	router.Post("/templates/:id/render", quotaMiddleware.Headers, idempotencyMiddleware.Handle, handler.Render)
+}
@@ -0,0 +1,215 @@
+package template
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	templateent "github.com/kiminodare/HOVARLAY-BE/ent/generated/template"
+	filter "github.com/kiminodare/HOVARLAY-BE/internal/moderation"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/history"
+	dtoHistory "github.com/kiminodare/HOVARLAY-BE/internal/modules/history/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/moderation"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/preset"
+	dtoTemplate "github.com/kiminodare/HOVARLAY-BE/internal/modules/template/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/ssml"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+// Defaults for templates that do not set a language or currency.
+const (
+	DefaultLanguage = "id-ID"
+	DefaultCurrency = "IDR"
+)
+
+type Service struct {
+	repo       *Repository
+	presets    *preset.Service
+	histories  *history.Service
+	moderation *moderation.Service
+}
+
+func NewService(repo *Repository, presets *preset.Service, histories *history.Service, moderation *moderation.Service) *Service {
+	return &Service{repo: repo, presets: presets, histories: histories, moderation: moderation}
+}
+
+func (s *Service) Create(ctx context.Context, userID uuid.UUID, req *dtoTemplate.TemplateRequest) (*dtoTemplate.TemplateResponse, error) {
+	compiled, err := s.check(ctx, userID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.repo.Create(ctx, userID, req)
+	if generated.IsConstraintError(err) {
+		return nil, utils.ErrTemplateExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &dtoTemplate.TemplateResponse{Template: t, Variables: compiled.Variables()}, nil
+}
+
+func (s *Service) GetByUser(ctx context.Context, userID uuid.UUID) ([]*dtoTemplate.TemplateResponse, error) {
+	templates, err := s.repo.GetByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*dtoTemplate.TemplateResponse, len(templates))
+	for i, t := range templates {
+		result[i] = response(t)
+	}
+	return result, nil
+}
+
+// GetOwned returns the template or ErrTemplateNotFound when it is not the
+// user's.
+func (s *Service) GetOwned(ctx context.Context, userID, id uuid.UUID) (*dtoTemplate.TemplateResponse, error) {
+	t, err := s.repo.GetOwned(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	return response(t), nil
+}
+
+func (s *Service) Update(ctx context.Context, userID, id uuid.UUID, req *dtoTemplate.TemplateRequest) (*dtoTemplate.TemplateResponse, error) {
+	if _, err := s.repo.GetOwned(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	compiled, err := s.check(ctx, userID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.repo.Update(ctx, id, req)
+	if generated.IsConstraintError(err) {
+		return nil, utils.ErrTemplateExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &dtoTemplate.TemplateResponse{Template: t, Variables: compiled.Variables()}, nil
+}
+
+func (s *Service) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	if _, err := s.repo.GetOwned(ctx, userID, id); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// Render fills in a template of the user and, when asked, saves the result
+// as a history. Creating the history goes through the history service, so
+// it is charged to the quota like any other. Values often come from viewers,
+// so the rendered text is screened by the moderation filter of the user.
+func (s *Service) Render(ctx context.Context, userID, id uuid.UUID, req *dtoTemplate.RenderTemplateRequest) (*dtoTemplate.RenderTemplateResponse, error) {
+	t, err := s.repo.GetOwned(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	compiled, err := Parse(t.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := FormatOptions{Language: t.Language, Currency: t.Currency}
+	if t.Format == templateent.FormatSsml {
+		opts.Escape = ssml.Escape
+	}
+	text, err := compiled.Execute(req.Variables, opts)
+	if err != nil {
+		return nil, err
+	}
+	if !req.CreateHistory {
+		f, err := s.moderation.Filter(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		screened, err := moderation.Screen(f, text, t.Format == templateent.FormatSsml)
+		if err != nil {
+			return nil, err
+		}
+		if screened.Rejected() {
+			return nil, &filter.RejectedError{Result: screened}
+		}
+		return renderResponse(screened, nil), nil
+	}
+
+	if strings.TrimSpace(text) == "" {
+		return nil, &RenderError{Problems: []string{"rendered text is empty"}}
+	}
+	// Pembuatan history sudah menyaring teks, jadi hasilnya dipakai langsung.
+	h, screened, err := s.histories.Create(ctx, userID, req.HistoryRequest(text, string(t.Format), t.PresetID))
+	if err != nil {
+		return nil, err
+	}
+	return renderResponse(screened, h), nil
+}
+
+// renderResponse reports the screened text, and the moderation result when
+// a rule changed it.
+func renderResponse(screened *filter.Result, h *generated.History) *dtoTemplate.RenderTemplateResponse {
+	result := &dtoTemplate.RenderTemplateResponse{Text: screened.Text, History: h}
+	if screened.Action != "" {
+		result.Moderation = screened
+	}
+	return result
+}
+
+// LanguageWarnings reports a voice that does not speak the language of a
+// history created from a template.
+func (s *Service) LanguageWarnings(ctx context.Context, h *generated.History) ([]dtoHistory.LanguageWarning, error) {
+	return s.histories.LanguageWarnings(ctx, h)
+}
+
+// check fills in the defaults of req and parses its text. SSML templates
+// must stay valid whatever the values, which are escaped, so they are
+// checked with a placeholder value.
+func (s *Service) check(ctx context.Context, userID uuid.UUID, req *dtoTemplate.TemplateRequest) (*Compiled, error) {
+	if req.Format == "" {
+		req.Format = string(templateent.FormatPlain)
+	}
+	if req.Language == "" {
+		req.Language = DefaultLanguage
+	}
+	if req.Currency == "" {
+		req.Currency = DefaultCurrency
+	}
+	req.Currency = strings.ToUpper(req.Currency)
+
+	compiled, err := Parse(req.Text)
+	if err != nil {
+		return nil, err
+	}
+	if req.Format == string(templateent.FormatSsml) {
+		sample := map[string]any{}
+		for _, v := range compiled.Variables() {
+			sample[v.Name] = "1"
+		}
+		text, err := compiled.Execute(sample, FormatOptions{Language: req.Language, Currency: req.Currency, Escape: ssml.Escape})
+		if err != nil {
+			return nil, err
+		}
+		if err := ssml.Validate(text); err != nil {
+			return nil, &SyntaxError{Offset: -1, Message: fmt.Sprintf("text is not valid SSML: %v", err)}
+		}
+	}
+
+	if req.PresetID != nil {
+		if _, err := s.presets.GetOwned(ctx, userID, *req.PresetID); err != nil {
+			return nil, err
+		}
+	}
+	return compiled, nil
+}
+
+// response lists the variables of a stored template. Stored templates were
+// checked when saved, so a parse error only drops the list.
+func response(t *generated.Template) *dtoTemplate.TemplateResponse {
+	resp := &dtoTemplate.TemplateResponse{Template: t, Variables: []dtoTemplate.Variable{}}
+	if compiled, err := Parse(t.Text); err == nil {
+		resp.Variables = compiled.Variables()
+	}
+	return resp
+}
@@ -0,0 +1,40 @@
+package dtoVoice
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var validate *validator.Validate
+
+func init() {
+	validate = validator.New()
+}
+
+// CatalogEntry is one voice in a catalog seed file.
+type CatalogEntry struct {
+	ID          string  `json:"id" validate:"required,max=100"`
+	Provider    string  `json:"provider" validate:"required"`
+	Language    string  `json:"language" validate:"required,bcp47_language_tag"`
+	Gender      string  `json:"gender" validate:"required,oneof=male female neutral"`
+	DisplayName string  `json:"displayName" validate:"required"`
+	MinRate     float64 `json:"minRate" validate:"min=0.1,max=5"`
+	MaxRate     float64 `json:"maxRate" validate:"min=0.1,max=5,gtefield=MinRate"`
+	MinPitch    float64 `json:"minPitch" validate:"min=0,max=2"`
+	MaxPitch    float64 `json:"maxPitch" validate:"min=0,max=2,gtefield=MinPitch"`
+}
+
+func (e *CatalogEntry) Validate() error {
+	if err := validate.Struct(e); err != nil {
+		return fmt.Errorf("voice %q: %w", e.ID, err)
+	}
+	return nil
+}
+
+// SeedResult summarizes a catalog seed.
+type SeedResult struct {
+	Created  int `json:"created"`
+	Updated  int `json:"updated"`
+	Disabled int `json:"disabled"`
+}
@@ -0,0 +1,9 @@
+package dtoVoice
+
+// VoiceQuery filters the catalog. Language matches a whole tag or its
+// primary subtag, so "id" matches "id-ID".
+type VoiceQuery struct {
+	Language string `query:"language"`
+	Provider string `query:"provider"`
+	Gender   string `query:"gender"`
+}
@@ -0,0 +1,44 @@
+package voice
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiminodare/HOVARLAY-BE/internal/middleware"
+	dtoVoice "github.com/kiminodare/HOVARLAY-BE/internal/modules/voice/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) List(c *fiber.Ctx) error {
+	var query dtoVoice.VoiceQuery
+	if err := c.QueryParser(&query); err != nil {
+		return middleware.Error(c, "Invalid query parameters", fiber.StatusBadRequest)
+	}
+
+	voices, err := h.service.List(c.Context(), &query)
+	if err != nil {
+		return middleware.Error(c, "Failed to fetch voices", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, voices, "Voices fetched successfully", nil)
+}
+
+func (h *Handler) Get(c *fiber.Ctx) error {
+	v, err := h.service.Get(c.Context(), c.Params("id"))
+	if err != nil {
+		if errors.Is(err, utils.ErrVoiceNotFound) {
+			return middleware.Error(c, "Voice not found", fiber.StatusNotFound)
+		}
+		return middleware.Error(c, "Failed to fetch voice", fiber.StatusInternalServerError)
+	}
+
+	return middleware.Success(c, v, "Voice fetched successfully", nil)
+}
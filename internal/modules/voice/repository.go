@@ -0,0 +1,89 @@
+package voice
+
+import (
+	"context"
+
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated/voice"
+	"github.com/kiminodare/HOVARLAY-BE/internal/db"
+	dtoVoice "github.com/kiminodare/HOVARLAY-BE/internal/modules/voice/dto"
+)
+
+type Repository struct {
+	client *generated.Client
+}
+
+func NewVoiceRepository(client *generated.Client) *Repository {
+	return &Repository{client: client}
+}
+
+func (r *Repository) GetEnabled(ctx context.Context) ([]*generated.Voice, error) {
+	return r.client.Voice.Query().
+		Where(voice.Enabled(true)).
+		Order(voice.ByLanguage(), voice.ByDisplayName()).
+		All(ctx)
+}
+
+// Seed makes the catalog match entries: new voices are created, known ones
+// updated and voices missing from entries are disabled.
+func (r *Repository) Seed(ctx context.Context, entries []dtoVoice.CatalogEntry) (*dtoVoice.SeedResult, error) {
+	res := &dtoVoice.SeedResult{}
+	err := db.WithTx(ctx, r.client, func(tx *generated.Tx) error {
+		ids := make([]string, len(entries))
+		for i, e := range entries {
+			ids[i] = e.ID
+		}
+		existing, err := tx.Voice.Query().Where(voice.IDIn(ids...)).IDs(ctx)
+		if err != nil {
+			return err
+		}
+		known := make(map[string]bool, len(existing))
+		for _, id := range existing {
+			known[id] = true
+		}
+
+		for _, e := range entries {
+			if known[e.ID] {
+				err := tx.Voice.UpdateOneID(e.ID).
+					SetProvider(e.Provider).
+					SetLanguage(e.Language).
+					SetGender(voice.Gender(e.Gender)).
+					SetDisplayName(e.DisplayName).
+					SetMinRate(e.MinRate).
+					SetMaxRate(e.MaxRate).
+					SetMinPitch(e.MinPitch).
+					SetMaxPitch(e.MaxPitch).
+					SetEnabled(true).
+					Exec(ctx)
+				if err != nil {
+					return err
+				}
+				res.Updated++
+				continue
+			}
+
+			err := tx.Voice.Create().
+				SetID(e.ID).
+				SetProvider(e.Provider).
+				SetLanguage(e.Language).
+				SetGender(voice.Gender(e.Gender)).
+				SetDisplayName(e.DisplayName).
+				SetMinRate(e.MinRate).
+				SetMaxRate(e.MaxRate).
+				SetMinPitch(e.MinPitch).
+				SetMaxPitch(e.MaxPitch).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+			res.Created++
+		}
+
+		res.Disabled, err = tx.Voice.Update().
+			Where(voice.IDNotIn(ids...), voice.Enabled(true)).
+			SetEnabled(false).
+			Save(ctx)
+		return err
+	})
+	return res, err
+}
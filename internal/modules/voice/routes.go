@@ -0,0 +1,8 @@
+package voice
+
+import "github.com/gofiber/fiber/v2"
+
+func SetupVoiceRoutes(router fiber.Router, handler *Handler) {
+	router.Get("/voices", handler.List)
+	router.Get("/voices/:id", handler.Get)
+}
@@ -0,0 +1,177 @@
+package voice
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	dtoVoice "github.com/kiminodare/HOVARLAY-BE/internal/modules/voice/dto"
+	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
+)
+
+// cacheTTL adalah lama katalog disimpan di memori sebelum dibaca ulang.
+const cacheTTL = 5 * time.Minute
+
+//go:embed catalog.json
+var defaultCatalog []byte
+
+// LimitError reports a synthesis parameter outside the range of a voice.
+type LimitError struct {
+	Voice string
+	Field string
+	Min   float64
+	Max   float64
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%s must be between %g and %g for voice %s", e.Field, e.Min, e.Max, e.Voice)
+}
+
+// IsValidationError reports whether err means the request picked a voice or
+// parameters the catalog does not allow, as opposed to a lookup failure.
+func IsValidationError(err error) bool {
+	var limit *LimitError
+	return errors.Is(err, utils.ErrVoiceNotFound) || errors.As(err, &limit)
+}
+
+type Service struct {
+	repo *Repository
+
+	mu       sync.RWMutex
+	voices   []*generated.Voice
+	byID     map[string]*generated.Voice
+	loadedAt time.Time
+}
+
+func NewService(repo *Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// List returns the enabled voices matching query.
+func (s *Service) List(ctx context.Context, query *dtoVoice.VoiceQuery) ([]*generated.Voice, error) {
+	voices, _, err := s.catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*generated.Voice, 0, len(voices))
+	for _, v := range voices {
+		if query.Language != "" && !matchLanguage(v.Language, query.Language) {
+			continue
+		}
+		if query.Provider != "" && !strings.EqualFold(v.Provider, query.Provider) {
+			continue
+		}
+		if query.Gender != "" && !strings.EqualFold(string(v.Gender), query.Gender) {
+			continue
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+// Get returns an enabled voice or ErrVoiceNotFound.
+func (s *Service) Get(ctx context.Context, id string) (*generated.Voice, error) {
+	_, byID, err := s.catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := byID[id]
+	if !ok {
+		return nil, utils.ErrVoiceNotFound
+	}
+	return v, nil
+}
+
+// Check validates rate and pitch against the limits of the voice.
+func (s *Service) Check(ctx context.Context, id string, rate, pitch float64) error {
+	v, err := s.Get(ctx, id)
+	if errors.Is(err, utils.ErrVoiceNotFound) {
+		return fmt.Errorf("%w: %s", err, id)
+	}
+	if err != nil {
+		return err
+	}
+	if rate < v.MinRate || rate > v.MaxRate {
+		return &LimitError{Voice: v.ID, Field: "rate", Min: v.MinRate, Max: v.MaxRate}
+	}
+	if pitch < v.MinPitch || pitch > v.MaxPitch {
+		return &LimitError{Voice: v.ID, Field: "pitch", Min: v.MinPitch, Max: v.MaxPitch}
+	}
+	return nil
+}
+
+// Seed loads the catalog from the JSON file at path, or the built-in catalog
+// when path is empty, and stores it.
+func (s *Service) Seed(ctx context.Context, path string) (*dtoVoice.SeedResult, error) {
+	data := defaultCatalog
+	if path != "" {
+		var err error
+		if data, err = os.ReadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	var entries []dtoVoice.CatalogEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("parsing voice catalog: %w", err)
+	}
+	seen := make(map[string]bool, len(entries))
+	for i := range entries {
+		if err := entries[i].Validate(); err != nil {
+			return nil, err
+		}
+		if seen[entries[i].ID] {
+			return nil, fmt.Errorf("voice %q is listed twice", entries[i].ID)
+		}
+		seen[entries[i].ID] = true
+	}
+
+	res, err := s.repo.Seed(ctx, entries)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidate()
+	return res, nil
+}
+
+func (s *Service) catalog(ctx context.Context) ([]*generated.Voice, map[string]*generated.Voice, error) {
+	s.mu.RLock()
+	if s.byID != nil && time.Since(s.loadedAt) < cacheTTL {
+		defer s.mu.RUnlock()
+		return s.voices, s.byID, nil
+	}
+	s.mu.RUnlock()
+
+	voices, err := s.repo.GetEnabled(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	byID := make(map[string]*generated.Voice, len(voices))
+	for _, v := range voices {
+		byID[v.ID] = v
+	}
+
+	s.mu.Lock()
+	s.voices, s.byID, s.loadedAt = voices, byID, time.Now()
+	s.mu.Unlock()
+	return voices, byID, nil
+}
+
+func (s *Service) invalidate() {
+	s.mu.Lock()
+	s.byID = nil
+	s.mu.Unlock()
+}
+
+func matchLanguage(tag, query string) bool {
+	return strings.EqualFold(tag, query) ||
+		(len(tag) > len(query) && strings.EqualFold(tag[:len(query)], query) && tag[len(query)] == '-')
+}
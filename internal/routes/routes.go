@@ -1,12 +1,34 @@
 package routes
 
 import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/kiminodare/HOVARLAY-BE/ent/generated"
+	"github.com/kiminodare/HOVARLAY-BE/internal/blob"
 	"github.com/kiminodare/HOVARLAY-BE/internal/middleware"
 	"github.com/kiminodare/HOVARLAY-BE/internal/modules/auth"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/folder"
 	"github.com/kiminodare/HOVARLAY-BE/internal/modules/history"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/idempotency"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/lexicon"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/moderation"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/normalize"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/preference"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/preset"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/quota"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/render"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/stats"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/tag"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/template"
 	"github.com/kiminodare/HOVARLAY-BE/internal/modules/user"
+	"github.com/kiminodare/HOVARLAY-BE/internal/modules/voice"
+	"github.com/kiminodare/HOVARLAY-BE/internal/segment"
+	"github.com/kiminodare/HOVARLAY-BE/internal/synth"
 	"github.com/kiminodare/HOVARLAY-BE/internal/utils"
 )
 
@@ -23,9 +45,133 @@ func SetupRoutes(app *fiber.App, jwtMiddleware *middleware.JWTMiddleware, client
 	api := app.Group("/api")
 	api.Use(jwtMiddleware.Auth)
 
+	idempotencyRepository := idempotency.NewIdempotencyRepository(client)
+	idempotencyService := idempotency.NewService(idempotencyRepository, durationFromEnv("IDEMPOTENCY_TTL", idempotency.DefaultTTL))
+	idempotencyMiddleware := idempotency.NewMiddleware(idempotencyService)
+	go idempotencyService.RunCleanup(context.Background(), time.Hour)
+
+	tagRepository := tag.NewTagRepository(client)
+	tagService := tag.NewService(tagRepository)
+	tagHandler := tag.NewHandler(tagService)
+
+	folderRepository := folder.NewFolderRepository(client)
+	folderService := folder.NewService(folderRepository)
+	folderHandler := folder.NewHandler(folderService)
+
+	voiceRepository := voice.NewVoiceRepository(client)
+	voiceService := voice.NewService(voiceRepository)
+	voiceHandler := voice.NewHandler(voiceService)
+
+	presetRepository := preset.NewPresetRepository(client)
+	presetService := preset.NewService(presetRepository, voiceService)
+	presetHandler := preset.NewHandler(presetService)
+
+	preferenceRepository := preference.NewPreferenceRepository(client)
+	preferenceService := preference.NewService(preferenceRepository, voiceService)
+	preferenceHandler := preference.NewHandler(preferenceService)
+
+	quotaRepository := quota.NewQuotaRepository(client)
+	quotaService := quota.NewService(quotaRepository)
+	quotaHandler := quota.NewHandler(quotaService)
+	quotaMiddleware := quota.NewMiddleware(quotaService)
+	go quotaService.RunMonthlyReset(context.Background(), time.Hour)
+
+	lexiconRepository := lexicon.NewLexiconRepository(client)
+	lexiconService := lexicon.NewService(lexiconRepository)
+	lexiconHandler := lexicon.NewHandler(lexiconService)
+
+	moderationRepository := moderation.NewModerationRepository(client)
+	moderationService := moderation.NewService(moderationRepository)
+	moderationHandler := moderation.NewHandler(moderationService)
+
 	historyRepository := history.NewHistoryRepository(client)
-	historyService := history.NewService(historyRepository)
+	historyService := history.NewService(historyRepository, tagService, folderService, voiceService, presetService, preferenceService, quotaService, lexiconService, moderationService)
 	historyHandler := history.NewHandler(historyService)
+	go historyService.RunTrashPurge(context.Background(), durationFromEnv("HISTORY_TRASH_RETENTION", 30*24*time.Hour), time.Hour)
+
+	synthRegistry := synth.NewRegistry()
+	synthRegistry.Register(synth.TestEngine, synth.NewTestSynthesizer())
+	if name := os.Getenv("SYNTH_FALLBACK_ENGINE"); name != "" {
+		engine, err := synth.Engine(name)
+		if err != nil {
+			log.Fatalf("❌ invalid SYNTH_FALLBACK_ENGINE: %v", err)
+		}
+		synthRegistry.SetFallback(engine)
+	}
+
+	audioStore, err := blobStoreFromEnv()
+	if err != nil {
+		log.Fatalf("❌ failed to open audio cache: %v", err)
+	}
+
+	renderRepository := render.NewRenderRepository(client)
+	renderService := render.NewService(renderRepository, historyService, voiceService, lexiconService, synthRegistry, audioStore, intFromEnv("SYNTH_SEGMENT_CHARS", segment.DefaultLimit))
+	renderHandler := render.NewHandler(renderService)
+
+	templateRepository := template.NewTemplateRepository(client)
+	templateService := template.NewService(templateRepository, presetService, historyService, moderationService)
+	templateHandler := template.NewHandler(templateService)
+
+	normalizeService := normalize.NewService(preferenceService, voiceService)
+	normalizeHandler := normalize.NewHandler(normalizeService)
+
+	statsRepository := stats.NewStatsRepository(client)
+	statsService := stats.NewService(statsRepository)
+	statsHandler := stats.NewHandler(statsService)
+
+	history.SetupHistoryRoutes(api, historyHandler, idempotencyMiddleware, quotaMiddleware)
+	tag.SetupTagRoutes(api, tagHandler)
+	folder.SetupFolderRoutes(api, folderHandler)
+	voice.SetupVoiceRoutes(api, voiceHandler)
+	preset.SetupPresetRoutes(api, presetHandler)
+	preference.SetupPreferenceRoutes(api, preferenceHandler)
+	stats.SetupStatsRoutes(api, statsHandler)
+	quota.SetupQuotaRoutes(api, quotaHandler)
+	render.SetupRenderRoutes(api, renderHandler)
+	normalize.SetupNormalizeRoutes(api, normalizeHandler)
+	lexicon.SetupLexiconRoutes(api, lexiconHandler)
+	template.SetupTemplateRoutes(api, templateHandler, idempotencyMiddleware, quotaMiddleware)
+	moderation.SetupModerationRoutes(api, moderationHandler)
+}
+
+// blobStoreFromEnv opens the audio cache: an S3-compatible bucket when
+// AUDIO_S3_BUCKET is set, otherwise a size-bounded local directory.
+func blobStoreFromEnv() (blob.Store, error) {
+	if bucket := os.Getenv("AUDIO_S3_BUCKET"); bucket != "" {
+		return blob.NewS3Store(blob.S3Config{
+			Endpoint:  os.Getenv("AUDIO_S3_ENDPOINT"),
+			Region:    os.Getenv("AUDIO_S3_REGION"),
+			Bucket:    bucket,
+			AccessKey: os.Getenv("AUDIO_S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("AUDIO_S3_SECRET_KEY"),
+			Prefix:    os.Getenv("AUDIO_S3_PREFIX"),
+			PathStyle: os.Getenv("AUDIO_S3_PATH_STYLE") == "true",
+		})
+	}
+
+	dir := os.Getenv("AUDIO_CACHE_DIR")
+	if dir == "" {
+		dir = "data/audio"
+	}
+	maxMB, err := strconv.ParseInt(os.Getenv("AUDIO_CACHE_MAX_MB"), 10, 64)
+	if err != nil || maxMB <= 0 {
+		maxMB = 1024
+	}
+	return blob.NewFileStore(dir, maxMB<<20)
+}
+
+func intFromEnv(key string, fallback int) int {
+	n, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || n <= 0 {
+		return fallback
+	}
+	return n
+}
 
-	history.SetupHistoryRoutes(api, historyHandler)
+func durationFromEnv(key string, fallback time.Duration) time.Duration {
+	d, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || d <= 0 {
+		return fallback
+	}
+	return d
 }
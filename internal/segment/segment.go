@@ -0,0 +1,245 @@
+// Package segment splits long text into utterances short enough for one
+// speech synthesis request, preferring paragraph, sentence and clause
+// boundaries, in that order.
+package segment
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/kiminodare/HOVARLAY-BE/internal/ssml"
+)
+
+// DefaultLimit is a request size every supported engine accepts.
+const DefaultLimit = 3000
+
+// Boundary strengths, from a break inside a word to the end of a paragraph.
+const (
+	breakNone = iota
+	breakWord
+	breakClause
+	breakSentence
+	breakParagraph
+)
+
+// Segment is one utterance. Text is plain text, or a complete <speak>
+// document when the source is SSML. Characters counts the spoken
+// characters, which is what the limit applies to.
+type Segment struct {
+	Index      int    `json:"index"`
+	Text       string `json:"text"`
+	Characters int    `json:"characters"`
+}
+
+// unit is the smallest piece a segment is built from: a word with its
+// trailing space, or an SSML element that cannot be split such as <sub>.
+// path holds the elements enclosing it below <speak>.
+type unit struct {
+	path     []*ssml.Node
+	node     *ssml.Node
+	size     int
+	strength int
+}
+
+// Split cuts text into segments of at most limit spoken characters. Only a
+// single word or unsplittable SSML element longer than limit yields a
+// longer segment. SSML segments repeat the elements they are nested in, so
+// each one is a valid document on its own.
+func Split(text string, isSSML bool, limit int) ([]Segment, error) {
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+
+	root := &ssml.Node{Name: "speak", Children: []*ssml.Node{{Text: text}}}
+	if isSSML {
+		var err error
+		if root, err = ssml.Parse(text); err != nil {
+			return nil, err
+		}
+	}
+
+	var units []unit
+	collect(root, nil, limit, &units)
+
+	var segments []Segment
+	for _, group := range pack(units, limit) {
+		out := build(root, group)
+		plain := out.PlainText()
+		if plain == "" {
+			continue
+		}
+		seg := Segment{Index: len(segments), Characters: utf8.RuneCountInString(plain)}
+		if isSSML {
+			seg.Text = out.String()
+		} else {
+			var b strings.Builder
+			for _, u := range group {
+				b.WriteString(u.node.Text)
+			}
+			seg.Text = strings.TrimSpace(b.String())
+		}
+		segments = append(segments, seg)
+	}
+	return segments, nil
+}
+
+// collect appends the units below n in document order.
+func collect(n *ssml.Node, path []*ssml.Node, limit int, units *[]unit) {
+	for _, c := range n.Children {
+		switch {
+		case c.IsText():
+			for _, tok := range tokenize(c.Text, limit) {
+				*units = append(*units, unit{path: path, node: &ssml.Node{Text: tok.text}, size: utf8.RuneCountInString(tok.text), strength: tok.strength})
+			}
+		case atomic(c):
+			u := unit{path: path, node: c, size: utf8.RuneCountInString(c.PlainText())}
+			if c.Name == "break" {
+				u.strength = breakClause
+			}
+			*units = append(*units, u)
+		default:
+			collect(c, append(path[:len(path):len(path)], c), limit, units)
+			if len(*units) == 0 {
+				continue
+			}
+			last := &(*units)[len(*units)-1]
+			switch c.Name {
+			case "p":
+				last.strength = max(last.strength, breakParagraph)
+			case "s":
+				last.strength = max(last.strength, breakSentence)
+			}
+		}
+	}
+}
+
+// atomic reports whether c must stay whole: its content is read as one
+// thing, or it has none.
+func atomic(c *ssml.Node) bool {
+	switch c.Name {
+	case "say-as", "sub", "phoneme", "break", "mark":
+		return true
+	}
+	return len(c.Children) == 0
+}
+
+type token struct {
+	text     string
+	strength int
+}
+
+// tokenize splits text into words with their trailing space. Space at the
+// start is a token of its own, ending the previous node at a word boundary.
+// Words longer than limit are cut into pieces of limit characters.
+func tokenize(text string, limit int) []token {
+	var tokens []token
+	trimmed := strings.TrimLeftFunc(text, unicode.IsSpace)
+	if trimmed != text {
+		tokens = append(tokens, token{text: text[:len(text)-len(trimmed)], strength: breakWord})
+	}
+	for rest := trimmed; rest != ""; {
+		end := strings.IndexFunc(rest, unicode.IsSpace)
+		if end < 0 {
+			end = len(rest)
+		}
+		word := rest[:end]
+		spaceEnd := end + len(rest[end:]) - len(strings.TrimLeftFunc(rest[end:], unicode.IsSpace))
+		space := rest[end:spaceEnd]
+		rest = rest[spaceEnd:]
+
+		for utf8.RuneCountInString(word) > limit {
+			cut := byteOffset(word, limit)
+			tokens = append(tokens, token{text: word[:cut], strength: breakNone})
+			word = word[cut:]
+		}
+		tokens = append(tokens, token{text: word + space, strength: strength(word, space)})
+	}
+	return tokens
+}
+
+// strength returns how good a place the end of word followed by space is
+// to start a new segment. A word not followed by space continues in the
+// next node, as in "Hel<emphasis>lo</emphasis>".
+func strength(word, space string) int {
+	if space == "" {
+		return breakNone
+	}
+	if strings.Count(space, "\n") >= 2 {
+		return breakParagraph
+	}
+	last, _ := utf8.DecodeLastRuneInString(strings.TrimRight(word, `"')]}»”’`))
+	switch {
+	case strings.ContainsRune(".!?…。", last), strings.Contains(space, "\n"):
+		return breakSentence
+	case strings.ContainsRune(",;:—–", last):
+		return breakClause
+	}
+	return breakWord
+}
+
+func byteOffset(s string, runes int) int {
+	for i := range s {
+		if runes == 0 {
+			return i
+		}
+		runes--
+	}
+	return len(s)
+}
+
+// pack groups units into segments of at most limit characters. Each
+// segment ends at the strongest boundary in its last two thirds, the
+// latest one on a tie, so segments are neither cut mid-sentence nor left
+// needlessly short.
+func pack(units []unit, limit int) [][]unit {
+	var groups [][]unit
+	for start := 0; start < len(units); {
+		end, total := start, 0
+		for end < len(units) && total+units[end].size <= limit {
+			total += units[end].size
+			end++
+		}
+		if end == start {
+			// Satu unit sudah melebihi limit, misalnya <say-as> yang panjang.
+			end++
+		} else if end < len(units) {
+			best, bestStrength, size := end, -1, 0
+			for i := start; i < end; i++ {
+				size += units[i].size
+				if size*3 >= limit && units[i].strength >= bestStrength {
+					best, bestStrength = i+1, units[i].strength
+				}
+			}
+			end = best
+		}
+		groups = append(groups, units[start:end])
+		start = end
+	}
+	return groups
+}
+
+// build returns a copy of root holding only units, re-opening the elements
+// each unit is nested in.
+func build(root *ssml.Node, units []unit) *ssml.Node {
+	out := &ssml.Node{Name: root.Name, Attrs: root.Attrs}
+	var open []*ssml.Node
+	parents := []*ssml.Node{out}
+	for _, u := range units {
+		k := 0
+		for k < len(open) && k < len(u.path) && open[k] == u.path[k] {
+			k++
+		}
+		open, parents = open[:k], parents[:k+1]
+		for _, p := range u.path[k:] {
+			c := &ssml.Node{Name: p.Name, Attrs: p.Attrs}
+			parent := parents[len(parents)-1]
+			parent.Children = append(parent.Children, c)
+			open = append(open, p)
+			parents = append(parents, c)
+		}
+		parent := parents[len(parents)-1]
+		parent.Children = append(parent.Children, u.node)
+	}
+	return out
+}
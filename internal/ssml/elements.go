@@ -0,0 +1,96 @@
+package ssml
+
+import (
+	"regexp"
+	"strings"
+)
+
+// elementSpec describes an element: its attributes with an optional value
+// check, the attributes it requires and whether it must be empty.
+type elementSpec struct {
+	attrs    map[string]func(string) bool
+	required []string
+	anyOf    []string
+	empty    bool
+}
+
+var (
+	timePattern   = regexp.MustCompile(`^\d+(\.\d+)?(ms|s)$`)
+	ratePattern   = regexp.MustCompile(`^(\+|-)?\d+(\.\d+)?%?$`)
+	pitchPattern  = regexp.MustCompile(`^(\+|-)?\d+(\.\d+)?(%|Hz|st)$`)
+	volumePattern = regexp.MustCompile(`^(\+|-)?\d+(\.\d+)?(dB|%)?$`)
+)
+
+// specs lists the supported elements. Anything else is rejected.
+var specs = map[string]elementSpec{
+	"speak": {attrs: map[string]func(string) bool{"version": nil, "xml:lang": notEmpty}},
+	"p":     {attrs: map[string]func(string) bool{"xml:lang": notEmpty}},
+	"s":     {attrs: map[string]func(string) bool{"xml:lang": notEmpty}},
+	"break": {
+		attrs: map[string]func(string) bool{
+			"time":     timePattern.MatchString,
+			"strength": oneOf("none", "x-weak", "weak", "medium", "strong", "x-strong"),
+		},
+		empty: true,
+	},
+	"prosody": {
+		attrs: map[string]func(string) bool{
+			"rate":   either(oneOf("x-slow", "slow", "medium", "fast", "x-fast", "default"), ratePattern.MatchString),
+			"pitch":  either(oneOf("x-low", "low", "medium", "high", "x-high", "default"), pitchPattern.MatchString),
+			"volume": either(oneOf("silent", "x-soft", "soft", "medium", "loud", "x-loud", "default"), volumePattern.MatchString),
+		},
+		anyOf: []string{"rate", "pitch", "volume"},
+	},
+	"emphasis": {attrs: map[string]func(string) bool{"level": oneOf("strong", "moderate", "none", "reduced")}},
+	"say-as": {
+		attrs:    map[string]func(string) bool{"interpret-as": notEmpty, "format": nil, "detail": nil},
+		required: []string{"interpret-as"},
+	},
+	"sub": {
+		attrs:    map[string]func(string) bool{"alias": notEmpty},
+		required: []string{"alias"},
+	},
+	"phoneme": {
+		attrs:    map[string]func(string) bool{"alphabet": oneOf("ipa", "x-sampa"), "ph": notEmpty},
+		required: []string{"ph"},
+	},
+	"voice": {
+		attrs: map[string]func(string) bool{"name": notEmpty, "gender": oneOf("male", "female", "neutral"), "xml:lang": notEmpty},
+		anyOf: []string{"name", "gender", "xml:lang"},
+	},
+	"lang": {
+		attrs:    map[string]func(string) bool{"xml:lang": notEmpty},
+		required: []string{"xml:lang"},
+	},
+	"mark": {
+		attrs:    map[string]func(string) bool{"name": notEmpty},
+		required: []string{"name"},
+		empty:    true,
+	},
+}
+
+func notEmpty(v string) bool {
+	return strings.TrimSpace(v) != ""
+}
+
+func oneOf(values ...string) func(string) bool {
+	return func(v string) bool {
+		for _, allowed := range values {
+			if v == allowed {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func either(checks ...func(string) bool) func(string) bool {
+	return func(v string) bool {
+		for _, check := range checks {
+			if check(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
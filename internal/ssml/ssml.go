@@ -0,0 +1,310 @@
+// Package ssml parses and validates the subset of SSML supported for history
+// text and extracts the plain text that is actually spoken.
+package ssml
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	// Namespace is the SSML namespace declared on <speak>.
+	Namespace = "http://www.w3.org/2001/10/synthesis"
+
+	xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+	// maxDepth membatasi kedalaman nesting elemen.
+	maxDepth = 32
+)
+
+// Error is a validation error at a position in the source, both 1-based.
+type Error struct {
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+// Node is an element or, when Name is empty, a run of text.
+type Node struct {
+	Name     string
+	Attrs    map[string]string
+	Text     string
+	Children []*Node
+	Line     int
+	Column   int
+}
+
+// IsText reports whether n is a text node.
+func (n *Node) IsText() bool {
+	return n.Name == ""
+}
+
+// Parse parses src and validates it against the supported elements. The
+// returned node is the root <speak> element.
+func Parse(src string) (*Node, error) {
+	d := xml.NewDecoder(strings.NewReader(src))
+	d.Strict = true
+
+	var root *Node
+	var stack []*Node
+	for {
+		line, col := d.InputPos()
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, syntaxError(d, err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if root != nil && len(stack) == 0 {
+				return nil, errorAt(line, col, "document must have a single <speak> element")
+			}
+			n, err := element(t, line, col)
+			if err != nil {
+				return nil, err
+			}
+			if len(stack) == 0 {
+				if n.Name != "speak" {
+					return nil, errorAt(line, col, fmt.Sprintf("root element must be <speak>, got <%s>", n.Name))
+				}
+				root = n
+			} else {
+				parent := stack[len(stack)-1]
+				if specs[parent.Name].empty {
+					return nil, errorAt(line, col, fmt.Sprintf("<%s> must be empty", parent.Name))
+				}
+				if n.Name == "speak" {
+					return nil, errorAt(line, col, "<speak> cannot be nested")
+				}
+				parent.Children = append(parent.Children, n)
+			}
+			if len(stack) == maxDepth {
+				return nil, errorAt(line, col, fmt.Sprintf("elements are nested deeper than %d levels", maxDepth))
+			}
+			stack = append(stack, n)
+
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+
+		case xml.CharData:
+			text := string(t)
+			if len(stack) == 0 {
+				if strings.TrimSpace(text) != "" {
+					return nil, errorAt(line, col, "text must be inside the <speak> element")
+				}
+				continue
+			}
+			parent := stack[len(stack)-1]
+			if specs[parent.Name].empty && strings.TrimSpace(text) != "" {
+				return nil, errorAt(line, col, fmt.Sprintf("<%s> must be empty", parent.Name))
+			}
+			parent.Children = append(parent.Children, &Node{Text: text, Line: line, Column: col})
+
+		case xml.Directive:
+			return nil, errorAt(line, col, "DOCTYPE and other directives are not allowed")
+
+		case xml.ProcInst:
+			if t.Target != "xml" || root != nil {
+				return nil, errorAt(line, col, fmt.Sprintf("processing instruction <?%s?> is not allowed", t.Target))
+			}
+		}
+	}
+
+	if root == nil {
+		line, col := d.InputPos()
+		return nil, errorAt(line, col, "document must have a <speak> element")
+	}
+	return root, nil
+}
+
+// Validate reports the first problem in src, or nil when it is valid SSML.
+func Validate(src string) error {
+	_, err := Parse(src)
+	return err
+}
+
+// PlainText parses src and returns the text it speaks.
+func PlainText(src string) (string, error) {
+	root, err := Parse(src)
+	if err != nil {
+		return "", err
+	}
+	return root.PlainText(), nil
+}
+
+// PlainText returns the text content of n with markup removed and whitespace
+// collapsed. Paragraphs, sentences and breaks separate the words around them.
+func (n *Node) PlainText() string {
+	var b strings.Builder
+	n.writeText(&b)
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+func (n *Node) writeText(b *strings.Builder) {
+	if n.IsText() {
+		b.WriteString(n.Text)
+		return
+	}
+	separate := n.Name == "p" || n.Name == "s" || n.Name == "break"
+	if separate {
+		b.WriteByte(' ')
+	}
+	for _, c := range n.Children {
+		c.writeText(b)
+	}
+	if separate {
+		b.WriteByte(' ')
+	}
+}
+
+// String returns n serialized as markup.
+func (n *Node) String() string {
+	var b strings.Builder
+	n.writeXML(&b)
+	return b.String()
+}
+
+// InnerXML returns the serialized children of n, for example to embed the
+// content of a <speak> document in another one.
+func (n *Node) InnerXML() string {
+	var b strings.Builder
+	for _, c := range n.Children {
+		c.writeXML(&b)
+	}
+	return b.String()
+}
+
+func (n *Node) writeXML(b *strings.Builder) {
+	if n.IsText() {
+		b.WriteString(textEscaper.Replace(n.Text))
+		return
+	}
+
+	b.WriteString("<" + n.Name)
+	names := make([]string, 0, len(n.Attrs))
+	for name := range n.Attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		b.WriteString(" " + name + `="` + attrEscaper.Replace(n.Attrs[name]) + `"`)
+	}
+	if len(n.Children) == 0 {
+		b.WriteString("/>")
+		return
+	}
+	b.WriteByte('>')
+	for _, c := range n.Children {
+		c.writeXML(b)
+	}
+	b.WriteString("</" + n.Name + ">")
+}
+
+var (
+	textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	attrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+)
+
+// Escape escapes plain text so it can be placed inside SSML markup.
+func Escape(text string) string {
+	return textEscaper.Replace(text)
+}
+
+func element(t xml.StartElement, line, col int) (*Node, error) {
+	name := t.Name.Local
+	spec, ok := specs[name]
+	if !ok || (t.Name.Space != "" && t.Name.Space != Namespace) {
+		return nil, errorAt(line, col, fmt.Sprintf("unsupported element <%s>", qualified(t.Name)))
+	}
+
+	n := &Node{Name: name, Attrs: make(map[string]string, len(t.Attr)), Line: line, Column: col}
+	for _, a := range t.Attr {
+		attr := a.Name.Local
+		switch {
+		case a.Name.Space == "xmlns" || (a.Name.Space == "" && attr == "xmlns"):
+			if name != "speak" {
+				return nil, errorAt(line, col, fmt.Sprintf("namespace declarations are only allowed on <speak>, found on <%s>", name))
+			}
+			if attr == "xmlns" {
+				n.Attrs["xmlns"] = a.Value
+			}
+			continue
+		case a.Name.Space == xmlNamespace:
+			attr = "xml:" + attr
+		case a.Name.Space != "":
+			return nil, errorAt(line, col, fmt.Sprintf("unsupported attribute %q on <%s>", qualified(a.Name), name))
+		}
+
+		check, ok := spec.attrs[attr]
+		if !ok {
+			return nil, errorAt(line, col, fmt.Sprintf("unsupported attribute %q on <%s>", attr, name))
+		}
+		if check != nil && !check(a.Value) {
+			return nil, errorAt(line, col, fmt.Sprintf("invalid value %q for attribute %q on <%s>", a.Value, attr, name))
+		}
+		n.Attrs[attr] = a.Value
+	}
+
+	for _, attr := range spec.required {
+		if _, ok := n.Attrs[attr]; !ok {
+			return nil, errorAt(line, col, fmt.Sprintf("<%s> requires the %q attribute", name, attr))
+		}
+	}
+	if spec.anyOf != nil && !hasAny(n.Attrs, spec.anyOf) {
+		return nil, errorAt(line, col, fmt.Sprintf("<%s> requires one of the attributes %s", name, strings.Join(spec.anyOf, ", ")))
+	}
+	return n, nil
+}
+
+func hasAny(attrs map[string]string, names []string) bool {
+	for _, name := range names {
+		if _, ok := attrs[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func qualified(name xml.Name) string {
+	if name.Space == "" || name.Space == Namespace {
+		return name.Local
+	}
+	if name.Space == xmlNamespace {
+		return "xml:" + name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+func errorAt(line, col int, msg string) *Error {
+	return &Error{Line: line, Column: col, Message: msg}
+}
+
+// syntaxError converts a decoder error to an Error at the decoder position.
+func syntaxError(d *xml.Decoder, err error) *Error {
+	line, col := d.InputPos()
+	msg := err.Error()
+	var se *xml.SyntaxError
+	if errors.As(err, &se) {
+		msg = se.Msg
+	}
+	return errorAt(line, col, lowerFirst(msg))
+}
+
+func lowerFirst(s string) string {
+	for i, r := range s {
+		return string(unicode.ToLower(r)) + s[i+len(string(r)):]
+	}
+	return s
+}
@@ -0,0 +1,190 @@
+package synth
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// ErrConcatUnsupported is returned when segments are rendered in a format
+// that cannot be joined, or in formats that differ from each other.
+var ErrConcatUnsupported = errors.New("audio segments cannot be joined")
+
+// Concat renders reqs one after the other with s and joins the results into
+// one stream, with word timings shifted to the joined audio. WAV and MP3 are
+// supported. The audio is spooled to a temporary file, removed on Close, so
+// the total size is known before the stream starts.
+func Concat(ctx context.Context, s Synthesizer, reqs []*Request) (*Audio, error) {
+	if len(reqs) == 1 {
+		return s.Synthesize(ctx, reqs[0])
+	}
+
+	spool, err := os.CreateTemp("", "synth-*")
+	if err != nil {
+		return nil, err
+	}
+	out := &spooled{file: spool, r: spool}
+	fail := func(err error) (*Audio, error) {
+		out.Close()
+		return nil, err
+	}
+
+	var meta Metadata
+	var format WAVFormat
+	for i, req := range reqs {
+		audio, err := s.Synthesize(ctx, req)
+		if err != nil {
+			return fail(fmt.Errorf("segment %d: %w", i, err))
+		}
+		if i == 0 {
+			meta = audio.Metadata
+			meta.Words = nil
+			meta.Duration = 0
+		} else if audio.ContentType != meta.ContentType {
+			audio.Close()
+			return fail(fmt.Errorf("%w: segment %d is %s, not %s", ErrConcatUnsupported, i, audio.ContentType, meta.ContentType))
+		}
+
+		var duration time.Duration
+		switch audio.ContentType {
+		case "audio/wav", "audio/wave", "audio/x-wav":
+			var f WAVFormat
+			var size int64
+			f, size, err = copyWAVData(spool, audio)
+			if err == nil && i > 0 && f != format {
+				err = fmt.Errorf("%w: segment %d has a different WAV format", ErrConcatUnsupported, i)
+			}
+			format = f
+			duration = f.Duration(size)
+		case "audio/mpeg", "audio/mp3":
+			err = copyMP3Frames(spool, audio, i > 0)
+			duration = audio.Duration
+		default:
+			err = fmt.Errorf("%w: %s", ErrConcatUnsupported, audio.ContentType)
+		}
+		// Words diisi engine setelah stream habis dibaca.
+		for _, w := range audio.Words {
+			w.Start += meta.Duration
+			w.End += meta.Duration
+			meta.Words = append(meta.Words, w)
+		}
+		meta.Duration += duration
+		audio.Close()
+		if err != nil {
+			return fail(err)
+		}
+	}
+
+	dataSize, err := spool.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fail(err)
+	}
+	if _, err := spool.Seek(0, io.SeekStart); err != nil {
+		return fail(err)
+	}
+
+	meta.Size = dataSize
+	if format.SampleRate > 0 {
+		if dataSize > 1<<32-1-36 {
+			return fail(fmt.Errorf("%w: joined WAV exceeds 4 GiB", ErrConcatUnsupported))
+		}
+		var header bytes.Buffer
+		if err := WriteWAVHeader(&header, format, uint32(dataSize)); err != nil {
+			return fail(err)
+		}
+		meta.Size += WAVHeaderSize
+		out.r = io.MultiReader(&header, spool)
+	}
+	return &Audio{ReadCloser: out, Metadata: meta}, nil
+}
+
+// spooled reads joined audio from a temporary file and removes it on Close.
+type spooled struct {
+	r    io.Reader
+	file *os.File
+}
+
+func (s *spooled) Read(p []byte) (int, error) {
+	return s.r.Read(p)
+}
+
+func (s *spooled) Close() error {
+	err := s.file.Close()
+	if rmErr := os.Remove(s.file.Name()); err == nil {
+		err = rmErr
+	}
+	return err
+}
+
+// copyWAVData copies the samples of a WAV stream to w, skipping the header
+// and any chunk other than "data".
+func copyWAVData(w io.Writer, r io.Reader) (WAVFormat, int64, error) {
+	var f WAVFormat
+	br := bufio.NewReader(r)
+	riff := make([]byte, 12)
+	if _, err := io.ReadFull(br, riff); err != nil {
+		return f, 0, err
+	}
+	if string(riff[0:4]) != "RIFF" || string(riff[8:12]) != "WAVE" {
+		return f, 0, fmt.Errorf("%w: not a WAV stream", ErrConcatUnsupported)
+	}
+
+	chunk := make([]byte, 8)
+	for {
+		if _, err := io.ReadFull(br, chunk); err != nil {
+			return f, 0, err
+		}
+		id, size := string(chunk[0:4]), binary.LittleEndian.Uint32(chunk[4:8])
+		switch id {
+		case "fmt ":
+			body := make([]byte, size+size%2)
+			if _, err := io.ReadFull(br, body); err != nil {
+				return f, 0, err
+			}
+			if len(body) < 16 || binary.LittleEndian.Uint16(body[0:2]) != 1 {
+				return f, 0, fmt.Errorf("%w: WAV is not PCM", ErrConcatUnsupported)
+			}
+			f.Channels = int(binary.LittleEndian.Uint16(body[2:4]))
+			f.SampleRate = int(binary.LittleEndian.Uint32(body[4:8]))
+			f.BitsPerSample = int(binary.LittleEndian.Uint16(body[14:16]))
+		case "data":
+			if f.SampleRate == 0 {
+				return f, 0, fmt.Errorf("%w: WAV data before format", ErrConcatUnsupported)
+			}
+			var src io.Reader = br
+			// Engine yang streaming menulis ukuran 0 atau maksimum; baca sampai habis.
+			if size != 0 && size != 1<<32-1 {
+				src = io.LimitReader(br, int64(size))
+			}
+			n, err := io.Copy(w, src)
+			return f, n, err
+		default:
+			if _, err := io.CopyN(io.Discard, br, int64(size+size%2)); err != nil {
+				return f, 0, err
+			}
+		}
+	}
+}
+
+// copyMP3Frames copies an MP3 stream to w. MP3 frames stand on their own,
+// so streams join by appending; only the ID3v2 tag of later segments is
+// dropped.
+func copyMP3Frames(w io.Writer, r io.Reader, skipTag bool) error {
+	br := bufio.NewReader(r)
+	if skipTag {
+		if h, err := br.Peek(10); err == nil && string(h[0:3]) == "ID3" {
+			size := int64(h[6]&0x7f)<<21 | int64(h[7]&0x7f)<<14 | int64(h[8]&0x7f)<<7 | int64(h[9]&0x7f)
+			if _, err := io.CopyN(io.Discard, br, 10+size); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := io.Copy(w, br)
+	return err
+}
@@ -0,0 +1,107 @@
+// Package synth defines the speech synthesis engines that render history text
+// to audio and a registry that picks one by voice provider.
+package synth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNoSynthesizer is returned when no engine is registered for a provider.
+var ErrNoSynthesizer = errors.New("no synthesizer for provider")
+
+// Request is the text to speak and how to speak it.
+type Request struct {
+	Text string
+	// SSML menandakan Text adalah dokumen SSML, bukan teks biasa.
+	SSML     bool
+	Voice    string
+	Language string
+	Rate     float64
+	Pitch    float64
+	Volume   float64
+}
+
+// WordTiming is when a word of the spoken text is heard in the audio.
+type WordTiming struct {
+	Word  string        `json:"word"`
+	Start time.Duration `json:"start"`
+	End   time.Duration `json:"end"`
+}
+
+// Metadata describes rendered audio. Size is -1 when it is not known before
+// the stream is read. Words is nil for engines that do not report word
+// timings; engines that learn them while streaming fill it in by the time
+// the stream has been read to the end.
+type Metadata struct {
+	ContentType string
+	SampleRate  int
+	Channels    int
+	Duration    time.Duration
+	Size        int64
+	Words       []WordTiming
+}
+
+// Audio is a rendered audio stream. The caller must close it.
+type Audio struct {
+	io.ReadCloser
+	Metadata
+}
+
+// Synthesizer renders speech. Implementations must be safe for concurrent use.
+type Synthesizer interface {
+	Synthesize(ctx context.Context, req *Request) (*Audio, error)
+}
+
+// Registry maps voice providers to the engine that renders their voices.
+type Registry struct {
+	mu       sync.RWMutex
+	engines  map[string]Synthesizer
+	fallback Synthesizer
+}
+
+func NewRegistry() *Registry {
+	return &Registry{engines: make(map[string]Synthesizer)}
+}
+
+// Register sets the engine of provider, replacing any previous one.
+func (r *Registry) Register(provider string, s Synthesizer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.engines[strings.ToLower(provider)] = s
+}
+
+// SetFallback sets the engine used for providers without one of their own,
+// for example the test synthesizer during development.
+func (r *Registry) SetFallback(s Synthesizer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fallback = s
+}
+
+// Get returns the engine of provider, or ErrNoSynthesizer.
+func (r *Registry) Get(provider string) (Synthesizer, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if s, ok := r.engines[strings.ToLower(provider)]; ok {
+		return s, nil
+	}
+	if r.fallback != nil {
+		return r.fallback, nil
+	}
+	return nil, fmt.Errorf("%w %s", ErrNoSynthesizer, provider)
+}
+
+// Engine returns a built-in engine by name, for configuration.
+func Engine(name string) (Synthesizer, error) {
+	switch strings.ToLower(name) {
+	case TestEngine:
+		return NewTestSynthesizer(), nil
+	}
+	return nil, fmt.Errorf("unknown synthesizer %q", name)
+}
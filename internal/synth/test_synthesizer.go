@@ -0,0 +1,134 @@
+package synth
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"math"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/kiminodare/HOVARLAY-BE/internal/ssml"
+)
+
+// TestEngine is the name of the built-in test synthesizer.
+const TestEngine = "test"
+
+const (
+	testSampleRate = 16000
+	// Durasi dasar per karakter dan jeda tanda baca pada rate 1.
+	testCharSeconds  = 0.08
+	testPauseSeconds = 0.2
+	testFadeSamples  = 32
+)
+
+var testFormat = WAVFormat{SampleRate: testSampleRate, Channels: 1, BitsPerSample: 16}
+
+// TestSynthesizer renders every character as a short tone, so the output is
+// valid WAV audio whose length follows the text and the rate. The same
+// request always produces the same bytes. It reports exact word timings.
+type TestSynthesizer struct{}
+
+func NewTestSynthesizer() *TestSynthesizer {
+	return &TestSynthesizer{}
+}
+
+// tone is a run of samples at freq Hz, or silence when freq is 0.
+type tone struct {
+	freq    float64
+	samples int
+}
+
+func (t *TestSynthesizer) Synthesize(_ context.Context, req *Request) (*Audio, error) {
+	text := req.Text
+	if req.SSML {
+		var err error
+		if text, err = ssml.PlainText(req.Text); err != nil {
+			return nil, err
+		}
+	}
+
+	rate := req.Rate
+	if rate <= 0 {
+		rate = 1
+	}
+	pitch := req.Pitch
+	if pitch <= 0 {
+		pitch = 1
+	}
+	amplitude := math.Min(math.Max(req.Volume, 0), 1) * 0.5 * math.MaxInt16
+
+	charSamples := int(testCharSeconds * testSampleRate / rate)
+	pauseSamples := int(testPauseSeconds * testSampleRate / rate)
+	var tones []tone
+	var words []WordTiming
+	total := 0
+	wordStart := -1
+	endWord := func(end int) {
+		if wordStart >= 0 {
+			words = append(words, WordTiming{Start: samplesDuration(wordStart), End: samplesDuration(end)})
+			wordStart = -1
+		}
+	}
+	for _, r := range text {
+		t := tone{samples: charSamples}
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			t.freq = (220 + float64(unicode.ToLower(r)%24)*15) * pitch
+		case unicode.IsPunct(r):
+			t.samples = pauseSamples
+		}
+		if unicode.IsSpace(r) {
+			endWord(total)
+		} else if wordStart < 0 {
+			wordStart = total
+		}
+		tones = append(tones, t)
+		total += t.samples
+	}
+	endWord(total)
+	for i, w := range strings.Fields(text) {
+		words[i].Word = w
+	}
+
+	dataSize := int64(total * testFormat.BlockAlign())
+	pr, pw := io.Pipe()
+	go func() {
+		w := bufio.NewWriter(pw)
+		err := WriteWAVHeader(w, testFormat, uint32(dataSize))
+		sample := make([]byte, 2)
+		for _, t := range tones {
+			for i := 0; i < t.samples && err == nil; i++ {
+				var v float64
+				if t.freq > 0 {
+					fade := math.Min(1, float64(min(i, t.samples-1-i))/testFadeSamples)
+					v = amplitude * fade * math.Sin(2*math.Pi*t.freq*float64(i)/testSampleRate)
+				}
+				binary.LittleEndian.PutUint16(sample, uint16(int16(v)))
+				_, err = w.Write(sample)
+			}
+		}
+		if err == nil {
+			err = w.Flush()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	return &Audio{
+		ReadCloser: pr,
+		Metadata: Metadata{
+			ContentType: "audio/wav",
+			SampleRate:  testFormat.SampleRate,
+			Channels:    testFormat.Channels,
+			Duration:    testFormat.Duration(dataSize),
+			Size:        WAVHeaderSize + dataSize,
+			Words:       words,
+		},
+	}, nil
+}
+
+func samplesDuration(n int) time.Duration {
+	return time.Duration(n) * time.Second / testSampleRate
+}
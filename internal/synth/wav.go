@@ -0,0 +1,49 @@
+package synth
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// WAVHeaderSize is the size of the header written by WriteWAVHeader.
+const WAVHeaderSize = 44
+
+// WAVFormat describes uncompressed PCM audio.
+type WAVFormat struct {
+	SampleRate    int
+	Channels      int
+	BitsPerSample int
+}
+
+// BlockAlign returns the size in bytes of one frame, a sample per channel.
+func (f WAVFormat) BlockAlign() int {
+	return f.Channels * f.BitsPerSample / 8
+}
+
+// Duration returns the play time of dataSize bytes of audio.
+func (f WAVFormat) Duration(dataSize int64) time.Duration {
+	frames := dataSize / int64(f.BlockAlign())
+	return time.Duration(frames) * time.Second / time.Duration(f.SampleRate)
+}
+
+// WriteWAVHeader writes a canonical RIFF header for dataSize bytes of PCM
+// audio in format f.
+func WriteWAVHeader(w io.Writer, f WAVFormat, dataSize uint32) error {
+	h := make([]byte, WAVHeaderSize)
+	copy(h[0:], "RIFF")
+	binary.LittleEndian.PutUint32(h[4:], 36+dataSize)
+	copy(h[8:], "WAVE")
+	copy(h[12:], "fmt ")
+	binary.LittleEndian.PutUint32(h[16:], 16)
+	binary.LittleEndian.PutUint16(h[20:], 1) // PCM
+	binary.LittleEndian.PutUint16(h[22:], uint16(f.Channels))
+	binary.LittleEndian.PutUint32(h[24:], uint32(f.SampleRate))
+	binary.LittleEndian.PutUint32(h[28:], uint32(f.SampleRate*f.BlockAlign()))
+	binary.LittleEndian.PutUint16(h[32:], uint16(f.BlockAlign()))
+	binary.LittleEndian.PutUint16(h[34:], uint16(f.BitsPerSample))
+	copy(h[36:], "data")
+	binary.LittleEndian.PutUint32(h[40:], dataSize)
+	_, err := w.Write(h)
+	return err
+}
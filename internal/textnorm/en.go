@@ -0,0 +1,147 @@
+package textnorm
+
+var enMonths = []string{"January", "February", "March", "April", "May", "June",
+	"July", "August", "September", "October", "November", "December"}
+
+// English reads numbers written with comma group separators and a decimal
+// point, and dates month first.
+var English = &Locale{
+	Cardinal: enCardinal,
+	Ordinal:  enOrdinal,
+	Ones:     enOnes[:10],
+	Group:    ",",
+	Decimal:  ".",
+	Point:    "point",
+	Percent:  "percent",
+	Date: func(year, month, day int) string {
+		return enMonths[month-1] + " " + enOrdinal(int64(day)) + ", " + enYear(year)
+	},
+	Time: func(hour, minute, second int) string {
+		var t string
+		switch {
+		case minute == 0 && hour <= 12:
+			t = enCardinal(int64(hour)) + " o'clock"
+		case minute == 0:
+			t = enCardinal(int64(hour)) + " hundred"
+		case minute < 10:
+			t = enCardinal(int64(hour)) + " oh " + enOnes[minute]
+		default:
+			t = enCardinal(int64(hour)) + " " + enCardinal(int64(minute))
+		}
+		switch {
+		case second == 1:
+			t += " and one second"
+		case second > 1:
+			t += " and " + enCardinal(int64(second)) + " seconds"
+		}
+		return t
+	},
+	Fraction: func(num, den int64) string {
+		var unit string
+		switch {
+		case den == 2:
+			unit = "half"
+			if num != 1 {
+				unit = "halves"
+			}
+		case den == 4:
+			unit = "quarter"
+		case den <= 20:
+			unit = enOrdinal(den)
+		default:
+			return enCardinal(num) + " over " + enCardinal(den)
+		}
+		if num != 1 && den != 2 {
+			unit += "s"
+		}
+		return enCardinal(num) + " " + unit
+	},
+	Currencies: map[string]Currency{
+		"$":   {One: "dollar", Many: "dollars", MinorOne: "cent", MinorMany: "cents"},
+		"US$": {One: "dollar", Many: "dollars", MinorOne: "cent", MinorMany: "cents"},
+		"USD": {One: "dollar", Many: "dollars", MinorOne: "cent", MinorMany: "cents"},
+		"€":   {One: "euro", Many: "euros", MinorOne: "cent", MinorMany: "cents"},
+		"EUR": {One: "euro", Many: "euros", MinorOne: "cent", MinorMany: "cents"},
+		"£":   {One: "pound", Many: "pounds", MinorOne: "penny", MinorMany: "pence"},
+		"GBP": {One: "pound", Many: "pounds", MinorOne: "penny", MinorMany: "pence"},
+		"Rp":  {One: "rupiah", Many: "rupiah"},
+		"IDR": {One: "rupiah", Many: "rupiah"},
+	},
+	Scales: map[string]string{
+		"k": "thousand", "thousand": "thousand",
+		"m": "million", "M": "million", "million": "million",
+		"bn": "billion", "billion": "billion",
+	},
+	Minor: " and ",
+	Dot:   "dot",
+	Dash:  "dash",
+	At:    "at",
+	Abbreviations: map[string]string{
+		"Mr.":     "Mister",
+		"Mrs.":    "Missus",
+		"Ms.":     "Miz",
+		"Dr.":     "Doctor",
+		"Prof.":   "Professor",
+		"St.":     "Street",
+		"Ave.":    "Avenue",
+		"etc.":    "et cetera",
+		"e.g.":    "for example",
+		"i.e.":    "that is",
+		"vs.":     "versus",
+		"approx.": "approximately",
+		"km/h":    "kilometers per hour",
+		"mph":     "miles per hour",
+		"km":      "kilometers",
+		"kg":      "kilograms",
+		"cm":      "centimeters",
+		"mm":      "millimeters",
+		"lbs":     "pounds",
+	},
+	Emoji: map[string]string{
+		"😀": "grinning face",
+		"😊": "smiling face",
+		"😂": "tears of joy",
+		"🤣": "rolling on the floor laughing",
+		"😍": "heart eyes",
+		"😢": "crying face",
+		"😭": "sobbing",
+		"😡": "angry face",
+		"😮": "surprised face",
+		"🤔": "thinking face",
+		"😉": "winking face",
+		"👍": "thumbs up",
+		"👎": "thumbs down",
+		"👏": "clapping",
+		"🙏": "thank you",
+		"💪": "flexed biceps",
+		"❤": "heart",
+		"💔": "broken heart",
+		"🔥": "fire",
+		"🎉": "party",
+		"✅": "check mark",
+		"❌": "cross mark",
+		"⭐": "star",
+	},
+}
+
+// enYear reads a year the way it is spoken, e.g. 1945 as "nineteen
+// forty-five" and 2005 as "two thousand five".
+func enYear(year int) string {
+	y := int64(year)
+	switch {
+	case y < 1000 || y >= 10000 || (y >= 2000 && y < 2010):
+		return enCardinal(y)
+	case y%100 == 0:
+		if y%1000 == 0 {
+			return enCardinal(y)
+		}
+		return enCardinal(y/100) + " hundred"
+	case y%100 < 10:
+		return enCardinal(y/100) + " oh " + enCardinal(y%100)
+	}
+	return enCardinal(y/100) + " " + enCardinal(y%100)
+}
+
+func init() {
+	Register("en", NewNormalizers(English)...)
+}
@@ -0,0 +1,120 @@
+package textnorm
+
+var idMonths = []string{"Januari", "Februari", "Maret", "April", "Mei", "Juni",
+	"Juli", "Agustus", "September", "Oktober", "November", "Desember"}
+
+// Indonesian membaca angka dengan titik sebagai pemisah ribuan dan koma
+// sebagai pemisah desimal, seperti penulisan baku.
+var Indonesian = &Locale{
+	Cardinal: idCardinal,
+	Ones:     idOnes[:10],
+	Group:    ".",
+	Decimal:  ",",
+	Point:    "koma",
+	Percent:  "persen",
+	Date: func(year, month, day int) string {
+		return idCardinal(int64(day)) + " " + idMonths[month-1] + " " + idCardinal(int64(year))
+	},
+	DayFirst: true,
+	Time: func(hour, minute, second int) string {
+		t := idCardinal(int64(hour))
+		switch {
+		case second > 0:
+			t += " lewat " + idCardinal(int64(minute)) + " menit " + idCardinal(int64(second)) + " detik"
+		case minute > 0:
+			t += " lewat " + idCardinal(int64(minute))
+		}
+		return t
+	},
+	Fraction: func(num, den int64) string {
+		switch {
+		case num == 1 && den == 2:
+			return "setengah"
+		case num == 1 && den <= 10:
+			return "seper" + idCardinal(den)
+		}
+		return idCardinal(num) + " per " + idCardinal(den)
+	},
+	Currencies: map[string]Currency{
+		"Rp":  {One: "rupiah", Many: "rupiah"},
+		"Rp.": {One: "rupiah", Many: "rupiah"},
+		"IDR": {One: "rupiah", Many: "rupiah"},
+		"$":   {One: "dolar", Many: "dolar", MinorOne: "sen", MinorMany: "sen"},
+		"US$": {One: "dolar", Many: "dolar", MinorOne: "sen", MinorMany: "sen"},
+		"USD": {One: "dolar", Many: "dolar", MinorOne: "sen", MinorMany: "sen"},
+		"€":   {One: "euro", Many: "euro", MinorOne: "sen", MinorMany: "sen"},
+		"EUR": {One: "euro", Many: "euro", MinorOne: "sen", MinorMany: "sen"},
+	},
+	Scales: map[string]string{
+		"rb": "ribu", "ribu": "ribu",
+		"jt": "juta", "juta": "juta",
+		"M": "miliar", "miliar": "miliar",
+		"T": "triliun", "triliun": "triliun",
+	},
+	Minor: " ",
+	Dot:   "titik",
+	Dash:  "strip",
+	At:    "et",
+	Abbreviations: map[string]string{
+		"dll.":   "dan lain-lain",
+		"dsb.":   "dan sebagainya",
+		"dst.":   "dan seterusnya",
+		"yg":     "yang",
+		"dgn":    "dengan",
+		"tdk":    "tidak",
+		"utk":    "untuk",
+		"krn":    "karena",
+		"sdh":    "sudah",
+		"blm":    "belum",
+		"bbrp":   "beberapa",
+		"Jl.":    "Jalan",
+		"No.":    "nomor",
+		"Bpk.":   "Bapak",
+		"Sdr.":   "Saudara",
+		"Dr.":    "Doktor",
+		"dr.":    "dokter",
+		"Prof.":  "Profesor",
+		"PT":     "pe te",
+		"RT":     "er te",
+		"RW":     "er we",
+		"WIB":    "waktu Indonesia barat",
+		"WITA":   "waktu Indonesia tengah",
+		"WIT":    "waktu Indonesia timur",
+		"km/jam": "kilometer per jam",
+		"km":     "kilometer",
+		"kg":     "kilogram",
+		"cm":     "sentimeter",
+		"mm":     "milimeter",
+		"ml":     "mililiter",
+	},
+	Emoji: map[string]string{
+		"😀":  "senyum lebar",
+		"😊":  "senyum",
+		"😂":  "tertawa",
+		"🤣":  "tertawa terbahak-bahak",
+		"😍":  "jatuh cinta",
+		"😢":  "sedih",
+		"😭":  "menangis",
+		"😡":  "marah",
+		"😮":  "kaget",
+		"🤔":  "berpikir",
+		"😉":  "mengedipkan mata",
+		"👍":  "jempol",
+		"👎":  "jempol ke bawah",
+		"👏":  "tepuk tangan",
+		"🙏":  "terima kasih",
+		"💪":  "semangat",
+		"❤":  "hati",
+		"💔":  "patah hati",
+		"🔥":  "api",
+		"🎉":  "perayaan",
+		"✅":  "centang",
+		"❌":  "silang",
+		"⭐":  "bintang",
+		"🇮🇩": "bendera Indonesia",
+	},
+}
+
+func init() {
+	Register("id", NewNormalizers(Indonesian)...)
+}
@@ -0,0 +1,121 @@
+package textnorm
+
+import (
+	"strconv"
+	"strings"
+)
+
+// maxCardinal is the largest number read as a whole; longer numbers are
+// read digit by digit.
+const maxCardinal = 999_999_999_999_999
+
+var idOnes = []string{"nol", "satu", "dua", "tiga", "empat", "lima", "enam", "tujuh", "delapan", "sembilan", "sepuluh", "sebelas"}
+
+// idCardinal membaca n dalam bahasa Indonesia, misalnya 1.250 menjadi
+// "seribu dua ratus lima puluh".
+func idCardinal(n int64) string {
+	switch {
+	case n < 12:
+		return idOnes[n]
+	case n < 20:
+		return idOnes[n-10] + " belas"
+	case n < 100:
+		return join(idOnes[n/10]+" puluh", n%10, idCardinal)
+	case n < 200:
+		return join("seratus", n-100, idCardinal)
+	case n < 1000:
+		return join(idOnes[n/100]+" ratus", n%100, idCardinal)
+	case n < 2000:
+		return join("seribu", n-1000, idCardinal)
+	}
+	return scale(n, idCardinal, []string{"ribu", "juta", "miliar", "triliun"})
+}
+
+var (
+	enOnes = []string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+		"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	enTens = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+)
+
+// enCardinal reads n in English, for example 1250 as
+// "one thousand two hundred fifty".
+func enCardinal(n int64) string {
+	switch {
+	case n < 20:
+		return enOnes[n]
+	case n < 100:
+		if n%10 == 0 {
+			return enTens[n/10]
+		}
+		return enTens[n/10] + "-" + enOnes[n%10]
+	case n < 1000:
+		return join(enOnes[n/100]+" hundred", n%100, enCardinal)
+	}
+	return scale(n, enCardinal, []string{"thousand", "million", "billion", "trillion"})
+}
+
+// scale reads n of at least 1000 as groups of three digits followed by their
+// scale word.
+func scale(n int64, read func(int64) string, words []string) string {
+	div := int64(1000)
+	i := 0
+	for i+1 < len(words) && n >= div*1000 {
+		div *= 1000
+		i++
+	}
+	return join(read(n/div)+" "+words[i], n%div, read)
+}
+
+func join(head string, rest int64, read func(int64) string) string {
+	if rest == 0 {
+		return head
+	}
+	return head + " " + read(rest)
+}
+
+var enOrdinalWords = map[string]string{
+	"one": "first", "two": "second", "three": "third", "five": "fifth",
+	"eight": "eighth", "nine": "ninth", "twelve": "twelfth",
+}
+
+// enOrdinal reads n as an English ordinal, for example "twenty-first".
+func enOrdinal(n int64) string {
+	words := enCardinal(n)
+	cut := strings.LastIndexAny(words, " -") + 1
+	head, last := words[:cut], words[cut:]
+	if w, ok := enOrdinalWords[last]; ok {
+		return head + w
+	}
+	if strings.HasSuffix(last, "y") {
+		return head + strings.TrimSuffix(last, "y") + "ieth"
+	}
+	return head + last + "th"
+}
+
+// digits reads every digit of s on its own.
+func digits(s string, ones []string) string {
+	words := make([]string, 0, len(s))
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			words = append(words, ones[r-'0'])
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// number reads a number already stripped of group separators, with frac
+// holding the digits after the decimal separator. Numbers with a leading
+// zero, such as phone numbers, are read digit by digit.
+func number(whole, frac string, cardinal func(int64) string, ones []string, point string) string {
+	var b strings.Builder
+	n, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || n > maxCardinal || (len(whole) > 1 && whole[0] == '0') {
+		b.WriteString(digits(whole, ones))
+	} else {
+		b.WriteString(cardinal(n))
+	}
+	if frac != "" {
+		b.WriteString(" " + point + " " + digits(frac, ones))
+	}
+	return b.String()
+}
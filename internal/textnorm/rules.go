@@ -0,0 +1,378 @@
+package textnorm
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Locale holds what the built-in normalizers need to read text in one
+// language.
+type Locale struct {
+	Cardinal func(int64) string
+	// Ordinal reads numbers written with an English ordinal suffix such
+	// as 21st. It is optional.
+	Ordinal func(int64) string
+	// Ones are the words for zero to nine, used to read digits one by one.
+	Ones []string
+	// Group and Decimal are the number separators, e.g. "." and "," for 1.000,5.
+	Group, Decimal string
+	Point          string
+	Percent        string
+	// Date reads a valid calendar date.
+	Date func(year, month, day int) string
+	// DayFirst reads 01/02/2026 as 1 February rather than January 2.
+	DayFirst bool
+	// Time reads a clock time such as 10:30 or 10:30:15. It is optional.
+	Time     func(hour, minute, second int) string
+	Fraction func(num, den int64) string
+	// Currencies maps symbols and ISO codes to how amounts are read.
+	Currencies map[string]Currency
+	// Scales maps amount suffixes such as "jt" to their words.
+	Scales map[string]string
+	// Minor joins the major and minor units, e.g. " and " in "five dollars
+	// and fifty cents".
+	Minor string
+	Dot   string
+	Dash  string
+	At    string
+	// Abbreviations are expanded where they appear as whole words.
+	// Case matters.
+	Abbreviations map[string]string
+	// Emoji names the emoji read aloud with EmojiName.
+	Emoji map[string]string
+}
+
+type Currency struct {
+	One, Many           string
+	MinorOne, MinorMany string
+}
+
+// NewNormalizers returns the built-in normalizers for loc, in the order
+// they take precedence: url, emoji, date, time, currency, percent,
+// fraction, number and abbreviation.
+func NewNormalizers(loc *Locale) []Normalizer {
+	num := numberPattern(loc)
+	symbols := make([]string, 0, len(loc.Currencies))
+	for s := range loc.Currencies {
+		symbols = append(symbols, regexp.QuoteMeta(s))
+	}
+	scales := make([]string, 0, len(loc.Scales))
+	for s := range loc.Scales {
+		scales = append(scales, regexp.QuoteMeta(s))
+	}
+	// Simbol yang lebih panjang dicoba lebih dulu, misalnya "US$" sebelum "$".
+	sortByLength(symbols)
+	sortByLength(scales)
+
+	return []Normalizer{
+		&rule{
+			name:    "url",
+			pattern: regexp.MustCompile(`(?i)\b(?:(?:https?://|www\.)[^\s<>"'()]*[^\s<>"'().,;:!?]|[a-z0-9._%+-]+@[a-z0-9-]+(?:\.[a-z0-9-]+)*\.[a-z]{2,})`),
+			read:    loc.readURL,
+		},
+		&emojiRule{loc: loc},
+		&rule{
+			name:    "date",
+			pattern: regexp.MustCompile(`\b(?:(\d{4})-(\d{2})-(\d{2})|(\d{1,2})[/.-](\d{1,2})[/.-](\d{4}))\b`),
+			read:    loc.readDate,
+		},
+		&rule{
+			name:    "time",
+			pattern: regexp.MustCompile(`\b([01]?\d|2[0-3]):([0-5]\d)(?::([0-5]\d))?\b`),
+			read: func(m []string) (string, bool) {
+				if loc.Time == nil {
+					return "", false
+				}
+				hour, _ := strconv.Atoi(m[1])
+				minute, _ := strconv.Atoi(m[2])
+				second, _ := strconv.Atoi(m[3])
+				return loc.Time(hour, minute, second), true
+			},
+		},
+		&rule{
+			name: "currency",
+			pattern: regexp.MustCompile(`(` + strings.Join(symbols, "|") + `)\s?(` + num + `)` +
+				`(?:\s?(` + strings.Join(scales, "|") + `)\b)?`),
+			read: loc.readCurrency,
+		},
+		&rule{
+			name:    "percent",
+			pattern: regexp.MustCompile(`(` + num + `)\s?%`),
+			read: func(m []string) (string, bool) {
+				return loc.readNumber(m[1]) + " " + loc.Percent, true
+			},
+		},
+		&rule{
+			name:    "fraction",
+			pattern: regexp.MustCompile(`\b(\d{1,3})/(\d{1,3})\b`),
+			read: func(m []string) (string, bool) {
+				num, _ := strconv.ParseInt(m[1], 10, 64)
+				den, _ := strconv.ParseInt(m[2], 10, 64)
+				if den == 0 {
+					return "", false
+				}
+				return loc.Fraction(num, den), true
+			},
+		},
+		&rule{
+			name:    "number",
+			pattern: regexp.MustCompile(`\b(\d+)(?:st|nd|rd|th)\b`),
+			read: func(m []string) (string, bool) {
+				if loc.Ordinal == nil {
+					return "", false
+				}
+				n, err := strconv.ParseInt(m[1], 10, 64)
+				if err != nil || n > maxCardinal {
+					return "", false
+				}
+				return loc.Ordinal(n), true
+			},
+		},
+		&rule{
+			name:    "number",
+			pattern: regexp.MustCompile(num),
+			read: func(m []string) (string, bool) {
+				return loc.readNumber(m[0]), true
+			},
+		},
+		newAbbreviationRule(loc.Abbreviations),
+	}
+}
+
+// rule rewrites every match of pattern that read accepts. read receives the
+// match followed by its submatches.
+type rule struct {
+	name    string
+	pattern *regexp.Regexp
+	read    func(m []string) (string, bool)
+}
+
+func (r *rule) Name() string { return r.name }
+
+func (r *rule) Find(text string, _ *Options) []Match {
+	var matches []Match
+	for _, loc := range r.pattern.FindAllStringSubmatchIndex(text, -1) {
+		m := make([]string, len(loc)/2)
+		for i := range m {
+			if loc[2*i] >= 0 {
+				m[i] = text[loc[2*i]:loc[2*i+1]]
+			}
+		}
+		if replacement, ok := r.read(m); ok {
+			matches = append(matches, Match{Start: loc[0], End: loc[0] + len(m[0]), Replacement: replacement})
+		}
+	}
+	return matches
+}
+
+// numberPattern matches a number written with the separators of loc, such
+// as 1.250.000,5 in Indonesian.
+func numberPattern(loc *Locale) string {
+	g, d := regexp.QuoteMeta(loc.Group), regexp.QuoteMeta(loc.Decimal)
+	return `\d{1,3}(?:` + g + `\d{3})+(?:` + d + `\d+)?|\d+(?:` + d + `\d+)?`
+}
+
+func (loc *Locale) splitNumber(s string) (whole, frac string) {
+	whole, frac, _ = strings.Cut(s, loc.Decimal)
+	return strings.ReplaceAll(whole, loc.Group, ""), frac
+}
+
+func (loc *Locale) readNumber(s string) string {
+	whole, frac := loc.splitNumber(s)
+	return number(whole, frac, loc.Cardinal, loc.Ones, loc.Point)
+}
+
+func (loc *Locale) readCurrency(m []string) (string, bool) {
+	cur, ok := loc.Currencies[m[1]]
+	if !ok {
+		return "", false
+	}
+	whole, frac := loc.splitNumber(m[2])
+	if m[3] != "" {
+		// "Rp1,5 jt" dibaca "satu koma lima juta rupiah".
+		return number(whole, frac, loc.Cardinal, loc.Ones, loc.Point) + " " + loc.Scales[m[3]] + " " + cur.Many, true
+	}
+
+	n, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || n > maxCardinal {
+		return "", false
+	}
+	unit := cur.Many
+	if n == 1 {
+		unit = cur.One
+	}
+	if frac == "" || (cur.MinorMany == "" && strings.Trim(frac, "0") == "") {
+		return loc.Cardinal(n) + " " + unit, true
+	}
+	if cur.MinorMany == "" || len(frac) != 2 {
+		return number(whole, frac, loc.Cardinal, loc.Ones, loc.Point) + " " + cur.Many, true
+	}
+
+	minor, _ := strconv.ParseInt(frac, 10, 64)
+	if minor == 0 {
+		return loc.Cardinal(n) + " " + unit, true
+	}
+	minorUnit := cur.MinorMany
+	if minor == 1 {
+		minorUnit = cur.MinorOne
+	}
+	if n == 0 {
+		return loc.Cardinal(minor) + " " + minorUnit, true
+	}
+	return loc.Cardinal(n) + " " + unit + loc.Minor + loc.Cardinal(minor) + " " + minorUnit, true
+}
+
+func (loc *Locale) readDate(m []string) (string, bool) {
+	var y, mo, d string
+	switch {
+	case m[1] != "":
+		y, mo, d = m[1], m[2], m[3]
+	case loc.DayFirst:
+		d, mo, y = m[4], m[5], m[6]
+	default:
+		mo, d, y = m[4], m[5], m[6]
+	}
+	year, _ := strconv.Atoi(y)
+	month, _ := strconv.Atoi(mo)
+	day, _ := strconv.Atoi(d)
+	if month < 1 || month > 12 || day < 1 || day > daysIn(year, month) {
+		return "", false
+	}
+	return loc.Date(year, month, day), true
+}
+
+func daysIn(year, month int) int {
+	switch month {
+	case 2:
+		if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+			return 29
+		}
+		return 28
+	case 4, 6, 9, 11:
+		return 30
+	}
+	return 31
+}
+
+// readURL reads only the host of a URL, since paths and query strings are
+// noise when spoken, and every part of an email address.
+func (loc *Locale) readURL(m []string) (string, bool) {
+	s := m[0]
+	if local, domain, ok := strings.Cut(s, "@"); ok && !strings.Contains(s, "/") {
+		return loc.spell(local) + " " + loc.At + " " + loc.spell(domain), true
+	}
+
+	host := s
+	if i := strings.Index(host, "://"); i >= 0 {
+		host = host[i+3:]
+	}
+	if i := strings.IndexAny(host, "/?#"); i >= 0 {
+		host = host[:i]
+	}
+	if i := strings.LastIndex(host, "@"); i >= 0 {
+		host = host[i+1:]
+	}
+	if i := strings.LastIndex(host, ":"); i >= 0 {
+		host = host[:i]
+	}
+	host = strings.TrimPrefix(strings.ToLower(host), "www.")
+	if host == "" {
+		return "", false
+	}
+	return loc.spell(host), true
+}
+
+// spell reads the punctuation inside a host name or email address.
+func (loc *Locale) spell(s string) string {
+	r := strings.NewReplacer(".", " "+loc.Dot+" ", "-", " "+loc.Dash+" ", "_", " ")
+	return r.Replace(s)
+}
+
+type abbreviationRule struct {
+	patterns []*regexp.Regexp
+	words    []string
+}
+
+func newAbbreviationRule(abbreviations map[string]string) *abbreviationRule {
+	keys := make([]string, 0, len(abbreviations))
+	for k := range abbreviations {
+		keys = append(keys, k)
+	}
+	sortByLength(keys)
+
+	r := &abbreviationRule{}
+	for _, k := range keys {
+		pattern := regexp.QuoteMeta(k)
+		if last := k[len(k)-1]; last != '.' && last != '/' {
+			pattern += `\b`
+		}
+		r.patterns = append(r.patterns, regexp.MustCompile(pattern))
+		r.words = append(r.words, abbreviations[k])
+	}
+	return r
+}
+
+func (r *abbreviationRule) Name() string { return "abbreviation" }
+
+func (r *abbreviationRule) Find(text string, _ *Options) []Match {
+	var matches []Match
+	for i, p := range r.patterns {
+		for _, loc := range p.FindAllStringIndex(text, -1) {
+			// Angka boleh menempel di depan, seperti "5kg".
+			if r, _ := utf8.DecodeLastRuneInString(text[:loc[0]]); unicode.IsLetter(r) {
+				continue
+			}
+			matches = append(matches, Match{Start: loc[0], End: loc[1], Replacement: r.words[i]})
+		}
+	}
+	return matches
+}
+
+// emojiPattern matches one emoji including its modifiers, keycaps, flags
+// and zero-width-joiner sequences.
+var emojiPattern = regexp.MustCompile(
+	`(?:[\x{1F1E6}-\x{1F1FF}]{2}|[#*0-9]\x{FE0F}?\x{20E3}|` +
+		`[\x{1F000}-\x{1FAFF}\x{2600}-\x{27BF}\x{2B00}-\x{2BFF}\x{2300}-\x{23FF}\x{3030}\x{303D}\x{3297}\x{3299}]` +
+		`[\x{FE0F}\x{1F3FB}-\x{1F3FF}]*(?:\x{200D}[\x{1F000}-\x{1FAFF}\x{2600}-\x{27BF}\x{2B00}-\x{2BFF}][\x{FE0F}\x{1F3FB}-\x{1F3FF}]*)*)`)
+
+type emojiRule struct {
+	loc *Locale
+}
+
+func (r *emojiRule) Name() string { return "emoji" }
+
+func (r *emojiRule) Find(text string, opts *Options) []Match {
+	var matches []Match
+	for _, loc := range emojiPattern.FindAllStringIndex(text, -1) {
+		m := Match{Start: loc[0], End: loc[1]}
+		if opts.Emoji == EmojiName {
+			m.Replacement = r.loc.Emoji[stripModifiers(text[loc[0]:loc[1]])]
+		}
+		matches = append(matches, m)
+	}
+	return matches
+}
+
+// stripModifiers drops variation selectors and skin tones so 👍🏽 reads
+// like 👍.
+func stripModifiers(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == 0xFE0F || (r >= 0x1F3FB && r <= 0x1F3FF) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func sortByLength(s []string) {
+	sort.Slice(s, func(i, j int) bool {
+		if len(s[i]) != len(s[j]) {
+			return len(s[i]) > len(s[j])
+		}
+		return s[i] < s[j]
+	})
+}
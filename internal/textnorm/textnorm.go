@@ -0,0 +1,218 @@
+// Package textnorm rewrites text that speech engines read badly, such as
+// amounts, dates, URLs and emoji, into the words a person would say. Rules
+// are registered per language.
+package textnorm
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrUnsupportedLanguage is returned for languages without registered rules.
+var ErrUnsupportedLanguage = errors.New("unsupported language")
+
+// EmojiMode decides what happens to emoji.
+type EmojiMode string
+
+const (
+	// EmojiName reads known emoji by name and removes the others.
+	EmojiName EmojiMode = "name"
+	// EmojiStrip removes every emoji.
+	EmojiStrip EmojiMode = "strip"
+)
+
+// Options select the rules to apply. An empty Rules applies all of them.
+type Options struct {
+	Language string
+	Rules    []string
+	Emoji    EmojiMode
+}
+
+// Match is a span of text, in byte offsets, and what to read instead.
+type Match struct {
+	Start       int
+	End         int
+	Replacement string
+}
+
+// Normalizer finds the spans of text it rewrites.
+type Normalizer interface {
+	Name() string
+	Find(text string, opts *Options) []Match
+}
+
+// Change is one applied rewrite. Start and End are rune offsets in the
+// original text.
+type Change struct {
+	Rule        string `json:"rule"`
+	Start       int    `json:"start"`
+	End         int    `json:"end"`
+	Original    string `json:"original"`
+	Replacement string `json:"replacement"`
+}
+
+type Result struct {
+	Language string   `json:"language"`
+	Text     string   `json:"text"`
+	Changes  []Change `json:"changes"`
+}
+
+var registry = struct {
+	sync.RWMutex
+	rules map[string][]Normalizer
+}{rules: make(map[string][]Normalizer)}
+
+// Register adds normalizers for lang, a primary language subtag such as
+// "id". Earlier normalizers win when matches overlap.
+func Register(lang string, normalizers ...Normalizer) {
+	registry.Lock()
+	defer registry.Unlock()
+	lang = strings.ToLower(lang)
+	registry.rules[lang] = append(registry.rules[lang], normalizers...)
+}
+
+// Languages returns the languages with registered rules.
+func Languages() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+	langs := make([]string, 0, len(registry.rules))
+	for lang := range registry.rules {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// Rules returns the names of the rules of lang, in the order they apply.
+func Rules(lang string) ([]string, error) {
+	rules, err := rulesFor(lang)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(rules))
+	for i, r := range rules {
+		names[i] = r.Name()
+	}
+	return names, nil
+}
+
+// Normalize applies the rules of opts.Language to text in a single pass, so
+// replaced text is never rewritten again. A BCP 47 tag such as "id-ID" uses
+// the rules of its primary language.
+func Normalize(text string, opts Options) (*Result, error) {
+	rules, err := rulesFor(opts.Language)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Emoji == "" {
+		opts.Emoji = EmojiName
+	}
+
+	type candidate struct {
+		Match
+		rule  string
+		order int
+	}
+	var candidates []candidate
+	for i, r := range rules {
+		if len(opts.Rules) > 0 && !contains(opts.Rules, r.Name()) {
+			continue
+		}
+		for _, m := range r.Find(text, &opts) {
+			candidates = append(candidates, candidate{Match: m, rule: r.Name(), order: i})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.Start != b.Start {
+			return a.Start < b.Start
+		}
+		if a.order != b.order {
+			return a.order < b.order
+		}
+		return a.End > b.End
+	})
+
+	res := &Result{Language: primary(opts.Language), Changes: []Change{}}
+	var b []byte
+	pos, runePos := 0, 0
+	for _, c := range candidates {
+		if c.Start < pos {
+			continue
+		}
+		b = append(b, text[pos:c.Start]...)
+		runePos += utf8.RuneCountInString(text[pos:c.Start])
+
+		replacement := c.Replacement
+		next, _ := utf8.DecodeRuneInString(text[c.End:])
+		if replacement == "" {
+			// Spasi sebelum emoji yang dibuang tidak perlu tersisa di akhir baris.
+			if next == utf8.RuneError || next == '\n' {
+				b = []byte(strings.TrimRight(string(b), " \t"))
+			}
+		} else {
+			if r, _ := utf8.DecodeLastRune(b); isWordRune(r) {
+				replacement = " " + replacement
+			}
+			if isWordRune(next) {
+				replacement += " "
+			}
+		}
+		b = append(b, replacement...)
+
+		original := text[c.Start:c.End]
+		n := utf8.RuneCountInString(original)
+		res.Changes = append(res.Changes, Change{
+			Rule:        c.rule,
+			Start:       runePos,
+			End:         runePos + n,
+			Original:    original,
+			Replacement: c.Replacement,
+		})
+		runePos += n
+		pos = c.End
+	}
+	b = append(b, text[pos:]...)
+
+	res.Text = string(b)
+	if len(res.Changes) > 0 {
+		res.Text = spaces.ReplaceAllString(res.Text, " ")
+	}
+	return res, nil
+}
+
+var spaces = regexp.MustCompile(`[ \t]{2,}`)
+
+func rulesFor(lang string) ([]Normalizer, error) {
+	registry.RLock()
+	defer registry.RUnlock()
+	rules, ok := registry.rules[primary(lang)]
+	if !ok {
+		return nil, ErrUnsupportedLanguage
+	}
+	return rules, nil
+}
+
+// primary returns the primary language subtag of a BCP 47 tag.
+func primary(tag string) string {
+	lang, _, _ := strings.Cut(strings.ToLower(tag), "-")
+	return lang
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func contains(list []string, v string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
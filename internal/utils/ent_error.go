@@ -7,10 +7,39 @@ import (
 
 // Custom errors
 var (
-	ErrEmailAlreadyExists = errors.New("email already exists")
-	ErrUserNotFound       = errors.New("user not found")
-	ErrInvalidData        = errors.New("invalid data")
-	ErrInvalidCredentials = errors.New("invalid email or password")
+	ErrEmailAlreadyExists     = errors.New("email already exists")
+	ErrUserNotFound           = errors.New("user not found")
+	ErrInvalidData            = errors.New("invalid data")
+	ErrInvalidCredentials     = errors.New("invalid email or password")
+	ErrHistoryNotFound        = errors.New("history not found")
+	ErrRevisionNotFound       = errors.New("revision not found")
+	ErrTagNotFound            = errors.New("tag not found")
+	ErrTagAlreadyExists       = errors.New("tag already exists")
+	ErrFolderNotFound         = errors.New("folder not found")
+	ErrFolderExists           = errors.New("folder already exists")
+	ErrFolderCycle            = errors.New("folder cannot be moved into itself or its descendants")
+	ErrNotDuplicate           = errors.New("histories are not duplicates of each other")
+	ErrVoiceNotFound          = errors.New("voice is not in the catalog")
+	ErrPresetNotFound         = errors.New("preset not found")
+	ErrPresetExists           = errors.New("preset with this name already exists")
+	ErrInvalidStatsRange      = errors.New("from must not be after to")
+	ErrStatsRangeTooLarge     = errors.New("stats range has too many buckets")
+	ErrInvalidTimezone        = errors.New("tz must be an IANA time zone such as Asia/Jakarta")
+	ErrCharacterQuotaExceeded = errors.New("monthly character quota exceeded")
+	ErrEntryQuotaExceeded     = errors.New("monthly entry limit reached")
+	ErrLexiconEntryNotFound   = errors.New("lexicon entry not found")
+	ErrLexiconEntryExists     = errors.New("lexicon entry for this grapheme already exists")
+	ErrLexiconFull            = errors.New("lexicon has reached its maximum number of entries")
+	ErrInvalidPLS             = errors.New("invalid PLS document")
+	ErrTemplateNotFound       = errors.New("template not found")
+	ErrTemplateExists         = errors.New("template with this name already exists")
+	ErrModerationRuleNotFound = errors.New("moderation rule not found")
+	ErrModerationRuleExists   = errors.New("moderation rule with this pattern already exists")
+	ErrModerationRulesFull    = errors.New("moderation rules have reached their maximum number")
+	ErrInvalidPattern         = errors.New("invalid pattern")
+
+	ErrIdempotencyKeyMismatch   = errors.New("idempotency key reused with a different request")
+	ErrIdempotencyKeyInProgress = errors.New("idempotency key request still in progress")
 )
 
 // MapEntError converts Ent/DB errors to custom errors
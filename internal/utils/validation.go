@@ -3,6 +3,7 @@ package utils
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -27,12 +28,19 @@ func FormatValidationErrors(err error) []string {
 
 func formatFieldError(fe validator.FieldError) string {
 	switch fe.Tag() {
-	case "required":
+	case "required", "required_without", "notblank":
 		return fmt.Sprintf("%s is required", fe.Field())
 	case "min":
 		return fmt.Sprintf("%s must be at least %s", fe.Field(), fe.Param())
 	case "max":
 		return fmt.Sprintf("%s must be at most %s", fe.Field(), fe.Param())
+	case "excluded_with":
+		return fmt.Sprintf("%s cannot be combined with %s", fe.Field(), fe.Param())
+	case "excluded_if":
+		field, value, _ := strings.Cut(fe.Param(), " ")
+		return fmt.Sprintf("%s cannot be set when %s is %s", fe.Field(), field, value)
+	case "hexrgb":
+		return fmt.Sprintf("%s must be a color like #RGB or #RRGGBB", fe.Field())
 	case "uuid4":
 		return fmt.Sprintf("%s must be a valid UUID", fe.Field())
 	default: